@@ -23,6 +23,10 @@ docker/build: \
 	docker/build/gateway-filter \
 	docker/build/manager-index \
 	docker/build/filter-ingress-tensorflow \
+	docker/build/filter-ingress-onnx \
+	docker/build/filter-ingress-transform \
+	docker/build/filter-ingress-http \
+	docker/build/filter-egress-enrich \
 	docker/build/helm-operator
 
 .PHONY: docker/name/org
@@ -152,6 +156,66 @@ docker/build/filter-ingress-tensorflow:
 	    --build-arg DISTROLESS_IMAGE_TAG=$(DISTROLESS_IMAGE_TAG) \
 	    --build-arg MAINTAINER=$(MAINTAINER)
 
+.PHONY: docker/name/filter-ingress-onnx
+docker/name/filter-ingress-onnx:
+	@echo "$(ORG)/$(FILTER_INGRESS_ONNX_IMAGE)"
+
+.PHONY: docker/build/filter-ingress-onnx
+## build filter-ingress-onnx image
+docker/build/filter-ingress-onnx:
+	$(DOCKER) build \
+	    $(DOCKER_OPTS) \
+	    -f dockers/filter/ingress/onnx/Dockerfile \
+	    -t $(ORG)/$(FILTER_INGRESS_ONNX_IMAGE):$(TAG) . \
+	    --build-arg GO_VERSION=$(GO_VERSION) \
+	    --build-arg DISTROLESS_IMAGE_TAG=$(DISTROLESS_IMAGE_TAG) \
+	    --build-arg MAINTAINER=$(MAINTAINER)
+
+.PHONY: docker/name/filter-ingress-transform
+docker/name/filter-ingress-transform:
+	@echo "$(ORG)/$(FILTER_INGRESS_TRANSFORM_IMAGE)"
+
+.PHONY: docker/build/filter-ingress-transform
+## build filter-ingress-transform image
+docker/build/filter-ingress-transform:
+	$(DOCKER) build \
+	    $(DOCKER_OPTS) \
+	    -f dockers/filter/ingress/transform/Dockerfile \
+	    -t $(ORG)/$(FILTER_INGRESS_TRANSFORM_IMAGE):$(TAG) . \
+	    --build-arg GO_VERSION=$(GO_VERSION) \
+	    --build-arg DISTROLESS_IMAGE_TAG=$(DISTROLESS_IMAGE_TAG) \
+	    --build-arg MAINTAINER=$(MAINTAINER)
+
+.PHONY: docker/name/filter-ingress-http
+docker/name/filter-ingress-http:
+	@echo "$(ORG)/$(FILTER_INGRESS_HTTP_IMAGE)"
+
+.PHONY: docker/build/filter-ingress-http
+## build filter-ingress-http image
+docker/build/filter-ingress-http:
+	$(DOCKER) build \
+	    $(DOCKER_OPTS) \
+	    -f dockers/filter/ingress/http/Dockerfile \
+	    -t $(ORG)/$(FILTER_INGRESS_HTTP_IMAGE):$(TAG) . \
+	    --build-arg GO_VERSION=$(GO_VERSION) \
+	    --build-arg DISTROLESS_IMAGE_TAG=$(DISTROLESS_IMAGE_TAG) \
+	    --build-arg MAINTAINER=$(MAINTAINER)
+
+.PHONY: docker/name/filter-egress-enrich
+docker/name/filter-egress-enrich:
+	@echo "$(ORG)/$(FILTER_EGRESS_ENRICH_IMAGE)"
+
+.PHONY: docker/build/filter-egress-enrich
+## build filter-egress-enrich image
+docker/build/filter-egress-enrich:
+	$(DOCKER) build \
+	    $(DOCKER_OPTS) \
+	    -f dockers/filter/egress/enrich/Dockerfile \
+	    -t $(ORG)/$(FILTER_EGRESS_ENRICH_IMAGE):$(TAG) . \
+	    --build-arg GO_VERSION=$(GO_VERSION) \
+	    --build-arg DISTROLESS_IMAGE_TAG=$(DISTROLESS_IMAGE_TAG) \
+	    --build-arg MAINTAINER=$(MAINTAINER)
+
 .PHONY: docker/name/ci-container
 docker/name/ci-container:
 	@echo "$(ORG)/$(CI_CONTAINER_IMAGE)"
@@ -50,7 +50,7 @@ var file_apis_proto_v1_agent_core_agent_proto_rawDesc = []byte{
 	0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x32, 0xf2, 0x02, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x5f, 0x0a,
+	0x6f, 0x74, 0x6f, 0x32, 0xdd, 0x03, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x5f, 0x0a,
 	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x2e, 0x70,
 	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
 	0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71,
@@ -73,31 +73,42 @@ var file_apis_proto_v1_agent_core_agent_proto_rawDesc = []byte{
 	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
 	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x43, 0x6f,
 	0x75, 0x6e, 0x74, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x5e, 0x0a, 0x20, 0x6f, 0x72, 0x67, 0x2e,
-	0x76, 0x64, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x61, 0x6c, 0x64, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x09, 0x56, 0x61,
-	0x6c, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x64,
-	0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x65, 0x78, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x69, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x55, 0x55, 0x49, 0x44, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x55,
+	0x55, 0x49, 0x44, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x73, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x22, 0x0c, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x75, 0x75, 0x69, 0x64, 0x73,
+	0x3a, 0x01, 0x2a, 0x42, 0x5e, 0x0a, 0x20, 0x6f, 0x72, 0x67, 0x2e, 0x76, 0x64, 0x61, 0x61, 0x73,
+	0x2e, 0x76, 0x61, 0x6c, 0x64, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x09, 0x56, 0x61, 0x6c, 0x64, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x73,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_apis_proto_v1_agent_core_agent_proto_goTypes = []interface{}{
 	(*payload.Control_CreateIndexRequest)(nil), // 0: payload.v1.Control.CreateIndexRequest
 	(*payload.Empty)(nil),                      // 1: payload.v1.Empty
-	(*payload.Info_Index_Count)(nil),           // 2: payload.v1.Info.Index.Count
+	(*payload.Info_Index_UUIDs_Request)(nil),   // 2: payload.v1.Info.Index.UUIDs.Request
+	(*payload.Info_Index_Count)(nil),           // 3: payload.v1.Info.Index.Count
+	(*payload.Info_Index_UUIDs)(nil),           // 4: payload.v1.Info.Index.UUIDs
 }
 var file_apis_proto_v1_agent_core_agent_proto_depIdxs = []int32{
 	0, // 0: core.v1.Agent.CreateIndex:input_type -> payload.v1.Control.CreateIndexRequest
 	1, // 1: core.v1.Agent.SaveIndex:input_type -> payload.v1.Empty
 	0, // 2: core.v1.Agent.CreateAndSaveIndex:input_type -> payload.v1.Control.CreateIndexRequest
 	1, // 3: core.v1.Agent.IndexInfo:input_type -> payload.v1.Empty
-	1, // 4: core.v1.Agent.CreateIndex:output_type -> payload.v1.Empty
-	1, // 5: core.v1.Agent.SaveIndex:output_type -> payload.v1.Empty
-	1, // 6: core.v1.Agent.CreateAndSaveIndex:output_type -> payload.v1.Empty
-	2, // 7: core.v1.Agent.IndexInfo:output_type -> payload.v1.Info.Index.Count
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
+	2, // 4: core.v1.Agent.IndexUUIDs:input_type -> payload.v1.Info.Index.UUIDs.Request
+	1, // 5: core.v1.Agent.CreateIndex:output_type -> payload.v1.Empty
+	1, // 6: core.v1.Agent.SaveIndex:output_type -> payload.v1.Empty
+	1, // 7: core.v1.Agent.CreateAndSaveIndex:output_type -> payload.v1.Empty
+	3, // 8: core.v1.Agent.IndexInfo:output_type -> payload.v1.Info.Index.Count
+	4, // 9: core.v1.Agent.IndexUUIDs:output_type -> payload.v1.Info.Index.UUIDs
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -50,6 +50,8 @@ type AgentClient interface {
 	CreateAndSaveIndex(ctx context.Context, in *payload.Control_CreateIndexRequest, opts ...grpc.CallOption) (*payload.Empty, error)
 	// Represent the RPC to get the agent index information.
 	IndexInfo(ctx context.Context, in *payload.Empty, opts ...grpc.CallOption) (*payload.Info_Index_Count, error)
+	// Represent the RPC to get the agent index UUIDs.
+	IndexUUIDs(ctx context.Context, in *payload.Info_Index_UUIDs_Request, opts ...grpc.CallOption) (*payload.Info_Index_UUIDs, error)
 }
 
 type agentClient struct {
@@ -96,6 +98,15 @@ func (c *agentClient) IndexInfo(ctx context.Context, in *payload.Empty, opts ...
 	return out, nil
 }
 
+func (c *agentClient) IndexUUIDs(ctx context.Context, in *payload.Info_Index_UUIDs_Request, opts ...grpc.CallOption) (*payload.Info_Index_UUIDs, error) {
+	out := new(payload.Info_Index_UUIDs)
+	err := c.cc.Invoke(ctx, "/core.v1.Agent/IndexUUIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations must embed UnimplementedAgentServer
 // for forward compatibility
@@ -108,6 +119,8 @@ type AgentServer interface {
 	CreateAndSaveIndex(context.Context, *payload.Control_CreateIndexRequest) (*payload.Empty, error)
 	// Represent the RPC to get the agent index information.
 	IndexInfo(context.Context, *payload.Empty) (*payload.Info_Index_Count, error)
+	// Represent the RPC to get the agent index UUIDs.
+	IndexUUIDs(context.Context, *payload.Info_Index_UUIDs_Request) (*payload.Info_Index_UUIDs, error)
 	mustEmbedUnimplementedAgentServer()
 }
 
@@ -127,6 +140,9 @@ func (UnimplementedAgentServer) CreateAndSaveIndex(context.Context, *payload.Con
 func (UnimplementedAgentServer) IndexInfo(context.Context, *payload.Empty) (*payload.Info_Index_Count, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IndexInfo not implemented")
 }
+func (UnimplementedAgentServer) IndexUUIDs(context.Context, *payload.Info_Index_UUIDs_Request) (*payload.Info_Index_UUIDs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexUUIDs not implemented")
+}
 func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
 
 // UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
@@ -212,6 +228,24 @@ func _Agent_IndexInfo_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_IndexUUIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Info_Index_UUIDs_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).IndexUUIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/core.v1.Agent/IndexUUIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).IndexUUIDs(ctx, req.(*payload.Info_Index_UUIDs_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -235,6 +269,10 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IndexInfo",
 			Handler:    _Agent_IndexInfo_Handler,
 		},
+		{
+			MethodName: "IndexUUIDs",
+			Handler:    _Agent_IndexUUIDs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apis/proto/v1/agent/core/agent.proto",
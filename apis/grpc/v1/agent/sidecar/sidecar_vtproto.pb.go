@@ -17,6 +17,11 @@
 package sidecar
 
 import (
+	context "context"
+
+	payload "github.com/vdaas/vald/apis/grpc/v1/payload"
+	codes "github.com/vdaas/vald/internal/net/grpc/codes"
+	status "github.com/vdaas/vald/internal/net/grpc/status"
 	grpc "google.golang.org/grpc"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 )
@@ -37,6 +42,16 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SidecarClient interface {
+	// Represent the RPC to back up the agent index immediately.
+	Backup(ctx context.Context, in *payload.Backup_Request, opts ...grpc.CallOption) (*payload.Backup_Info, error)
+	// Represent the RPC to list the restorable backups.
+	ListBackups(ctx context.Context, in *payload.Empty, opts ...grpc.CallOption) (*payload.Backup_Infos, error)
+	// Represent the RPC to restore the agent index from the backup.
+	// It is refused while the agent is running, and the agent loads the
+	// restored index when it starts.
+	Restore(ctx context.Context, in *payload.Backup_RestoreRequest, opts ...grpc.CallOption) (*payload.Empty, error)
+	// Represent the RPC to get the backup status.
+	Status(ctx context.Context, in *payload.Empty, opts ...grpc.CallOption) (*payload.Backup_Status, error)
 }
 
 type sidecarClient struct {
@@ -47,10 +62,56 @@ func NewSidecarClient(cc grpc.ClientConnInterface) SidecarClient {
 	return &sidecarClient{cc}
 }
 
+func (c *sidecarClient) Backup(ctx context.Context, in *payload.Backup_Request, opts ...grpc.CallOption) (*payload.Backup_Info, error) {
+	out := new(payload.Backup_Info)
+	err := c.cc.Invoke(ctx, "/sidecar.v1.Sidecar/Backup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) ListBackups(ctx context.Context, in *payload.Empty, opts ...grpc.CallOption) (*payload.Backup_Infos, error) {
+	out := new(payload.Backup_Infos)
+	err := c.cc.Invoke(ctx, "/sidecar.v1.Sidecar/ListBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) Restore(ctx context.Context, in *payload.Backup_RestoreRequest, opts ...grpc.CallOption) (*payload.Empty, error) {
+	out := new(payload.Empty)
+	err := c.cc.Invoke(ctx, "/sidecar.v1.Sidecar/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) Status(ctx context.Context, in *payload.Empty, opts ...grpc.CallOption) (*payload.Backup_Status, error) {
+	out := new(payload.Backup_Status)
+	err := c.cc.Invoke(ctx, "/sidecar.v1.Sidecar/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SidecarServer is the server API for Sidecar service.
 // All implementations must embed UnimplementedSidecarServer
 // for forward compatibility
 type SidecarServer interface {
+	// Represent the RPC to back up the agent index immediately.
+	Backup(context.Context, *payload.Backup_Request) (*payload.Backup_Info, error)
+	// Represent the RPC to list the restorable backups.
+	ListBackups(context.Context, *payload.Empty) (*payload.Backup_Infos, error)
+	// Represent the RPC to restore the agent index from the backup.
+	// It is refused while the agent is running, and the agent loads the
+	// restored index when it starts.
+	Restore(context.Context, *payload.Backup_RestoreRequest) (*payload.Empty, error)
+	// Represent the RPC to get the backup status.
+	Status(context.Context, *payload.Empty) (*payload.Backup_Status, error)
 	mustEmbedUnimplementedSidecarServer()
 }
 
@@ -58,6 +119,18 @@ type SidecarServer interface {
 type UnimplementedSidecarServer struct {
 }
 
+func (UnimplementedSidecarServer) Backup(context.Context, *payload.Backup_Request) (*payload.Backup_Info, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (UnimplementedSidecarServer) ListBackups(context.Context, *payload.Empty) (*payload.Backup_Infos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (UnimplementedSidecarServer) Restore(context.Context, *payload.Backup_RestoreRequest) (*payload.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedSidecarServer) Status(context.Context, *payload.Empty) (*payload.Backup_Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
 func (UnimplementedSidecarServer) mustEmbedUnimplementedSidecarServer() {}
 
 // UnsafeSidecarServer may be embedded to opt out of forward compatibility for this service.
@@ -71,13 +144,102 @@ func RegisterSidecarServer(s grpc.ServiceRegistrar, srv SidecarServer) {
 	s.RegisterService(&Sidecar_ServiceDesc, srv)
 }
 
+func _Sidecar_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Backup_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidecar.v1.Sidecar/Backup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).Backup(ctx, req.(*payload.Backup_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidecar.v1.Sidecar/ListBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).ListBackups(ctx, req.(*payload.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Backup_RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidecar.v1.Sidecar/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).Restore(ctx, req.(*payload.Backup_RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidecar.v1.Sidecar/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).Status(ctx, req.(*payload.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Sidecar_ServiceDesc is the grpc.ServiceDesc for Sidecar service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Sidecar_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "sidecar.v1.Sidecar",
 	HandlerType: (*SidecarServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "apis/proto/v1/agent/sidecar/sidecar.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Backup",
+			Handler:    _Sidecar_Backup_Handler,
+		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _Sidecar_ListBackups_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Sidecar_Restore_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Sidecar_Status_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apis/proto/v1/agent/sidecar/sidecar.proto",
 }
@@ -51,7 +51,7 @@ var file_apis_proto_v1_filter_egress_egress_filter_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xe0, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xe1, 0x02, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74,
 	0x65, 0x72, 0x12, 0x6e, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74,
 	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
 	0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
@@ -59,33 +59,45 @@ var file_apis_proto_v1_filter_egress_egress_filter_proto_rawDesc = []byte{
 	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x22,
 	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2f,
 	0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x3a,
-	0x01, 0x2a, 0x12, 0x66, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x19, 0x2e,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
-	0x22, 0x15, 0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2f, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x01, 0x2a, 0x42, 0x6b, 0x0a, 0x23, 0x6f, 0x72,
-	0x67, 0x2e, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x61, 0x6c, 0x64, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x65, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x42, 0x10, 0x56, 0x61, 0x6c, 0x64, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x64, 0x2f, 0x61, 0x70, 0x69,
-	0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x2f, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x14, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2f, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x3a, 0x01, 0x2a, 0x12, 0x66, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x19,
+	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x22, 0x15, 0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2f, 0x65, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x01, 0x2a, 0x42, 0x6b, 0x0a, 0x23, 0x6f,
+	0x72, 0x67, 0x2e, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x61, 0x6c, 0x64, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x10, 0x56, 0x61, 0x6c, 0x64, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x64, 0x2f, 0x61, 0x70,
+	0x69, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x2f, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_apis_proto_v1_filter_egress_egress_filter_proto_goTypes = []interface{}{
-	(*payload.Object_Distance)(nil), // 0: payload.v1.Object.Distance
-	(*payload.Object_Vector)(nil),   // 1: payload.v1.Object.Vector
+	(*payload.Object_Distance)(nil),              // 0: payload.v1.Object.Distance
+	(*payload.Filter_SearchResponseRequest)(nil), // 1: payload.v1.Filter.SearchResponseRequest
+	(*payload.Object_Vector)(nil),                // 2: payload.v1.Object.Vector
+	(*payload.Search_Response)(nil),              // 3: payload.v1.Search.Response
 }
 var file_apis_proto_v1_filter_egress_egress_filter_proto_depIdxs = []int32{
 	0, // 0: filter.egress.v1.Filter.FilterDistance:input_type -> payload.v1.Object.Distance
-	1, // 1: filter.egress.v1.Filter.FilterVector:input_type -> payload.v1.Object.Vector
-	0, // 2: filter.egress.v1.Filter.FilterDistance:output_type -> payload.v1.Object.Distance
-	1, // 3: filter.egress.v1.Filter.FilterVector:output_type -> payload.v1.Object.Vector
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	1, // 1: filter.egress.v1.Filter.FilterSearchResponse:input_type -> payload.v1.Filter.SearchResponseRequest
+	2, // 2: filter.egress.v1.Filter.FilterVector:input_type -> payload.v1.Object.Vector
+	0, // 3: filter.egress.v1.Filter.FilterDistance:output_type -> payload.v1.Object.Distance
+	3, // 4: filter.egress.v1.Filter.FilterSearchResponse:output_type -> payload.v1.Search.Response
+	2, // 5: filter.egress.v1.Filter.FilterVector:output_type -> payload.v1.Object.Vector
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -44,6 +44,8 @@ const _ = grpc.SupportPackageIsVersion7
 type FilterClient interface {
 	// Represent the RPC to filter the distance.
 	FilterDistance(ctx context.Context, in *payload.Object_Distance, opts ...grpc.CallOption) (*payload.Object_Distance, error)
+	// Represent the RPC to filter, re-score and re-order a whole search response.
+	FilterSearchResponse(ctx context.Context, in *payload.Filter_SearchResponseRequest, opts ...grpc.CallOption) (*payload.Search_Response, error)
 	// Represent the RPC to filter the vector.
 	FilterVector(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (*payload.Object_Vector, error)
 }
@@ -65,6 +67,15 @@ func (c *filterClient) FilterDistance(ctx context.Context, in *payload.Object_Di
 	return out, nil
 }
 
+func (c *filterClient) FilterSearchResponse(ctx context.Context, in *payload.Filter_SearchResponseRequest, opts ...grpc.CallOption) (*payload.Search_Response, error) {
+	out := new(payload.Search_Response)
+	err := c.cc.Invoke(ctx, "/filter.egress.v1.Filter/FilterSearchResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *filterClient) FilterVector(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (*payload.Object_Vector, error) {
 	out := new(payload.Object_Vector)
 	err := c.cc.Invoke(ctx, "/filter.egress.v1.Filter/FilterVector", in, out, opts...)
@@ -80,6 +91,8 @@ func (c *filterClient) FilterVector(ctx context.Context, in *payload.Object_Vect
 type FilterServer interface {
 	// Represent the RPC to filter the distance.
 	FilterDistance(context.Context, *payload.Object_Distance) (*payload.Object_Distance, error)
+	// Represent the RPC to filter, re-score and re-order a whole search response.
+	FilterSearchResponse(context.Context, *payload.Filter_SearchResponseRequest) (*payload.Search_Response, error)
 	// Represent the RPC to filter the vector.
 	FilterVector(context.Context, *payload.Object_Vector) (*payload.Object_Vector, error)
 	mustEmbedUnimplementedFilterServer()
@@ -92,6 +105,9 @@ type UnimplementedFilterServer struct {
 func (UnimplementedFilterServer) FilterDistance(context.Context, *payload.Object_Distance) (*payload.Object_Distance, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FilterDistance not implemented")
 }
+func (UnimplementedFilterServer) FilterSearchResponse(context.Context, *payload.Filter_SearchResponseRequest) (*payload.Search_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterSearchResponse not implemented")
+}
 func (UnimplementedFilterServer) FilterVector(context.Context, *payload.Object_Vector) (*payload.Object_Vector, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FilterVector not implemented")
 }
@@ -126,6 +142,24 @@ func _Filter_FilterDistance_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Filter_FilterSearchResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Filter_SearchResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilterServer).FilterSearchResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filter.egress.v1.Filter/FilterSearchResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilterServer).FilterSearchResponse(ctx, req.(*payload.Filter_SearchResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Filter_FilterVector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(payload.Object_Vector)
 	if err := dec(in); err != nil {
@@ -155,6 +189,10 @@ var Filter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FilterDistance",
 			Handler:    _Filter_FilterDistance_Handler,
 		},
+		{
+			MethodName: "FilterSearchResponse",
+			Handler:    _Filter_FilterSearchResponse_Handler,
+		},
 		{
 			MethodName: "FilterVector",
 			Handler:    _Filter_FilterVector_Handler,
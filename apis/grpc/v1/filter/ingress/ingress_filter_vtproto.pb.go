@@ -44,6 +44,8 @@ const _ = grpc.SupportPackageIsVersion7
 type FilterClient interface {
 	// Represent the RPC to generate the vector.
 	GenVector(ctx context.Context, in *payload.Object_Blob, opts ...grpc.CallOption) (*payload.Object_Vector, error)
+	// Represent the RPC to generate multiple vectors at once.
+	GenVectors(ctx context.Context, in *payload.Object_Blobs, opts ...grpc.CallOption) (*payload.Object_Vectors, error)
 	// Represent the RPC to filter the vector.
 	FilterVector(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (*payload.Object_Vector, error)
 }
@@ -65,6 +67,15 @@ func (c *filterClient) GenVector(ctx context.Context, in *payload.Object_Blob, o
 	return out, nil
 }
 
+func (c *filterClient) GenVectors(ctx context.Context, in *payload.Object_Blobs, opts ...grpc.CallOption) (*payload.Object_Vectors, error) {
+	out := new(payload.Object_Vectors)
+	err := c.cc.Invoke(ctx, "/filter.ingress.v1.Filter/GenVectors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *filterClient) FilterVector(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (*payload.Object_Vector, error) {
 	out := new(payload.Object_Vector)
 	err := c.cc.Invoke(ctx, "/filter.ingress.v1.Filter/FilterVector", in, out, opts...)
@@ -80,6 +91,8 @@ func (c *filterClient) FilterVector(ctx context.Context, in *payload.Object_Vect
 type FilterServer interface {
 	// Represent the RPC to generate the vector.
 	GenVector(context.Context, *payload.Object_Blob) (*payload.Object_Vector, error)
+	// Represent the RPC to generate multiple vectors at once.
+	GenVectors(context.Context, *payload.Object_Blobs) (*payload.Object_Vectors, error)
 	// Represent the RPC to filter the vector.
 	FilterVector(context.Context, *payload.Object_Vector) (*payload.Object_Vector, error)
 	mustEmbedUnimplementedFilterServer()
@@ -92,6 +105,9 @@ type UnimplementedFilterServer struct {
 func (UnimplementedFilterServer) GenVector(context.Context, *payload.Object_Blob) (*payload.Object_Vector, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenVector not implemented")
 }
+func (UnimplementedFilterServer) GenVectors(context.Context, *payload.Object_Blobs) (*payload.Object_Vectors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenVectors not implemented")
+}
 func (UnimplementedFilterServer) FilterVector(context.Context, *payload.Object_Vector) (*payload.Object_Vector, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FilterVector not implemented")
 }
@@ -126,6 +142,24 @@ func _Filter_GenVector_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Filter_GenVectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Object_Blobs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilterServer).GenVectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filter.ingress.v1.Filter/GenVectors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilterServer).GenVectors(ctx, req.(*payload.Object_Blobs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Filter_FilterVector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(payload.Object_Vector)
 	if err := dec(in); err != nil {
@@ -155,6 +189,10 @@ var Filter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenVector",
 			Handler:    _Filter_GenVector_Handler,
 		},
+		{
+			MethodName: "GenVectors",
+			Handler:    _Filter_GenVectors_Handler,
+		},
 		{
 			MethodName: "FilterVector",
 			Handler:    _Filter_FilterVector_Handler,
@@ -44,6 +44,8 @@ const _ = grpc.SupportPackageIsVersion7
 type IndexClient interface {
 	// Represent the RPC to get the index information.
 	IndexInfo(ctx context.Context, in *payload.Empty, opts ...grpc.CallOption) (*payload.Info_Index_Count, error)
+	// Represent the RPC to get the rebalance status.
+	RebalanceStatus(ctx context.Context, in *payload.Empty, opts ...grpc.CallOption) (*payload.Info_Rebalance, error)
 }
 
 type indexClient struct {
@@ -63,12 +65,23 @@ func (c *indexClient) IndexInfo(ctx context.Context, in *payload.Empty, opts ...
 	return out, nil
 }
 
+func (c *indexClient) RebalanceStatus(ctx context.Context, in *payload.Empty, opts ...grpc.CallOption) (*payload.Info_Rebalance, error) {
+	out := new(payload.Info_Rebalance)
+	err := c.cc.Invoke(ctx, "/manager.index.v1.Index/RebalanceStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IndexServer is the server API for Index service.
 // All implementations must embed UnimplementedIndexServer
 // for forward compatibility
 type IndexServer interface {
 	// Represent the RPC to get the index information.
 	IndexInfo(context.Context, *payload.Empty) (*payload.Info_Index_Count, error)
+	// Represent the RPC to get the rebalance status.
+	RebalanceStatus(context.Context, *payload.Empty) (*payload.Info_Rebalance, error)
 	mustEmbedUnimplementedIndexServer()
 }
 
@@ -79,6 +92,9 @@ type UnimplementedIndexServer struct {
 func (UnimplementedIndexServer) IndexInfo(context.Context, *payload.Empty) (*payload.Info_Index_Count, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IndexInfo not implemented")
 }
+func (UnimplementedIndexServer) RebalanceStatus(context.Context, *payload.Empty) (*payload.Info_Rebalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceStatus not implemented")
+}
 func (UnimplementedIndexServer) mustEmbedUnimplementedIndexServer() {}
 
 // UnsafeIndexServer may be embedded to opt out of forward compatibility for this service.
@@ -110,6 +126,24 @@ func _Index_IndexInfo_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Index_RebalanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).RebalanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.index.v1.Index/RebalanceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).RebalanceStatus(ctx, req.(*payload.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Index_ServiceDesc is the grpc.ServiceDesc for Index service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,6 +155,10 @@ var Index_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IndexInfo",
 			Handler:    _Index_IndexInfo_Handler,
 		},
+		{
+			MethodName: "RebalanceStatus",
+			Handler:    _Index_RebalanceStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apis/proto/v1/manager/index/index_manager.proto",
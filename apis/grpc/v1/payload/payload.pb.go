@@ -39,6 +39,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Represent the write consistency level required by a mutation request.
+type Consistency int32
+
+const (
+	// The gateway default, which fails on any replica error except a timeout or cancellation.
+	Consistency_DEFAULT Consistency = 0
+	// Succeed once a single replica acknowledges the write.
+	Consistency_ONE Consistency = 1
+	// Succeed once a majority of the replicas acknowledge the write.
+	Consistency_QUORUM Consistency = 2
+	// Succeed only when every replica acknowledges the write.
+	Consistency_ALL Consistency = 3
+)
+
+// Enum value maps for Consistency.
+var (
+	Consistency_name = map[int32]string{
+		0: "DEFAULT",
+		1: "ONE",
+		2: "QUORUM",
+		3: "ALL",
+	}
+	Consistency_value = map[string]int32{
+		"DEFAULT": 0,
+		"ONE":     1,
+		"QUORUM":  2,
+		"ALL":     3,
+	}
+)
+
+func (x Consistency) Enum() *Consistency {
+	p := new(Consistency)
+	*p = x
+	return p
+}
+
+func (x Consistency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Consistency) Descriptor() protoreflect.EnumDescriptor {
+	return file_apis_proto_v1_payload_payload_proto_enumTypes[0].Descriptor()
+}
+
+func (Consistency) Type() protoreflect.EnumType {
+	return &file_apis_proto_v1_payload_payload_proto_enumTypes[0]
+}
+
+func (x Consistency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Consistency.Descriptor instead.
+func (Consistency) EnumDescriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{0}
+}
+
 // Search related messages.
 type Search struct {
 	state         protoimpl.MessageState
@@ -429,6 +486,45 @@ func (*Info) Descriptor() ([]byte, []int) {
 	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{9}
 }
 
+// Backup related messages.
+type Backup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Backup) Reset() {
+	*x = Backup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Backup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup) ProtoMessage() {}
+
+func (x *Backup) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup.ProtoReflect.Descriptor instead.
+func (*Backup) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{10}
+}
+
 // Represent an empty message.
 type Empty struct {
 	state         protoimpl.MessageState
@@ -439,7 +535,7 @@ type Empty struct {
 func (x *Empty) Reset() {
 	*x = Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[10]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -452,7 +548,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[10]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +561,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{10}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{11}
 }
 
 // Represent a search request.
@@ -483,7 +579,7 @@ type Search_Request struct {
 func (x *Search_Request) Reset() {
 	*x = Search_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[11]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -496,7 +592,7 @@ func (x *Search_Request) String() string {
 func (*Search_Request) ProtoMessage() {}
 
 func (x *Search_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[11]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +635,7 @@ type Search_MultiRequest struct {
 func (x *Search_MultiRequest) Reset() {
 	*x = Search_MultiRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[12]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -552,7 +648,7 @@ func (x *Search_MultiRequest) String() string {
 func (*Search_MultiRequest) ProtoMessage() {}
 
 func (x *Search_MultiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[12]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,7 +686,7 @@ type Search_IDRequest struct {
 func (x *Search_IDRequest) Reset() {
 	*x = Search_IDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[13]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -603,7 +699,7 @@ func (x *Search_IDRequest) String() string {
 func (*Search_IDRequest) ProtoMessage() {}
 
 func (x *Search_IDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[13]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +742,7 @@ type Search_MultiIDRequest struct {
 func (x *Search_MultiIDRequest) Reset() {
 	*x = Search_MultiIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[14]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -659,7 +755,7 @@ func (x *Search_MultiIDRequest) String() string {
 func (*Search_MultiIDRequest) ProtoMessage() {}
 
 func (x *Search_MultiIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[14]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +795,7 @@ type Search_ObjectRequest struct {
 func (x *Search_ObjectRequest) Reset() {
 	*x = Search_ObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[15]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -712,7 +808,7 @@ func (x *Search_ObjectRequest) String() string {
 func (*Search_ObjectRequest) ProtoMessage() {}
 
 func (x *Search_ObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[15]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,7 +858,7 @@ type Search_MultiObjectRequest struct {
 func (x *Search_MultiObjectRequest) Reset() {
 	*x = Search_MultiObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[16]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -775,7 +871,7 @@ func (x *Search_MultiObjectRequest) String() string {
 func (*Search_MultiObjectRequest) ProtoMessage() {}
 
 func (x *Search_MultiObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[16]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +919,7 @@ type Search_Config struct {
 func (x *Search_Config) Reset() {
 	*x = Search_Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[17]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -836,7 +932,7 @@ func (x *Search_Config) String() string {
 func (*Search_Config) ProtoMessage() {}
 
 func (x *Search_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[17]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,7 +1012,7 @@ type Search_Response struct {
 func (x *Search_Response) Reset() {
 	*x = Search_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[18]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -929,7 +1025,7 @@ func (x *Search_Response) String() string {
 func (*Search_Response) ProtoMessage() {}
 
 func (x *Search_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[18]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1068,7 @@ type Search_Responses struct {
 func (x *Search_Responses) Reset() {
 	*x = Search_Responses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[19]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -985,7 +1081,7 @@ func (x *Search_Responses) String() string {
 func (*Search_Responses) ProtoMessage() {}
 
 func (x *Search_Responses) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[19]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1119,7 @@ type Search_StreamResponse struct {
 func (x *Search_StreamResponse) Reset() {
 	*x = Search_StreamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[20]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1036,7 +1132,7 @@ func (x *Search_StreamResponse) String() string {
 func (*Search_StreamResponse) ProtoMessage() {}
 
 func (x *Search_StreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[20]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1106,7 +1202,7 @@ type Filter_Target struct {
 func (x *Filter_Target) Reset() {
 	*x = Filter_Target{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[21]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1119,7 +1215,7 @@ func (x *Filter_Target) String() string {
 func (*Filter_Target) ProtoMessage() {}
 
 func (x *Filter_Target) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[21]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,7 +1258,7 @@ type Filter_Config struct {
 func (x *Filter_Config) Reset() {
 	*x = Filter_Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[22]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1175,7 +1271,7 @@ func (x *Filter_Config) String() string {
 func (*Filter_Config) ProtoMessage() {}
 
 func (x *Filter_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[22]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,6 +1294,140 @@ func (x *Filter_Config) GetTargets() []*Filter_Target {
 	return nil
 }
 
+// Represent the request to filter a whole search response.
+type Filter_SearchResponseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The query vector of the search request.
+	Vector []float32 `protobuf:"fixed32,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	// The query ID, set when the search was requested by ID.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The configuration of the search request.
+	Config *Search_Config `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// The search response to be filtered.
+	Response *Search_Response `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *Filter_SearchResponseRequest) Reset() {
+	*x = Filter_SearchResponseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter_SearchResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter_SearchResponseRequest) ProtoMessage() {}
+
+func (x *Filter_SearchResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter_SearchResponseRequest.ProtoReflect.Descriptor instead.
+func (*Filter_SearchResponseRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Filter_SearchResponseRequest) GetVector() []float32 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *Filter_SearchResponseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Filter_SearchResponseRequest) GetConfig() *Search_Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Filter_SearchResponseRequest) GetResponse() *Search_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// Represent the request to re-vectorize the stored objects.
+type Filter_RevectorizeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The vectorizer target to generate the new vectors.
+	Vectorizer *Filter_Target `protobuf:"bytes,1,opt,name=vectorizer,proto3" json:"vectorizer,omitempty"`
+	// The configuration of the upsert requests of the new vectors.
+	Config *Upsert_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *Filter_RevectorizeRequest) Reset() {
+	*x = Filter_RevectorizeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter_RevectorizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter_RevectorizeRequest) ProtoMessage() {}
+
+func (x *Filter_RevectorizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter_RevectorizeRequest.ProtoReflect.Descriptor instead.
+func (*Filter_RevectorizeRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *Filter_RevectorizeRequest) GetVectorizer() *Filter_Target {
+	if x != nil {
+		return x.Vectorizer
+	}
+	return nil
+}
+
+func (x *Filter_RevectorizeRequest) GetConfig() *Upsert_Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
 // Represent the insert request.
 type Insert_Request struct {
 	state         protoimpl.MessageState
@@ -1213,7 +1443,7 @@ type Insert_Request struct {
 func (x *Insert_Request) Reset() {
 	*x = Insert_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[23]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1226,7 +1456,7 @@ func (x *Insert_Request) String() string {
 func (*Insert_Request) ProtoMessage() {}
 
 func (x *Insert_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[23]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,7 +1499,7 @@ type Insert_MultiRequest struct {
 func (x *Insert_MultiRequest) Reset() {
 	*x = Insert_MultiRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[24]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1282,7 +1512,7 @@ func (x *Insert_MultiRequest) String() string {
 func (*Insert_MultiRequest) ProtoMessage() {}
 
 func (x *Insert_MultiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[24]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1322,7 +1552,7 @@ type Insert_ObjectRequest struct {
 func (x *Insert_ObjectRequest) Reset() {
 	*x = Insert_ObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[25]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1335,7 +1565,7 @@ func (x *Insert_ObjectRequest) String() string {
 func (*Insert_ObjectRequest) ProtoMessage() {}
 
 func (x *Insert_ObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[25]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,7 +1615,7 @@ type Insert_MultiObjectRequest struct {
 func (x *Insert_MultiObjectRequest) Reset() {
 	*x = Insert_MultiObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[26]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1398,7 +1628,7 @@ func (x *Insert_MultiObjectRequest) String() string {
 func (*Insert_MultiObjectRequest) ProtoMessage() {}
 
 func (x *Insert_MultiObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[26]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1433,12 +1663,14 @@ type Insert_Config struct {
 	Filters *Filter_Config `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
 	// Insert timestamp.
 	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The number of replica acknowledgements required for success.
+	Consistency Consistency `protobuf:"varint,4,opt,name=consistency,proto3,enum=payload.v1.Consistency" json:"consistency,omitempty"`
 }
 
 func (x *Insert_Config) Reset() {
 	*x = Insert_Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[27]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1451,7 +1683,7 @@ func (x *Insert_Config) String() string {
 func (*Insert_Config) ProtoMessage() {}
 
 func (x *Insert_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[27]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1488,6 +1720,13 @@ func (x *Insert_Config) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *Insert_Config) GetConsistency() Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return Consistency_DEFAULT
+}
+
 // Represent the update request.
 type Update_Request struct {
 	state         protoimpl.MessageState
@@ -1503,7 +1742,7 @@ type Update_Request struct {
 func (x *Update_Request) Reset() {
 	*x = Update_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[28]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1516,7 +1755,7 @@ func (x *Update_Request) String() string {
 func (*Update_Request) ProtoMessage() {}
 
 func (x *Update_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[28]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,7 +1798,7 @@ type Update_MultiRequest struct {
 func (x *Update_MultiRequest) Reset() {
 	*x = Update_MultiRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[29]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1572,7 +1811,7 @@ func (x *Update_MultiRequest) String() string {
 func (*Update_MultiRequest) ProtoMessage() {}
 
 func (x *Update_MultiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[29]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1612,7 +1851,7 @@ type Update_ObjectRequest struct {
 func (x *Update_ObjectRequest) Reset() {
 	*x = Update_ObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[30]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1625,7 +1864,7 @@ func (x *Update_ObjectRequest) String() string {
 func (*Update_ObjectRequest) ProtoMessage() {}
 
 func (x *Update_ObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[30]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,7 +1914,7 @@ type Update_MultiObjectRequest struct {
 func (x *Update_MultiObjectRequest) Reset() {
 	*x = Update_MultiObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[31]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1688,7 +1927,7 @@ func (x *Update_MultiObjectRequest) String() string {
 func (*Update_MultiObjectRequest) ProtoMessage() {}
 
 func (x *Update_MultiObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[31]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1723,12 +1962,14 @@ type Update_Config struct {
 	Filters *Filter_Config `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
 	// Update timestamp.
 	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The number of replica acknowledgements required for success.
+	Consistency Consistency `protobuf:"varint,4,opt,name=consistency,proto3,enum=payload.v1.Consistency" json:"consistency,omitempty"`
 }
 
 func (x *Update_Config) Reset() {
 	*x = Update_Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[32]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1741,7 +1982,7 @@ func (x *Update_Config) String() string {
 func (*Update_Config) ProtoMessage() {}
 
 func (x *Update_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[32]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,6 +2019,13 @@ func (x *Update_Config) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *Update_Config) GetConsistency() Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return Consistency_DEFAULT
+}
+
 // Represent the upsert request.
 type Upsert_Request struct {
 	state         protoimpl.MessageState
@@ -1793,7 +2041,7 @@ type Upsert_Request struct {
 func (x *Upsert_Request) Reset() {
 	*x = Upsert_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[33]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1806,7 +2054,7 @@ func (x *Upsert_Request) String() string {
 func (*Upsert_Request) ProtoMessage() {}
 
 func (x *Upsert_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[33]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1849,7 +2097,7 @@ type Upsert_MultiRequest struct {
 func (x *Upsert_MultiRequest) Reset() {
 	*x = Upsert_MultiRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[34]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1862,7 +2110,7 @@ func (x *Upsert_MultiRequest) String() string {
 func (*Upsert_MultiRequest) ProtoMessage() {}
 
 func (x *Upsert_MultiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[34]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1902,7 +2150,7 @@ type Upsert_ObjectRequest struct {
 func (x *Upsert_ObjectRequest) Reset() {
 	*x = Upsert_ObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[35]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1915,7 +2163,7 @@ func (x *Upsert_ObjectRequest) String() string {
 func (*Upsert_ObjectRequest) ProtoMessage() {}
 
 func (x *Upsert_ObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[35]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1965,7 +2213,7 @@ type Upsert_MultiObjectRequest struct {
 func (x *Upsert_MultiObjectRequest) Reset() {
 	*x = Upsert_MultiObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[36]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1978,7 +2226,7 @@ func (x *Upsert_MultiObjectRequest) String() string {
 func (*Upsert_MultiObjectRequest) ProtoMessage() {}
 
 func (x *Upsert_MultiObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[36]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2013,12 +2261,14 @@ type Upsert_Config struct {
 	Filters *Filter_Config `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
 	// Upsert timestamp.
 	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The number of replica acknowledgements required for success.
+	Consistency Consistency `protobuf:"varint,4,opt,name=consistency,proto3,enum=payload.v1.Consistency" json:"consistency,omitempty"`
 }
 
 func (x *Upsert_Config) Reset() {
 	*x = Upsert_Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[37]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2031,7 +2281,7 @@ func (x *Upsert_Config) String() string {
 func (*Upsert_Config) ProtoMessage() {}
 
 func (x *Upsert_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[37]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2068,6 +2318,13 @@ func (x *Upsert_Config) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *Upsert_Config) GetConsistency() Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return Consistency_DEFAULT
+}
+
 // Represent the remove request.
 type Remove_Request struct {
 	state         protoimpl.MessageState
@@ -2083,7 +2340,7 @@ type Remove_Request struct {
 func (x *Remove_Request) Reset() {
 	*x = Remove_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[38]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2096,7 +2353,7 @@ func (x *Remove_Request) String() string {
 func (*Remove_Request) ProtoMessage() {}
 
 func (x *Remove_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[38]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2139,7 +2396,7 @@ type Remove_MultiRequest struct {
 func (x *Remove_MultiRequest) Reset() {
 	*x = Remove_MultiRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[39]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2152,7 +2409,7 @@ func (x *Remove_MultiRequest) String() string {
 func (*Remove_MultiRequest) ProtoMessage() {}
 
 func (x *Remove_MultiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[39]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2185,12 +2442,14 @@ type Remove_Config struct {
 	SkipStrictExistCheck bool `protobuf:"varint,1,opt,name=skip_strict_exist_check,json=skipStrictExistCheck,proto3" json:"skip_strict_exist_check,omitempty"`
 	// Remove timestamp.
 	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The number of replica acknowledgements required for success.
+	Consistency Consistency `protobuf:"varint,4,opt,name=consistency,proto3,enum=payload.v1.Consistency" json:"consistency,omitempty"`
 }
 
 func (x *Remove_Config) Reset() {
 	*x = Remove_Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[40]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2203,7 +2462,7 @@ func (x *Remove_Config) String() string {
 func (*Remove_Config) ProtoMessage() {}
 
 func (x *Remove_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[40]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,6 +2492,13 @@ func (x *Remove_Config) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *Remove_Config) GetConsistency() Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return Consistency_DEFAULT
+}
+
 // Represent a request to fetch raw vector.
 type Object_VectorRequest struct {
 	state         protoimpl.MessageState
@@ -2248,7 +2514,7 @@ type Object_VectorRequest struct {
 func (x *Object_VectorRequest) Reset() {
 	*x = Object_VectorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[41]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2261,7 +2527,7 @@ func (x *Object_VectorRequest) String() string {
 func (*Object_VectorRequest) ProtoMessage() {}
 
 func (x *Object_VectorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[41]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2291,6 +2557,55 @@ func (x *Object_VectorRequest) GetFilters() *Filter_Config {
 	return nil
 }
 
+// Represent multiple requests to fetch raw vectors.
+type Object_MultiVectorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Represent the multiple fetch request content.
+	Requests []*Object_VectorRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *Object_MultiVectorRequest) Reset() {
+	*x = Object_MultiVectorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Object_MultiVectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_MultiVectorRequest) ProtoMessage() {}
+
+func (x *Object_MultiVectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_MultiVectorRequest.ProtoReflect.Descriptor instead.
+func (*Object_MultiVectorRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *Object_MultiVectorRequest) GetRequests() []*Object_VectorRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
 // Represent the ID and distance pair.
 type Object_Distance struct {
 	state         protoimpl.MessageState
@@ -2301,12 +2616,16 @@ type Object_Distance struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// The distance.
 	Distance float32 `protobuf:"fixed32,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	// The document attached to the vector ID, set by the egress filter.
+	Document []byte `protobuf:"bytes,3,opt,name=document,proto3" json:"document,omitempty"`
+	// The attributes attached to the vector ID, set by the egress filter.
+	Attributes []*Object_Attribute `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *Object_Distance) Reset() {
 	*x = Object_Distance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[42]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2319,7 +2638,7 @@ func (x *Object_Distance) String() string {
 func (*Object_Distance) ProtoMessage() {}
 
 func (x *Object_Distance) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[42]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2332,7 +2651,7 @@ func (x *Object_Distance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_Distance.ProtoReflect.Descriptor instead.
 func (*Object_Distance) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 1}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 2}
 }
 
 func (x *Object_Distance) GetId() string {
@@ -2349,6 +2668,78 @@ func (x *Object_Distance) GetDistance() float32 {
 	return 0
 }
 
+func (x *Object_Distance) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *Object_Distance) GetAttributes() []*Object_Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Represent an attribute of the document attached to the vector ID.
+type Object_Attribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The attribute name.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The attribute value.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Object_Attribute) Reset() {
+	*x = Object_Attribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Object_Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_Attribute) ProtoMessage() {}
+
+func (x *Object_Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_Attribute.ProtoReflect.Descriptor instead.
+func (*Object_Attribute) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 3}
+}
+
+func (x *Object_Attribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Object_Attribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 // Represent stream response of distances.
 type Object_StreamDistance struct {
 	state         protoimpl.MessageState
@@ -2364,7 +2755,7 @@ type Object_StreamDistance struct {
 func (x *Object_StreamDistance) Reset() {
 	*x = Object_StreamDistance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[43]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2377,7 +2768,7 @@ func (x *Object_StreamDistance) String() string {
 func (*Object_StreamDistance) ProtoMessage() {}
 
 func (x *Object_StreamDistance) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[43]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2390,7 +2781,7 @@ func (x *Object_StreamDistance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_StreamDistance.ProtoReflect.Descriptor instead.
 func (*Object_StreamDistance) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 2}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 4}
 }
 
 func (m *Object_StreamDistance) GetPayload() isObject_StreamDistance_Payload {
@@ -2444,7 +2835,7 @@ type Object_ID struct {
 func (x *Object_ID) Reset() {
 	*x = Object_ID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[44]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2457,7 +2848,7 @@ func (x *Object_ID) String() string {
 func (*Object_ID) ProtoMessage() {}
 
 func (x *Object_ID) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[44]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2470,7 +2861,7 @@ func (x *Object_ID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_ID.ProtoReflect.Descriptor instead.
 func (*Object_ID) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 3}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 5}
 }
 
 func (x *Object_ID) GetId() string {
@@ -2492,7 +2883,7 @@ type Object_IDs struct {
 func (x *Object_IDs) Reset() {
 	*x = Object_IDs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[45]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2505,7 +2896,7 @@ func (x *Object_IDs) String() string {
 func (*Object_IDs) ProtoMessage() {}
 
 func (x *Object_IDs) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[45]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2518,7 +2909,7 @@ func (x *Object_IDs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_IDs.ProtoReflect.Descriptor instead.
 func (*Object_IDs) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 4}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 6}
 }
 
 func (x *Object_IDs) GetIds() []string {
@@ -2543,7 +2934,7 @@ type Object_Vector struct {
 func (x *Object_Vector) Reset() {
 	*x = Object_Vector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[46]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2556,7 +2947,7 @@ func (x *Object_Vector) String() string {
 func (*Object_Vector) ProtoMessage() {}
 
 func (x *Object_Vector) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[46]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2569,7 +2960,7 @@ func (x *Object_Vector) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_Vector.ProtoReflect.Descriptor instead.
 func (*Object_Vector) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 5}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 7}
 }
 
 func (x *Object_Vector) GetId() string {
@@ -2598,7 +2989,7 @@ type Object_Vectors struct {
 func (x *Object_Vectors) Reset() {
 	*x = Object_Vectors{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[47]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2611,7 +3002,7 @@ func (x *Object_Vectors) String() string {
 func (*Object_Vectors) ProtoMessage() {}
 
 func (x *Object_Vectors) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[47]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2624,7 +3015,7 @@ func (x *Object_Vectors) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_Vectors.ProtoReflect.Descriptor instead.
 func (*Object_Vectors) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 6}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 8}
 }
 
 func (x *Object_Vectors) GetVectors() []*Object_Vector {
@@ -2649,7 +3040,7 @@ type Object_StreamVector struct {
 func (x *Object_StreamVector) Reset() {
 	*x = Object_StreamVector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[48]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2662,7 +3053,7 @@ func (x *Object_StreamVector) String() string {
 func (*Object_StreamVector) ProtoMessage() {}
 
 func (x *Object_StreamVector) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[48]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2675,7 +3066,7 @@ func (x *Object_StreamVector) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_StreamVector.ProtoReflect.Descriptor instead.
 func (*Object_StreamVector) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 7}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 9}
 }
 
 func (m *Object_StreamVector) GetPayload() isObject_StreamVector_Payload {
@@ -2732,7 +3123,7 @@ type Object_ReshapeVector struct {
 func (x *Object_ReshapeVector) Reset() {
 	*x = Object_ReshapeVector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[49]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2745,7 +3136,7 @@ func (x *Object_ReshapeVector) String() string {
 func (*Object_ReshapeVector) ProtoMessage() {}
 
 func (x *Object_ReshapeVector) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[49]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2758,7 +3149,7 @@ func (x *Object_ReshapeVector) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_ReshapeVector.ProtoReflect.Descriptor instead.
 func (*Object_ReshapeVector) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 8}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 10}
 }
 
 func (x *Object_ReshapeVector) GetObject() []byte {
@@ -2790,7 +3181,7 @@ type Object_Blob struct {
 func (x *Object_Blob) Reset() {
 	*x = Object_Blob{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[50]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2803,7 +3194,7 @@ func (x *Object_Blob) String() string {
 func (*Object_Blob) ProtoMessage() {}
 
 func (x *Object_Blob) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[50]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2816,7 +3207,7 @@ func (x *Object_Blob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_Blob.ProtoReflect.Descriptor instead.
 func (*Object_Blob) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 9}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 11}
 }
 
 func (x *Object_Blob) GetId() string {
@@ -2833,6 +3224,54 @@ func (x *Object_Blob) GetObject() []byte {
 	return nil
 }
 
+// Represent multiple binary objects.
+type Object_Blobs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blobs []*Object_Blob `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs,omitempty"`
+}
+
+func (x *Object_Blobs) Reset() {
+	*x = Object_Blobs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Object_Blobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_Blobs) ProtoMessage() {}
+
+func (x *Object_Blobs) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_Blobs.ProtoReflect.Descriptor instead.
+func (*Object_Blobs) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 12}
+}
+
+func (x *Object_Blobs) GetBlobs() []*Object_Blob {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
 // Represent stream response of binary objects.
 type Object_StreamBlob struct {
 	state         protoimpl.MessageState
@@ -2848,7 +3287,7 @@ type Object_StreamBlob struct {
 func (x *Object_StreamBlob) Reset() {
 	*x = Object_StreamBlob{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[51]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2861,7 +3300,7 @@ func (x *Object_StreamBlob) String() string {
 func (*Object_StreamBlob) ProtoMessage() {}
 
 func (x *Object_StreamBlob) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[51]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2874,7 +3313,7 @@ func (x *Object_StreamBlob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_StreamBlob.ProtoReflect.Descriptor instead.
 func (*Object_StreamBlob) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 10}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 13}
 }
 
 func (m *Object_StreamBlob) GetPayload() isObject_StreamBlob_Payload {
@@ -2928,12 +3367,14 @@ type Object_Location struct {
 	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	// The IP list.
 	Ips []string `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
+	// The replicas which failed to process the request.
+	Failures []*Object_Location_Failure `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"`
 }
 
 func (x *Object_Location) Reset() {
 	*x = Object_Location{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[52]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2946,7 +3387,7 @@ func (x *Object_Location) String() string {
 func (*Object_Location) ProtoMessage() {}
 
 func (x *Object_Location) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[52]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2959,7 +3400,7 @@ func (x *Object_Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_Location.ProtoReflect.Descriptor instead.
 func (*Object_Location) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 11}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 14}
 }
 
 func (x *Object_Location) GetName() string {
@@ -2983,6 +3424,13 @@ func (x *Object_Location) GetIps() []string {
 	return nil
 }
 
+func (x *Object_Location) GetFailures() []*Object_Location_Failure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
 // Represent the stream response of the vector location.
 type Object_StreamLocation struct {
 	state         protoimpl.MessageState
@@ -2998,7 +3446,7 @@ type Object_StreamLocation struct {
 func (x *Object_StreamLocation) Reset() {
 	*x = Object_StreamLocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[53]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3011,7 +3459,7 @@ func (x *Object_StreamLocation) String() string {
 func (*Object_StreamLocation) ProtoMessage() {}
 
 func (x *Object_StreamLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[53]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3024,7 +3472,7 @@ func (x *Object_StreamLocation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_StreamLocation.ProtoReflect.Descriptor instead.
 func (*Object_StreamLocation) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 12}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 15}
 }
 
 func (m *Object_StreamLocation) GetPayload() isObject_StreamLocation_Payload {
@@ -3078,7 +3526,7 @@ type Object_Locations struct {
 func (x *Object_Locations) Reset() {
 	*x = Object_Locations{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[54]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3091,7 +3539,7 @@ func (x *Object_Locations) String() string {
 func (*Object_Locations) ProtoMessage() {}
 
 func (x *Object_Locations) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[54]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3104,7 +3552,7 @@ func (x *Object_Locations) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object_Locations.ProtoReflect.Descriptor instead.
 func (*Object_Locations) Descriptor() ([]byte, []int) {
-	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 13}
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 16}
 }
 
 func (x *Object_Locations) GetLocations() []*Object_Location {
@@ -3114,33 +3562,91 @@ func (x *Object_Locations) GetLocations() []*Object_Location {
 	return nil
 }
 
-// Represent the create index request.
-type Control_CreateIndexRequest struct {
+// Represent the failure of a single replica.
+type Object_Location_Failure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The pool size of the create index operation.
-	PoolSize uint32 `protobuf:"varint,1,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	// The address of the replica.
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The RPC error status.
+	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *Control_CreateIndexRequest) Reset() {
-	*x = Control_CreateIndexRequest{}
+func (x *Object_Location_Failure) Reset() {
+	*x = Object_Location_Failure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[55]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Control_CreateIndexRequest) String() string {
+func (x *Object_Location_Failure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Control_CreateIndexRequest) ProtoMessage() {}
+func (*Object_Location_Failure) ProtoMessage() {}
 
-func (x *Control_CreateIndexRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[55]
+func (x *Object_Location_Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_Location_Failure.ProtoReflect.Descriptor instead.
+func (*Object_Location_Failure) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{6, 14, 0}
+}
+
+func (x *Object_Location_Failure) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Object_Location_Failure) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Represent the create index request.
+type Control_CreateIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pool size of the create index operation.
+	PoolSize uint32 `protobuf:"varint,1,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+}
+
+func (x *Control_CreateIndexRequest) Reset() {
+	*x = Control_CreateIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Control_CreateIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Control_CreateIndexRequest) ProtoMessage() {}
+
+func (x *Control_CreateIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3180,7 +3686,7 @@ type Discoverer_Request struct {
 func (x *Discoverer_Request) Reset() {
 	*x = Discoverer_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[56]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3193,7 +3699,7 @@ func (x *Discoverer_Request) String() string {
 func (*Discoverer_Request) ProtoMessage() {}
 
 func (x *Discoverer_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[56]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3240,7 +3746,7 @@ type Info_Index struct {
 func (x *Info_Index) Reset() {
 	*x = Info_Index{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[57]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3253,7 +3759,7 @@ func (x *Info_Index) String() string {
 func (*Info_Index) ProtoMessage() {}
 
 func (x *Info_Index) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[57]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3294,7 +3800,7 @@ type Info_Pod struct {
 func (x *Info_Pod) Reset() {
 	*x = Info_Pod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[58]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3307,7 +3813,7 @@ func (x *Info_Pod) String() string {
 func (*Info_Pod) ProtoMessage() {}
 
 func (x *Info_Pod) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[58]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3395,7 +3901,7 @@ type Info_Node struct {
 func (x *Info_Node) Reset() {
 	*x = Info_Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[59]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3408,7 +3914,7 @@ func (x *Info_Node) String() string {
 func (*Info_Node) ProtoMessage() {}
 
 func (x *Info_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[59]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3483,7 +3989,7 @@ type Info_CPU struct {
 func (x *Info_CPU) Reset() {
 	*x = Info_CPU{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[60]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3496,7 +4002,7 @@ func (x *Info_CPU) String() string {
 func (*Info_CPU) ProtoMessage() {}
 
 func (x *Info_CPU) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[60]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3550,7 +4056,7 @@ type Info_Memory struct {
 func (x *Info_Memory) Reset() {
 	*x = Info_Memory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[61]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3563,7 +4069,7 @@ func (x *Info_Memory) String() string {
 func (*Info_Memory) ProtoMessage() {}
 
 func (x *Info_Memory) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[61]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3613,7 +4119,7 @@ type Info_Pods struct {
 func (x *Info_Pods) Reset() {
 	*x = Info_Pods{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[62]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3626,7 +4132,7 @@ func (x *Info_Pods) String() string {
 func (*Info_Pods) ProtoMessage() {}
 
 func (x *Info_Pods) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[62]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3662,7 +4168,7 @@ type Info_Nodes struct {
 func (x *Info_Nodes) Reset() {
 	*x = Info_Nodes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[63]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3675,7 +4181,7 @@ func (x *Info_Nodes) String() string {
 func (*Info_Nodes) ProtoMessage() {}
 
 func (x *Info_Nodes) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[63]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3710,7 +4216,7 @@ type Info_IPs struct {
 func (x *Info_IPs) Reset() {
 	*x = Info_IPs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[64]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3723,7 +4229,7 @@ func (x *Info_IPs) String() string {
 func (*Info_IPs) ProtoMessage() {}
 
 func (x *Info_IPs) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[64]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3746,6 +4252,82 @@ func (x *Info_IPs) GetIp() []string {
 	return nil
 }
 
+// Represent the rebalance information message.
+type Info_Rebalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rebalance is running or not.
+	Running bool `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	// The moves of the current or the last rebalance.
+	Moves []*Info_Rebalance_Move `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves,omitempty"`
+	// The unix nano time of the last rebalance started.
+	StartedAt int64 `protobuf:"varint,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// The unix nano time of the last rebalance finished.
+	FinishedAt int64 `protobuf:"varint,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (x *Info_Rebalance) Reset() {
+	*x = Info_Rebalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Info_Rebalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Info_Rebalance) ProtoMessage() {}
+
+func (x *Info_Rebalance) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Info_Rebalance.ProtoReflect.Descriptor instead.
+func (*Info_Rebalance) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{9, 8}
+}
+
+func (x *Info_Rebalance) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *Info_Rebalance) GetMoves() []*Info_Rebalance_Move {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *Info_Rebalance) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Info_Rebalance) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
 // Represent the index count message.
 type Info_Index_Count struct {
 	state         protoimpl.MessageState
@@ -3765,7 +4347,7 @@ type Info_Index_Count struct {
 func (x *Info_Index_Count) Reset() {
 	*x = Info_Index_Count{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[65]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3778,7 +4360,7 @@ func (x *Info_Index_Count) String() string {
 func (*Info_Index_Count) ProtoMessage() {}
 
 func (x *Info_Index_Count) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[65]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3832,7 +4414,7 @@ type Info_Index_UUID struct {
 func (x *Info_Index_UUID) Reset() {
 	*x = Info_Index_UUID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[66]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3845,7 +4427,7 @@ func (x *Info_Index_UUID) String() string {
 func (*Info_Index_UUID) ProtoMessage() {}
 
 func (x *Info_Index_UUID) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[66]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3861,6 +4443,64 @@ func (*Info_Index_UUID) Descriptor() ([]byte, []int) {
 	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{9, 0, 1}
 }
 
+// Represent the multiple UUID message.
+type Info_Index_UUIDs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The UUID list.
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// The cursor to get the next page of the ordered UUIDs, it is empty when all the UUIDs are returned.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *Info_Index_UUIDs) Reset() {
+	*x = Info_Index_UUIDs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Info_Index_UUIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Info_Index_UUIDs) ProtoMessage() {}
+
+func (x *Info_Index_UUIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Info_Index_UUIDs.ProtoReflect.Descriptor instead.
+func (*Info_Index_UUIDs) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{9, 0, 2}
+}
+
+func (x *Info_Index_UUIDs) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *Info_Index_UUIDs) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 // The committed UUID.
 type Info_Index_UUID_Committed struct {
 	state         protoimpl.MessageState
@@ -3873,7 +4513,7 @@ type Info_Index_UUID_Committed struct {
 func (x *Info_Index_UUID_Committed) Reset() {
 	*x = Info_Index_UUID_Committed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[67]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3886,7 +4526,7 @@ func (x *Info_Index_UUID_Committed) String() string {
 func (*Info_Index_UUID_Committed) ProtoMessage() {}
 
 func (x *Info_Index_UUID_Committed) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[67]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3921,7 +4561,7 @@ type Info_Index_UUID_Uncommitted struct {
 func (x *Info_Index_UUID_Uncommitted) Reset() {
 	*x = Info_Index_UUID_Uncommitted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[68]
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3934,7 +4574,7 @@ func (x *Info_Index_UUID_Uncommitted) String() string {
 func (*Info_Index_UUID_Uncommitted) ProtoMessage() {}
 
 func (x *Info_Index_UUID_Uncommitted) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[68]
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3957,85 +4597,657 @@ func (x *Info_Index_UUID_Uncommitted) GetUuid() string {
 	return ""
 }
 
-var File_apis_proto_v1_payload_payload_proto protoreflect.FileDescriptor
+// Represent the request of the UUID list.
+type Info_Index_UUIDs_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_apis_proto_v1_payload_payload_proto_rawDesc = []byte{
-	0x0a, 0x23, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
-	0x31, 0x1a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6e,
-	0x76, 0x6f, 0x79, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
-	0x67, 0x65, 0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70,
-	0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80,
-	0x09, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x5e, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x02, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x06,
-	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x46, 0x0a, 0x0c, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x1a, 0x4e, 0x0a, 0x09, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31,
-	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x1a, 0x4a, 0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x95, 0x01,
-	0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x72, 0x1a, 0x52, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x94, 0x02, 0x0a, 0x06, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x01, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06,
-	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x69, 0x6e,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e,
-	0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x40,
-	0x0a, 0x0e, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x0d, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x1a, 0x60, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x2e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x1a, 0x46, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12,
-	0x39, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x1a, 0x84, 0x01, 0x0a, 0x0e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	// The maximum number of UUIDs to be returned.
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Return the UUIDs in the lexicographical order to page through them with the cursor.
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// The cursor returned as next_cursor of the previous page.
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *Info_Index_UUIDs_Request) Reset() {
+	*x = Info_Index_UUIDs_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Info_Index_UUIDs_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Info_Index_UUIDs_Request) ProtoMessage() {}
+
+func (x *Info_Index_UUIDs_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Info_Index_UUIDs_Request.ProtoReflect.Descriptor instead.
+func (*Info_Index_UUIDs_Request) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{9, 0, 2, 0}
+}
+
+func (x *Info_Index_UUIDs_Request) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Info_Index_UUIDs_Request) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *Info_Index_UUIDs_Request) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Represent the vector move between two agents.
+type Info_Rebalance_Move struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the source agent.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The address of the target agent.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// The number of vectors planned to be moved.
+	Planned uint32 `protobuf:"varint,3,opt,name=planned,proto3" json:"planned,omitempty"`
+	// The number of moved vectors.
+	Moved uint32 `protobuf:"varint,4,opt,name=moved,proto3" json:"moved,omitempty"`
+	// The number of vectors failed to be moved.
+	Failed uint32 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *Info_Rebalance_Move) Reset() {
+	*x = Info_Rebalance_Move{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Info_Rebalance_Move) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Info_Rebalance_Move) ProtoMessage() {}
+
+func (x *Info_Rebalance_Move) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Info_Rebalance_Move.ProtoReflect.Descriptor instead.
+func (*Info_Rebalance_Move) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{9, 8, 0}
+}
+
+func (x *Info_Rebalance_Move) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Info_Rebalance_Move) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Info_Rebalance_Move) GetPlanned() uint32 {
+	if x != nil {
+		return x.Planned
+	}
+	return 0
+}
+
+func (x *Info_Rebalance_Move) GetMoved() uint32 {
+	if x != nil {
+		return x.Moved
+	}
+	return 0
+}
+
+func (x *Info_Rebalance_Move) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// Represent the request to back up the agent index.
+type Backup_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The label recorded in the manifest of the backup.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *Backup_Request) Reset() {
+	*x = Backup_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Backup_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup_Request) ProtoMessage() {}
+
+func (x *Backup_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup_Request.ProtoReflect.Descriptor instead.
+func (*Backup_Request) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *Backup_Request) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// Represent the request to restore the agent index.
+type Backup_RestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The backup version to restore. The latest readable version is restored
+	// when it is empty.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Backup_RestoreRequest) Reset() {
+	*x = Backup_RestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Backup_RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup_RestoreRequest) ProtoMessage() {}
+
+func (x *Backup_RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup_RestoreRequest.ProtoReflect.Descriptor instead.
+func (*Backup_RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *Backup_RestoreRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// Represent the backup information.
+type Backup_Info struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The backup version. It is empty when the versioning is disabled.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The backup generation.
+	Generation uint64 `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	// The label of the backup.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// The unix nano time of the backup started.
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The uncompressed size of the backup data.
+	Bytes int64 `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// The index count recorded in the agent metadata.
+	IndexCount uint64 `protobuf:"varint,6,opt,name=index_count,json=indexCount,proto3" json:"index_count,omitempty"`
+	// The backup is incremental or not.
+	Incremental bool `protobuf:"varint,7,opt,name=incremental,proto3" json:"incremental,omitempty"`
+}
+
+func (x *Backup_Info) Reset() {
+	*x = Backup_Info{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Backup_Info) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup_Info) ProtoMessage() {}
+
+func (x *Backup_Info) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup_Info.ProtoReflect.Descriptor instead.
+func (*Backup_Info) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *Backup_Info) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Backup_Info) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *Backup_Info) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Backup_Info) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Backup_Info) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *Backup_Info) GetIndexCount() uint64 {
+	if x != nil {
+		return x.IndexCount
+	}
+	return 0
+}
+
+func (x *Backup_Info) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+// Represent the multiple backup information.
+type Backup_Infos struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Infos []*Backup_Info `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
+}
+
+func (x *Backup_Infos) Reset() {
+	*x = Backup_Infos{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Backup_Infos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup_Infos) ProtoMessage() {}
+
+func (x *Backup_Infos) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup_Infos.ProtoReflect.Descriptor instead.
+func (*Backup_Infos) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{10, 3}
+}
+
+func (x *Backup_Infos) GetInfos() []*Backup_Info {
+	if x != nil {
+		return x.Infos
+	}
+	return nil
+}
+
+// Represent the result of a backup.
+type Backup_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The written backup version.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The label of the backup.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The unix nano time of the backup started.
+	StartedAt int64 `protobuf:"varint,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// The unix nano time of the backup finished.
+	FinishedAt int64 `protobuf:"varint,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// The uncompressed size of the backup data.
+	Bytes int64 `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// The duration of the backup in nanoseconds.
+	Duration int64 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The error message of the failed backup.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *Backup_Result) Reset() {
+	*x = Backup_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Backup_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup_Result) ProtoMessage() {}
+
+func (x *Backup_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup_Result.ProtoReflect.Descriptor instead.
+func (*Backup_Result) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{10, 4}
+}
+
+func (x *Backup_Result) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Backup_Result) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Backup_Result) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Backup_Result) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *Backup_Result) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *Backup_Result) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Backup_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Represent the backup status.
+type Backup_Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The backup is running or not.
+	Running bool `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	// The result of the last succeeded backup.
+	LastSuccess *Backup_Result `protobuf:"bytes,2,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	// The result of the last failed backup.
+	LastFailure *Backup_Result `protobuf:"bytes,3,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+}
+
+func (x *Backup_Status) Reset() {
+	*x = Backup_Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Backup_Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup_Status) ProtoMessage() {}
+
+func (x *Backup_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_v1_payload_payload_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup_Status.ProtoReflect.Descriptor instead.
+func (*Backup_Status) Descriptor() ([]byte, []int) {
+	return file_apis_proto_v1_payload_payload_proto_rawDescGZIP(), []int{10, 5}
+}
+
+func (x *Backup_Status) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *Backup_Status) GetLastSuccess() *Backup_Result {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return nil
+}
+
+func (x *Backup_Status) GetLastFailure() *Backup_Result {
+	if x != nil {
+		return x.LastFailure
+	}
+	return nil
+}
+
+var File_apis_proto_v1_payload_payload_proto protoreflect.FileDescriptor
+
+var file_apis_proto_v1_payload_payload_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x1a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70,
+	0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80,
+	0x09, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x5e, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x02, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x06,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x46, 0x0a, 0x0c, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x1a, 0x4e, 0x0a, 0x09, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x1a, 0x4a, 0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x95, 0x01,
+	0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x72, 0x1a, 0x52, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x94, 0x02, 0x0a, 0x06, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x01, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x40,
+	0x0a, 0x0e, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0d, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x1a, 0x60, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x1a, 0x46, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x1a, 0x84, 0x01, 0x0a, 0x0e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
 	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
 	0x72, 0x63, 0x68, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08,
@@ -4043,299 +5255,435 @@ var file_apis_proto_v1_payload_payload_proto_rawDesc = []byte{
 	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06,
 	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x22, 0x79, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x30, 0x0a, 0x06, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x3d, 0x0a,
-	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0xe5, 0x04, 0x0a,
-	0x06, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x1a, 0x79, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x08, 0xfa,
-	0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
-	0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
-	0x65, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x1a, 0x46, 0x0a, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0xae, 0x01, 0x0a, 0x0d, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x31, 0x0a,
+	0x64, 0x22, 0xac, 0x03, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x30, 0x0a, 0x06,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x3d,
+	0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x1a, 0xab, 0x01,
+	0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x82, 0x01, 0x0a, 0x12,
+	0x52, 0x65, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x31, 0x0a,
 	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72,
 	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x39, 0x0a, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x03,
+	0x22, 0xa0, 0x05, 0x0a, 0x06, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x1a, 0x79, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x46, 0x0a, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0xae,
+	0x01, 0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x1a,
+	0x52, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x1a, 0xcd, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35,
+	0x0a, 0x17, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0xa0, 0x05, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x79,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x06,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x46, 0x0a, 0x0c, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x1a, 0xae, 0x01, 0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
-	0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x1a, 0x52, 0x0a, 0x12, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a,
-	0x92, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x6b,
-	0x69, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x5f,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x6b, 0x69,
-	0x70, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0xe5, 0x04, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a,
-	0x79, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x76, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52,
-	0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x72, 0x1a, 0x52, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0xcd, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x39, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xa0, 0x05, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x1a, 0x79, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
+	0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x46, 0x0a, 0x0c,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x1a, 0xae, 0x01, 0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52,
+	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x46, 0x0a, 0x0c, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x1a, 0xae, 0x01, 0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x06, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x72, 0x1a, 0x52, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0xcd, 0x01, 0x0a, 0x06, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
 	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x72, 0x1a, 0x52, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x92, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63,
-	0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x39,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xd0, 0x02, 0x0a, 0x06, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x63, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x46, 0x0a, 0x0c, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x1a, 0x98, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x17,
+	0x73, 0x6b, 0x69, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73,
+	0x6b, 0x69, 0x70, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xa5, 0x0c, 0x0a,
+	0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x75, 0x0a, 0x0d, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x49, 0x44, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c,
 	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79,
 	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xe5, 0x04, 0x0a,
-	0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x1a, 0x79, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x08, 0xfa,
-	0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
-	0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73,
-	0x65, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x1a, 0x46, 0x0a, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0xae, 0x01, 0x0a, 0x0d, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x31, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72,
-	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x39, 0x0a, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
-	0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x1a, 0x52, 0x0a, 0x12, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a,
-	0x92, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x6b,
-	0x69, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x5f,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x6b, 0x69,
-	0x70, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0x94, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a,
-	0x63, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x46, 0x0a, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x5d, 0x0a, 0x06,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xfc, 0x08, 0x0a, 0x06,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x75, 0x0a, 0x0d, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x49, 0x44, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92,
-	0x01, 0x02, 0x08, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x36, 0x0a,
-	0x08, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x84, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x44,
-	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x52,
+	0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x1a, 0x90, 0x01, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x33, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x84, 0x01, 0x0a, 0x0e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x1a, 0x1d, 0x0a, 0x02, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x1a, 0x17, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x1a, 0x43, 0x0a, 0x06, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x06,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x02, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x3e,
+	0x0a, 0x07, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x7c,
+	0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x33,
+	0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
 	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x1d, 0x0a, 0x02,
-	0x49, 0x44, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x17, 0x0a, 0x03, 0x49,
-	0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x03, 0x69, 0x64, 0x73, 0x1a, 0x43, 0x0a, 0x06, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x17,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x02, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
-	0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x3e, 0x0a, 0x07, 0x56, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x52, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x7c, 0x0a, 0x0c, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x76, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x56, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2c,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x3d, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x68, 0x61,
-	0x70, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52,
-	0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x1a, 0x37, 0x0a, 0x04, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x17,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a,
-	0x74, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2d, 0x0a,
-	0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
-	0x42, 0x6c, 0x6f, 0x62, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x2c, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x44, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x73, 0x1a, 0x84, 0x01, 0x0a, 0x0e,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39,
-	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x3d, 0x0a, 0x0d,
+	0x52, 0x65, 0x73, 0x68, 0x61, 0x70, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x1a, 0x37, 0x0a, 0x04, 0x42,
+	0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x1a, 0x36, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x2d, 0x0a,
+	0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x1a, 0x74, 0x0a, 0x0a,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2d, 0x0a, 0x04, 0x62, 0x6c,
+	0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x42, 0x6c, 0x6f,
+	0x62, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52,
 	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x1a, 0x46, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x39, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x45, 0x0a, 0x07, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x1a, 0x3a, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70,
-	0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a,
-	0x65, 0x22, 0x66, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x72, 0x1a,
-	0x58, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
-	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xe0, 0x07, 0x0a, 0x04, 0x49, 0x6e,
-	0x66, 0x6f, 0x1a, 0xca, 0x01, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x75, 0x0a, 0x05,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x61, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x61, 0x76,
-	0x69, 0x6e, 0x67, 0x1a, 0x4a, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x1a, 0x1f, 0x0a, 0x09, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x1a, 0x21, 0x0a, 0x0b,
-	0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x1a,
-	0xef, 0x01, 0x0a, 0x03, 0x50, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x78, 0x01, 0x52, 0x02, 0x69, 0x70, 0x12, 0x26, 0x0a,
-	0x03, 0x63, 0x70, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x50, 0x55,
-	0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x2f, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06,
-	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64,
-	0x65, 0x1a, 0xe8, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x26, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x50, 0x55, 0x52, 0x03, 0x63, 0x70, 0x75,
-	0x12, 0x2f, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x12, 0x29, 0x0a, 0x04, 0x50, 0x6f, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x73, 0x52, 0x04, 0x50, 0x6f, 0x64, 0x73, 0x1a, 0x4b, 0x0a, 0x03,
-	0x43, 0x50, 0x55, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x4e, 0x0a, 0x06, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x3a, 0x0a, 0x04, 0x50, 0x6f, 0x64,
-	0x73, 0x12, 0x32, 0x0a, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52,
-	0x04, 0x70, 0x6f, 0x64, 0x73, 0x1a, 0x3e, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x35,
-	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x61, 0x64, 0x1a, 0xd0, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x49, 0x0a, 0x07, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x84, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x46, 0x0a, 0x09,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x45, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x1a,
+	0x3a, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28,
+	0x00, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x66, 0x0a, 0x0a, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x72, 0x1a, 0x58, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x22, 0x93, 0x0b, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0xde, 0x02, 0x0a,
+	0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x75, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x1a, 0x4a, 0x0a,
+	0x04, 0x55, 0x55, 0x49, 0x44, 0x1a, 0x1f, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x1a, 0x21, 0x0a, 0x0b, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x1a, 0x91, 0x01, 0x0a, 0x05, 0x55, 0x55,
+	0x49, 0x44, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x51, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0xef, 0x01,
+	0x0a, 0x03, 0x50, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x78, 0x01, 0x52, 0x02, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x03, 0x63,
+	0x70, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x50, 0x55, 0x52, 0x03,
+	0x63, 0x70, 0x75, 0x12, 0x2f, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x1a,
+	0xe8, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x26, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x50, 0x55, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x2f,
+	0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12,
+	0x29, 0x0a, 0x04, 0x50, 0x6f, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x50, 0x6f, 0x64, 0x73, 0x52, 0x04, 0x50, 0x6f, 0x64, 0x73, 0x1a, 0x4b, 0x0a, 0x03, 0x43, 0x50,
+	0x55, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x4e, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x3a, 0x0a, 0x04, 0x50, 0x6f, 0x64, 0x73, 0x12,
+	0x32, 0x0a, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
 	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05,
-	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0x15, 0x0a, 0x03, 0x49, 0x50, 0x73, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x07, 0x0a, 0x05,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x5a, 0x0a, 0x1d, 0x6f, 0x72, 0x67, 0x2e, 0x76, 0x64, 0x61,
-	0x61, 0x73, 0x2e, 0x76, 0x61, 0x6c, 0x64, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x0b, 0x56, 0x61, 0x6c, 0x64, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x64, 0x2f, 0x61, 0x70, 0x69,
-	0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x50, 0x6f, 0x64, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x70,
+	0x6f, 0x64, 0x73, 0x1a, 0x3e, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x1a, 0x15, 0x0a, 0x03, 0x49, 0x50, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x1a, 0x9c, 0x02, 0x0a, 0x09, 0x52,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x6f,
+	0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x7e, 0x0a, 0x04, 0x4d, 0x6f, 0x76,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0xc2, 0x05, 0x0a, 0x06, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x1a, 0x1f, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x1a, 0x2a, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x1a, 0xce, 0x01, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x1a, 0x36, 0x0a, 0x05, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x69,
+	0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0xc0, 0x01, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x9e, 0x01,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x07,
+	0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x2a, 0x38, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x51, 0x55, 0x4f, 0x52, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10,
+	0x03, 0x42, 0x5a, 0x0a, 0x1d, 0x6f, 0x72, 0x67, 0x2e, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2e, 0x76,
+	0x61, 0x6c, 0x64, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x42, 0x0b, 0x56, 0x61, 0x6c, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x50,
+	0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x64,
+	0x61, 0x61, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4350,146 +5698,182 @@ func file_apis_proto_v1_payload_payload_proto_rawDescGZIP() []byte {
 	return file_apis_proto_v1_payload_payload_proto_rawDescData
 }
 
-var file_apis_proto_v1_payload_payload_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_apis_proto_v1_payload_payload_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_apis_proto_v1_payload_payload_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_apis_proto_v1_payload_payload_proto_goTypes = []interface{}{
-	(*Search)(nil),                      // 0: payload.v1.Search
-	(*Filter)(nil),                      // 1: payload.v1.Filter
-	(*Insert)(nil),                      // 2: payload.v1.Insert
-	(*Update)(nil),                      // 3: payload.v1.Update
-	(*Upsert)(nil),                      // 4: payload.v1.Upsert
-	(*Remove)(nil),                      // 5: payload.v1.Remove
-	(*Object)(nil),                      // 6: payload.v1.Object
-	(*Control)(nil),                     // 7: payload.v1.Control
-	(*Discoverer)(nil),                  // 8: payload.v1.Discoverer
-	(*Info)(nil),                        // 9: payload.v1.Info
-	(*Empty)(nil),                       // 10: payload.v1.Empty
-	(*Search_Request)(nil),              // 11: payload.v1.Search.Request
-	(*Search_MultiRequest)(nil),         // 12: payload.v1.Search.MultiRequest
-	(*Search_IDRequest)(nil),            // 13: payload.v1.Search.IDRequest
-	(*Search_MultiIDRequest)(nil),       // 14: payload.v1.Search.MultiIDRequest
-	(*Search_ObjectRequest)(nil),        // 15: payload.v1.Search.ObjectRequest
-	(*Search_MultiObjectRequest)(nil),   // 16: payload.v1.Search.MultiObjectRequest
-	(*Search_Config)(nil),               // 17: payload.v1.Search.Config
-	(*Search_Response)(nil),             // 18: payload.v1.Search.Response
-	(*Search_Responses)(nil),            // 19: payload.v1.Search.Responses
-	(*Search_StreamResponse)(nil),       // 20: payload.v1.Search.StreamResponse
-	(*Filter_Target)(nil),               // 21: payload.v1.Filter.Target
-	(*Filter_Config)(nil),               // 22: payload.v1.Filter.Config
-	(*Insert_Request)(nil),              // 23: payload.v1.Insert.Request
-	(*Insert_MultiRequest)(nil),         // 24: payload.v1.Insert.MultiRequest
-	(*Insert_ObjectRequest)(nil),        // 25: payload.v1.Insert.ObjectRequest
-	(*Insert_MultiObjectRequest)(nil),   // 26: payload.v1.Insert.MultiObjectRequest
-	(*Insert_Config)(nil),               // 27: payload.v1.Insert.Config
-	(*Update_Request)(nil),              // 28: payload.v1.Update.Request
-	(*Update_MultiRequest)(nil),         // 29: payload.v1.Update.MultiRequest
-	(*Update_ObjectRequest)(nil),        // 30: payload.v1.Update.ObjectRequest
-	(*Update_MultiObjectRequest)(nil),   // 31: payload.v1.Update.MultiObjectRequest
-	(*Update_Config)(nil),               // 32: payload.v1.Update.Config
-	(*Upsert_Request)(nil),              // 33: payload.v1.Upsert.Request
-	(*Upsert_MultiRequest)(nil),         // 34: payload.v1.Upsert.MultiRequest
-	(*Upsert_ObjectRequest)(nil),        // 35: payload.v1.Upsert.ObjectRequest
-	(*Upsert_MultiObjectRequest)(nil),   // 36: payload.v1.Upsert.MultiObjectRequest
-	(*Upsert_Config)(nil),               // 37: payload.v1.Upsert.Config
-	(*Remove_Request)(nil),              // 38: payload.v1.Remove.Request
-	(*Remove_MultiRequest)(nil),         // 39: payload.v1.Remove.MultiRequest
-	(*Remove_Config)(nil),               // 40: payload.v1.Remove.Config
-	(*Object_VectorRequest)(nil),        // 41: payload.v1.Object.VectorRequest
-	(*Object_Distance)(nil),             // 42: payload.v1.Object.Distance
-	(*Object_StreamDistance)(nil),       // 43: payload.v1.Object.StreamDistance
-	(*Object_ID)(nil),                   // 44: payload.v1.Object.ID
-	(*Object_IDs)(nil),                  // 45: payload.v1.Object.IDs
-	(*Object_Vector)(nil),               // 46: payload.v1.Object.Vector
-	(*Object_Vectors)(nil),              // 47: payload.v1.Object.Vectors
-	(*Object_StreamVector)(nil),         // 48: payload.v1.Object.StreamVector
-	(*Object_ReshapeVector)(nil),        // 49: payload.v1.Object.ReshapeVector
-	(*Object_Blob)(nil),                 // 50: payload.v1.Object.Blob
-	(*Object_StreamBlob)(nil),           // 51: payload.v1.Object.StreamBlob
-	(*Object_Location)(nil),             // 52: payload.v1.Object.Location
-	(*Object_StreamLocation)(nil),       // 53: payload.v1.Object.StreamLocation
-	(*Object_Locations)(nil),            // 54: payload.v1.Object.Locations
-	(*Control_CreateIndexRequest)(nil),  // 55: payload.v1.Control.CreateIndexRequest
-	(*Discoverer_Request)(nil),          // 56: payload.v1.Discoverer.Request
-	(*Info_Index)(nil),                  // 57: payload.v1.Info.Index
-	(*Info_Pod)(nil),                    // 58: payload.v1.Info.Pod
-	(*Info_Node)(nil),                   // 59: payload.v1.Info.Node
-	(*Info_CPU)(nil),                    // 60: payload.v1.Info.CPU
-	(*Info_Memory)(nil),                 // 61: payload.v1.Info.Memory
-	(*Info_Pods)(nil),                   // 62: payload.v1.Info.Pods
-	(*Info_Nodes)(nil),                  // 63: payload.v1.Info.Nodes
-	(*Info_IPs)(nil),                    // 64: payload.v1.Info.IPs
-	(*Info_Index_Count)(nil),            // 65: payload.v1.Info.Index.Count
-	(*Info_Index_UUID)(nil),             // 66: payload.v1.Info.Index.UUID
-	(*Info_Index_UUID_Committed)(nil),   // 67: payload.v1.Info.Index.UUID.Committed
-	(*Info_Index_UUID_Uncommitted)(nil), // 68: payload.v1.Info.Index.UUID.Uncommitted
-	(*status.Status)(nil),               // 69: google.rpc.Status
+	(Consistency)(0),                     // 0: payload.v1.Consistency
+	(*Search)(nil),                       // 1: payload.v1.Search
+	(*Filter)(nil),                       // 2: payload.v1.Filter
+	(*Insert)(nil),                       // 3: payload.v1.Insert
+	(*Update)(nil),                       // 4: payload.v1.Update
+	(*Upsert)(nil),                       // 5: payload.v1.Upsert
+	(*Remove)(nil),                       // 6: payload.v1.Remove
+	(*Object)(nil),                       // 7: payload.v1.Object
+	(*Control)(nil),                      // 8: payload.v1.Control
+	(*Discoverer)(nil),                   // 9: payload.v1.Discoverer
+	(*Info)(nil),                         // 10: payload.v1.Info
+	(*Backup)(nil),                       // 11: payload.v1.Backup
+	(*Empty)(nil),                        // 12: payload.v1.Empty
+	(*Search_Request)(nil),               // 13: payload.v1.Search.Request
+	(*Search_MultiRequest)(nil),          // 14: payload.v1.Search.MultiRequest
+	(*Search_IDRequest)(nil),             // 15: payload.v1.Search.IDRequest
+	(*Search_MultiIDRequest)(nil),        // 16: payload.v1.Search.MultiIDRequest
+	(*Search_ObjectRequest)(nil),         // 17: payload.v1.Search.ObjectRequest
+	(*Search_MultiObjectRequest)(nil),    // 18: payload.v1.Search.MultiObjectRequest
+	(*Search_Config)(nil),                // 19: payload.v1.Search.Config
+	(*Search_Response)(nil),              // 20: payload.v1.Search.Response
+	(*Search_Responses)(nil),             // 21: payload.v1.Search.Responses
+	(*Search_StreamResponse)(nil),        // 22: payload.v1.Search.StreamResponse
+	(*Filter_Target)(nil),                // 23: payload.v1.Filter.Target
+	(*Filter_Config)(nil),                // 24: payload.v1.Filter.Config
+	(*Filter_SearchResponseRequest)(nil), // 25: payload.v1.Filter.SearchResponseRequest
+	(*Filter_RevectorizeRequest)(nil),    // 26: payload.v1.Filter.RevectorizeRequest
+	(*Insert_Request)(nil),               // 27: payload.v1.Insert.Request
+	(*Insert_MultiRequest)(nil),          // 28: payload.v1.Insert.MultiRequest
+	(*Insert_ObjectRequest)(nil),         // 29: payload.v1.Insert.ObjectRequest
+	(*Insert_MultiObjectRequest)(nil),    // 30: payload.v1.Insert.MultiObjectRequest
+	(*Insert_Config)(nil),                // 31: payload.v1.Insert.Config
+	(*Update_Request)(nil),               // 32: payload.v1.Update.Request
+	(*Update_MultiRequest)(nil),          // 33: payload.v1.Update.MultiRequest
+	(*Update_ObjectRequest)(nil),         // 34: payload.v1.Update.ObjectRequest
+	(*Update_MultiObjectRequest)(nil),    // 35: payload.v1.Update.MultiObjectRequest
+	(*Update_Config)(nil),                // 36: payload.v1.Update.Config
+	(*Upsert_Request)(nil),               // 37: payload.v1.Upsert.Request
+	(*Upsert_MultiRequest)(nil),          // 38: payload.v1.Upsert.MultiRequest
+	(*Upsert_ObjectRequest)(nil),         // 39: payload.v1.Upsert.ObjectRequest
+	(*Upsert_MultiObjectRequest)(nil),    // 40: payload.v1.Upsert.MultiObjectRequest
+	(*Upsert_Config)(nil),                // 41: payload.v1.Upsert.Config
+	(*Remove_Request)(nil),               // 42: payload.v1.Remove.Request
+	(*Remove_MultiRequest)(nil),          // 43: payload.v1.Remove.MultiRequest
+	(*Remove_Config)(nil),                // 44: payload.v1.Remove.Config
+	(*Object_VectorRequest)(nil),         // 45: payload.v1.Object.VectorRequest
+	(*Object_MultiVectorRequest)(nil),    // 46: payload.v1.Object.MultiVectorRequest
+	(*Object_Distance)(nil),              // 47: payload.v1.Object.Distance
+	(*Object_Attribute)(nil),             // 48: payload.v1.Object.Attribute
+	(*Object_StreamDistance)(nil),        // 49: payload.v1.Object.StreamDistance
+	(*Object_ID)(nil),                    // 50: payload.v1.Object.ID
+	(*Object_IDs)(nil),                   // 51: payload.v1.Object.IDs
+	(*Object_Vector)(nil),                // 52: payload.v1.Object.Vector
+	(*Object_Vectors)(nil),               // 53: payload.v1.Object.Vectors
+	(*Object_StreamVector)(nil),          // 54: payload.v1.Object.StreamVector
+	(*Object_ReshapeVector)(nil),         // 55: payload.v1.Object.ReshapeVector
+	(*Object_Blob)(nil),                  // 56: payload.v1.Object.Blob
+	(*Object_Blobs)(nil),                 // 57: payload.v1.Object.Blobs
+	(*Object_StreamBlob)(nil),            // 58: payload.v1.Object.StreamBlob
+	(*Object_Location)(nil),              // 59: payload.v1.Object.Location
+	(*Object_StreamLocation)(nil),        // 60: payload.v1.Object.StreamLocation
+	(*Object_Locations)(nil),             // 61: payload.v1.Object.Locations
+	(*Object_Location_Failure)(nil),      // 62: payload.v1.Object.Location.Failure
+	(*Control_CreateIndexRequest)(nil),   // 63: payload.v1.Control.CreateIndexRequest
+	(*Discoverer_Request)(nil),           // 64: payload.v1.Discoverer.Request
+	(*Info_Index)(nil),                   // 65: payload.v1.Info.Index
+	(*Info_Pod)(nil),                     // 66: payload.v1.Info.Pod
+	(*Info_Node)(nil),                    // 67: payload.v1.Info.Node
+	(*Info_CPU)(nil),                     // 68: payload.v1.Info.CPU
+	(*Info_Memory)(nil),                  // 69: payload.v1.Info.Memory
+	(*Info_Pods)(nil),                    // 70: payload.v1.Info.Pods
+	(*Info_Nodes)(nil),                   // 71: payload.v1.Info.Nodes
+	(*Info_IPs)(nil),                     // 72: payload.v1.Info.IPs
+	(*Info_Rebalance)(nil),               // 73: payload.v1.Info.Rebalance
+	(*Info_Index_Count)(nil),             // 74: payload.v1.Info.Index.Count
+	(*Info_Index_UUID)(nil),              // 75: payload.v1.Info.Index.UUID
+	(*Info_Index_UUIDs)(nil),             // 76: payload.v1.Info.Index.UUIDs
+	(*Info_Index_UUID_Committed)(nil),    // 77: payload.v1.Info.Index.UUID.Committed
+	(*Info_Index_UUID_Uncommitted)(nil),  // 78: payload.v1.Info.Index.UUID.Uncommitted
+	(*Info_Index_UUIDs_Request)(nil),     // 79: payload.v1.Info.Index.UUIDs.Request
+	(*Info_Rebalance_Move)(nil),          // 80: payload.v1.Info.Rebalance.Move
+	(*Backup_Request)(nil),               // 81: payload.v1.Backup.Request
+	(*Backup_RestoreRequest)(nil),        // 82: payload.v1.Backup.RestoreRequest
+	(*Backup_Info)(nil),                  // 83: payload.v1.Backup.Info
+	(*Backup_Infos)(nil),                 // 84: payload.v1.Backup.Infos
+	(*Backup_Result)(nil),                // 85: payload.v1.Backup.Result
+	(*Backup_Status)(nil),                // 86: payload.v1.Backup.Status
+	(*status.Status)(nil),                // 87: google.rpc.Status
 }
 var file_apis_proto_v1_payload_payload_proto_depIdxs = []int32{
-	17, // 0: payload.v1.Search.Request.config:type_name -> payload.v1.Search.Config
-	11, // 1: payload.v1.Search.MultiRequest.requests:type_name -> payload.v1.Search.Request
-	17, // 2: payload.v1.Search.IDRequest.config:type_name -> payload.v1.Search.Config
-	13, // 3: payload.v1.Search.MultiIDRequest.requests:type_name -> payload.v1.Search.IDRequest
-	17, // 4: payload.v1.Search.ObjectRequest.config:type_name -> payload.v1.Search.Config
-	21, // 5: payload.v1.Search.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
-	15, // 6: payload.v1.Search.MultiObjectRequest.requests:type_name -> payload.v1.Search.ObjectRequest
-	22, // 7: payload.v1.Search.Config.ingress_filters:type_name -> payload.v1.Filter.Config
-	22, // 8: payload.v1.Search.Config.egress_filters:type_name -> payload.v1.Filter.Config
-	42, // 9: payload.v1.Search.Response.results:type_name -> payload.v1.Object.Distance
-	18, // 10: payload.v1.Search.Responses.responses:type_name -> payload.v1.Search.Response
-	18, // 11: payload.v1.Search.StreamResponse.response:type_name -> payload.v1.Search.Response
-	69, // 12: payload.v1.Search.StreamResponse.status:type_name -> google.rpc.Status
-	21, // 13: payload.v1.Filter.Config.targets:type_name -> payload.v1.Filter.Target
-	46, // 14: payload.v1.Insert.Request.vector:type_name -> payload.v1.Object.Vector
-	27, // 15: payload.v1.Insert.Request.config:type_name -> payload.v1.Insert.Config
-	23, // 16: payload.v1.Insert.MultiRequest.requests:type_name -> payload.v1.Insert.Request
-	50, // 17: payload.v1.Insert.ObjectRequest.object:type_name -> payload.v1.Object.Blob
-	27, // 18: payload.v1.Insert.ObjectRequest.config:type_name -> payload.v1.Insert.Config
-	21, // 19: payload.v1.Insert.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
-	25, // 20: payload.v1.Insert.MultiObjectRequest.requests:type_name -> payload.v1.Insert.ObjectRequest
-	22, // 21: payload.v1.Insert.Config.filters:type_name -> payload.v1.Filter.Config
-	46, // 22: payload.v1.Update.Request.vector:type_name -> payload.v1.Object.Vector
-	32, // 23: payload.v1.Update.Request.config:type_name -> payload.v1.Update.Config
-	28, // 24: payload.v1.Update.MultiRequest.requests:type_name -> payload.v1.Update.Request
-	50, // 25: payload.v1.Update.ObjectRequest.object:type_name -> payload.v1.Object.Blob
-	32, // 26: payload.v1.Update.ObjectRequest.config:type_name -> payload.v1.Update.Config
-	21, // 27: payload.v1.Update.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
-	30, // 28: payload.v1.Update.MultiObjectRequest.requests:type_name -> payload.v1.Update.ObjectRequest
-	22, // 29: payload.v1.Update.Config.filters:type_name -> payload.v1.Filter.Config
-	46, // 30: payload.v1.Upsert.Request.vector:type_name -> payload.v1.Object.Vector
-	37, // 31: payload.v1.Upsert.Request.config:type_name -> payload.v1.Upsert.Config
-	33, // 32: payload.v1.Upsert.MultiRequest.requests:type_name -> payload.v1.Upsert.Request
-	50, // 33: payload.v1.Upsert.ObjectRequest.object:type_name -> payload.v1.Object.Blob
-	37, // 34: payload.v1.Upsert.ObjectRequest.config:type_name -> payload.v1.Upsert.Config
-	21, // 35: payload.v1.Upsert.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
-	35, // 36: payload.v1.Upsert.MultiObjectRequest.requests:type_name -> payload.v1.Upsert.ObjectRequest
-	22, // 37: payload.v1.Upsert.Config.filters:type_name -> payload.v1.Filter.Config
-	44, // 38: payload.v1.Remove.Request.id:type_name -> payload.v1.Object.ID
-	40, // 39: payload.v1.Remove.Request.config:type_name -> payload.v1.Remove.Config
-	38, // 40: payload.v1.Remove.MultiRequest.requests:type_name -> payload.v1.Remove.Request
-	44, // 41: payload.v1.Object.VectorRequest.id:type_name -> payload.v1.Object.ID
-	22, // 42: payload.v1.Object.VectorRequest.filters:type_name -> payload.v1.Filter.Config
-	42, // 43: payload.v1.Object.StreamDistance.distance:type_name -> payload.v1.Object.Distance
-	69, // 44: payload.v1.Object.StreamDistance.status:type_name -> google.rpc.Status
-	46, // 45: payload.v1.Object.Vectors.vectors:type_name -> payload.v1.Object.Vector
-	46, // 46: payload.v1.Object.StreamVector.vector:type_name -> payload.v1.Object.Vector
-	69, // 47: payload.v1.Object.StreamVector.status:type_name -> google.rpc.Status
-	50, // 48: payload.v1.Object.StreamBlob.blob:type_name -> payload.v1.Object.Blob
-	69, // 49: payload.v1.Object.StreamBlob.status:type_name -> google.rpc.Status
-	52, // 50: payload.v1.Object.StreamLocation.location:type_name -> payload.v1.Object.Location
-	69, // 51: payload.v1.Object.StreamLocation.status:type_name -> google.rpc.Status
-	52, // 52: payload.v1.Object.Locations.locations:type_name -> payload.v1.Object.Location
-	60, // 53: payload.v1.Info.Pod.cpu:type_name -> payload.v1.Info.CPU
-	61, // 54: payload.v1.Info.Pod.memory:type_name -> payload.v1.Info.Memory
-	59, // 55: payload.v1.Info.Pod.node:type_name -> payload.v1.Info.Node
-	60, // 56: payload.v1.Info.Node.cpu:type_name -> payload.v1.Info.CPU
-	61, // 57: payload.v1.Info.Node.memory:type_name -> payload.v1.Info.Memory
-	62, // 58: payload.v1.Info.Node.Pods:type_name -> payload.v1.Info.Pods
-	58, // 59: payload.v1.Info.Pods.pods:type_name -> payload.v1.Info.Pod
-	59, // 60: payload.v1.Info.Nodes.nodes:type_name -> payload.v1.Info.Node
-	61, // [61:61] is the sub-list for method output_type
-	61, // [61:61] is the sub-list for method input_type
-	61, // [61:61] is the sub-list for extension type_name
-	61, // [61:61] is the sub-list for extension extendee
-	0,  // [0:61] is the sub-list for field type_name
+	19, // 0: payload.v1.Search.Request.config:type_name -> payload.v1.Search.Config
+	13, // 1: payload.v1.Search.MultiRequest.requests:type_name -> payload.v1.Search.Request
+	19, // 2: payload.v1.Search.IDRequest.config:type_name -> payload.v1.Search.Config
+	15, // 3: payload.v1.Search.MultiIDRequest.requests:type_name -> payload.v1.Search.IDRequest
+	19, // 4: payload.v1.Search.ObjectRequest.config:type_name -> payload.v1.Search.Config
+	23, // 5: payload.v1.Search.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
+	17, // 6: payload.v1.Search.MultiObjectRequest.requests:type_name -> payload.v1.Search.ObjectRequest
+	24, // 7: payload.v1.Search.Config.ingress_filters:type_name -> payload.v1.Filter.Config
+	24, // 8: payload.v1.Search.Config.egress_filters:type_name -> payload.v1.Filter.Config
+	47, // 9: payload.v1.Search.Response.results:type_name -> payload.v1.Object.Distance
+	20, // 10: payload.v1.Search.Responses.responses:type_name -> payload.v1.Search.Response
+	20, // 11: payload.v1.Search.StreamResponse.response:type_name -> payload.v1.Search.Response
+	87, // 12: payload.v1.Search.StreamResponse.status:type_name -> google.rpc.Status
+	23, // 13: payload.v1.Filter.Config.targets:type_name -> payload.v1.Filter.Target
+	19, // 14: payload.v1.Filter.SearchResponseRequest.config:type_name -> payload.v1.Search.Config
+	20, // 15: payload.v1.Filter.SearchResponseRequest.response:type_name -> payload.v1.Search.Response
+	23, // 16: payload.v1.Filter.RevectorizeRequest.vectorizer:type_name -> payload.v1.Filter.Target
+	41, // 17: payload.v1.Filter.RevectorizeRequest.config:type_name -> payload.v1.Upsert.Config
+	52, // 18: payload.v1.Insert.Request.vector:type_name -> payload.v1.Object.Vector
+	31, // 19: payload.v1.Insert.Request.config:type_name -> payload.v1.Insert.Config
+	27, // 20: payload.v1.Insert.MultiRequest.requests:type_name -> payload.v1.Insert.Request
+	56, // 21: payload.v1.Insert.ObjectRequest.object:type_name -> payload.v1.Object.Blob
+	31, // 22: payload.v1.Insert.ObjectRequest.config:type_name -> payload.v1.Insert.Config
+	23, // 23: payload.v1.Insert.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
+	29, // 24: payload.v1.Insert.MultiObjectRequest.requests:type_name -> payload.v1.Insert.ObjectRequest
+	24, // 25: payload.v1.Insert.Config.filters:type_name -> payload.v1.Filter.Config
+	0,  // 26: payload.v1.Insert.Config.consistency:type_name -> payload.v1.Consistency
+	52, // 27: payload.v1.Update.Request.vector:type_name -> payload.v1.Object.Vector
+	36, // 28: payload.v1.Update.Request.config:type_name -> payload.v1.Update.Config
+	32, // 29: payload.v1.Update.MultiRequest.requests:type_name -> payload.v1.Update.Request
+	56, // 30: payload.v1.Update.ObjectRequest.object:type_name -> payload.v1.Object.Blob
+	36, // 31: payload.v1.Update.ObjectRequest.config:type_name -> payload.v1.Update.Config
+	23, // 32: payload.v1.Update.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
+	34, // 33: payload.v1.Update.MultiObjectRequest.requests:type_name -> payload.v1.Update.ObjectRequest
+	24, // 34: payload.v1.Update.Config.filters:type_name -> payload.v1.Filter.Config
+	0,  // 35: payload.v1.Update.Config.consistency:type_name -> payload.v1.Consistency
+	52, // 36: payload.v1.Upsert.Request.vector:type_name -> payload.v1.Object.Vector
+	41, // 37: payload.v1.Upsert.Request.config:type_name -> payload.v1.Upsert.Config
+	37, // 38: payload.v1.Upsert.MultiRequest.requests:type_name -> payload.v1.Upsert.Request
+	56, // 39: payload.v1.Upsert.ObjectRequest.object:type_name -> payload.v1.Object.Blob
+	41, // 40: payload.v1.Upsert.ObjectRequest.config:type_name -> payload.v1.Upsert.Config
+	23, // 41: payload.v1.Upsert.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
+	39, // 42: payload.v1.Upsert.MultiObjectRequest.requests:type_name -> payload.v1.Upsert.ObjectRequest
+	24, // 43: payload.v1.Upsert.Config.filters:type_name -> payload.v1.Filter.Config
+	0,  // 44: payload.v1.Upsert.Config.consistency:type_name -> payload.v1.Consistency
+	50, // 45: payload.v1.Remove.Request.id:type_name -> payload.v1.Object.ID
+	44, // 46: payload.v1.Remove.Request.config:type_name -> payload.v1.Remove.Config
+	42, // 47: payload.v1.Remove.MultiRequest.requests:type_name -> payload.v1.Remove.Request
+	0,  // 48: payload.v1.Remove.Config.consistency:type_name -> payload.v1.Consistency
+	50, // 49: payload.v1.Object.VectorRequest.id:type_name -> payload.v1.Object.ID
+	24, // 50: payload.v1.Object.VectorRequest.filters:type_name -> payload.v1.Filter.Config
+	45, // 51: payload.v1.Object.MultiVectorRequest.requests:type_name -> payload.v1.Object.VectorRequest
+	48, // 52: payload.v1.Object.Distance.attributes:type_name -> payload.v1.Object.Attribute
+	47, // 53: payload.v1.Object.StreamDistance.distance:type_name -> payload.v1.Object.Distance
+	87, // 54: payload.v1.Object.StreamDistance.status:type_name -> google.rpc.Status
+	52, // 55: payload.v1.Object.Vectors.vectors:type_name -> payload.v1.Object.Vector
+	52, // 56: payload.v1.Object.StreamVector.vector:type_name -> payload.v1.Object.Vector
+	87, // 57: payload.v1.Object.StreamVector.status:type_name -> google.rpc.Status
+	56, // 58: payload.v1.Object.Blobs.blobs:type_name -> payload.v1.Object.Blob
+	56, // 59: payload.v1.Object.StreamBlob.blob:type_name -> payload.v1.Object.Blob
+	87, // 60: payload.v1.Object.StreamBlob.status:type_name -> google.rpc.Status
+	62, // 61: payload.v1.Object.Location.failures:type_name -> payload.v1.Object.Location.Failure
+	59, // 62: payload.v1.Object.StreamLocation.location:type_name -> payload.v1.Object.Location
+	87, // 63: payload.v1.Object.StreamLocation.status:type_name -> google.rpc.Status
+	59, // 64: payload.v1.Object.Locations.locations:type_name -> payload.v1.Object.Location
+	87, // 65: payload.v1.Object.Location.Failure.status:type_name -> google.rpc.Status
+	68, // 66: payload.v1.Info.Pod.cpu:type_name -> payload.v1.Info.CPU
+	69, // 67: payload.v1.Info.Pod.memory:type_name -> payload.v1.Info.Memory
+	67, // 68: payload.v1.Info.Pod.node:type_name -> payload.v1.Info.Node
+	68, // 69: payload.v1.Info.Node.cpu:type_name -> payload.v1.Info.CPU
+	69, // 70: payload.v1.Info.Node.memory:type_name -> payload.v1.Info.Memory
+	70, // 71: payload.v1.Info.Node.Pods:type_name -> payload.v1.Info.Pods
+	66, // 72: payload.v1.Info.Pods.pods:type_name -> payload.v1.Info.Pod
+	67, // 73: payload.v1.Info.Nodes.nodes:type_name -> payload.v1.Info.Node
+	80, // 74: payload.v1.Info.Rebalance.moves:type_name -> payload.v1.Info.Rebalance.Move
+	83, // 75: payload.v1.Backup.Infos.infos:type_name -> payload.v1.Backup.Info
+	85, // 76: payload.v1.Backup.Status.last_success:type_name -> payload.v1.Backup.Result
+	85, // 77: payload.v1.Backup.Status.last_failure:type_name -> payload.v1.Backup.Result
+	78, // [78:78] is the sub-list for method output_type
+	78, // [78:78] is the sub-list for method input_type
+	78, // [78:78] is the sub-list for extension type_name
+	78, // [78:78] is the sub-list for extension extendee
+	0,  // [0:78] is the sub-list for field type_name
 }
 
 func init() { file_apis_proto_v1_payload_payload_proto_init() }
@@ -4510,8 +5894,200 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Filter); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Insert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Update); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upsert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Remove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Control); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Discoverer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Backup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search_MultiRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search_IDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search_MultiIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search_ObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search_MultiObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4522,8 +6098,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Insert); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search_Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4534,8 +6110,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Update); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4546,8 +6122,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Upsert); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search_Responses); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4558,8 +6134,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Remove); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search_StreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4570,8 +6146,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter_Target); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4582,8 +6158,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Control); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter_Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4594,8 +6170,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Discoverer); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter_SearchResponseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4606,8 +6182,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter_RevectorizeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4618,8 +6194,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Insert_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4630,8 +6206,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search_Request); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Insert_MultiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4642,8 +6218,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search_MultiRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Insert_ObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4654,8 +6230,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search_IDRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Insert_MultiObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4666,8 +6242,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search_MultiIDRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Insert_Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4678,8 +6254,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search_ObjectRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Update_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4690,8 +6266,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search_MultiObjectRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Update_MultiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4702,8 +6278,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search_Config); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Update_ObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4714,8 +6290,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search_Response); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Update_MultiObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4726,8 +6302,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search_Responses); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Update_Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4738,8 +6314,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search_StreamResponse); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upsert_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4750,8 +6326,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Filter_Target); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upsert_MultiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4762,8 +6338,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Filter_Config); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upsert_ObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4774,8 +6350,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Insert_Request); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upsert_MultiObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4786,8 +6362,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Insert_MultiRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upsert_Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4798,8 +6374,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Insert_ObjectRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Remove_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4810,8 +6386,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Insert_MultiObjectRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Remove_MultiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4822,8 +6398,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Insert_Config); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Remove_Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4834,8 +6410,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Update_Request); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_VectorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4846,8 +6422,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Update_MultiRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_MultiVectorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4858,8 +6434,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Update_ObjectRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_Distance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4870,8 +6446,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Update_MultiObjectRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_Attribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4882,8 +6458,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Update_Config); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_StreamDistance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4894,8 +6470,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Upsert_Request); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_ID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4906,8 +6482,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Upsert_MultiRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_IDs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4918,8 +6494,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Upsert_ObjectRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_Vector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4930,8 +6506,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Upsert_MultiObjectRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_Vectors); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4942,8 +6518,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Upsert_Config); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_StreamVector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4954,8 +6530,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Remove_Request); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_ReshapeVector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4966,8 +6542,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Remove_MultiRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_Blob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4978,8 +6554,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Remove_Config); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_Blobs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4990,8 +6566,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_VectorRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_StreamBlob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5002,8 +6578,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_Distance); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_Location); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5014,8 +6590,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_StreamDistance); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_StreamLocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5026,8 +6602,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_ID); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_Locations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5038,8 +6614,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_IDs); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Object_Location_Failure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5050,8 +6626,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_Vector); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Control_CreateIndexRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5062,8 +6638,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_Vectors); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Discoverer_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5074,8 +6650,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_StreamVector); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Index); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5086,8 +6662,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_ReshapeVector); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Pod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5098,8 +6674,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_Blob); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Node); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5110,8 +6686,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_StreamBlob); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_CPU); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5122,8 +6698,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_Location); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Memory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5134,8 +6710,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_StreamLocation); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Pods); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5146,8 +6722,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object_Locations); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Nodes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5158,8 +6734,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Control_CreateIndexRequest); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_IPs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5170,8 +6746,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Discoverer_Request); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Rebalance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5182,8 +6758,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_Index); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Index_Count); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5194,8 +6770,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_Pod); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Index_UUID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5206,8 +6782,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_Node); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Index_UUIDs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5218,8 +6794,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_CPU); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Index_UUID_Committed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5230,8 +6806,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_Memory); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Index_UUID_Uncommitted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5242,8 +6818,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_Pods); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Index_UUIDs_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5254,8 +6830,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_Nodes); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Info_Rebalance_Move); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5266,8 +6842,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_IPs); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Backup_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5278,8 +6854,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_Index_Count); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Backup_RestoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5290,8 +6866,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_Index_UUID); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Backup_Info); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5302,8 +6878,8 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_Index_UUID_Committed); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Backup_Infos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5314,8 +6890,20 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_v1_payload_payload_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Info_Index_UUID_Uncommitted); i {
+		file_apis_proto_v1_payload_payload_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Backup_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_v1_payload_payload_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Backup_Status); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5327,23 +6915,23 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 			}
 		}
 	}
-	file_apis_proto_v1_payload_payload_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_apis_proto_v1_payload_payload_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*Search_StreamResponse_Response)(nil),
 		(*Search_StreamResponse_Status)(nil),
 	}
-	file_apis_proto_v1_payload_payload_proto_msgTypes[43].OneofWrappers = []interface{}{
+	file_apis_proto_v1_payload_payload_proto_msgTypes[48].OneofWrappers = []interface{}{
 		(*Object_StreamDistance_Distance)(nil),
 		(*Object_StreamDistance_Status)(nil),
 	}
-	file_apis_proto_v1_payload_payload_proto_msgTypes[48].OneofWrappers = []interface{}{
+	file_apis_proto_v1_payload_payload_proto_msgTypes[53].OneofWrappers = []interface{}{
 		(*Object_StreamVector_Vector)(nil),
 		(*Object_StreamVector_Status)(nil),
 	}
-	file_apis_proto_v1_payload_payload_proto_msgTypes[51].OneofWrappers = []interface{}{
+	file_apis_proto_v1_payload_payload_proto_msgTypes[57].OneofWrappers = []interface{}{
 		(*Object_StreamBlob_Blob)(nil),
 		(*Object_StreamBlob_Status)(nil),
 	}
-	file_apis_proto_v1_payload_payload_proto_msgTypes[53].OneofWrappers = []interface{}{
+	file_apis_proto_v1_payload_payload_proto_msgTypes[59].OneofWrappers = []interface{}{
 		(*Object_StreamLocation_Location)(nil),
 		(*Object_StreamLocation_Status)(nil),
 	}
@@ -5352,13 +6940,14 @@ func file_apis_proto_v1_payload_payload_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apis_proto_v1_payload_payload_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   69,
+			NumEnums:      1,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_apis_proto_v1_payload_payload_proto_goTypes,
 		DependencyIndexes: file_apis_proto_v1_payload_payload_proto_depIdxs,
+		EnumInfos:         file_apis_proto_v1_payload_payload_proto_enumTypes,
 		MessageInfos:      file_apis_proto_v1_payload_payload_proto_msgTypes,
 	}.Build()
 	File_apis_proto_v1_payload_payload_proto = out.File
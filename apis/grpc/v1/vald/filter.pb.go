@@ -49,8 +49,8 @@ var file_apis_proto_v1_vald_filter_proto_rawDesc = []byte{
 	0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69,
 	0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xac,
-	0x0a, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x0c, 0x53, 0x65, 0x61,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x89,
+	0x0b, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x0c, 0x53, 0x65, 0x61,
 	0x72, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6c,
 	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x4f, 0x62,
 	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x61,
@@ -132,13 +132,19 @@ var file_apis_proto_v1_vald_filter_proto_rawDesc = []byte{
 	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
 	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
 	0x1c, 0x22, 0x17, 0x2f, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x2f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x42, 0x53, 0x0a,
-	0x1a, 0x6f, 0x72, 0x67, 0x2e, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x61, 0x6c, 0x64, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x76, 0x61, 0x6c, 0x64, 0x42, 0x0a, 0x56, 0x61, 0x6c,
-	0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x64,
-	0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61,
-	0x6c, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x5b, 0x0a,
+	0x0b, 0x52, 0x65, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x2e, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x42, 0x53, 0x0a, 0x1a, 0x6f, 0x72,
+	0x67, 0x2e, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x61, 0x6c, 0x64, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x76, 0x61, 0x6c, 0x64, 0x42, 0x0a, 0x56, 0x61, 0x6c, 0x64, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x64, 0x61, 0x61, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x64, 0x2f, 0x61, 0x70,
+	0x69, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x64, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_apis_proto_v1_vald_filter_proto_goTypes = []interface{}{
@@ -150,12 +156,13 @@ var file_apis_proto_v1_vald_filter_proto_goTypes = []interface{}{
 	(*payload.Update_MultiObjectRequest)(nil), // 5: payload.v1.Update.MultiObjectRequest
 	(*payload.Upsert_ObjectRequest)(nil),      // 6: payload.v1.Upsert.ObjectRequest
 	(*payload.Upsert_MultiObjectRequest)(nil), // 7: payload.v1.Upsert.MultiObjectRequest
-	(*payload.Search_Response)(nil),           // 8: payload.v1.Search.Response
-	(*payload.Search_Responses)(nil),          // 9: payload.v1.Search.Responses
-	(*payload.Search_StreamResponse)(nil),     // 10: payload.v1.Search.StreamResponse
-	(*payload.Object_Location)(nil),           // 11: payload.v1.Object.Location
-	(*payload.Object_StreamLocation)(nil),     // 12: payload.v1.Object.StreamLocation
-	(*payload.Object_Locations)(nil),          // 13: payload.v1.Object.Locations
+	(*payload.Filter_RevectorizeRequest)(nil), // 8: payload.v1.Filter.RevectorizeRequest
+	(*payload.Search_Response)(nil),           // 9: payload.v1.Search.Response
+	(*payload.Search_Responses)(nil),          // 10: payload.v1.Search.Responses
+	(*payload.Search_StreamResponse)(nil),     // 11: payload.v1.Search.StreamResponse
+	(*payload.Object_Location)(nil),           // 12: payload.v1.Object.Location
+	(*payload.Object_StreamLocation)(nil),     // 13: payload.v1.Object.StreamLocation
+	(*payload.Object_Locations)(nil),          // 14: payload.v1.Object.Locations
 }
 var file_apis_proto_v1_vald_filter_proto_depIdxs = []int32{
 	0,  // 0: vald.v1.Filter.SearchObject:input_type -> payload.v1.Search.ObjectRequest
@@ -170,20 +177,22 @@ var file_apis_proto_v1_vald_filter_proto_depIdxs = []int32{
 	6,  // 9: vald.v1.Filter.UpsertObject:input_type -> payload.v1.Upsert.ObjectRequest
 	6,  // 10: vald.v1.Filter.StreamUpsertObject:input_type -> payload.v1.Upsert.ObjectRequest
 	7,  // 11: vald.v1.Filter.MultiUpsertObject:input_type -> payload.v1.Upsert.MultiObjectRequest
-	8,  // 12: vald.v1.Filter.SearchObject:output_type -> payload.v1.Search.Response
-	9,  // 13: vald.v1.Filter.MultiSearchObject:output_type -> payload.v1.Search.Responses
-	10, // 14: vald.v1.Filter.StreamSearchObject:output_type -> payload.v1.Search.StreamResponse
-	11, // 15: vald.v1.Filter.InsertObject:output_type -> payload.v1.Object.Location
-	12, // 16: vald.v1.Filter.StreamInsertObject:output_type -> payload.v1.Object.StreamLocation
-	13, // 17: vald.v1.Filter.MultiInsertObject:output_type -> payload.v1.Object.Locations
-	11, // 18: vald.v1.Filter.UpdateObject:output_type -> payload.v1.Object.Location
-	12, // 19: vald.v1.Filter.StreamUpdateObject:output_type -> payload.v1.Object.StreamLocation
-	13, // 20: vald.v1.Filter.MultiUpdateObject:output_type -> payload.v1.Object.Locations
-	11, // 21: vald.v1.Filter.UpsertObject:output_type -> payload.v1.Object.Location
-	12, // 22: vald.v1.Filter.StreamUpsertObject:output_type -> payload.v1.Object.StreamLocation
-	13, // 23: vald.v1.Filter.MultiUpsertObject:output_type -> payload.v1.Object.Locations
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
+	8,  // 12: vald.v1.Filter.Revectorize:input_type -> payload.v1.Filter.RevectorizeRequest
+	9,  // 13: vald.v1.Filter.SearchObject:output_type -> payload.v1.Search.Response
+	10, // 14: vald.v1.Filter.MultiSearchObject:output_type -> payload.v1.Search.Responses
+	11, // 15: vald.v1.Filter.StreamSearchObject:output_type -> payload.v1.Search.StreamResponse
+	12, // 16: vald.v1.Filter.InsertObject:output_type -> payload.v1.Object.Location
+	13, // 17: vald.v1.Filter.StreamInsertObject:output_type -> payload.v1.Object.StreamLocation
+	14, // 18: vald.v1.Filter.MultiInsertObject:output_type -> payload.v1.Object.Locations
+	12, // 19: vald.v1.Filter.UpdateObject:output_type -> payload.v1.Object.Location
+	13, // 20: vald.v1.Filter.StreamUpdateObject:output_type -> payload.v1.Object.StreamLocation
+	14, // 21: vald.v1.Filter.MultiUpdateObject:output_type -> payload.v1.Object.Locations
+	12, // 22: vald.v1.Filter.UpsertObject:output_type -> payload.v1.Object.Location
+	13, // 23: vald.v1.Filter.StreamUpsertObject:output_type -> payload.v1.Object.StreamLocation
+	14, // 24: vald.v1.Filter.MultiUpsertObject:output_type -> payload.v1.Object.Locations
+	13, // 25: vald.v1.Filter.Revectorize:output_type -> payload.v1.Object.StreamLocation
+	13, // [13:26] is the sub-list for method output_type
+	0,  // [0:13] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -66,6 +66,8 @@ type FilterClient interface {
 	StreamUpsertObject(ctx context.Context, opts ...grpc.CallOption) (Filter_StreamUpsertObjectClient, error)
 	// A method to upsert multiple objects.
 	MultiUpsertObject(ctx context.Context, in *payload.Upsert_MultiObjectRequest, opts ...grpc.CallOption) (*payload.Object_Locations, error)
+	// A method to re-vectorize all the stored objects and upsert them.
+	Revectorize(ctx context.Context, in *payload.Filter_RevectorizeRequest, opts ...grpc.CallOption) (Filter_RevectorizeClient, error)
 }
 
 type filterClient struct {
@@ -272,6 +274,38 @@ func (c *filterClient) MultiUpsertObject(ctx context.Context, in *payload.Upsert
 	return out, nil
 }
 
+func (c *filterClient) Revectorize(ctx context.Context, in *payload.Filter_RevectorizeRequest, opts ...grpc.CallOption) (Filter_RevectorizeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Filter_ServiceDesc.Streams[4], "/vald.v1.Filter/Revectorize", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &filterRevectorizeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Filter_RevectorizeClient interface {
+	Recv() (*payload.Object_StreamLocation, error)
+	grpc.ClientStream
+}
+
+type filterRevectorizeClient struct {
+	grpc.ClientStream
+}
+
+func (x *filterRevectorizeClient) Recv() (*payload.Object_StreamLocation, error) {
+	m := new(payload.Object_StreamLocation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // FilterServer is the server API for Filter service.
 // All implementations must embed UnimplementedFilterServer
 // for forward compatibility
@@ -300,6 +334,8 @@ type FilterServer interface {
 	StreamUpsertObject(Filter_StreamUpsertObjectServer) error
 	// A method to upsert multiple objects.
 	MultiUpsertObject(context.Context, *payload.Upsert_MultiObjectRequest) (*payload.Object_Locations, error)
+	// A method to re-vectorize all the stored objects and upsert them.
+	Revectorize(*payload.Filter_RevectorizeRequest, Filter_RevectorizeServer) error
 	mustEmbedUnimplementedFilterServer()
 }
 
@@ -343,6 +379,9 @@ func (UnimplementedFilterServer) StreamUpsertObject(Filter_StreamUpsertObjectSer
 func (UnimplementedFilterServer) MultiUpsertObject(context.Context, *payload.Upsert_MultiObjectRequest) (*payload.Object_Locations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiUpsertObject not implemented")
 }
+func (UnimplementedFilterServer) Revectorize(*payload.Filter_RevectorizeRequest, Filter_RevectorizeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Revectorize not implemented")
+}
 func (UnimplementedFilterServer) mustEmbedUnimplementedFilterServer() {}
 
 // UnsafeFilterServer may be embedded to opt out of forward compatibility for this service.
@@ -604,6 +643,27 @@ func _Filter_MultiUpsertObject_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Filter_Revectorize_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(payload.Filter_RevectorizeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FilterServer).Revectorize(m, &filterRevectorizeServer{stream})
+}
+
+type Filter_RevectorizeServer interface {
+	Send(*payload.Object_StreamLocation) error
+	grpc.ServerStream
+}
+
+type filterRevectorizeServer struct {
+	grpc.ServerStream
+}
+
+func (x *filterRevectorizeServer) Send(m *payload.Object_StreamLocation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Filter_ServiceDesc is the grpc.ServiceDesc for Filter service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -669,6 +729,11 @@ var Filter_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "Revectorize",
+			Handler:       _Filter_Revectorize_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "apis/proto/v1/vald/filter.proto",
 }
@@ -48,6 +48,8 @@ type ObjectClient interface {
 	GetObject(ctx context.Context, in *payload.Object_VectorRequest, opts ...grpc.CallOption) (*payload.Object_Vector, error)
 	// A method to fetch vectors by bidirectional streaming.
 	StreamGetObject(ctx context.Context, opts ...grpc.CallOption) (Object_StreamGetObjectClient, error)
+	// A method to fetch vectors by multiple IDs in a single request.
+	MultiGetObject(ctx context.Context, in *payload.Object_MultiVectorRequest, opts ...grpc.CallOption) (*payload.Object_Vectors, error)
 }
 
 type objectClient struct {
@@ -107,6 +109,15 @@ func (x *objectStreamGetObjectClient) Recv() (*payload.Object_StreamVector, erro
 	return m, nil
 }
 
+func (c *objectClient) MultiGetObject(ctx context.Context, in *payload.Object_MultiVectorRequest, opts ...grpc.CallOption) (*payload.Object_Vectors, error) {
+	out := new(payload.Object_Vectors)
+	err := c.cc.Invoke(ctx, "/vald.v1.Object/MultiGetObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ObjectServer is the server API for Object service.
 // All implementations must embed UnimplementedObjectServer
 // for forward compatibility
@@ -117,6 +128,8 @@ type ObjectServer interface {
 	GetObject(context.Context, *payload.Object_VectorRequest) (*payload.Object_Vector, error)
 	// A method to fetch vectors by bidirectional streaming.
 	StreamGetObject(Object_StreamGetObjectServer) error
+	// A method to fetch vectors by multiple IDs in a single request.
+	MultiGetObject(context.Context, *payload.Object_MultiVectorRequest) (*payload.Object_Vectors, error)
 	mustEmbedUnimplementedObjectServer()
 }
 
@@ -133,6 +146,9 @@ func (UnimplementedObjectServer) GetObject(context.Context, *payload.Object_Vect
 func (UnimplementedObjectServer) StreamGetObject(Object_StreamGetObjectServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamGetObject not implemented")
 }
+func (UnimplementedObjectServer) MultiGetObject(context.Context, *payload.Object_MultiVectorRequest) (*payload.Object_Vectors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiGetObject not implemented")
+}
 func (UnimplementedObjectServer) mustEmbedUnimplementedObjectServer() {}
 
 // UnsafeObjectServer may be embedded to opt out of forward compatibility for this service.
@@ -208,6 +224,24 @@ func (x *objectStreamGetObjectServer) Recv() (*payload.Object_VectorRequest, err
 	return m, nil
 }
 
+func _Object_MultiGetObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Object_MultiVectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectServer).MultiGetObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vald.v1.Object/MultiGetObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectServer).MultiGetObject(ctx, req.(*payload.Object_MultiVectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Object_ServiceDesc is the grpc.ServiceDesc for Object service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -223,6 +257,10 @@ var Object_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetObject",
 			Handler:    _Object_GetObject_Handler,
 		},
+		{
+			MethodName: "MultiGetObject",
+			Handler:    _Object_MultiGetObject_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
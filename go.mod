@@ -29,6 +29,7 @@ replace (
 	github.com/klauspost/compress => github.com/klauspost/compress v1.13.7-0.20211011124647-94ad1f0bf03c
 	github.com/kpango/glg => github.com/kpango/glg v1.6.4
 	github.com/tensorflow/tensorflow => github.com/tensorflow/tensorflow v2.1.2+incompatible
+	github.com/tetratelabs/wazero => github.com/tetratelabs/wazero v1.3.1
 	github.com/zeebo/xxh3 => github.com/zeebo/xxh3 v0.13.0
 	go.uber.org/goleak => go.uber.org/goleak v1.1.12
 	go.uber.org/multierr => go.uber.org/multierr v1.7.0
@@ -101,10 +102,12 @@ require (
 	github.com/leanovate/gopter v0.2.9
 	github.com/lucasb-eyer/go-colorful v1.2.0
 	github.com/pierrec/lz4/v3 v3.3.3
+	github.com/prometheus/common v0.28.0
 	github.com/quasilyte/go-ruleguard v0.3.13
 	github.com/quasilyte/go-ruleguard/dsl v0.3.10
 	github.com/scylladb/gocqlx v1.5.0
 	github.com/tensorflow/tensorflow v0.0.0-00010101000000-000000000000
+	github.com/tetratelabs/wazero v1.3.1
 	github.com/zeebo/xxh3 v0.12.0
 	go.opencensus.io v0.23.0
 	go.uber.org/automaxprocs v1.4.0
@@ -189,7 +192,6 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/prometheus/client_golang v1.11.0 // indirect
 	github.com/prometheus/client_model v0.2.0 // indirect
-	github.com/prometheus/common v0.28.0 // indirect
 	github.com/prometheus/procfs v0.6.0 // indirect
 	github.com/prometheus/statsd_exporter v0.21.0 // indirect
 	github.com/scylladb/go-reflectx v1.0.1 // indirect
@@ -33,6 +33,7 @@ type Cache interface {
 	Set(string, interface{})
 	Delete(string)
 	GetAndDelete(string) (interface{}, bool)
+	Len() int
 }
 
 type cache struct {
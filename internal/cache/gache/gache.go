@@ -76,3 +76,8 @@ func (c *cache) GetAndDelete(key string) (interface{}, bool) {
 	c.gache.Delete(key)
 	return v, true
 }
+
+// Len returns the number of the stored values in c.gache.
+func (c *cache) Len() int {
+	return c.gache.Len()
+}
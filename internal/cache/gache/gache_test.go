@@ -567,3 +567,79 @@ func Test_cache_GetAndDelete(t *testing.T) {
 		})
 	}
 }
+
+func Test_cache_Len(t *testing.T) {
+	type fields struct {
+		gache          gache.Gache
+		expireDur      time.Duration
+		expireCheckDur time.Duration
+		expiredHook    func(context.Context, string)
+	}
+	type want struct {
+		want int
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		checkFunc  func(want, int) error
+		beforeFunc func(*cache)
+	}
+	defaultCheckFunc := func(w want, got int) error {
+		if got != w.want {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "Call Len when gache is empty",
+			fields: fields{
+				gache:          gache.New(),
+				expireDur:      1 * time.Second,
+				expireCheckDur: 1 * time.Second,
+			},
+			want: want{
+				want: 0,
+			},
+		},
+		{
+			name: "Call Len when gache is not empty",
+			fields: fields{
+				gache:          gache.New(),
+				expireDur:      1 * time.Second,
+				expireCheckDur: 1 * time.Second,
+			},
+			want: want{
+				want: 2,
+			},
+			beforeFunc: func(c *cache) {
+				c.Set("vdaas", "vald")
+				c.Set("vald", "vdaas")
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			c := &cache{
+				gache:          test.fields.gache,
+				expireDur:      test.fields.expireDur,
+				expireCheckDur: test.fields.expireCheckDur,
+				expiredHook:    test.fields.expiredHook,
+			}
+			if test.beforeFunc != nil {
+				test.beforeFunc(c)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := c.Len()
+			if err := test.checkFunc(test.want, got); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
@@ -176,6 +176,31 @@ func (c *agentClient) IndexInfo(
 	return res, nil
 }
 
+func (c *agentClient) IndexUUIDs(
+	ctx context.Context,
+	req *client.InfoIndexUUIDsRequest,
+	opts ...grpc.CallOption,
+) (res *client.InfoIndexUUIDs, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/agentClient.IndexUUIDs")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	_, err = c.c.RoundRobin(ctx, func(ctx context.Context,
+		conn *grpc.ClientConn, copts ...grpc.CallOption) (interface{}, error) {
+		res, err = agent.NewAgentClient(conn).IndexUUIDs(ctx, req, copts...)
+		if err != nil {
+			return nil, err
+		}
+		return res, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (c *singleAgentClient) CreateIndex(
 	ctx context.Context,
 	req *client.ControlCreateIndexRequest,
@@ -231,3 +256,17 @@ func (c *singleAgentClient) IndexInfo(
 	}()
 	return c.ac.IndexInfo(ctx, new(client.Empty), opts...)
 }
+
+func (c *singleAgentClient) IndexUUIDs(
+	ctx context.Context,
+	req *client.InfoIndexUUIDsRequest,
+	opts ...grpc.CallOption,
+) (res *client.InfoIndexUUIDs, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/agentClient.IndexUUIDs")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return c.ac.IndexUUIDs(ctx, req, opts...)
+}
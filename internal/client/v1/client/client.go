@@ -47,6 +47,8 @@ type (
 	ControlCreateIndexRequest = payload.Control_CreateIndexRequest
 	InfoIndex                 = payload.Info_Index
 	InfoIndexCount            = payload.Info_Index_Count
+	InfoIndexUUIDs            = payload.Info_Index_UUIDs
+	InfoIndexUUIDsRequest     = payload.Info_Index_UUIDs_Request
 	Empty                     = payload.Empty
 	SearchConfig              = payload.Search_Config
 	ObjectDistance            = payload.Object_Distance
@@ -195,6 +195,78 @@ func (m *multipleAddrsClient) FilterDistance(ctx context.Context, in *payload.Ob
 	return res, nil
 }
 
+func (c *client) FilterSearchResponse(ctx context.Context, in *payload.Filter_SearchResponseRequest, opts ...grpc.CallOption) (res *payload.Search_Response, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/Client.FilterSearchResponse")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	_, err = c.c.RoundRobin(ctx, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption) (interface{}, error) {
+		res, err = egress.NewFilterClient(conn).FilterSearchResponse(ctx, in, append(copts, opts...)...)
+		return nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *specificAddrClient) FilterSearchResponse(ctx context.Context, in *payload.Filter_SearchResponseRequest, opts ...grpc.CallOption) (res *payload.Search_Response, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/Client.FilterSearchResponse/"+s.addr)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	_, err = s.c.Do(ctx, s.addr, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption) (interface{}, error) {
+		res, err = egress.NewFilterClient(conn).FilterSearchResponse(ctx, in, append(copts, opts...)...)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// FilterSearchResponse passes the search response through the filters in order,
+// so that each filter receives the response produced by the previous one.
+func (m *multipleAddrsClient) FilterSearchResponse(ctx context.Context, in *payload.Filter_SearchResponseRequest, opts ...grpc.CallOption) (res *payload.Search_Response, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/Client.FilterSearchResponse/["+strings.Join(m.addrs, ",")+"]")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	req := &payload.Filter_SearchResponseRequest{
+		Vector:   in.GetVector(),
+		Id:       in.GetId(),
+		Config:   in.GetConfig(),
+		Response: in.GetResponse(),
+	}
+	err = m.c.OrderedRange(ctx, m.addrs, func(ctx context.Context, addr string,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption) error {
+		res, err = egress.NewFilterClient(conn).FilterSearchResponse(ctx, req, append(copts, opts...)...)
+		if err != nil {
+			return err
+		}
+		req.Response = res
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (c *client) FilterVector(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (res *payload.Object_Vector, err error) {
 	ctx, span := trace.StartSpan(ctx, apiName+"/Client.FilterVector")
 	defer func() {
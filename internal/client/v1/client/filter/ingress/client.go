@@ -149,6 +149,25 @@ func (c *client) GenVector(ctx context.Context, in *payload.Object_Blob, opts ..
 	return res, nil
 }
 
+func (c *client) GenVectors(ctx context.Context, in *payload.Object_Blobs, opts ...grpc.CallOption) (res *payload.Object_Vectors, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/Client.GenVectors")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	_, err = c.c.RoundRobin(ctx, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption) (interface{}, error) {
+		res, err = ingress.NewFilterClient(conn).GenVectors(ctx, in, append(copts, opts...)...)
+		return nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (c *client) FilterVector(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (res *payload.Object_Vector, err error) {
 	ctx, span := trace.StartSpan(ctx, apiName+"/Client.FilterVector")
 	defer func() {
@@ -187,6 +206,25 @@ func (s *specificAddrClient) GenVector(ctx context.Context, in *payload.Object_B
 	return res, nil
 }
 
+func (s *specificAddrClient) GenVectors(ctx context.Context, in *payload.Object_Blobs, opts ...grpc.CallOption) (res *payload.Object_Vectors, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/Client.GenVectors/"+s.addr)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	_, err = s.c.Do(ctx, s.addr, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption) (interface{}, error) {
+		res, err = ingress.NewFilterClient(conn).GenVectors(ctx, in, append(copts, opts...)...)
+		return nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (s *specificAddrClient) FilterVector(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (res *payload.Object_Vector, err error) {
 	ctx, span := trace.StartSpan(ctx, apiName+"/Client.FilterVector/"+s.addr)
 	defer func() {
@@ -225,6 +263,25 @@ func (m *multipleAddrsClient) GenVector(ctx context.Context, in *payload.Object_
 	return res, nil
 }
 
+func (m *multipleAddrsClient) GenVectors(ctx context.Context, in *payload.Object_Blobs, opts ...grpc.CallOption) (res *payload.Object_Vectors, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/Client.GenVectors/["+strings.Join(m.addrs, ",")+"]")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	_, err = m.c.Do(ctx, m.addrs[0], func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption) (interface{}, error) {
+		res, err = ingress.NewFilterClient(conn).GenVectors(ctx, in, append(copts, opts...)...)
+		return nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (m *multipleAddrsClient) FilterVector(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (res *payload.Object_Vector, err error) {
 	ctx, span := trace.StartSpan(ctx, apiName+"/Client.FilterVector/["+strings.Join(m.addrs, ",")+"]")
 	defer func() {
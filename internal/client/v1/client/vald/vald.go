@@ -480,6 +480,25 @@ func (c *client) StreamGetObject(ctx context.Context, opts ...grpc.CallOption) (
 	return res, nil
 }
 
+func (c *client) MultiGetObject(ctx context.Context, in *payload.Object_MultiVectorRequest, opts ...grpc.CallOption) (res *payload.Object_Vectors, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/Client.MultiGetObject")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	_, err = c.c.RoundRobin(ctx, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption) (interface{}, error) {
+		res, err = vald.NewValdClient(conn).MultiGetObject(ctx, in, append(copts, opts...)...)
+		return nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (c *singleClient) Start(ctx context.Context) (<-chan error, error) {
 	return nil, nil
 }
@@ -701,3 +720,13 @@ func (c *singleClient) StreamGetObject(ctx context.Context, opts ...grpc.CallOpt
 	}()
 	return c.vc.StreamGetObject(ctx, opts...)
 }
+
+func (c *singleClient) MultiGetObject(ctx context.Context, in *payload.Object_MultiVectorRequest, opts ...grpc.CallOption) (res *payload.Object_Vectors, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/singleClient.MultiGetObject")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return c.vc.MultiGetObject(ctx, in, opts...)
+}
@@ -2352,6 +2352,106 @@ func Test_client_GetObject(t *testing.T) {
 	}
 }
 
+func Test_client_MultiGetObject(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx  context.Context
+		in   *payload.Object_MultiVectorRequest
+		opts []grpc.CallOption
+	}
+	type fields struct {
+		addrs []string
+		c     grpc.Client
+	}
+	type want struct {
+		wantRes *payload.Object_Vectors
+		err     error
+	}
+	type test struct {
+		name       string
+		args       args
+		fields     fields
+		want       want
+		checkFunc  func(want, *payload.Object_Vectors, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, gotRes *payload.Object_Vectors, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(gotRes, w.wantRes) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", gotRes, w.wantRes)
+		}
+		return nil
+	}
+	tests := []test{
+		// TODO test cases
+		/*
+		   {
+		       name: "test_case_1",
+		       args: args {
+		           ctx: nil,
+		           in: nil,
+		           opts: nil,
+		       },
+		       fields: fields {
+		           addrs: nil,
+		           c: nil,
+		       },
+		       want: want{},
+		       checkFunc: defaultCheckFunc,
+		   },
+		*/
+
+		// TODO test cases
+		/*
+		   func() test {
+		       return test {
+		           name: "test_case_2",
+		           args: args {
+		           ctx: nil,
+		           in: nil,
+		           opts: nil,
+		           },
+		           fields: fields {
+		           addrs: nil,
+		           c: nil,
+		           },
+		           want: want{},
+		           checkFunc: defaultCheckFunc,
+		       }
+		   }(),
+		*/
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			c := &client{
+				addrs: test.fields.addrs,
+				c:     test.fields.c,
+			}
+
+			gotRes, err := c.MultiGetObject(test.args.ctx, test.args.in, test.args.opts...)
+			if err := test.checkFunc(test.want, gotRes, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
 func Test_client_StreamGetObject(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -4652,6 +4752,101 @@ func Test_singleClient_GetObject(t *testing.T) {
 	}
 }
 
+func Test_singleClient_MultiGetObject(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		in   *payload.Object_MultiVectorRequest
+		opts []grpc.CallOption
+	}
+	type fields struct {
+		vc vald.Client
+	}
+	type want struct {
+		wantRes *payload.Object_Vectors
+		err     error
+	}
+	type test struct {
+		name       string
+		args       args
+		fields     fields
+		want       want
+		checkFunc  func(want, *payload.Object_Vectors, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, gotRes *payload.Object_Vectors, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(gotRes, w.wantRes) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", gotRes, w.wantRes)
+		}
+		return nil
+	}
+	tests := []test{
+		// TODO test cases
+		/*
+		   {
+		       name: "test_case_1",
+		       args: args {
+		           ctx: nil,
+		           in: nil,
+		           opts: nil,
+		       },
+		       fields: fields {
+		           vc: nil,
+		       },
+		       want: want{},
+		       checkFunc: defaultCheckFunc,
+		   },
+		*/
+
+		// TODO test cases
+		/*
+		   func() test {
+		       return test {
+		           name: "test_case_2",
+		           args: args {
+		           ctx: nil,
+		           in: nil,
+		           opts: nil,
+		           },
+		           fields: fields {
+		           vc: nil,
+		           },
+		           want: want{},
+		           checkFunc: defaultCheckFunc,
+		       }
+		   }(),
+		*/
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			c := &singleClient{
+				vc: test.fields.vc,
+			}
+
+			gotRes, err := c.MultiGetObject(test.args.ctx, test.args.in, test.args.opts...)
+			if err := test.checkFunc(test.want, gotRes, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
 func Test_singleClient_StreamGetObject(t *testing.T) {
 	type args struct {
 		ctx  context.Context
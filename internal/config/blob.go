@@ -26,6 +26,8 @@ const (
 	// S3 represents s3 storage type.
 	S3 BlobStorageType = 1 + iota
 	CloudStorage
+	// Local represents local filesystem storage type.
+	Local
 )
 
 // String returns blob storage type.
@@ -35,6 +37,8 @@ func (bst BlobStorageType) String() string {
 		return "s3"
 	case CloudStorage:
 		return "cloud_storage"
+	case Local:
+		return "local"
 	}
 	return "unknown"
 }
@@ -46,6 +50,8 @@ func AtoBST(bst string) BlobStorageType {
 		return S3
 	case CloudStorage.String():
 		return CloudStorage
+	case Local.String():
+		return Local
 	}
 	return 0
 }
@@ -63,6 +69,9 @@ type Blob struct {
 
 	// CloudStorage represents CloudStorage config
 	CloudStorage *CloudStorageConfig `json:"cloud_storage" yaml:"cloud_storage"`
+
+	// Local represents local filesystem config
+	Local *LocalConfig `json:"local" yaml:"local"`
 }
 
 // S3Config represents S3Config configuration.
@@ -87,6 +96,8 @@ type S3Config struct {
 
 	MaxPartSize  string `json:"max_part_size" yaml:"max_part_size"`
 	MaxChunkSize string `json:"max_chunk_size" yaml:"max_chunk_size"`
+
+	DownloadConcurrency int `json:"download_concurrency" yaml:"download_concurrency"`
 }
 
 // CloudStorageConfig represents CloudStorage configuration.
@@ -108,6 +119,12 @@ type CloudStorageClient struct {
 	CredentialsJSON     string `json:"credentials_json" yaml:"credentials_json"`
 }
 
+// LocalConfig represents local filesystem storage configuration.
+type LocalConfig struct {
+	// Path represents the directory which stores the objects. file:// URL is also accepted.
+	Path string `json:"path" yaml:"path"`
+}
+
 // Bind binds the actual data from the Blob receiver field.
 func (b *Blob) Bind() *Blob {
 	b.StorageType = GetActualValue(b.StorageType)
@@ -125,6 +142,12 @@ func (b *Blob) Bind() *Blob {
 		b.CloudStorage = new(CloudStorageConfig)
 	}
 
+	if b.Local != nil {
+		b.Local = b.Local.Bind()
+	} else {
+		b.Local = new(LocalConfig)
+	}
+
 	return b
 }
 
@@ -159,3 +182,9 @@ func (c *CloudStorageConfig) Bind() *CloudStorageConfig {
 
 	return c
 }
+
+// Bind binds the actual data from the LocalConfig receiver field.
+func (l *LocalConfig) Bind() *LocalConfig {
+	l.Path = GetActualValue(l.Path)
+	return l
+}
@@ -59,6 +59,13 @@ func TestBlobStorageType_String(t *testing.T) {
 				want: "cloud_storage",
 			},
 		},
+		{
+			name: "return local when the bst is Local",
+			bst:  Local,
+			want: want{
+				want: "local",
+			},
+		},
 		{
 			name: "return unknown when the bst is empty",
 			want: want{
@@ -153,6 +160,15 @@ func TestAtoBST(t *testing.T) {
 				want: CloudStorage,
 			},
 		},
+		{
+			name: "return Local when the bst is local",
+			args: args{
+				bst: "local",
+			},
+			want: want{
+				want: Local,
+			},
+		},
 		{
 			name: "return 0 when the bst is empty",
 			want: want{
@@ -197,6 +213,7 @@ func TestBlob_Bind(t *testing.T) {
 		Bucket       string
 		S3           *S3Config
 		CloudStorage *CloudStorageConfig
+		Local        *LocalConfig
 	}
 	type want struct {
 		want *Blob
@@ -229,6 +246,7 @@ func TestBlob_Bind(t *testing.T) {
 						Bucket:       "test.vald",
 						S3:           new(S3Config),
 						CloudStorage: new(CloudStorageConfig),
+						Local:        new(LocalConfig),
 					},
 				},
 			}
@@ -241,13 +259,17 @@ func TestBlob_Bind(t *testing.T) {
 				URL:    "gs://test.vald",
 				Client: new(CloudStorageClient),
 			}
+			local := &LocalConfig{
+				Path: "/var/backup",
+			}
 			return test{
-				name: "return Blob when the bind successes and the S3Config CloudStorageConfig is not nil",
+				name: "return Blob when the bind successes and the S3Config CloudStorageConfig LocalConfig is not nil",
 				fields: fields{
 					StorageType:  "s3",
 					Bucket:       "test.vald",
 					S3:           s3,
 					CloudStorage: cloudStorage,
+					Local:        local,
 				},
 				want: want{
 					want: &Blob{
@@ -255,6 +277,7 @@ func TestBlob_Bind(t *testing.T) {
 						Bucket:       "test.vald",
 						S3:           s3,
 						CloudStorage: cloudStorage,
+						Local:        local,
 					},
 				},
 			}
@@ -296,6 +319,7 @@ func TestBlob_Bind(t *testing.T) {
 						Bucket:       "test.vald",
 						S3:           new(S3Config),
 						CloudStorage: new(CloudStorageConfig),
+						Local:        new(LocalConfig),
 					},
 				},
 			}
@@ -319,6 +343,7 @@ func TestBlob_Bind(t *testing.T) {
 				Bucket:       test.fields.Bucket,
 				S3:           test.fields.S3,
 				CloudStorage: test.fields.CloudStorage,
+				Local:        test.fields.Local,
 			}
 
 			got := b.Bind()
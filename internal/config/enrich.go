@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package config providers configuration type and load configuration logic
+package config
+
+// Enrich represent the enrich egress filter configuration.
+type Enrich struct {
+	// Backend represent the store of the documents, redis or mysql.
+	Backend string `json:"backend,omitempty" yaml:"backend"`
+
+	// DropMissing represent whether the search results whose document is not found are dropped.
+	DropMissing bool `json:"drop_missing,omitempty" yaml:"drop_missing"`
+
+	// Redis represent the redis configuration used when the backend is redis.
+	Redis *Redis `json:"redis,omitempty" yaml:"redis"`
+
+	// KeyPrefix represent the prefix of the redis key, the key of the document is KeyPrefix + ID.
+	KeyPrefix string `json:"key_prefix,omitempty" yaml:"key_prefix"`
+
+	// MySQL represent the mysql configuration used when the backend is mysql.
+	MySQL *MySQL `json:"mysql,omitempty" yaml:"mysql"`
+
+	// Table represent the mysql table of the documents.
+	Table string `json:"table,omitempty" yaml:"table"`
+
+	// UUIDColumn represent the column of the vector ID in the table.
+	UUIDColumn string `json:"uuid_column,omitempty" yaml:"uuid_column"`
+
+	// DocumentColumn represent the column of the document in the table.
+	DocumentColumn string `json:"document_column,omitempty" yaml:"document_column"`
+
+	// AttributesColumn represent the JSON object column of the attributes in the table.
+	AttributesColumn string `json:"attributes_column,omitempty" yaml:"attributes_column"`
+}
+
+// Bind returns Enrich object whose some string value is filed value or environment value.
+func (e *Enrich) Bind() *Enrich {
+	e.Backend = GetActualValue(e.Backend)
+	e.KeyPrefix = GetActualValue(e.KeyPrefix)
+	e.Table = GetActualValue(e.Table)
+	e.UUIDColumn = GetActualValue(e.UUIDColumn)
+	e.DocumentColumn = GetActualValue(e.DocumentColumn)
+	e.AttributesColumn = GetActualValue(e.AttributesColumn)
+
+	if e.Redis != nil {
+		e.Redis = e.Redis.Bind()
+	}
+	if e.MySQL != nil {
+		e.MySQL = e.MySQL.Bind()
+	}
+	return e
+}
@@ -0,0 +1,141 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package config providers configuration type and load configuration logic
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestEnrich_Bind(t *testing.T) {
+	type fields struct {
+		Backend          string
+		DropMissing      bool
+		Redis            *Redis
+		KeyPrefix        string
+		MySQL            *MySQL
+		Table            string
+		UUIDColumn       string
+		DocumentColumn   string
+		AttributesColumn string
+	}
+	type want struct {
+		want *Enrich
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+	tests := []test{
+		{
+			name: "return Enrich when the bind successes",
+			fields: fields{
+				Backend:     "redis",
+				DropMissing: true,
+				KeyPrefix:   "doc:",
+			},
+			want: want{
+				want: &Enrich{
+					Backend:     "redis",
+					DropMissing: true,
+					KeyPrefix:   "doc:",
+				},
+			},
+		},
+		func() test {
+			suffix := "_FOR_TEST_ENRICH_BIND"
+			m := map[string]string{
+				"BACKEND" + suffix: "mysql",
+				"TABLE" + suffix:   "documents",
+			}
+			return test{
+				name: "return Enrich when the bind successes and the data is loaded from the environment variable",
+				fields: fields{
+					Backend:        "_BACKEND" + suffix + "_",
+					Table:          "_TABLE" + suffix + "_",
+					UUIDColumn:     "vector_id",
+					DocumentColumn: "body",
+					MySQL:          new(MySQL),
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+					for k, v := range m {
+						if err := os.Setenv(k, v); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+					for k := range m {
+						if err := os.Unsetenv(k); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				want: want{
+					want: &Enrich{
+						Backend:        "mysql",
+						Table:          "documents",
+						UUIDColumn:     "vector_id",
+						DocumentColumn: "body",
+						MySQL: &MySQL{
+							TLS: new(TLS),
+							Net: new(Net),
+						},
+					},
+				},
+			}
+		}(),
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(tt)
+			}
+			e := &Enrich{
+				Backend:          test.fields.Backend,
+				DropMissing:      test.fields.DropMissing,
+				Redis:            test.fields.Redis,
+				KeyPrefix:        test.fields.KeyPrefix,
+				MySQL:            test.fields.MySQL,
+				Table:            test.fields.Table,
+				UUIDColumn:       test.fields.UUIDColumn,
+				DocumentColumn:   test.fields.DocumentColumn,
+				AttributesColumn: test.fields.AttributesColumn,
+			}
+
+			got := e.Bind()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
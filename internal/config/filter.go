@@ -17,21 +17,110 @@
 // Package config providers configuration type and load configuration logic
 package config
 
+import "strings"
+
+// FilterPluginType represents the type of the in-process filter plugin.
+type FilterPluginType uint8
+
+const (
+	// WASMPlugin represents the WebAssembly filter plugin type.
+	WASMPlugin FilterPluginType = 1 + iota
+)
+
+// String returns the filter plugin type.
+func (fpt FilterPluginType) String() string {
+	switch fpt {
+	case WASMPlugin:
+		return "wasm"
+	}
+	return "unknown"
+}
+
+// AtoFPT returns the FilterPluginType converted from string.
+func AtoFPT(fpt string) FilterPluginType {
+	switch strings.ToLower(fpt) {
+	case WASMPlugin.String():
+		return WASMPlugin
+	}
+	return 0
+}
+
 // EgressFilter represents the EgressFilter configuration.
 type EgressFilter struct {
-	Client          *GRPCClient `json:"client,omitempty"           yaml:"client"`
-	DistanceFilters []string    `json:"distance_filters,omitempty" yaml:"distance_filters"`
-	ObjectFilters   []string    `json:"object_filters,omitempty"   yaml:"object_filters"`
+	Client                *GRPCClient     `json:"client,omitempty"                  yaml:"client"`
+	DistanceFilters       []string        `json:"distance_filters,omitempty"        yaml:"distance_filters"`
+	ObjectFilters         []string        `json:"object_filters,omitempty"          yaml:"object_filters"`
+	SearchResponseFilters []string        `json:"search_response_filters,omitempty" yaml:"search_response_filters"`
+	OverFetchRatio        float64         `json:"over_fetch_ratio,omitempty"        yaml:"over_fetch_ratio"`
+	DistancePipeline      []*FilterStage  `json:"distance_pipeline,omitempty"       yaml:"distance_pipeline"`
+	ObjectPipeline        []*FilterStage  `json:"object_pipeline,omitempty"         yaml:"object_pipeline"`
+	Plugins               []*FilterPlugin `json:"plugins,omitempty"                 yaml:"plugins"`
 }
 
 // IngressFilter represents the IngressFilter configuration.
 type IngressFilter struct {
-	Client        *GRPCClient `json:"client,omitempty"         yaml:"client"`
-	Vectorizer    string      `json:"vectorizer,omitempty"     yaml:"vectorizer"`
-	SearchFilters []string    `json:"search_filters,omitempty" yaml:"search_filters"`
-	InsertFilters []string    `json:"insert_filters,omitempty" yaml:"insert_filters"`
-	UpdateFilters []string    `json:"update_filters,omitempty" yaml:"update_filters"`
-	UpsertFilters []string    `json:"upsert_filters,omitempty" yaml:"upsert_filters"`
+	Client         *GRPCClient     `json:"client,omitempty"          yaml:"client"`
+	Vectorizer     string          `json:"vectorizer,omitempty"      yaml:"vectorizer"`
+	SearchFilters  []string        `json:"search_filters,omitempty"  yaml:"search_filters"`
+	InsertFilters  []string        `json:"insert_filters,omitempty"  yaml:"insert_filters"`
+	UpdateFilters  []string        `json:"update_filters,omitempty"  yaml:"update_filters"`
+	UpsertFilters  []string        `json:"upsert_filters,omitempty"  yaml:"upsert_filters"`
+	SearchPipeline []*FilterStage  `json:"search_pipeline,omitempty" yaml:"search_pipeline"`
+	InsertPipeline []*FilterStage  `json:"insert_pipeline,omitempty" yaml:"insert_pipeline"`
+	UpdatePipeline []*FilterStage  `json:"update_pipeline,omitempty" yaml:"update_pipeline"`
+	UpsertPipeline []*FilterStage  `json:"upsert_pipeline,omitempty" yaml:"upsert_pipeline"`
+	Plugins        []*FilterPlugin `json:"plugins,omitempty"         yaml:"plugins"`
+}
+
+// ObjectStore represents the configuration of the blob store of the raw objects.
+// The stored objects are used to re-vectorize them with another vectorizer.
+type ObjectStore struct {
+	// Enabled represents whether the raw objects are stored or not
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled"`
+
+	// Prefix represents the key prefix of the stored objects
+	Prefix string `json:"prefix,omitempty" yaml:"prefix"`
+
+	// BlobStorage represents the blob storage configuration
+	BlobStorage *Blob `json:"blob_storage,omitempty" yaml:"blob_storage"`
+}
+
+// FilterStage represents the configuration of a stage of the filter pipeline.
+// The stages of a pipeline are executed in the configured order.
+type FilterStage struct {
+	// Name represents the stage name used for trace spans and metrics, the target is used when it is empty
+	Name string `json:"name,omitempty" yaml:"name"`
+
+	// Target represents the filter address of the stage
+	Target string `json:"target,omitempty" yaml:"target"`
+
+	// Timeout represents the timeout duration of the stage
+	Timeout string `json:"timeout,omitempty" yaml:"timeout"`
+
+	// OnFailure represents the failure policy of the stage, fail or skip
+	OnFailure string `json:"on_failure,omitempty" yaml:"on_failure"`
+
+	// Concurrency represents the maximum number of concurrent requests to the stage target
+	Concurrency int `json:"concurrency,omitempty" yaml:"concurrency"`
+}
+
+// FilterPlugin represents the configuration of the filter plugin which is loaded in-process.
+// The plugin name is used as the filter target instead of the filter server address.
+type FilterPlugin struct {
+	// Name represents the plugin name used as the filter target
+	Name string `json:"name,omitempty" yaml:"name"`
+
+	// Type represents the plugin type, only wasm is supported
+	Type string `json:"type,omitempty" yaml:"type"`
+
+	// Path represents the path of the plugin file, which is reloaded when it is changed
+	Path string `json:"path,omitempty" yaml:"path"`
+
+	// Timeout represents the timeout duration of each plugin call
+	Timeout string `json:"timeout,omitempty" yaml:"timeout"`
+
+	// MemoryLimit represents the maximum memory size of the plugin instance
+	MemoryLimit string `json:"memory_limit,omitempty" yaml:"memory_limit"`
 }
 
 // Bind binds the actual data from the EgressFilter receiver field.
@@ -45,6 +134,12 @@ func (e *EgressFilter) Bind() *EgressFilter {
 	if e.ObjectFilters != nil {
 		e.ObjectFilters = GetActualValues(e.ObjectFilters)
 	}
+	if e.SearchResponseFilters != nil {
+		e.SearchResponseFilters = GetActualValues(e.SearchResponseFilters)
+	}
+	bindFilterStages(e.DistancePipeline)
+	bindFilterStages(e.ObjectPipeline)
+	bindFilterPlugins(e.Plugins)
 	return e
 }
 
@@ -68,5 +163,57 @@ func (i *IngressFilter) Bind() *IngressFilter {
 	if i.UpsertFilters != nil {
 		i.UpsertFilters = GetActualValues(i.UpsertFilters)
 	}
+	bindFilterStages(i.SearchPipeline)
+	bindFilterStages(i.InsertPipeline)
+	bindFilterStages(i.UpdatePipeline)
+	bindFilterStages(i.UpsertPipeline)
+	bindFilterPlugins(i.Plugins)
 	return i
 }
+
+// Bind binds the actual data from the ObjectStore receiver field.
+func (o *ObjectStore) Bind() *ObjectStore {
+	o.Prefix = GetActualValue(o.Prefix)
+
+	if o.BlobStorage != nil {
+		o.BlobStorage = o.BlobStorage.Bind()
+	} else {
+		o.BlobStorage = new(Blob).Bind()
+	}
+	return o
+}
+
+// Bind binds the actual data from the FilterStage receiver field.
+func (f *FilterStage) Bind() *FilterStage {
+	f.Name = GetActualValue(f.Name)
+	f.Target = GetActualValue(f.Target)
+	f.Timeout = GetActualValue(f.Timeout)
+	f.OnFailure = GetActualValue(f.OnFailure)
+	return f
+}
+
+func bindFilterStages(stages []*FilterStage) {
+	for _, stage := range stages {
+		if stage != nil {
+			stage.Bind()
+		}
+	}
+}
+
+// Bind binds the actual data from the FilterPlugin receiver field.
+func (f *FilterPlugin) Bind() *FilterPlugin {
+	f.Name = GetActualValue(f.Name)
+	f.Type = GetActualValue(f.Type)
+	f.Path = GetActualValue(f.Path)
+	f.Timeout = GetActualValue(f.Timeout)
+	f.MemoryLimit = GetActualValue(f.MemoryLimit)
+	return f
+}
+
+func bindFilterPlugins(plugins []*FilterPlugin) {
+	for _, plugin := range plugins {
+		if plugin != nil {
+			plugin.Bind()
+		}
+	}
+}
@@ -28,9 +28,11 @@ import (
 
 func TestEgressFilter_Bind(t *testing.T) {
 	type fields struct {
-		Client          *GRPCClient
-		DistanceFilters []string
-		ObjectFilters   []string
+		Client                *GRPCClient
+		DistanceFilters       []string
+		ObjectFilters         []string
+		SearchResponseFilters []string
+		OverFetchRatio        float64
 	}
 	type want struct {
 		want *EgressFilter
@@ -107,8 +109,9 @@ func TestEgressFilter_Bind(t *testing.T) {
 		func() test {
 			suffix := "_FOR_TEST_EGRESS_FILTER_BIND"
 			m := map[string]string{
-				"DISTANCE_FILTERS" + suffix: "192.168.1.2",
-				"OBJECT_FILTERS" + suffix:   "192.168.1.3",
+				"DISTANCE_FILTERS" + suffix:        "192.168.1.2",
+				"OBJECT_FILTERS" + suffix:          "192.168.1.3",
+				"SEARCH_RESPONSE_FILTERS" + suffix: "192.168.1.4",
 			}
 			return test{
 				name: "return EgressFilter when the bind successes and the data is loaded from the environment variable",
@@ -119,6 +122,10 @@ func TestEgressFilter_Bind(t *testing.T) {
 					ObjectFilters: []string{
 						"_OBJECT_FILTERS" + suffix + "_",
 					},
+					SearchResponseFilters: []string{
+						"_SEARCH_RESPONSE_FILTERS" + suffix + "_",
+					},
+					OverFetchRatio: 2,
 				},
 				beforeFunc: func(t *testing.T) {
 					t.Helper()
@@ -144,6 +151,10 @@ func TestEgressFilter_Bind(t *testing.T) {
 						ObjectFilters: []string{
 							"192.168.1.3",
 						},
+						SearchResponseFilters: []string{
+							"192.168.1.4",
+						},
+						OverFetchRatio: 2,
 					},
 				},
 			}
@@ -164,9 +175,11 @@ func TestEgressFilter_Bind(t *testing.T) {
 				test.checkFunc = defaultCheckFunc
 			}
 			e := &EgressFilter{
-				Client:          test.fields.Client,
-				DistanceFilters: test.fields.DistanceFilters,
-				ObjectFilters:   test.fields.ObjectFilters,
+				Client:                test.fields.Client,
+				DistanceFilters:       test.fields.DistanceFilters,
+				ObjectFilters:         test.fields.ObjectFilters,
+				SearchResponseFilters: test.fields.SearchResponseFilters,
+				OverFetchRatio:        test.fields.OverFetchRatio,
 			}
 
 			got := e.Bind()
@@ -379,3 +392,364 @@ func TestIngressFilter_Bind(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectStore_Bind(t *testing.T) {
+	type fields struct {
+		Enabled     bool
+		Prefix      string
+		BlobStorage *Blob
+	}
+	type want struct {
+		want *ObjectStore
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+	tests := []test{
+		{
+			name: "return ObjectStore when the bind successes",
+			fields: fields{
+				Enabled: true,
+				Prefix:  "objects/",
+				BlobStorage: &Blob{
+					StorageType: "s3",
+					Bucket:      "vald",
+				},
+			},
+			want: want{
+				want: &ObjectStore{
+					Enabled: true,
+					Prefix:  "objects/",
+					BlobStorage: &Blob{
+						StorageType:  "s3",
+						Bucket:       "vald",
+						S3:           new(S3Config),
+						CloudStorage: new(CloudStorageConfig),
+						Local:        new(LocalConfig),
+					},
+				},
+			},
+		},
+		{
+			name:   "return ObjectStore with the default blob storage when the blob storage is nil",
+			fields: fields{},
+			want: want{
+				want: &ObjectStore{
+					BlobStorage: &Blob{
+						S3:           new(S3Config),
+						CloudStorage: new(CloudStorageConfig),
+						Local:        new(LocalConfig),
+					},
+				},
+			},
+		},
+		func() test {
+			suffix := "_FOR_TEST_OBJECT_STORE_BIND"
+			m := map[string]string{
+				"PREFIX" + suffix: "objects/",
+				"BUCKET" + suffix: "vald",
+			}
+			return test{
+				name: "return ObjectStore when the bind successes and the data is loaded from the environment variable",
+				fields: fields{
+					Enabled: true,
+					Prefix:  "_PREFIX" + suffix + "_",
+					BlobStorage: &Blob{
+						Bucket: "_BUCKET" + suffix + "_",
+					},
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+					for k, v := range m {
+						if err := os.Setenv(k, v); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+					for k := range m {
+						if err := os.Unsetenv(k); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				want: want{
+					want: &ObjectStore{
+						Enabled: true,
+						Prefix:  "objects/",
+						BlobStorage: &Blob{
+							Bucket:       "vald",
+							S3:           new(S3Config),
+							CloudStorage: new(CloudStorageConfig),
+							Local:        new(LocalConfig),
+						},
+					},
+				},
+			}
+		}(),
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(tt)
+			}
+			o := &ObjectStore{
+				Enabled:     test.fields.Enabled,
+				Prefix:      test.fields.Prefix,
+				BlobStorage: test.fields.BlobStorage,
+			}
+
+			got := o.Bind()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+func TestFilterStage_Bind(t *testing.T) {
+	type fields struct {
+		Name        string
+		Target      string
+		Timeout     string
+		OnFailure   string
+		Concurrency int
+	}
+	type want struct {
+		want *FilterStage
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+	tests := []test{
+		{
+			name: "return FilterStage when the bind successes",
+			fields: fields{
+				Name:        "normalizer",
+				Target:      "192.168.1.2",
+				Timeout:     "100ms",
+				OnFailure:   "skip",
+				Concurrency: 10,
+			},
+			want: want{
+				want: &FilterStage{
+					Name:        "normalizer",
+					Target:      "192.168.1.2",
+					Timeout:     "100ms",
+					OnFailure:   "skip",
+					Concurrency: 10,
+				},
+			},
+		},
+		func() test {
+			suffix := "_FOR_TEST_FILTER_STAGE_BIND"
+			m := map[string]string{
+				"TARGET" + suffix:  "192.168.1.2",
+				"TIMEOUT" + suffix: "100ms",
+			}
+			return test{
+				name: "return FilterStage when the bind successes and the data is loaded from the environment variable",
+				fields: fields{
+					Target:  "_TARGET" + suffix + "_",
+					Timeout: "_TIMEOUT" + suffix + "_",
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+					for k, v := range m {
+						if err := os.Setenv(k, v); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+					for k := range m {
+						if err := os.Unsetenv(k); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				want: want{
+					want: &FilterStage{
+						Target:  "192.168.1.2",
+						Timeout: "100ms",
+					},
+				},
+			}
+		}(),
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(tt)
+			}
+			f := &FilterStage{
+				Name:        test.fields.Name,
+				Target:      test.fields.Target,
+				Timeout:     test.fields.Timeout,
+				OnFailure:   test.fields.OnFailure,
+				Concurrency: test.fields.Concurrency,
+			}
+
+			got := f.Bind()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+func TestFilterPlugin_Bind(t *testing.T) {
+	type fields struct {
+		Name        string
+		Type        string
+		Path        string
+		Timeout     string
+		MemoryLimit string
+	}
+	type want struct {
+		want *FilterPlugin
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+	tests := []test{
+		{
+			name: "return FilterPlugin when the bind successes",
+			fields: fields{
+				Name:        "normalizer",
+				Type:        "wasm",
+				Path:        "/etc/vald/plugins/normalizer.wasm",
+				Timeout:     "100ms",
+				MemoryLimit: "16MB",
+			},
+			want: want{
+				want: &FilterPlugin{
+					Name:        "normalizer",
+					Type:        "wasm",
+					Path:        "/etc/vald/plugins/normalizer.wasm",
+					Timeout:     "100ms",
+					MemoryLimit: "16MB",
+				},
+			},
+		},
+		func() test {
+			suffix := "_FOR_TEST_FILTER_PLUGIN_BIND"
+			m := map[string]string{
+				"PATH" + suffix:         "/etc/vald/plugins/normalizer.wasm",
+				"MEMORY_LIMIT" + suffix: "16MB",
+			}
+			return test{
+				name: "return FilterPlugin when the bind successes and the data is loaded from the environment variable",
+				fields: fields{
+					Path:        "_PATH" + suffix + "_",
+					MemoryLimit: "_MEMORY_LIMIT" + suffix + "_",
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+					for k, v := range m {
+						if err := os.Setenv(k, v); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+					for k := range m {
+						if err := os.Unsetenv(k); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				want: want{
+					want: &FilterPlugin{
+						Path:        "/etc/vald/plugins/normalizer.wasm",
+						MemoryLimit: "16MB",
+					},
+				},
+			}
+		}(),
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(tt)
+			}
+			f := &FilterPlugin{
+				Name:        test.fields.Name,
+				Type:        test.fields.Type,
+				Path:        test.fields.Path,
+				Timeout:     test.fields.Timeout,
+				MemoryLimit: test.fields.MemoryLimit,
+			}
+
+			got := f.Bind()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+func TestAtoFPT(t *testing.T) {
+	tests := []struct {
+		name string
+		fpt  string
+		want FilterPluginType
+	}{
+		{
+			name: "return WASMPlugin when the type is wasm",
+			fpt:  "wasm",
+			want: WASMPlugin,
+		},
+		{
+			name: "return WASMPlugin when the type is WASM",
+			fpt:  "WASM",
+			want: WASMPlugin,
+		},
+		{
+			name: "return 0 when the type is unknown",
+			fpt:  "go",
+			want: 0,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			if got := AtoFPT(test.fpt); got != test.want {
+				tt.Errorf("got: %v, want: %v", got, test.want)
+			}
+		})
+	}
+}
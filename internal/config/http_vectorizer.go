@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package config providers configuration type and load configuration logic
+package config
+
+// HTTPVectorizer represent the HTTP/JSON vectorizer ingress filter configuration.
+type HTTPVectorizer struct {
+	// Endpoint represent the URL of the inference server.
+	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint"`
+
+	// Method represent the HTTP method of the inference request.
+	Method string `json:"method,omitempty" yaml:"method"`
+
+	// Headers represent the HTTP headers added to the inference request.
+	Headers map[string]string `json:"headers,omitempty" yaml:"headers"`
+
+	// RequestTemplate represent the Go text/template which renders the request body of a single object.
+	RequestTemplate string `json:"request_template,omitempty" yaml:"request_template"`
+
+	// VectorPath represent the JSON path of the vector in the response of RequestTemplate.
+	VectorPath string `json:"vector_path,omitempty" yaml:"vector_path"`
+
+	// BatchRequestTemplate represent the Go text/template which renders the request body of multiple objects.
+	BatchRequestTemplate string `json:"batch_request_template,omitempty" yaml:"batch_request_template"`
+
+	// BatchVectorPath represent the JSON path of the vectors in the response of BatchRequestTemplate.
+	BatchVectorPath string `json:"batch_vector_path,omitempty" yaml:"batch_vector_path"`
+
+	// MaxBatchSize represent the maximum number of objects sent in a batch request.
+	MaxBatchSize int `json:"max_batch_size,omitempty" yaml:"max_batch_size"`
+
+	// Concurrency represent the maximum number of inference requests sent at the same time.
+	Concurrency int `json:"concurrency,omitempty" yaml:"concurrency"`
+
+	// Client represent the HTTP client configuration.
+	Client *Client `json:"client,omitempty" yaml:"client"`
+
+	// BackoffEnabled represent backoff enabled or not for the inference request.
+	BackoffEnabled bool `json:"backoff_enabled,omitempty" yaml:"backoff_enabled"`
+
+	// Backoff represent the retry configuration of the inference request.
+	Backoff *Backoff `json:"backoff,omitempty" yaml:"backoff"`
+}
+
+// Bind returns HTTPVectorizer object whose some string value is filed value or environment value.
+func (h *HTTPVectorizer) Bind() *HTTPVectorizer {
+	h.Endpoint = GetActualValue(h.Endpoint)
+	h.Method = GetActualValue(h.Method)
+	h.RequestTemplate = GetActualValue(h.RequestTemplate)
+	h.VectorPath = GetActualValue(h.VectorPath)
+	h.BatchRequestTemplate = GetActualValue(h.BatchRequestTemplate)
+	h.BatchVectorPath = GetActualValue(h.BatchVectorPath)
+	for k, v := range h.Headers {
+		h.Headers[k] = GetActualValue(v)
+	}
+
+	if h.Client != nil {
+		h.Client = h.Client.Bind()
+	} else {
+		h.Client = new(Client)
+	}
+	if h.Client.Net == nil {
+		h.Client.Net = new(Net)
+	}
+	if h.Client.Transport == nil {
+		h.Client.Transport = new(Transport).Bind()
+	}
+
+	if h.Backoff != nil {
+		h.Backoff = h.Backoff.Bind()
+	} else {
+		h.Backoff = new(Backoff)
+	}
+	return h
+}
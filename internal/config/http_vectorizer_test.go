@@ -0,0 +1,173 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package config providers configuration type and load configuration logic
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestHTTPVectorizer_Bind(t *testing.T) {
+	type fields struct {
+		Endpoint             string
+		Method               string
+		Headers              map[string]string
+		RequestTemplate      string
+		VectorPath           string
+		BatchRequestTemplate string
+		BatchVectorPath      string
+		MaxBatchSize         int
+		Concurrency          int
+		Client               *Client
+		BackoffEnabled       bool
+		Backoff              *Backoff
+	}
+	type want struct {
+		want *HTTPVectorizer
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+	tests := []test{
+		{
+			name: "return HTTPVectorizer when the bind successes",
+			fields: fields{
+				Endpoint:        "http://localhost:8080/embed",
+				Method:          "POST",
+				RequestTemplate: `{"text": {{ json .Object }}}`,
+				VectorPath:      "$.embedding",
+				MaxBatchSize:    8,
+				Client: &Client{
+					Net:       new(Net),
+					Transport: new(Transport),
+				},
+				BackoffEnabled: true,
+				Backoff: &Backoff{
+					RetryCount: 3,
+				},
+			},
+			want: want{
+				want: &HTTPVectorizer{
+					Endpoint:        "http://localhost:8080/embed",
+					Method:          "POST",
+					RequestTemplate: `{"text": {{ json .Object }}}`,
+					VectorPath:      "$.embedding",
+					MaxBatchSize:    8,
+					Client: &Client{
+						Net: new(Net),
+						Transport: &Transport{
+							RoundTripper: new(RoundTripper),
+							Backoff:      new(Backoff),
+						},
+					},
+					BackoffEnabled: true,
+					Backoff: &Backoff{
+						RetryCount: 3,
+					},
+				},
+			},
+		},
+		func() test {
+			suffix := "_FOR_TEST_HTTP_VECTORIZER_BIND"
+			m := map[string]string{
+				"ENDPOINT" + suffix: "http://model:8080/v1/embeddings",
+				"TOKEN" + suffix:    "Bearer token",
+			}
+			return test{
+				name: "return HTTPVectorizer when the bind successes and the data is loaded from the environment variable",
+				fields: fields{
+					Endpoint: "_ENDPOINT" + suffix + "_",
+					Headers: map[string]string{
+						"Authorization": "_TOKEN" + suffix + "_",
+					},
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+					for k, v := range m {
+						if err := os.Setenv(k, v); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+					for k := range m {
+						if err := os.Unsetenv(k); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				want: want{
+					want: &HTTPVectorizer{
+						Endpoint: "http://model:8080/v1/embeddings",
+						Headers: map[string]string{
+							"Authorization": "Bearer token",
+						},
+						Client: &Client{
+							Net: new(Net),
+							Transport: &Transport{
+								RoundTripper: new(RoundTripper),
+								Backoff:      new(Backoff),
+							},
+						},
+						Backoff: new(Backoff),
+					},
+				},
+			}
+		}(),
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(tt)
+			}
+			h := &HTTPVectorizer{
+				Endpoint:             test.fields.Endpoint,
+				Method:               test.fields.Method,
+				Headers:              test.fields.Headers,
+				RequestTemplate:      test.fields.RequestTemplate,
+				VectorPath:           test.fields.VectorPath,
+				BatchRequestTemplate: test.fields.BatchRequestTemplate,
+				BatchVectorPath:      test.fields.BatchVectorPath,
+				MaxBatchSize:         test.fields.MaxBatchSize,
+				Concurrency:          test.fields.Concurrency,
+				Client:               test.fields.Client,
+				BackoffEnabled:       test.fields.BackoffEnabled,
+				Backoff:              test.fields.Backoff,
+			}
+
+			got := h.Bind()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
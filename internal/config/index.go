@@ -57,6 +57,9 @@ type Indexer struct {
 
 	// Discoverer represent agent discoverer service configuration
 	Discoverer *DiscovererClient `json:"discoverer" yaml:"discoverer"`
+
+	// Rebalancer represent agent shard rebalancer configuration
+	Rebalancer *Rebalancer `json:"rebalancer" yaml:"rebalancer"`
 }
 
 // Bind binds the actual data from the Indexer receiver field.
@@ -73,5 +76,9 @@ func (im *Indexer) Bind() *Indexer {
 	if im.Discoverer != nil {
 		im.Discoverer = im.Discoverer.Bind()
 	}
+
+	if im.Rebalancer != nil {
+		im.Rebalancer = im.Rebalancer.Bind()
+	}
 	return im
 }
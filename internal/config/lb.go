@@ -39,6 +39,36 @@ type LB struct {
 
 	// Discoverer represent agent discoverer service configuration
 	Discoverer *DiscovererClient `json:"discoverer" yaml:"discoverer"`
+
+	// SearchCache represent search result cache configuration
+	SearchCache *SearchCache `json:"search_cache" yaml:"search_cache"`
+
+	// StreamInsertBatch represent the batching configuration of StreamInsert
+	StreamInsertBatch *StreamBatch `json:"stream_insert_batch" yaml:"stream_insert_batch"`
+}
+
+// SearchCache represents the configuration for the search result cache of load balancer.
+type SearchCache struct {
+	// Enabled represents whether the search result cache is enabled or not
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// ExpireDuration represents the TTL of each cached search result
+	ExpireDuration string `json:"expire_duration" yaml:"expire_duration"`
+
+	// ExpireCheckDuration represents the interval to delete the expired search results
+	ExpireCheckDuration string `json:"expire_check_duration" yaml:"expire_check_duration"`
+
+	// MaxSize represents the maximum number of the cached search results
+	MaxSize int `json:"max_size" yaml:"max_size"`
+}
+
+// StreamBatch represents the configuration for coalescing stream messages into batches.
+type StreamBatch struct {
+	// Size represents the maximum number of messages in a batch
+	Size int `json:"size" yaml:"size"`
+
+	// Duration represents the maximum time to wait for a batch to fill up
+	Duration string `json:"duration" yaml:"duration"`
 }
 
 // Bind binds the actual data from the LB receiver fields.
@@ -51,5 +81,26 @@ func (g *LB) Bind() *LB {
 	if g.Discoverer != nil {
 		g.Discoverer = g.Discoverer.Bind()
 	}
+
+	if g.SearchCache != nil {
+		g.SearchCache = g.SearchCache.Bind()
+	}
+
+	if g.StreamInsertBatch != nil {
+		g.StreamInsertBatch = g.StreamInsertBatch.Bind()
+	}
 	return g
 }
+
+// Bind binds the actual data from the SearchCache receiver fields.
+func (s *SearchCache) Bind() *SearchCache {
+	s.ExpireDuration = GetActualValue(s.ExpireDuration)
+	s.ExpireCheckDuration = GetActualValue(s.ExpireCheckDuration)
+	return s
+}
+
+// Bind binds the actual data from the StreamBatch receiver fields.
+func (s *StreamBatch) Bind() *StreamBatch {
+	s.Duration = GetActualValue(s.Duration)
+	return s
+}
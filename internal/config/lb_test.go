@@ -35,6 +35,7 @@ func TestLB_Bind(t *testing.T) {
 		NodeName       string
 		IndexReplica   int
 		Discoverer     *DiscovererClient
+		SearchCache    *SearchCache
 	}
 	type want struct {
 		want *LB
@@ -126,6 +127,35 @@ func TestLB_Bind(t *testing.T) {
 				},
 			}
 		}(),
+		func() test {
+			return test{
+				name: "return LB when the bind successes and the SearchCache is not nil",
+				fields: fields{
+					AgentPort:    8081,
+					AgentName:    "vald-agent-ngt",
+					IndexReplica: 3,
+					SearchCache: &SearchCache{
+						Enabled:             true,
+						ExpireDuration:      "1m",
+						ExpireCheckDuration: "10s",
+						MaxSize:             1000,
+					},
+				},
+				want: want{
+					want: &LB{
+						AgentPort:    8081,
+						AgentName:    "vald-agent-ngt",
+						IndexReplica: 3,
+						SearchCache: &SearchCache{
+							Enabled:             true,
+							ExpireDuration:      "1m",
+							ExpireCheckDuration: "10s",
+							MaxSize:             1000,
+						},
+					},
+				},
+			}
+		}(),
 		func() test {
 			envPrefix := "LB_BIND_"
 			agentName := "vald-agent-ngt"
@@ -199,6 +229,7 @@ func TestLB_Bind(t *testing.T) {
 				NodeName:       test.fields.NodeName,
 				IndexReplica:   test.fields.IndexReplica,
 				Discoverer:     test.fields.Discoverer,
+				SearchCache:    test.fields.SearchCache,
 			}
 
 			got := g.Bind()
@@ -208,3 +239,224 @@ func TestLB_Bind(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchCache_Bind(t *testing.T) {
+	type fields struct {
+		Enabled             bool
+		ExpireDuration      string
+		ExpireCheckDuration string
+		MaxSize             int
+	}
+	type want struct {
+		want *SearchCache
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		checkFunc  func(want, *SearchCache) error
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+	defaultCheckFunc := func(w want, got *SearchCache) error {
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got = %v, want %v", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "return SearchCache when all fields are set",
+			fields: fields{
+				Enabled:             true,
+				ExpireDuration:      "1m",
+				ExpireCheckDuration: "10s",
+				MaxSize:             1000,
+			},
+			want: want{
+				want: &SearchCache{
+					Enabled:             true,
+					ExpireDuration:      "1m",
+					ExpireCheckDuration: "10s",
+					MaxSize:             1000,
+				},
+			},
+		},
+		func() test {
+			envPrefix := "SEARCH_CACHE_BIND_"
+			m := map[string]string{
+				envPrefix + "EXPIRE_DURATION":       "1m",
+				envPrefix + "EXPIRE_CHECK_DURATION": "10s",
+			}
+			return test{
+				name: "return SearchCache when the data is loaded from the environment variable",
+				fields: fields{
+					Enabled:             true,
+					ExpireDuration:      "_" + envPrefix + "EXPIRE_DURATION_",
+					ExpireCheckDuration: "_" + envPrefix + "EXPIRE_CHECK_DURATION_",
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+					for k, v := range m {
+						if err := os.Setenv(k, v); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+					for k := range m {
+						if err := os.Unsetenv(k); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				want: want{
+					want: &SearchCache{
+						Enabled:             true,
+						ExpireDuration:      "1m",
+						ExpireCheckDuration: "10s",
+					},
+				},
+			}
+		}(),
+		{
+			name: "return SearchCache when all fields are empty",
+			want: want{
+				want: new(SearchCache),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(tt)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			s := &SearchCache{
+				Enabled:             test.fields.Enabled,
+				ExpireDuration:      test.fields.ExpireDuration,
+				ExpireCheckDuration: test.fields.ExpireCheckDuration,
+				MaxSize:             test.fields.MaxSize,
+			}
+
+			got := s.Bind()
+			if err := test.checkFunc(test.want, got); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func TestStreamBatch_Bind(t *testing.T) {
+	type fields struct {
+		Size     int
+		Duration string
+	}
+	type want struct {
+		want *StreamBatch
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		checkFunc  func(want, *StreamBatch) error
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+	defaultCheckFunc := func(w want, got *StreamBatch) error {
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got = %v, want %v", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "return StreamBatch when all fields are set",
+			fields: fields{
+				Size:     100,
+				Duration: "10ms",
+			},
+			want: want{
+				want: &StreamBatch{
+					Size:     100,
+					Duration: "10ms",
+				},
+			},
+		},
+		func() test {
+			envPrefix := "STREAM_BATCH_BIND_"
+			m := map[string]string{
+				envPrefix + "DURATION": "10ms",
+			}
+			return test{
+				name: "return StreamBatch when the data is loaded from the environment variable",
+				fields: fields{
+					Size:     100,
+					Duration: "_" + envPrefix + "DURATION_",
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+					for k, v := range m {
+						if err := os.Setenv(k, v); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+					for k := range m {
+						if err := os.Unsetenv(k); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				want: want{
+					want: &StreamBatch{
+						Size:     100,
+						Duration: "10ms",
+					},
+				},
+			}
+		}(),
+		{
+			name: "return StreamBatch when all fields are empty",
+			want: want{
+				want: new(StreamBatch),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(tt)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			s := &StreamBatch{
+				Size:     test.fields.Size,
+				Duration: test.fields.Duration,
+			}
+
+			got := s.Bind()
+			if err := test.checkFunc(test.want, got); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package config providers configuration type and load configuration logic
+package config
+
+// ONNX represent the ONNX Runtime converter core configuration for server.
+type ONNX struct {
+	// ModelPath represent the path of the ONNX model file.
+	ModelPath string `json:"model_path,omitempty" yaml:"model_path"`
+
+	// InputName represent the name of the model input tensor.
+	InputName string `json:"input_name,omitempty" yaml:"input_name"`
+
+	// OutputName represent the name of the model output tensor.
+	OutputName string `json:"output_name,omitempty" yaml:"output_name"`
+
+	// InputType represent the element type of the input tensor, float32 or uint8.
+	InputType string `json:"input_type,omitempty" yaml:"input_type"`
+
+	// InputShape represent the shape of the input tensor. at most one dimension can be -1.
+	InputShape []int64 `json:"input_shape,omitempty" yaml:"input_shape"`
+
+	// IntraOpNumThreads represent the number of threads used to parallelize a single operator.
+	IntraOpNumThreads int `json:"intra_op_num_threads,omitempty" yaml:"intra_op_num_threads"`
+
+	// WarmupInputs represent the base64 encoded inputs sent to the model on startup.
+	WarmupInputs []string `json:"warmup_inputs,omitempty" yaml:"warmup_inputs"`
+}
+
+// Bind returns ONNX object whose some string value is filed value or environment value.
+func (o *ONNX) Bind() *ONNX {
+	o.ModelPath = GetActualValue(o.ModelPath)
+	o.InputName = GetActualValue(o.InputName)
+	o.OutputName = GetActualValue(o.OutputName)
+	o.InputType = GetActualValue(o.InputType)
+	o.WarmupInputs = GetActualValues(o.WarmupInputs)
+	return o
+}
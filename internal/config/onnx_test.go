@@ -0,0 +1,142 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package config providers configuration type and load configuration logic
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestONNX_Bind(t *testing.T) {
+	type fields struct {
+		ModelPath         string
+		InputName         string
+		OutputName        string
+		InputType         string
+		InputShape        []int64
+		IntraOpNumThreads int
+		WarmupInputs      []string
+	}
+	type want struct {
+		want *ONNX
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+	tests := []test{
+		{
+			name: "return ONNX when the bind successes",
+			fields: fields{
+				ModelPath:         "/path/to/model.onnx",
+				InputName:         "input",
+				OutputName:        "output",
+				InputType:         "float32",
+				InputShape:        []int64{1, -1},
+				IntraOpNumThreads: 4,
+				WarmupInputs:      []string{"AACAPw=="},
+			},
+			want: want{
+				want: &ONNX{
+					ModelPath:         "/path/to/model.onnx",
+					InputName:         "input",
+					OutputName:        "output",
+					InputType:         "float32",
+					InputShape:        []int64{1, -1},
+					IntraOpNumThreads: 4,
+					WarmupInputs:      []string{"AACAPw=="},
+				},
+			},
+		},
+		func() test {
+			suffix := "_FOR_TEST_ONNX_BIND"
+			m := map[string]string{
+				"MODEL_PATH" + suffix:  "/path/to/model.onnx",
+				"INPUT_NAME" + suffix:  "input",
+				"OUTPUT_NAME" + suffix: "output",
+				"INPUT_TYPE" + suffix:  "uint8",
+			}
+			return test{
+				name: "return ONNX when the bind successes and the data is loaded from the environment variable",
+				fields: fields{
+					ModelPath:  "_MODEL_PATH" + suffix + "_",
+					InputName:  "_INPUT_NAME" + suffix + "_",
+					OutputName: "_OUTPUT_NAME" + suffix + "_",
+					InputType:  "_INPUT_TYPE" + suffix + "_",
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+					for k, v := range m {
+						if err := os.Setenv(k, v); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+					for k := range m {
+						if err := os.Unsetenv(k); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				want: want{
+					want: &ONNX{
+						ModelPath:  "/path/to/model.onnx",
+						InputName:  "input",
+						OutputName: "output",
+						InputType:  "uint8",
+					},
+				},
+			}
+		}(),
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(tt)
+			}
+			o := &ONNX{
+				ModelPath:         test.fields.ModelPath,
+				InputName:         test.fields.InputName,
+				OutputName:        test.fields.OutputName,
+				InputType:         test.fields.InputType,
+				InputShape:        test.fields.InputShape,
+				IntraOpNumThreads: test.fields.IntraOpNumThreads,
+				WarmupInputs:      test.fields.WarmupInputs,
+			}
+
+			got := o.Bind()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
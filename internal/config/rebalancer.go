@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package config providers configuration type and load configuration logic
+package config
+
+// Rebalancer represents the agent shard rebalancer configurations.
+type Rebalancer struct {
+	// Enabled represents whether the rebalancer is enabled or not
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// CheckDuration represents checking loop duration about the memory imbalance of agents
+	CheckDuration string `json:"check_duration" yaml:"check_duration"`
+
+	// Threshold represents the allowed difference of the memory usage ratio from the average
+	Threshold float64 `json:"threshold" yaml:"threshold"`
+
+	// RateLimit represents the maximum number of vectors moved per second
+	RateLimit int `json:"rate_limit" yaml:"rate_limit"`
+
+	// MaxMoves represents the maximum number of vectors moved in a single rebalance
+	MaxMoves uint32 `json:"max_moves" yaml:"max_moves"`
+
+	// CheckpointPath represents the file path to persist the rebalance progress for resuming
+	CheckpointPath string `json:"checkpoint_path" yaml:"checkpoint_path"`
+}
+
+// Bind binds the actual data from the Rebalancer receiver field.
+func (r *Rebalancer) Bind() *Rebalancer {
+	r.CheckDuration = GetActualValue(r.CheckDuration)
+	r.CheckpointPath = GetActualValue(r.CheckpointPath)
+	return r
+}
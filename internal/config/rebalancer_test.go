@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package config providers configuration type and load configuration logic
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestRebalancer_Bind(t *testing.T) {
+	type fields struct {
+		Enabled        bool
+		CheckDuration  string
+		Threshold      float64
+		RateLimit      int
+		MaxMoves       uint32
+		CheckpointPath string
+	}
+	type want struct {
+		want *Rebalancer
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		checkFunc  func(want, *Rebalancer) error
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+	defaultCheckFunc := func(w want, got *Rebalancer) error {
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		func() test {
+			return test{
+				name: "return Rebalancer when the bind successes",
+				fields: fields{
+					Enabled:        true,
+					CheckDuration:  "10m",
+					Threshold:      0.1,
+					RateLimit:      100,
+					MaxMoves:       100000,
+					CheckpointPath: "/var/rebalance/checkpoint.json",
+				},
+				want: want{
+					want: &Rebalancer{
+						Enabled:        true,
+						CheckDuration:  "10m",
+						Threshold:      0.1,
+						RateLimit:      100,
+						MaxMoves:       100000,
+						CheckpointPath: "/var/rebalance/checkpoint.json",
+					},
+				},
+			}
+		}(),
+		func() test {
+			suffix := "_FOR_TEST_REBALANCER_BIND"
+			m := map[string]string{
+				"CHECK_DURATION" + suffix:  "10m",
+				"CHECKPOINT_PATH" + suffix: "/var/rebalance/checkpoint.json",
+			}
+
+			return test{
+				name: "return Rebalancer when the bind successes and the data is loaded from the environment variable",
+				fields: fields{
+					Enabled:        true,
+					CheckDuration:  "_CHECK_DURATION" + suffix + "_",
+					Threshold:      0.1,
+					RateLimit:      100,
+					MaxMoves:       100000,
+					CheckpointPath: "_CHECKPOINT_PATH" + suffix + "_",
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+					for k, v := range m {
+						if err := os.Setenv(k, v); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+					for k := range m {
+						if err := os.Unsetenv(k); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				want: want{
+					want: &Rebalancer{
+						Enabled:        true,
+						CheckDuration:  "10m",
+						Threshold:      0.1,
+						RateLimit:      100,
+						MaxMoves:       100000,
+						CheckpointPath: "/var/rebalance/checkpoint.json",
+					},
+				},
+			}
+		}(),
+		func() test {
+			return test{
+				name: "return default Rebalancer when all fields are empty",
+				want: want{
+					want: new(Rebalancer),
+				},
+			}
+		}(),
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(tt)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			r := &Rebalancer{
+				Enabled:        test.fields.Enabled,
+				CheckDuration:  test.fields.CheckDuration,
+				Threshold:      test.fields.Threshold,
+				RateLimit:      test.fields.RateLimit,
+				MaxMoves:       test.fields.MaxMoves,
+				CheckpointPath: test.fields.CheckpointPath,
+			}
+
+			got := r.Bind()
+			if err := test.checkFunc(test.want, got); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
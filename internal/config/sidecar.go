@@ -34,6 +34,9 @@ type AgentSidecar struct {
 	// AutoBackupDuration represent checking loop duration for auto backup execution
 	AutoBackupDuration string `yaml:"auto_backup_duration" json:"auto_backup_duration"`
 
+	// CoordinatedBackupEnabled represent backup is triggered by the save marker of the agent and the saved index is snapshotted before upload
+	CoordinatedBackupEnabled bool `yaml:"coordinated_backup_enabled" json:"coordinated_backup_enabled"`
+
 	// PostStopTimeout represent timeout duration for file changing during post stop
 	PostStopTimeout string `yaml:"post_stop_timeout" json:"post_stop_timeout"`
 
@@ -57,6 +60,247 @@ type AgentSidecar struct {
 
 	// Client represent HTTP client configurations
 	Client *Client `yaml:"client" json:"client"`
+
+	// VersioningEnabled represent each backup is written under the versioned key with its manifest or not
+	VersioningEnabled bool `yaml:"versioning_enabled" json:"versioning_enabled"`
+
+	// Retention represent retention policy of the backup versions
+	Retention *BackupRetention `yaml:"retention" json:"retention"`
+
+	// RestoreVersion represent backup version to restore, the latest valid version is restored when it is empty
+	RestoreVersion string `yaml:"restore_version" json:"restore_version"`
+
+	// AgentLivenessURL represent URL of the liveness endpoint of the agent, the restore on demand is refused while it responds, e.g. http://localhost:3000/liveness
+	AgentLivenessURL string `yaml:"agent_liveness_url" json:"agent_liveness_url"`
+
+	// Incremental represent incremental backup configurations
+	Incremental *IncrementalBackup `yaml:"incremental" json:"incremental"`
+
+	// Encryption represent client-side encryption configurations of the backup
+	Encryption *BackupEncryption `yaml:"encryption" json:"encryption"`
+
+	// Clone represent configurations to clone the index from the backups of the agents of another cluster
+	Clone *BackupClone `yaml:"clone" json:"clone"`
+
+	// Throttle represent bandwidth and I/O throttling configurations of the backup
+	Throttle *BackupThrottle `yaml:"throttle" json:"throttle"`
+}
+
+// BackupRetention represents the retention policy of the backup versions.
+// The versions are kept when both of the KeepLast and KeepDailyDays are not set.
+type BackupRetention struct {
+	// KeepLast represent number of the latest versions to keep
+	KeepLast int `yaml:"keep_last" json:"keep_last"`
+
+	// KeepDailyDays represent number of days to keep the newest version of each day
+	KeepDailyDays int `yaml:"keep_daily_days" json:"keep_daily_days"`
+
+	// UncommittedGracePeriod represent period to keep the data of the version whose backup has failed before the manifest is committed
+	UncommittedGracePeriod string `yaml:"uncommitted_grace_period" json:"uncommitted_grace_period"`
+}
+
+// Bind binds the actual data from the BackupRetention receiver fields.
+func (r *BackupRetention) Bind() *BackupRetention {
+	r.UncommittedGracePeriod = GetActualValue(r.UncommittedGracePeriod)
+	return r
+}
+
+// IncrementalBackup represents the configuration of the incremental backup.
+// The files are split into the chunks keyed by their checksums, and only the chunks which are not stored yet are uploaded.
+type IncrementalBackup struct {
+	// Enabled represent incremental backup is enabled or not
+	Enabled bool `yaml:"enabled" json:"enabled"`
+
+	// ChunkSize represent maximum size of the chunks, e.g. 4MB
+	ChunkSize string `yaml:"chunk_size" json:"chunk_size"`
+}
+
+// Bind binds the actual data from the IncrementalBackup receiver fields.
+func (i *IncrementalBackup) Bind() *IncrementalBackup {
+	i.ChunkSize = GetActualValue(i.ChunkSize)
+	return i
+}
+
+// BackupEncryption represents the configuration of the client-side encryption of the backup.
+// The backup data is encrypted by AES-GCM before it is uploaded, and the key ID is recorded to select the key on restore.
+type BackupEncryption struct {
+	// Enabled represent the new backups are encrypted or not
+	Enabled bool `yaml:"enabled" json:"enabled"`
+
+	// KeyID represent the ID of the key to encrypt the new backups
+	KeyID string `yaml:"key_id" json:"key_id"`
+
+	// Keys represent the keys to encrypt and decrypt the backups, the rotated keys must be kept while their backups are restored
+	Keys []*EncryptionKey `yaml:"keys" json:"keys"`
+
+	// AllowUnencrypted represent the unencrypted backups are restored or not while the encryption is enabled, e.g. to migrate the backups before the encryption is enabled
+	AllowUnencrypted bool `yaml:"allow_unencrypted" json:"allow_unencrypted"`
+}
+
+// EncryptionKey represents the AES key of the backup encryption.
+type EncryptionKey struct {
+	// ID represent the key ID recorded in the backups
+	ID string `yaml:"id" json:"id"`
+
+	// Key represent the base64 encoded 16, 24 or 32 bytes key, e.g. _BACKUP_ENCRYPTION_KEY_ to read the environment variable
+	Key string `yaml:"key" json:"key"`
+
+	// KeyFile represent the path of the file which contains the base64 encoded key
+	KeyFile string `yaml:"key_file" json:"key_file"`
+}
+
+// Bind binds the actual data from the BackupEncryption receiver fields.
+func (e *BackupEncryption) Bind() *BackupEncryption {
+	e.KeyID = GetActualValue(e.KeyID)
+	for _, key := range e.Keys {
+		if key != nil {
+			key.Bind()
+		}
+	}
+	return e
+}
+
+// Bind binds the actual data from the EncryptionKey receiver fields.
+func (e *EncryptionKey) Bind() *EncryptionKey {
+	e.ID = GetActualValue(e.ID)
+	e.Key = GetActualValue(e.Key)
+	e.KeyFile = GetActualValue(e.KeyFile)
+	return e
+}
+
+// BackupClone represents the configuration to clone the index from the backups of the agents of another cluster.
+// The backup of the source agent of the ordinal s is assigned to the target agent of the ordinal s modulo the number of the target agents,
+// and the target agent restores its assigned backup instead of its own backup, or starts with the empty index when no backup is assigned.
+type BackupClone struct {
+	// Enabled represent the clone is enabled or not
+	Enabled bool `yaml:"enabled" json:"enabled"`
+
+	// SourceFilename represent backup filename of the source agents without the ordinal, e.g. vald-agent-ngt for vald-agent-ngt-0
+	SourceFilename string `yaml:"source_filename" json:"source_filename"`
+
+	// SourceReplicas represent number of the source agents
+	SourceReplicas int `yaml:"source_replicas" json:"source_replicas"`
+
+	// TargetReplicas represent number of the target agents, it is the same as the SourceReplicas when it is not set
+	TargetReplicas int `yaml:"target_replicas" json:"target_replicas"`
+
+	// Version represent backup version of the source agents to clone, the latest valid version is cloned when it is empty
+	Version string `yaml:"version" json:"version"`
+
+	// Replay represent configurations to replay the cloned vectors through the gateway
+	Replay *CloneReplay `yaml:"replay" json:"replay"`
+}
+
+// CloneReplay represents the configuration to replay the vectors of the assigned source backups through the gateway of the target cluster.
+// It re-shards the index when the number of the target agents differs from the source agents, and the target agent starts with the empty index instead of restoring the backups.
+type CloneReplay struct {
+	// Enabled represent the replay is enabled or not
+	Enabled bool `yaml:"enabled" json:"enabled"`
+
+	// GatewayClient represent gRPC client configurations of the gateway of the target cluster
+	GatewayClient *GRPCClient `yaml:"gateway_client" json:"gateway_client"`
+
+	// BatchSize represent number of the vectors inserted through the gateway at once
+	BatchSize int `yaml:"batch_size" json:"batch_size"`
+
+	// CheckDuration represent retry duration of the replay until it succeeds, e.g. while the gateway is not ready
+	CheckDuration string `yaml:"check_duration" json:"check_duration"`
+
+	// WorkDir represent directory to restore the source backups into during the replay, they are removed after the replay
+	WorkDir string `yaml:"work_dir" json:"work_dir"`
+
+	// CheckpointPath represent file path to record the finished replay not to replay again after restart
+	CheckpointPath string `yaml:"checkpoint_path" json:"checkpoint_path"`
+}
+
+// Bind binds the actual data from the BackupClone receiver fields.
+func (c *BackupClone) Bind() *BackupClone {
+	c.SourceFilename = GetActualValue(c.SourceFilename)
+	c.Version = GetActualValue(c.Version)
+
+	if c.Replay != nil {
+		c.Replay = c.Replay.Bind()
+	} else {
+		c.Replay = new(CloneReplay)
+	}
+	return c
+}
+
+// Bind binds the actual data from the CloneReplay receiver fields.
+func (r *CloneReplay) Bind() *CloneReplay {
+	r.CheckDuration = GetActualValue(r.CheckDuration)
+	r.CheckpointPath = GetActualValue(r.CheckpointPath)
+	r.WorkDir = GetActualValue(r.WorkDir)
+
+	if r.GatewayClient != nil {
+		r.GatewayClient = r.GatewayClient.Bind()
+	} else {
+		r.GatewayClient = newGRPCClientConfig()
+	}
+	return r
+}
+
+// BackupThrottle represents the configuration to throttle the reads and the uploads of the backup not to affect the agent.
+type BackupThrottle struct {
+	// ReadRate represent maximum bytes per second to read the files for the backup, e.g. 100MB, it is not limited when it is empty
+	ReadRate string `yaml:"read_rate" json:"read_rate"`
+
+	// UploadRate represent maximum bytes per second to upload the backup before compression, e.g. 50MB, it is not limited when it is empty
+	UploadRate string `yaml:"upload_rate" json:"upload_rate"`
+
+	// IOPriorityClass represent I/O scheduling class of the sidecar, best-effort or idle, the default priority is kept when it is empty
+	IOPriorityClass string `yaml:"io_priority_class" json:"io_priority_class"`
+
+	// IOPriorityLevel represent I/O priority level from 0 (highest) to 7 (lowest) of the best-effort class
+	IOPriorityLevel int `yaml:"io_priority_level" json:"io_priority_level"`
+
+	// Adaptive represent configurations to lower the rates while the gRPC latency of the agent is high
+	Adaptive *AdaptiveThrottle `yaml:"adaptive" json:"adaptive"`
+}
+
+// AdaptiveThrottle represents the configuration to lower the rates of the backup while the gRPC latency of the agent exceeds the threshold.
+type AdaptiveThrottle struct {
+	// Enabled represent the adaptive throttling is enabled or not
+	Enabled bool `yaml:"enabled" json:"enabled"`
+
+	// MetricsURL represent URL of the prometheus metrics of the agent, e.g. http://localhost:6061/metrics
+	MetricsURL string `yaml:"metrics_url" json:"metrics_url"`
+
+	// LatencyMetric represent name of the prometheus histogram of the gRPC server latency in milliseconds
+	LatencyMetric string `yaml:"latency_metric" json:"latency_metric"`
+
+	// LatencyThreshold represent average latency to start throttling
+	LatencyThreshold string `yaml:"latency_threshold" json:"latency_threshold"`
+
+	// CheckDuration represent interval to check the latency of the agent
+	CheckDuration string `yaml:"check_duration" json:"check_duration"`
+
+	// ThrottledRate represent bytes per second of the read and upload rates while the latency exceeds the threshold, e.g. 10MB
+	ThrottledRate string `yaml:"throttled_rate" json:"throttled_rate"`
+}
+
+// Bind binds the actual data from the BackupThrottle receiver fields.
+func (t *BackupThrottle) Bind() *BackupThrottle {
+	t.ReadRate = GetActualValue(t.ReadRate)
+	t.UploadRate = GetActualValue(t.UploadRate)
+	t.IOPriorityClass = GetActualValue(t.IOPriorityClass)
+
+	if t.Adaptive != nil {
+		t.Adaptive = t.Adaptive.Bind()
+	} else {
+		t.Adaptive = new(AdaptiveThrottle)
+	}
+	return t
+}
+
+// Bind binds the actual data from the AdaptiveThrottle receiver fields.
+func (a *AdaptiveThrottle) Bind() *AdaptiveThrottle {
+	a.MetricsURL = GetActualValue(a.MetricsURL)
+	a.LatencyMetric = GetActualValue(a.LatencyMetric)
+	a.LatencyThreshold = GetActualValue(a.LatencyThreshold)
+	a.CheckDuration = GetActualValue(a.CheckDuration)
+	a.ThrottledRate = GetActualValue(a.ThrottledRate)
+	return a
 }
 
 // Bind binds the actual data from the AgentSidecar receiver fields.
@@ -67,6 +311,8 @@ func (s *AgentSidecar) Bind() *AgentSidecar {
 	s.PostStopTimeout = GetActualValue(s.PostStopTimeout)
 	s.Filename = GetActualValue(s.Filename)
 	s.FilenameSuffix = GetActualValue(s.FilenameSuffix)
+	s.RestoreVersion = GetActualValue(s.RestoreVersion)
+	s.AgentLivenessURL = GetActualValue(s.AgentLivenessURL)
 
 	if s.BlobStorage != nil {
 		s.BlobStorage = s.BlobStorage.Bind()
@@ -92,5 +338,35 @@ func (s *AgentSidecar) Bind() *AgentSidecar {
 		s.Client = new(Client)
 	}
 
+	if s.Retention != nil {
+		s.Retention = s.Retention.Bind()
+	} else {
+		s.Retention = new(BackupRetention)
+	}
+
+	if s.Incremental != nil {
+		s.Incremental = s.Incremental.Bind()
+	} else {
+		s.Incremental = new(IncrementalBackup)
+	}
+
+	if s.Encryption != nil {
+		s.Encryption = s.Encryption.Bind()
+	} else {
+		s.Encryption = new(BackupEncryption)
+	}
+
+	if s.Clone != nil {
+		s.Clone = s.Clone.Bind()
+	} else {
+		s.Clone = new(BackupClone)
+	}
+
+	if s.Throttle != nil {
+		s.Throttle = s.Throttle.Bind()
+	} else {
+		s.Throttle = new(BackupThrottle)
+	}
+
 	return s
 }
@@ -38,6 +38,12 @@ func TestAgentSidecar_Bind(t *testing.T) {
 		Compress           *CompressCore
 		RestoreBackoff     *Backoff
 		Client             *Client
+		Retention          *BackupRetention
+		RestoreVersion     string
+		Incremental        *IncrementalBackup
+		Encryption         *BackupEncryption
+		Clone              *BackupClone
+		Throttle           *BackupThrottle
 	}
 	type want struct {
 		want *AgentSidecar
@@ -67,6 +73,10 @@ func TestAgentSidecar_Bind(t *testing.T) {
 			blobStorageType := "s3"
 			compressAlgorithm := GOB.String()
 			backoffInitialDuration := "10ms"
+			restoreVersion := "20211018T000000Z-00000000000000000001"
+			chunkSize := "4MB"
+			keyID := "key-1"
+			keyFile := "/etc/vald/backup.key"
 			return test{
 				name: "return AgentSidecar when all of object are set",
 				fields: fields{
@@ -88,6 +98,41 @@ func TestAgentSidecar_Bind(t *testing.T) {
 					Client: &Client{
 						Net: new(Net),
 					},
+					Retention: &BackupRetention{
+						KeepLast:      3,
+						KeepDailyDays: 7,
+					},
+					RestoreVersion: restoreVersion,
+					Incremental: &IncrementalBackup{
+						Enabled:   true,
+						ChunkSize: chunkSize,
+					},
+					Encryption: &BackupEncryption{
+						Enabled: true,
+						KeyID:   keyID,
+						Keys: []*EncryptionKey{
+							{
+								ID:      keyID,
+								KeyFile: keyFile,
+							},
+						},
+					},
+					Clone: &BackupClone{
+						Enabled:        true,
+						SourceFilename: "vald-agent-ngt",
+						SourceReplicas: 3,
+						TargetReplicas: 2,
+						Version:        restoreVersion,
+						Replay: &CloneReplay{
+							Enabled:   true,
+							BatchSize: 100,
+						},
+					},
+					Throttle: &BackupThrottle{
+						ReadRate:        "100MB",
+						UploadRate:      "50MB",
+						IOPriorityClass: "idle",
+					},
 				},
 				want: want{
 					want: &AgentSidecar{
@@ -101,6 +146,7 @@ func TestAgentSidecar_Bind(t *testing.T) {
 							StorageType:  blobStorageType,
 							S3:           new(S3Config),
 							CloudStorage: new(CloudStorageConfig),
+							Local:        new(LocalConfig),
 						},
 						Compress: &CompressCore{
 							CompressAlgorithm: compressAlgorithm,
@@ -111,6 +157,43 @@ func TestAgentSidecar_Bind(t *testing.T) {
 						Client: &Client{
 							Net: new(Net),
 						},
+						Retention: &BackupRetention{
+							KeepLast:      3,
+							KeepDailyDays: 7,
+						},
+						RestoreVersion: restoreVersion,
+						Incremental: &IncrementalBackup{
+							Enabled:   true,
+							ChunkSize: chunkSize,
+						},
+						Encryption: &BackupEncryption{
+							Enabled: true,
+							KeyID:   keyID,
+							Keys: []*EncryptionKey{
+								{
+									ID:      keyID,
+									KeyFile: keyFile,
+								},
+							},
+						},
+						Clone: &BackupClone{
+							Enabled:        true,
+							SourceFilename: "vald-agent-ngt",
+							SourceReplicas: 3,
+							TargetReplicas: 2,
+							Version:        restoreVersion,
+							Replay: &CloneReplay{
+								Enabled:       true,
+								GatewayClient: newGRPCClientConfig(),
+								BatchSize:     100,
+							},
+						},
+						Throttle: &BackupThrottle{
+							ReadRate:        "100MB",
+							UploadRate:      "50MB",
+							IOPriorityClass: "idle",
+							Adaptive:        new(AdaptiveThrottle),
+						},
 					},
 				},
 			}
@@ -144,6 +227,11 @@ func TestAgentSidecar_Bind(t *testing.T) {
 						Compress:           new(CompressCore),
 						RestoreBackoff:     new(Backoff),
 						Client:             new(Client),
+						Retention:          new(BackupRetention),
+						Incremental:        new(IncrementalBackup),
+						Encryption:         new(BackupEncryption),
+						Clone:              new(BackupClone),
+						Throttle:           new(BackupThrottle),
 					},
 				},
 			}
@@ -203,6 +291,11 @@ func TestAgentSidecar_Bind(t *testing.T) {
 						Compress:           new(CompressCore),
 						RestoreBackoff:     new(Backoff),
 						Client:             new(Client),
+						Retention:          new(BackupRetention),
+						Incremental:        new(IncrementalBackup),
+						Encryption:         new(BackupEncryption),
+						Clone:              new(BackupClone),
+						Throttle:           new(BackupThrottle),
 					},
 				},
 			}
@@ -217,6 +310,11 @@ func TestAgentSidecar_Bind(t *testing.T) {
 						Compress:       new(CompressCore),
 						RestoreBackoff: new(Backoff),
 						Client:         new(Client),
+						Retention:      new(BackupRetention),
+						Incremental:    new(IncrementalBackup),
+						Encryption:     new(BackupEncryption),
+						Clone:          new(BackupClone),
+						Throttle:       new(BackupThrottle),
 					},
 				},
 			}
@@ -247,6 +345,12 @@ func TestAgentSidecar_Bind(t *testing.T) {
 				Compress:           test.fields.Compress,
 				RestoreBackoff:     test.fields.RestoreBackoff,
 				Client:             test.fields.Client,
+				Retention:          test.fields.Retention,
+				RestoreVersion:     test.fields.RestoreVersion,
+				Incremental:        test.fields.Incremental,
+				Encryption:         test.fields.Encryption,
+				Clone:              test.fields.Clone,
+				Throttle:           test.fields.Throttle,
 			}
 
 			got := s.Bind()
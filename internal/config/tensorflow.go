@@ -30,6 +30,8 @@ type Tensorflow struct {
 	FetchesMap            map[string]int `json:"-"                                 yaml:"-"`
 	WarmupInputs          []string       `json:"warmup_inputs,omitempty"           yaml:"warmup_inputs"`
 	ResultNestedDimension uint8          `json:"result_nested_dimension,omitempty" yaml:"result_nested_dimension"`
+	MaxBatchSize          int            `json:"max_batch_size,omitempty"          yaml:"max_batch_size"`
+	BatchWindow           string         `json:"batch_window,omitempty"            yaml:"batch_window"`
 }
 
 type SessionOption struct {
@@ -59,6 +61,7 @@ func (tf *Tensorflow) Bind() *Tensorflow {
 	tf.ExportPath = GetActualValue(tf.ExportPath)
 	tf.Tags = GetActualValues(tf.Tags)
 	tf.WarmupInputs = GetActualValues(tf.WarmupInputs)
+	tf.BatchWindow = GetActualValue(tf.BatchWindow)
 	return tf
 }
 
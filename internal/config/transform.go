@@ -0,0 +1,56 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package config providers configuration type and load configuration logic
+package config
+
+// VectorTransform represent the vector transform ingress filter configuration.
+type VectorTransform struct {
+	// Steps represent the transform steps applied to the vector in order.
+	Steps []*TransformStep `json:"steps,omitempty" yaml:"steps"`
+}
+
+// TransformStep represent a step of the vector transform.
+type TransformStep struct {
+	// Type represent the step type, one of l2_normalization, mean_centering, projection and int8_quantization.
+	Type string `json:"type,omitempty" yaml:"type"`
+
+	// Path represent the .npy or text file of the mean vector for mean_centering or the matrix for projection.
+	Path string `json:"path,omitempty" yaml:"path"`
+
+	// Min represent the lower bound of the value range for int8_quantization.
+	Min float64 `json:"min,omitempty" yaml:"min"`
+
+	// Max represent the upper bound of the value range for int8_quantization.
+	Max float64 `json:"max,omitempty" yaml:"max"`
+}
+
+// Bind returns VectorTransform object whose some string value is filed value or environment value.
+func (v *VectorTransform) Bind() *VectorTransform {
+	for i, s := range v.Steps {
+		if s != nil {
+			v.Steps[i] = s.Bind()
+		}
+	}
+	return v
+}
+
+// Bind returns TransformStep object whose some string value is filed value or environment value.
+func (t *TransformStep) Bind() *TransformStep {
+	t.Type = GetActualValue(t.Type)
+	t.Path = GetActualValue(t.Path)
+	return t
+}
@@ -0,0 +1,146 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package config providers configuration type and load configuration logic
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestVectorTransform_Bind(t *testing.T) {
+	type fields struct {
+		Steps []*TransformStep
+	}
+	type want struct {
+		want *VectorTransform
+	}
+	type test struct {
+		name       string
+		fields     fields
+		want       want
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+	tests := []test{
+		{
+			name: "return VectorTransform when the bind successes",
+			fields: fields{
+				Steps: []*TransformStep{
+					{
+						Type: "l2_normalization",
+					},
+					{
+						Type: "int8_quantization",
+						Min:  -1,
+						Max:  1,
+					},
+				},
+			},
+			want: want{
+				want: &VectorTransform{
+					Steps: []*TransformStep{
+						{
+							Type: "l2_normalization",
+						},
+						{
+							Type: "int8_quantization",
+							Min:  -1,
+							Max:  1,
+						},
+					},
+				},
+			},
+		},
+		func() test {
+			suffix := "_FOR_TEST_VECTOR_TRANSFORM_BIND"
+			m := map[string]string{
+				"TYPE" + suffix: "projection",
+				"PATH" + suffix: "/var/pca.npy",
+			}
+			return test{
+				name: "return VectorTransform when the bind successes and the data is loaded from the environment variable",
+				fields: fields{
+					Steps: []*TransformStep{
+						{
+							Type: "_TYPE" + suffix + "_",
+							Path: "_PATH" + suffix + "_",
+						},
+						nil,
+					},
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+					for k, v := range m {
+						if err := os.Setenv(k, v); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+					for k := range m {
+						if err := os.Unsetenv(k); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				want: want{
+					want: &VectorTransform{
+						Steps: []*TransformStep{
+							{
+								Type: "projection",
+								Path: "/var/pca.npy",
+							},
+							nil,
+						},
+					},
+				},
+			}
+		}(),
+		{
+			name: "return VectorTransform when steps is nil",
+			want: want{
+				want: &VectorTransform{},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(tt)
+			}
+			v := &VectorTransform{
+				Steps: test.fields.Steps,
+			}
+
+			got := v.Bind()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
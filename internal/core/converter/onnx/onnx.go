@@ -0,0 +1,187 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package onnx provides implementation of Go API for extract data to vector by ONNX Runtime
+package onnx
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+)
+
+// Closer is a type alias io.Closer.
+type Closer = io.Closer
+
+// ONNX represents an ONNX Runtime converter interface.
+type ONNX interface {
+	GetVector(input []byte) ([]float32, error)
+	Closer
+}
+
+type session interface {
+	Run(in *tensor) ([]float32, error)
+	Closer
+}
+
+type elementType int
+
+// the values are the same as ONNXTensorElementDataType.
+const (
+	elementTypeFloat32 elementType = 1
+	elementTypeUint8   elementType = 2
+)
+
+type tensor struct {
+	dtype elementType
+	shape []int64
+	data  []byte
+}
+
+type onnx struct {
+	modelPath    string
+	inputName    string
+	outputName   string
+	inputType    elementType
+	inputShape   []int64
+	threads      int
+	warmupInputs [][]byte
+	loadFunc     func(path, input, output string, threads int) (session, error)
+	session      session
+}
+
+// New loads an ONNX model and returns a new ONNX Runtime converter.
+func New(opts ...Option) (ONNX, error) {
+	o := new(onnx)
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(o); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+
+	s, err := o.loadFunc(o.modelPath, o.inputName, o.outputName, o.threads)
+	if err != nil {
+		return nil, err
+	}
+	o.session = s
+
+	err = o.warmup()
+	if err != nil {
+		_ = o.session.Close()
+		return nil, err
+	}
+
+	return o, nil
+}
+
+func (o *onnx) warmup() error {
+	for _, in := range o.warmupInputs {
+		_, err := o.GetVector(in)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *onnx) Close() error {
+	return o.session.Close()
+}
+
+// GetVector feeds the input bytes to the model and returns the flattened output tensor.
+// The input is interpreted as little-endian float32 values or as raw uint8 values according to the configured input type.
+func (o *onnx) GetVector(input []byte) ([]float32, error) {
+	size := o.inputType.size()
+	if len(input)%size != 0 {
+		return nil, errors.ErrONNXInputLength(len(input), size)
+	}
+	shape, err := o.shape(len(input) / size)
+	if err != nil {
+		return nil, err
+	}
+	return o.session.Run(&tensor{
+		dtype: o.inputType,
+		shape: shape,
+		data:  input,
+	})
+}
+
+// shape resolves the input shape for n elements.
+// a dimension of -1 is inferred from n, and the default shape is [1, n].
+// at most one dimension can be inferred.
+func (o *onnx) shape(n int) ([]int64, error) {
+	if n == 0 {
+		return nil, errors.ErrONNXInputShape(n, o.inputShape)
+	}
+	if len(o.inputShape) == 0 {
+		return []int64{1, int64(n)}, nil
+	}
+	shape := make([]int64, len(o.inputShape))
+	copy(shape, o.inputShape)
+
+	prod, dyn := int64(1), -1
+	for i, d := range shape {
+		if d < 0 {
+			if dyn >= 0 {
+				return nil, errors.ErrONNXMultipleDynamicDimensions(o.inputShape)
+			}
+			dyn = i
+			continue
+		}
+		prod *= d
+	}
+	if dyn >= 0 {
+		if prod == 0 || int64(n)%prod != 0 {
+			return nil, errors.ErrONNXInputShape(n, o.inputShape)
+		}
+		shape[dyn] = int64(n) / prod
+		return shape, nil
+	}
+	if prod != int64(n) {
+		return nil, errors.ErrONNXInputShape(n, o.inputShape)
+	}
+	return shape, nil
+}
+
+func (t elementType) size() int {
+	if t == elementTypeFloat32 {
+		return 4
+	}
+	return 1
+}
+
+func elementTypeFrom(typ string) (elementType, error) {
+	switch strings.ToLower(typ) {
+	case "float32", "float":
+		return elementTypeFloat32, nil
+	case "uint8", "byte":
+		return elementTypeUint8, nil
+	}
+	return 0, errors.ErrONNXInvalidInputType(typ)
+}
+
+// Float32ToBytes encodes the vector as little-endian bytes which can be used as the float32 input of GetVector.
+func Float32ToBytes(vec []float32) []byte {
+	b := make([]byte, len(vec)*4)
+	for i, v := range vec {
+		binary.LittleEndian.PutUint32(b[i*4:], math.Float32bits(v))
+	}
+	return b
+}
@@ -0,0 +1,31 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package onnx provides implementation of Go API for extract data to vector by ONNX Runtime
+package onnx
+
+type mockSession struct {
+	RunFunc   func(*tensor) ([]float32, error)
+	CloseFunc func() error
+}
+
+func (m *mockSession) Run(in *tensor) ([]float32, error) {
+	return m.RunFunc(in)
+}
+
+func (m *mockSession) Close() error {
+	return m.CloseFunc()
+}
@@ -0,0 +1,267 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package onnx provides implementation of Go API for extract data to vector by ONNX Runtime
+package onnx
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestNew(t *testing.T) {
+	type args struct {
+		opts []Option
+	}
+	type want struct {
+		err    error
+		warmup int
+	}
+	type test struct {
+		name   string
+		args   args
+		want   want
+		runs   *int
+		closed *bool
+	}
+	newMock := func(runs *int, closed *bool, err error) Option {
+		return withLoadFunc(func(path, input, output string, threads int) (session, error) {
+			return &mockSession{
+				RunFunc: func(*tensor) ([]float32, error) {
+					*runs++
+					return []float32{1}, err
+				},
+				CloseFunc: func() error {
+					*closed = true
+					return nil
+				},
+			}, nil
+		})
+	}
+	tests := []test{
+		func() test {
+			runs, closed := 0, false
+			return test{
+				name: "returns converter and warms up the model",
+				args: args{
+					opts: []Option{
+						newMock(&runs, &closed, nil),
+						WithModelPath("model.onnx"),
+						WithWarmupInputs(Float32ToBytes([]float32{1, 2}), Float32ToBytes([]float32{3})),
+					},
+				},
+				want: want{
+					warmup: 2,
+				},
+				runs:   &runs,
+				closed: &closed,
+			}
+		}(),
+		func() test {
+			err := errors.New("warmup failed")
+			runs, closed := 0, false
+			return test{
+				name: "returns error and closes the session when warmup fails",
+				args: args{
+					opts: []Option{
+						newMock(&runs, &closed, err),
+						WithModelPath("model.onnx"),
+						WithWarmupInputs([]byte{0, 0, 0, 0}),
+					},
+				},
+				want: want{
+					err:    err,
+					warmup: 1,
+				},
+				runs:   &runs,
+				closed: &closed,
+			}
+		}(),
+		func() test {
+			err := errors.New("load failed")
+			runs, closed := 0, false
+			return test{
+				name: "returns error when the model cannot be loaded",
+				args: args{
+					opts: []Option{
+						withLoadFunc(func(string, string, string, int) (session, error) {
+							return nil, err
+						}),
+						WithModelPath("model.onnx"),
+					},
+				},
+				want: want{
+					err: err,
+				},
+				runs:   &runs,
+				closed: &closed,
+			}
+		}(),
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			got, err := New(test.args.opts...)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if err == nil && got == nil {
+				tt.Error("got nil converter")
+			}
+			if *test.runs != test.want.warmup {
+				tt.Errorf("got warmup runs: %d, want: %d", *test.runs, test.want.warmup)
+			}
+			if test.want.err != nil && test.want.warmup != 0 && !*test.closed {
+				tt.Error("session is not closed")
+			}
+		})
+	}
+}
+
+func Test_onnx_GetVector(t *testing.T) {
+	type fields struct {
+		inputType  elementType
+		inputShape []int64
+	}
+	type args struct {
+		input []byte
+	}
+	type want struct {
+		in  *tensor
+		err error
+	}
+	type test struct {
+		name   string
+		fields fields
+		args   args
+		want   want
+	}
+	tests := []test{
+		{
+			name: "feeds float32 input with the default shape",
+			fields: fields{
+				inputType: elementTypeFloat32,
+			},
+			args: args{
+				input: Float32ToBytes([]float32{1, 2, 3}),
+			},
+			want: want{
+				in: &tensor{
+					dtype: elementTypeFloat32,
+					shape: []int64{1, 3},
+					data:  Float32ToBytes([]float32{1, 2, 3}),
+				},
+			},
+		},
+		{
+			name: "feeds uint8 input with the dynamic dimension resolved",
+			fields: fields{
+				inputType:  elementTypeUint8,
+				inputShape: []int64{1, -1, 2},
+			},
+			args: args{
+				input: []byte{1, 2, 3, 4, 5, 6},
+			},
+			want: want{
+				in: &tensor{
+					dtype: elementTypeUint8,
+					shape: []int64{1, 3, 2},
+					data:  []byte{1, 2, 3, 4, 5, 6},
+				},
+			},
+		},
+		{
+			name: "returns error when float32 input length is not a multiple of 4",
+			fields: fields{
+				inputType: elementTypeFloat32,
+			},
+			args: args{
+				input: []byte{1, 2, 3},
+			},
+			want: want{
+				err: errors.ErrONNXInputLength(3, 4),
+			},
+		},
+		{
+			name: "returns error when input does not fit the static shape",
+			fields: fields{
+				inputType:  elementTypeUint8,
+				inputShape: []int64{1, 4},
+			},
+			args: args{
+				input: []byte{1, 2, 3},
+			},
+			want: want{
+				err: errors.ErrONNXInputShape(3, []int64{1, 4}),
+			},
+		},
+		{
+			name: "returns error when input shape has more than one dynamic dimension",
+			fields: fields{
+				inputType:  elementTypeUint8,
+				inputShape: []int64{-1, -1},
+			},
+			args: args{
+				input: []byte{1, 2, 3, 4},
+			},
+			want: want{
+				err: errors.ErrONNXMultipleDynamicDimensions([]int64{-1, -1}),
+			},
+		},
+		{
+			name: "returns error when input is empty",
+			fields: fields{
+				inputType: elementTypeUint8,
+			},
+			args: args{
+				input: []byte{},
+			},
+			want: want{
+				err: errors.ErrONNXInputShape(0, nil),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			var in *tensor
+			o := &onnx{
+				inputType:  test.fields.inputType,
+				inputShape: test.fields.inputShape,
+				session: &mockSession{
+					RunFunc: func(t *tensor) ([]float32, error) {
+						in = t
+						return []float32{0.5}, nil
+					},
+				},
+			}
+			_, err := o.GetVector(test.args.input)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if !reflect.DeepEqual(in, test.want.in) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", in, test.want.in)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package onnx provides implementation of Go API for extract data to vector by ONNX Runtime
+package onnx
+
+import (
+	"encoding/base64"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// Option is onnx configure.
+type Option func(*onnx) error
+
+var defaultOptions = []Option{
+	withLoadFunc(newRuntimeSession),
+	WithInputType("float32"),
+	WithIntraOpNumThreads(1),
+}
+
+// WithModelPath returns Option that sets the model path.
+func WithModelPath(path string) Option {
+	return func(o *onnx) error {
+		if path == "" {
+			return errors.NewErrInvalidOption("modelPath", path)
+		}
+		o.modelPath = path
+		return nil
+	}
+}
+
+// WithInputName returns Option that sets the input tensor name.
+func WithInputName(name string) Option {
+	return func(o *onnx) error {
+		if name != "" {
+			o.inputName = name
+		}
+		return nil
+	}
+}
+
+// WithOutputName returns Option that sets the output tensor name.
+func WithOutputName(name string) Option {
+	return func(o *onnx) error {
+		if name != "" {
+			o.outputName = name
+		}
+		return nil
+	}
+}
+
+// WithInputType returns Option that sets the input element type, float32 or uint8.
+func WithInputType(typ string) Option {
+	return func(o *onnx) error {
+		if typ == "" {
+			return nil
+		}
+		t, err := elementTypeFrom(typ)
+		if err != nil {
+			return err
+		}
+		o.inputType = t
+		return nil
+	}
+}
+
+// WithInputShape returns Option that sets the input tensor shape.
+func WithInputShape(shape ...int64) Option {
+	return func(o *onnx) error {
+		if len(shape) == 0 {
+			return nil
+		}
+		dyn := 0
+		for _, d := range shape {
+			if d < 0 {
+				dyn++
+			}
+		}
+		if dyn > 1 {
+			return errors.NewErrInvalidOption("inputShape", shape)
+		}
+		o.inputShape = shape
+		return nil
+	}
+}
+
+// WithIntraOpNumThreads returns Option that sets the number of threads used by an operator.
+func WithIntraOpNumThreads(n int) Option {
+	return func(o *onnx) error {
+		if n > 0 {
+			o.threads = n
+		}
+		return nil
+	}
+}
+
+// WithWarmupInputs returns Option that sets the inputs sent to the model on startup.
+func WithWarmupInputs(inputs ...[]byte) Option {
+	return func(o *onnx) error {
+		if len(inputs) != 0 {
+			o.warmupInputs = append(o.warmupInputs, inputs...)
+		}
+		return nil
+	}
+}
+
+// WithBase64WarmupInputs returns Option that sets the base64 encoded inputs sent to the model on startup.
+func WithBase64WarmupInputs(inputs ...string) Option {
+	return func(o *onnx) error {
+		for _, in := range inputs {
+			b, err := base64.StdEncoding.DecodeString(in)
+			if err != nil {
+				return err
+			}
+			o.warmupInputs = append(o.warmupInputs, b)
+		}
+		return nil
+	}
+}
+
+func withLoadFunc(f func(path, input, output string, threads int) (session, error)) Option {
+	return func(o *onnx) error {
+		if f != nil {
+			o.loadFunc = f
+		}
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package onnx provides implementation of Go API for extract data to vector by ONNX Runtime
+package onnx
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestWithInputType(t *testing.T) {
+	type want struct {
+		want elementType
+		err  error
+	}
+	type test struct {
+		name string
+		typ  string
+		want want
+	}
+	tests := []test{
+		{
+			name: "set float32 input type",
+			typ:  "float32",
+			want: want{
+				want: elementTypeFloat32,
+			},
+		},
+		{
+			name: "set uint8 input type by alias",
+			typ:  "byte",
+			want: want{
+				want: elementTypeUint8,
+			},
+		},
+		{
+			name: "returns error when input type is not supported",
+			typ:  "int64",
+			want: want{
+				err: errors.ErrONNXInvalidInputType("int64"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			o := new(onnx)
+			err := WithInputType(test.typ)(o)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if o.inputType != test.want.want {
+				tt.Errorf("got: %d, want: %d", o.inputType, test.want.want)
+			}
+		})
+	}
+}
+
+func TestWithInputShape(t *testing.T) {
+	type test struct {
+		name    string
+		shape   []int64
+		want    []int64
+		wantErr bool
+	}
+	tests := []test{
+		{
+			name:  "set shape with a dynamic dimension",
+			shape: []int64{1, -1},
+			want:  []int64{1, -1},
+		},
+		{
+			name:    "returns error when the shape has two dynamic dimensions",
+			shape:   []int64{-1, -1},
+			wantErr: true,
+		},
+		{
+			name: "do nothing when shape is empty",
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			o := new(onnx)
+			err := WithInputShape(test.shape...)(o)
+			if (err != nil) != test.wantErr {
+				tt.Errorf("got_error: %v, wantErr: %v", err, test.wantErr)
+			}
+			if !reflect.DeepEqual(o.inputShape, test.want) {
+				tt.Errorf("got: %v, want: %v", o.inputShape, test.want)
+			}
+		})
+	}
+}
+
+func TestWithBase64WarmupInputs(t *testing.T) {
+	type test struct {
+		name    string
+		inputs  []string
+		want    [][]byte
+		wantErr bool
+	}
+	tests := []test{
+		{
+			name:   "set decoded warmup inputs",
+			inputs: []string{"AQID"},
+			want:   [][]byte{{1, 2, 3}},
+		},
+		{
+			name:    "returns error when input is not base64",
+			inputs:  []string{"!"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			o := new(onnx)
+			err := WithBase64WarmupInputs(test.inputs...)(o)
+			if (err != nil) != test.wantErr {
+				tt.Errorf("got_error: %v, wantErr: %v", err, test.wantErr)
+			}
+			if !reflect.DeepEqual(o.warmupInputs, test.want) {
+				tt.Errorf("got: %v, want: %v", o.warmupInputs, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+//go:build onnxruntime
+// +build onnxruntime
+
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package onnx provides implementation of Go API for extract data to vector by ONNX Runtime
+package onnx
+
+/*
+#cgo LDFLAGS: -lonnxruntime
+#include <stdlib.h>
+#include <string.h>
+#include <onnxruntime_c_api.h>
+
+typedef struct {
+	OrtEnv* env;
+	OrtSession* session;
+	OrtMemoryInfo* mem;
+} vald_ort_session;
+
+static const OrtApi* vald_ort_api() {
+	return OrtGetApiBase()->GetApi(ORT_API_VERSION);
+}
+
+static char* vald_ort_error(OrtStatus* status) {
+	const OrtApi* api = vald_ort_api();
+	char* msg = strdup(api->GetErrorMessage(status));
+	api->ReleaseStatus(status);
+	return msg;
+}
+
+static void vald_ort_release(vald_ort_session* s) {
+	const OrtApi* api = vald_ort_api();
+	if (s->mem != NULL) {
+		api->ReleaseMemoryInfo(s->mem);
+		s->mem = NULL;
+	}
+	if (s->session != NULL) {
+		api->ReleaseSession(s->session);
+		s->session = NULL;
+	}
+	if (s->env != NULL) {
+		api->ReleaseEnv(s->env);
+		s->env = NULL;
+	}
+}
+
+static char* vald_ort_new_session(const char* path, int threads, vald_ort_session* s) {
+	const OrtApi* api = vald_ort_api();
+	OrtStatus* status = api->CreateEnv(ORT_LOGGING_LEVEL_WARNING, "vald", &s->env);
+	if (status != NULL) {
+		return vald_ort_error(status);
+	}
+	OrtSessionOptions* opts = NULL;
+	status = api->CreateSessionOptions(&opts);
+	if (status != NULL) {
+		return vald_ort_error(status);
+	}
+	status = api->SetIntraOpNumThreads(opts, threads);
+	if (status == NULL) {
+		status = api->SetSessionGraphOptimizationLevel(opts, ORT_ENABLE_ALL);
+	}
+	if (status == NULL) {
+		status = api->CreateSession(s->env, path, opts, &s->session);
+	}
+	api->ReleaseSessionOptions(opts);
+	if (status != NULL) {
+		return vald_ort_error(status);
+	}
+	status = api->CreateCpuMemoryInfo(OrtArenaAllocator, OrtMemTypeDefault, &s->mem);
+	if (status != NULL) {
+		return vald_ort_error(status);
+	}
+	return NULL;
+}
+
+static char* vald_ort_run(vald_ort_session* s, const char* in_name, const char* out_name,
+		void* data, size_t len, const int64_t* shape, size_t ndim, int dtype,
+		int* out_type, float** out, size_t* out_len) {
+	const OrtApi* api = vald_ort_api();
+	OrtValue* input = NULL;
+	OrtStatus* status = api->CreateTensorWithDataAsOrtValue(s->mem, data, len, shape, ndim,
+			(ONNXTensorElementDataType)dtype, &input);
+	if (status != NULL) {
+		return vald_ort_error(status);
+	}
+	const char* in_names[] = {in_name};
+	const char* out_names[] = {out_name};
+	OrtValue* output = NULL;
+	status = api->Run(s->session, NULL, in_names, (const OrtValue* const*)&input, 1, out_names, 1, &output);
+	api->ReleaseValue(input);
+	if (status != NULL) {
+		return vald_ort_error(status);
+	}
+
+	OrtTensorTypeAndShapeInfo* info = NULL;
+	ONNXTensorElementDataType otype = ONNX_TENSOR_ELEMENT_DATA_TYPE_UNDEFINED;
+	size_t count = 0;
+	status = api->GetTensorTypeAndShape(output, &info);
+	if (status == NULL) {
+		status = api->GetTensorElementType(info, &otype);
+		if (status == NULL) {
+			status = api->GetTensorShapeElementCount(info, &count);
+		}
+		api->ReleaseTensorTypeAndShapeInfo(info);
+	}
+	*out_type = (int)otype;
+	if (status != NULL || otype != ONNX_TENSOR_ELEMENT_DATA_TYPE_FLOAT) {
+		api->ReleaseValue(output);
+		return status != NULL ? vald_ort_error(status) : NULL;
+	}
+
+	float* ptr = NULL;
+	status = api->GetTensorMutableData(output, (void**)&ptr);
+	if (status != NULL) {
+		api->ReleaseValue(output);
+		return vald_ort_error(status);
+	}
+	*out = (float*)malloc(count * sizeof(float));
+	memcpy(*out, ptr, count * sizeof(float));
+	*out_len = count;
+	api->ReleaseValue(output);
+	return NULL;
+}
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+type runtimeSession struct {
+	s          *C.vald_ort_session
+	inputName  *C.char
+	outputName *C.char
+}
+
+// newRuntimeSession creates a CPU ONNX Runtime session of the model.
+func newRuntimeSession(path, input, output string, threads int) (session, error) {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+
+	s := (*C.vald_ort_session)(C.calloc(1, C.sizeof_vald_ort_session))
+	if msg := C.vald_ort_new_session(cpath, C.int(threads), s); msg != nil {
+		C.vald_ort_release(s)
+		C.free(unsafe.Pointer(s))
+		return nil, errors.ErrONNXRuntime(goString(msg))
+	}
+	return &runtimeSession{
+		s:          s,
+		inputName:  C.CString(input),
+		outputName: C.CString(output),
+	}, nil
+}
+
+func (r *runtimeSession) Run(in *tensor) ([]float32, error) {
+	data := C.CBytes(in.data)
+	defer C.free(data)
+
+	var (
+		otype C.int
+		out   *C.float
+		n     C.size_t
+	)
+	msg := C.vald_ort_run(r.s, r.inputName, r.outputName,
+		data, C.size_t(len(in.data)),
+		(*C.int64_t)(unsafe.Pointer(&in.shape[0])), C.size_t(len(in.shape)),
+		C.int(in.dtype), &otype, &out, &n)
+	if msg != nil {
+		return nil, errors.ErrONNXRuntime(goString(msg))
+	}
+	defer C.free(unsafe.Pointer(out))
+	if elementType(otype) != elementTypeFloat32 {
+		return nil, errors.ErrONNXUnsupportedOutputType(int(otype))
+	}
+
+	vec := make([]float32, int(n))
+	if n > 0 {
+		copy(vec, unsafe.Slice((*float32)(unsafe.Pointer(out)), int(n)))
+	}
+	return vec, nil
+}
+
+func (r *runtimeSession) Close() error {
+	C.vald_ort_release(r.s)
+	C.free(unsafe.Pointer(r.s))
+	C.free(unsafe.Pointer(r.inputName))
+	C.free(unsafe.Pointer(r.outputName))
+	return nil
+}
+
+func goString(msg *C.char) string {
+	defer C.free(unsafe.Pointer(msg))
+	return C.GoString(msg)
+}
@@ -0,0 +1,29 @@
+//go:build !onnxruntime
+// +build !onnxruntime
+
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package onnx provides implementation of Go API for extract data to vector by ONNX Runtime
+package onnx
+
+import "github.com/vdaas/vald/internal/errors"
+
+// newRuntimeSession returns an error because the binary is built without ONNX Runtime.
+// build with the onnxruntime tag to link against libonnxruntime.
+func newRuntimeSession(path, input, output string, threads int) (session, error) {
+	return nil, errors.ErrONNXRuntimeNotEnabled
+}
@@ -0,0 +1,141 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package tensorflow provides implementation of Go API for extract data to vector
+package tensorflow
+
+import (
+	"context"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+// batcher groups concurrent GetVector calls into a single session run.
+// a batch is flushed when it reaches size or when window has passed since its first request.
+type batcher struct {
+	size   int
+	window time.Duration
+	run    func(inputs ...[]string) ([][]float64, error)
+	ch     chan *batchRequest
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type batchRequest struct {
+	inputs []string
+	res    chan batchResult
+}
+
+type batchResult struct {
+	vec []float64
+	err error
+}
+
+func newBatcher(size int, window time.Duration, run func(inputs ...[]string) ([][]float64, error)) *batcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &batcher{
+		size:   size,
+		window: window,
+		run:    run,
+		ch:     make(chan *batchRequest, size),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(b.done)
+		_ = safety.RecoverFunc(func() error {
+			b.loop(ctx)
+			return nil
+		})()
+	}()
+	return b
+}
+
+func (b *batcher) loop(ctx context.Context) {
+	reqs := make([]*batchRequest, 0, b.size)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-b.ch:
+			reqs = append(reqs[:0], req)
+		}
+		timer := time.NewTimer(b.window)
+	collect:
+		for len(reqs) < b.size {
+			select {
+			case <-ctx.Done():
+				break collect
+			case req := <-b.ch:
+				reqs = append(reqs, req)
+			case <-timer.C:
+				break collect
+			}
+		}
+		timer.Stop()
+		b.flush(reqs)
+	}
+}
+
+func (b *batcher) flush(reqs []*batchRequest) {
+	inputs := make([][]string, 0, len(reqs))
+	for _, req := range reqs {
+		inputs = append(inputs, req.inputs)
+	}
+	vecs, err := b.run(inputs...)
+	if err == nil && len(vecs) != len(reqs) {
+		err = errors.ErrBatchResultLengthTF(len(vecs), len(reqs))
+	}
+	for i, req := range reqs {
+		if err != nil {
+			req.res <- batchResult{err: err}
+			continue
+		}
+		req.res <- batchResult{vec: vecs[i]}
+	}
+}
+
+// Do enqueues the inputs to the next batch and waits for its vector.
+func (b *batcher) Do(inputs ...string) ([]float64, error) {
+	req := &batchRequest{
+		inputs: inputs,
+		res:    make(chan batchResult, 1),
+	}
+	select {
+	case <-b.done:
+		return nil, errors.ErrBatcherClosedTF
+	case b.ch <- req:
+	}
+	select {
+	case r := <-req.res:
+		return r.vec, r.err
+	case <-b.done:
+		select {
+		case r := <-req.res:
+			return r.vec, r.err
+		default:
+			return nil, errors.ErrBatcherClosedTF
+		}
+	}
+}
+
+// Close stops the batcher. requests which are not flushed yet return ErrBatcherClosedTF.
+func (b *batcher) Close() {
+	b.cancel()
+	<-b.done
+}
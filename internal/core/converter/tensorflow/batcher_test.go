@@ -0,0 +1,145 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package tensorflow provides implementation of Go API for extract data to vector
+package tensorflow
+
+import (
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func Test_batcher_Do(t *testing.T) {
+	type args struct {
+		n int
+	}
+	type want struct {
+		batches []int
+		err     error
+	}
+	type test struct {
+		name   string
+		size   int
+		window time.Duration
+		args   args
+		runErr error
+		want   want
+	}
+	tests := []test{
+		{
+			name:   "groups concurrent requests up to the max batch size",
+			size:   4,
+			window: time.Second,
+			args: args{
+				n: 8,
+			},
+			want: want{
+				batches: []int{4, 4},
+			},
+		},
+		{
+			name:   "flushes a partial batch when the window has passed",
+			size:   4,
+			window: 100 * time.Millisecond,
+			args: args{
+				n: 3,
+			},
+			want: want{
+				batches: []int{3},
+			},
+		},
+		{
+			name:   "returns the run error to every request of the batch",
+			size:   2,
+			window: time.Second,
+			args: args{
+				n: 2,
+			},
+			runErr: errors.New("run error"),
+			want: want{
+				batches: []int{2},
+				err:     errors.New("run error"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			var (
+				mu      sync.Mutex
+				batches []int
+			)
+			b := newBatcher(test.size, test.window, func(inputs ...[]string) ([][]float64, error) {
+				mu.Lock()
+				batches = append(batches, len(inputs))
+				mu.Unlock()
+				if test.runErr != nil {
+					return nil, test.runErr
+				}
+				vecs := make([][]float64, 0, len(inputs))
+				for _, in := range inputs {
+					f, err := strconv.ParseFloat(in[0], 64)
+					if err != nil {
+						return nil, err
+					}
+					vecs = append(vecs, []float64{f})
+				}
+				return vecs, nil
+			})
+			defer b.Close()
+
+			var wg sync.WaitGroup
+			for i := 0; i < test.args.n; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					vec, err := b.Do(strconv.Itoa(i))
+					if !errors.Is(err, test.want.err) {
+						tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+						return
+					}
+					if err == nil && !reflect.DeepEqual(vec, []float64{float64(i)}) {
+						tt.Errorf("got: %v, want: %v", vec, []float64{float64(i)})
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			mu.Lock()
+			defer mu.Unlock()
+			if !reflect.DeepEqual(batches, test.want.batches) {
+				tt.Errorf("got batches: %v, want: %v", batches, test.want.batches)
+			}
+		})
+	}
+}
+
+func Test_batcher_Close(t *testing.T) {
+	b := newBatcher(2, time.Second, func(inputs ...[]string) ([][]float64, error) {
+		return make([][]float64, len(inputs)), nil
+	})
+	b.Close()
+	_, err := b.Do("1")
+	if !errors.Is(err, errors.ErrBatcherClosedTF) {
+		t.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, errors.ErrBatcherClosedTF)
+	}
+}
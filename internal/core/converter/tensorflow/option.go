@@ -19,6 +19,7 @@ package tensorflow
 
 import (
 	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/vdaas/vald/internal/timeutil"
 )
 
 // Option is tensorflow configure.
@@ -29,6 +30,7 @@ var defaultOptions = []Option{
 	WithOperations(),                // set to default
 	WithSessionOptions(nil),         // set to default
 	WithNdim(0),                     // set to default
+	WithBatchWindow("5ms"),          // set to default
 }
 
 // WithSessionOptions returns Option that sets options.
@@ -168,3 +170,27 @@ func WithNdim(ndim uint8) Option {
 		t.ndim = ndim
 	}
 }
+
+// WithMaxBatchSize returns Option that sets the max number of requests which are grouped into a session run.
+// micro batching is disabled when size is less than 2 or the result nested dimension is not 2 or 3.
+func WithMaxBatchSize(size int) Option {
+	return func(t *tensorflow) {
+		if size > 0 {
+			t.batchSize = size
+		}
+	}
+}
+
+// WithBatchWindow returns Option that sets the max time to wait for a batch to be filled.
+func WithBatchWindow(dur string) Option {
+	return func(t *tensorflow) {
+		if dur == "" {
+			return
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return
+		}
+		t.batchWindow = d
+	}
+}
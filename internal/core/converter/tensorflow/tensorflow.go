@@ -18,6 +18,8 @@
 package tensorflow
 
 import (
+	"time"
+
 	tf "github.com/tensorflow/tensorflow/tensorflow/go"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/io"
@@ -35,6 +37,7 @@ type Closer = io.Closer
 // TF represents a tensorflow interface.
 type TF interface {
 	GetVector(inputs ...string) ([]float64, error)
+	GetVectors(inputs ...[]string) ([][]float64, error)
 	GetValue(inputs ...string) (interface{}, error)
 	GetValues(inputs ...string) (values []interface{}, err error)
 	Closer
@@ -57,6 +60,9 @@ type tensorflow struct {
 	session      session
 	warmupInputs []string
 	ndim         uint8
+	batchSize    int
+	batchWindow  time.Duration
+	batcher      *batcher
 }
 
 // OutputSpec is the specification of an feed/fetch.
@@ -91,6 +97,10 @@ func New(opts ...Option) (TF, error) {
 		return nil, err
 	}
 
+	if t.batchSize > 1 && t.batchable() {
+		t.batcher = newBatcher(t.batchSize, t.batchWindow, t.runBatch)
+	}
+
 	return t, nil
 }
 
@@ -106,6 +116,9 @@ func (t *tensorflow) warmup() error {
 }
 
 func (t *tensorflow) Close() error {
+	if t.batcher != nil {
+		t.batcher.Close()
+	}
 	return t.session.Close()
 }
 
@@ -125,15 +138,118 @@ func (t *tensorflow) run(inputs ...string) ([]*tf.Tensor, error) {
 		feeds[t.graph.Operation(t.feeds[i].operationName).Output(t.feeds[i].outputIndex)] = inputTensor
 	}
 
+	return t.session.Run(feeds, t.fetchOutputs(), t.operations)
+}
+
+func (t *tensorflow) fetchOutputs() []tf.Output {
 	fetches := make([]tf.Output, 0, len(t.fetches))
 	for _, fetch := range t.fetches {
 		fetches = append(fetches, t.graph.Operation(fetch.operationName).Output(fetch.outputIndex))
 	}
+	return fetches
+}
 
-	return t.session.Run(feeds, fetches, t.operations)
+// batchable returns true when the model output has the batch dimension.
+func (t *tensorflow) batchable() bool {
+	return t.ndim == twoDim || t.ndim == threeDim
 }
 
 func (t *tensorflow) GetVector(inputs ...string) ([]float64, error) {
+	if t.batcher != nil {
+		return t.batcher.Do(inputs...)
+	}
+	return t.getVector(inputs...)
+}
+
+// GetVectors returns the vectors of multiple inputs.
+// each element of inputs is the feed values of one object.
+func (t *tensorflow) GetVectors(inputs ...[]string) ([][]float64, error) {
+	if !t.batchable() {
+		vecs := make([][]float64, 0, len(inputs))
+		for _, in := range inputs {
+			vec, err := t.getVector(in...)
+			if err != nil {
+				return nil, err
+			}
+			vecs = append(vecs, vec)
+		}
+		return vecs, nil
+	}
+	if t.batchSize <= 1 || len(inputs) <= t.batchSize {
+		return t.runBatch(inputs...)
+	}
+	vecs := make([][]float64, 0, len(inputs))
+	for i := 0; i < len(inputs); i += t.batchSize {
+		end := i + t.batchSize
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+		res, err := t.runBatch(inputs[i:end]...)
+		if err != nil {
+			return nil, err
+		}
+		vecs = append(vecs, res...)
+	}
+	return vecs, nil
+}
+
+// runBatch runs the session once with the inputs stacked along the first dimension of each feed.
+func (t *tensorflow) runBatch(inputs ...[]string) ([][]float64, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+	feeds := make(map[tf.Output]*tf.Tensor, len(t.feeds))
+	for i, feed := range t.feeds {
+		vals := make([]string, 0, len(inputs))
+		for _, in := range inputs {
+			if len(in) != len(t.feeds) {
+				return nil, errors.ErrInputLength(len(in), len(t.feeds))
+			}
+			vals = append(vals, in[i])
+		}
+		inputTensor, err := tf.NewTensor(vals)
+		if err != nil {
+			return nil, err
+		}
+		feeds[t.graph.Operation(feed.operationName).Output(feed.outputIndex)] = inputTensor
+	}
+
+	tensors, err := t.session.Run(feeds, t.fetchOutputs(), t.operations)
+	if err != nil {
+		return nil, err
+	}
+	if len(tensors) == 0 || tensors[0] == nil || tensors[0].Value() == nil {
+		return nil, errors.ErrNilTensorTF(tensors)
+	}
+
+	var vecs [][]float64
+	switch t.ndim {
+	case twoDim:
+		value, ok := tensors[0].Value().([][]float64)
+		if !ok {
+			return nil, errors.ErrFailedToCastTF(tensors[0].Value())
+		}
+		vecs = value
+	case threeDim:
+		value, ok := tensors[0].Value().([][][]float64)
+		if !ok {
+			return nil, errors.ErrFailedToCastTF(tensors[0].Value())
+		}
+		vecs = make([][]float64, 0, len(value))
+		for _, v := range value {
+			if len(v) == 0 {
+				return nil, errors.ErrNilTensorValueTF(value)
+			}
+			vecs = append(vecs, v[0])
+		}
+	}
+	if len(vecs) != len(inputs) {
+		return nil, errors.ErrBatchResultLengthTF(len(vecs), len(inputs))
+	}
+	return vecs, nil
+}
+
+func (t *tensorflow) getVector(inputs ...string) ([]float64, error) {
 	tensors, err := t.run(inputs...)
 	if err != nil {
 		return nil, err
@@ -21,4 +21,5 @@ import "context"
 type Getter interface {
 	GetVector(ctx context.Context, uuid string) (Vector, error)
 	GetIPs(ctx context.Context, uuid string) ([]string, error)
+	GetDocuments(ctx context.Context, uuids ...string) ([]Document, error)
 }
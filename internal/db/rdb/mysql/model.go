@@ -34,6 +34,13 @@ type data struct {
 	Vector []byte `db:"vector"`
 }
 
+// Document is an interface to handle the document attached to the vector kept in MySQL.
+type Document interface {
+	GetUUID() string
+	GetDocument() []byte
+	GetAttributes() []byte
+}
+
 type podIP struct {
 	ID int64  `db:"id"`
 	IP string `db:"ip"`
@@ -55,3 +62,18 @@ func (v *vector) GetIPs() []string {
 
 	return ips
 }
+
+type document struct {
+	UUID       string `db:"uuid"`
+	Document   []byte `db:"document"`
+	Attributes []byte `db:"attributes"`
+}
+
+// GetUUID returns UUID of Document.
+func (d *document) GetUUID() string { return d.UUID }
+
+// GetDocument returns the document body of Document.
+func (d *document) GetDocument() []byte { return d.Document }
+
+// GetAttributes returns the JSON encoded attributes of Document.
+func (d *document) GetAttributes() []byte { return d.Attributes }
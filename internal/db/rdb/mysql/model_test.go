@@ -238,3 +238,129 @@ func Test_vector_GetIPs(t *testing.T) {
 		})
 	}
 }
+
+func Test_document_GetUUID(t *testing.T) {
+	type fields struct {
+		UUID string
+	}
+	type want struct {
+		want string
+	}
+	type test struct {
+		name   string
+		fields fields
+		want   want
+	}
+	tests := []test{
+		{
+			name: "returns UUID when UUID of document is not empty",
+			fields: fields{
+				UUID: "vdaas-01",
+			},
+			want: want{
+				want: "vdaas-01",
+			},
+		},
+		{
+			name: "returns UUID when UUID of document is empty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			d := &document{
+				UUID: test.fields.UUID,
+			}
+
+			got := d.GetUUID()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+func Test_document_GetDocument(t *testing.T) {
+	type fields struct {
+		Document []byte
+	}
+	type want struct {
+		want []byte
+	}
+	type test struct {
+		name   string
+		fields fields
+		want   want
+	}
+	tests := []test{
+		{
+			name: "returns Document when Document of document is not empty",
+			fields: fields{
+				Document: []byte("doc"),
+			},
+			want: want{
+				want: []byte("doc"),
+			},
+		},
+		{
+			name: "returns Document when Document of document is empty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			d := &document{
+				Document: test.fields.Document,
+			}
+
+			got := d.GetDocument()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+func Test_document_GetAttributes(t *testing.T) {
+	type fields struct {
+		Attributes []byte
+	}
+	type want struct {
+		want []byte
+	}
+	type test struct {
+		name   string
+		fields fields
+		want   want
+	}
+	tests := []test{
+		{
+			name: "returns Attributes when Attributes of document is not empty",
+			fields: fields{
+				Attributes: []byte(`{"title":"doc"}`),
+			},
+			want: want{
+				want: []byte(`{"title":"doc"}`),
+			},
+		},
+		{
+			name: "returns Attributes when Attributes of document is empty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			d := &document{
+				Attributes: test.fields.Attributes,
+			}
+
+			got := d.GetAttributes()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
@@ -39,6 +39,9 @@ const (
 	vectorColumnName = "vector"
 	ipColumnName     = "ip"
 	asterisk         = "*"
+
+	documentColumnName   = "document"
+	attributesColumnName = "attributes"
 )
 
 // MySQL represents the interface to handle MySQL operation.
@@ -72,6 +75,10 @@ type mySQLClient struct {
 	connected            atomic.Value
 	eventReceiver        EventReceiver
 	dbr                  dbr.DBR
+	documentTable        string
+	documentUUIDColumn   string
+	documentColumn       string
+	attributesColumn     string
 }
 
 // New creates the new mySQLClient with option.
@@ -265,6 +272,44 @@ func (m *mySQLClient) GetIPs(ctx context.Context, uuid string) ([]string, error)
 	return ips, nil
 }
 
+// GetDocuments gets the documents attached to the uuids from the document table.
+// The uuids whose document is not found are not contained in the result.
+func (m *mySQLClient) GetDocuments(ctx context.Context, uuids ...string) ([]Document, error) {
+	if !m.connected.Load().(bool) {
+		return nil, errors.ErrMySQLConnectionClosed
+	}
+
+	if m.session == nil {
+		err := errors.ErrMySQLSessionNil
+		m.errorLog(err)
+		return nil, err
+	}
+
+	if len(uuids) == 0 {
+		return nil, nil
+	}
+
+	columns := []string{
+		m.documentUUIDColumn + " AS " + uuidColumnName,
+		m.documentColumn + " AS " + documentColumnName,
+	}
+	if len(m.attributesColumn) != 0 {
+		columns = append(columns, m.attributesColumn+" AS "+attributesColumnName)
+	}
+
+	var docs []document
+	_, err := m.session.Select(columns...).From(m.documentTable).Where(m.dbr.Eq(m.documentUUIDColumn, uuids)).LoadContext(ctx, &docs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Document, 0, len(docs))
+	for i := range docs {
+		res = append(res, &docs[i])
+	}
+	return res, nil
+}
+
 func validateVector(vec Vector) error {
 	if len(vec.GetVector()) == 0 {
 		return errors.ErrRequiredMemberNotFilled("vector")
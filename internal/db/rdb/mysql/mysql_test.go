@@ -1331,6 +1331,218 @@ func Test_mySQLClient_GetIPs(t *testing.T) {
 	}
 }
 
+func Test_mySQLClient_GetDocuments(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		uuids []string
+	}
+	type fields struct {
+		session          dbr.Session
+		connected        atomic.Value
+		dbr              dbr.DBR
+		attributesColumn string
+	}
+	type want struct {
+		want []Document
+		err  error
+	}
+	type test struct {
+		name       string
+		args       args
+		fields     fields
+		want       want
+		checkFunc  func(want, []Document, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, got []Document, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	connected := func(b bool) (v atomic.Value) {
+		v.Store(b)
+		return
+	}
+	eq := &dbr.MockDBR{
+		EqFunc: func(col string, val interface{}) dbr.Builder {
+			return dbr.New().Eq(col, val)
+		},
+	}
+	tests := []test{
+		{
+			name: "return (nil, error) when connection closed",
+			args: args{
+				ctx:   context.Background(),
+				uuids: []string{"vdaas-01"},
+			},
+			fields: fields{
+				connected: connected(false),
+			},
+			want: want{
+				err: errors.ErrMySQLConnectionClosed,
+			},
+		},
+		{
+			name: "return (nil, error) when MySQL session is nil",
+			args: args{
+				ctx:   context.Background(),
+				uuids: []string{"vdaas-01"},
+			},
+			fields: fields{
+				connected: connected(true),
+			},
+			want: want{
+				err: errors.ErrMySQLSessionNil,
+			},
+		},
+		{
+			name: "return (nil, nil) when uuids is empty",
+			args: args{
+				ctx: context.Background(),
+			},
+			fields: fields{
+				session:   new(dbr.MockSession),
+				connected: connected(true),
+			},
+		},
+		func() test {
+			err := errors.New("LoadContext error")
+			return test{
+				name: "return (nil, error) when LoadContext returns error",
+				args: args{
+					ctx:   context.Background(),
+					uuids: []string{"vdaas-01"},
+				},
+				fields: fields{
+					session: &dbr.MockSession{
+						SelectFunc: func(column ...string) dbr.SelectStmt {
+							s := new(dbr.MockSelect)
+							s.FromFunc = func(table interface{}) dbr.SelectStmt {
+								return s
+							}
+							s.WhereFunc = func(query interface{}, value ...interface{}) dbr.SelectStmt {
+								return s
+							}
+							s.LoadContextFunc = func(ctx context.Context, value interface{}) (int, error) {
+								return 0, err
+							}
+							return s
+						},
+					},
+					connected: connected(true),
+					dbr:       eq,
+				},
+				want: want{
+					err: err,
+				},
+			}
+		}(),
+		func() test {
+			var columns []string
+			return test{
+				name: "return documents when the attributes column is set",
+				args: args{
+					ctx:   context.Background(),
+					uuids: []string{"vdaas-01", "vdaas-02", "vdaas-03"},
+				},
+				fields: fields{
+					session: &dbr.MockSession{
+						SelectFunc: func(column ...string) dbr.SelectStmt {
+							columns = column
+							s := new(dbr.MockSelect)
+							s.FromFunc = func(table interface{}) dbr.SelectStmt {
+								return s
+							}
+							s.WhereFunc = func(query interface{}, value ...interface{}) dbr.SelectStmt {
+								return s
+							}
+							s.LoadContextFunc = func(ctx context.Context, value interface{}) (int, error) {
+								docs, ok := value.(*[]document)
+								if !ok {
+									return 0, errors.Errorf("unexpected type %T", value)
+								}
+								*docs = []document{
+									{
+										UUID:       "vdaas-01",
+										Document:   []byte("doc-01"),
+										Attributes: []byte(`{"title":"01"}`),
+									},
+									{
+										UUID:     "vdaas-03",
+										Document: []byte("doc-03"),
+									},
+								}
+								return len(*docs), nil
+							}
+							return s
+						},
+					},
+					connected:        connected(true),
+					dbr:              eq,
+					attributesColumn: "attrs",
+				},
+				want: want{
+					want: []Document{
+						&document{
+							UUID:       "vdaas-01",
+							Document:   []byte("doc-01"),
+							Attributes: []byte(`{"title":"01"}`),
+						},
+						&document{
+							UUID:     "vdaas-03",
+							Document: []byte("doc-03"),
+						},
+					},
+				},
+				checkFunc: func(w want, got []Document, err error) error {
+					if err := defaultCheckFunc(w, got, err); err != nil {
+						return err
+					}
+					want := []string{"uuid AS uuid", "document AS document", "attrs AS attributes"}
+					if !reflect.DeepEqual(columns, want) {
+						return errors.Errorf("got columns: %v, want: %v", columns, want)
+					}
+					return nil
+				},
+			}
+		}(),
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			m := &mySQLClient{
+				session:            test.fields.session,
+				connected:          test.fields.connected,
+				dbr:                test.fields.dbr,
+				documentTable:      "document",
+				documentUUIDColumn: "uuid",
+				documentColumn:     "document",
+				attributesColumn:   test.fields.attributesColumn,
+			}
+
+			got, err := m.GetDocuments(test.args.ctx, test.args.uuids...)
+			if err := test.checkFunc(test.want, got, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
 func Test_validateVector(t *testing.T) {
 	type args struct {
 		data Vector
@@ -19,8 +19,10 @@ package mysql
 import (
 	"context"
 	"crypto/tls"
+	"regexp"
 	"time"
 
+	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/net"
 	"github.com/vdaas/vald/internal/timeutil"
 )
@@ -33,6 +35,9 @@ var defaultOptions = []Option{
 	WithTimezone("Local"),
 	WithInitialPingDuration("30ms"),
 	WithInitialPingTimeLimit("5m"),
+	WithDocumentTable("document"),
+	WithDocumentUUIDColumn("uuid"),
+	WithDocumentColumn("document"),
 	// WithConnectionLifeTimeLimit("2m"),
 	// WithMaxOpenConns(40),
 	// WithMaxIdleConns(50),
@@ -245,3 +250,63 @@ func WithEventReceiver(er EventReceiver) Option {
 		return nil
 	}
 }
+
+// identifierPattern is the pattern of the table and column names which can be used without quoting.
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// WithDocumentTable returns the option to set the table name of the documents.
+func WithDocumentTable(table string) Option {
+	return func(m *mySQLClient) error {
+		if table == "" {
+			return nil
+		}
+		if !identifierPattern.MatchString(table) {
+			return errors.NewErrInvalidOption("documentTable", table)
+		}
+		m.documentTable = table
+		return nil
+	}
+}
+
+// WithDocumentUUIDColumn returns the option to set the column name of the uuid in the document table.
+func WithDocumentUUIDColumn(col string) Option {
+	return func(m *mySQLClient) error {
+		if col == "" {
+			return nil
+		}
+		if !identifierPattern.MatchString(col) {
+			return errors.NewErrInvalidOption("documentUUIDColumn", col)
+		}
+		m.documentUUIDColumn = col
+		return nil
+	}
+}
+
+// WithDocumentColumn returns the option to set the column name of the document body in the document table.
+func WithDocumentColumn(col string) Option {
+	return func(m *mySQLClient) error {
+		if col == "" {
+			return nil
+		}
+		if !identifierPattern.MatchString(col) {
+			return errors.NewErrInvalidOption("documentColumn", col)
+		}
+		m.documentColumn = col
+		return nil
+	}
+}
+
+// WithDocumentAttributesColumn returns the option to set the column name of the JSON encoded attributes in the document table.
+// The attributes are not loaded when the column is not set.
+func WithDocumentAttributesColumn(col string) Option {
+	return func(m *mySQLClient) error {
+		if col == "" {
+			return nil
+		}
+		if !identifierPattern.MatchString(col) {
+			return errors.NewErrInvalidOption("documentAttributesColumn", col)
+		}
+		m.attributesColumn = col
+		return nil
+	}
+}
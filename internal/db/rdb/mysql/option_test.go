@@ -1532,3 +1532,327 @@ func TestWithSocketPath(t *testing.T) {
 		})
 	}
 }
+
+func TestWithDocumentTable(t *testing.T) {
+	type T = mySQLClient
+	type args struct {
+		table string
+	}
+	type want struct {
+		obj *T
+		err error
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, *T, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+
+	defaultCheckFunc := func(w want, obj *T, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(obj, w.obj) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", obj, w.obj)
+		}
+		return nil
+	}
+
+	tests := []test{
+		{
+			name: "set success when table is doc_store",
+			args: args{
+				table: "doc_store",
+			},
+			want: want{
+				obj: &T{
+					documentTable: "doc_store",
+				},
+			},
+		},
+		{
+			name: "set success when table is empty",
+			want: want{
+				obj: new(T),
+			},
+		},
+		{
+			name: "return error when table is not an identifier",
+			args: args{
+				table: "doc_store; DROP TABLE doc_store",
+			},
+			want: want{
+				obj: new(T),
+				err: errors.NewErrInvalidOption("documentTable", "doc_store; DROP TABLE doc_store"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := WithDocumentTable(test.args.table)
+			obj := new(T)
+			if err := test.checkFunc(test.want, obj, got(obj)); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func TestWithDocumentUUIDColumn(t *testing.T) {
+	type T = mySQLClient
+	type args struct {
+		col string
+	}
+	type want struct {
+		obj *T
+		err error
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, *T, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+
+	defaultCheckFunc := func(w want, obj *T, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(obj, w.obj) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", obj, w.obj)
+		}
+		return nil
+	}
+
+	tests := []test{
+		{
+			name: "set success when col is vector_id",
+			args: args{
+				col: "vector_id",
+			},
+			want: want{
+				obj: &T{
+					documentUUIDColumn: "vector_id",
+				},
+			},
+		},
+		{
+			name: "set success when col is empty",
+			want: want{
+				obj: new(T),
+			},
+		},
+		{
+			name: "return error when col is not an identifier",
+			args: args{
+				col: "vector_id; DROP TABLE vector_id",
+			},
+			want: want{
+				obj: new(T),
+				err: errors.NewErrInvalidOption("documentUUIDColumn", "vector_id; DROP TABLE vector_id"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := WithDocumentUUIDColumn(test.args.col)
+			obj := new(T)
+			if err := test.checkFunc(test.want, obj, got(obj)); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func TestWithDocumentColumn(t *testing.T) {
+	type T = mySQLClient
+	type args struct {
+		col string
+	}
+	type want struct {
+		obj *T
+		err error
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, *T, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+
+	defaultCheckFunc := func(w want, obj *T, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(obj, w.obj) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", obj, w.obj)
+		}
+		return nil
+	}
+
+	tests := []test{
+		{
+			name: "set success when col is body",
+			args: args{
+				col: "body",
+			},
+			want: want{
+				obj: &T{
+					documentColumn: "body",
+				},
+			},
+		},
+		{
+			name: "set success when col is empty",
+			want: want{
+				obj: new(T),
+			},
+		},
+		{
+			name: "return error when col is not an identifier",
+			args: args{
+				col: "body; DROP TABLE body",
+			},
+			want: want{
+				obj: new(T),
+				err: errors.NewErrInvalidOption("documentColumn", "body; DROP TABLE body"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := WithDocumentColumn(test.args.col)
+			obj := new(T)
+			if err := test.checkFunc(test.want, obj, got(obj)); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func TestWithDocumentAttributesColumn(t *testing.T) {
+	type T = mySQLClient
+	type args struct {
+		col string
+	}
+	type want struct {
+		obj *T
+		err error
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, *T, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+
+	defaultCheckFunc := func(w want, obj *T, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(obj, w.obj) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", obj, w.obj)
+		}
+		return nil
+	}
+
+	tests := []test{
+		{
+			name: "set success when col is attrs",
+			args: args{
+				col: "attrs",
+			},
+			want: want{
+				obj: &T{
+					attributesColumn: "attrs",
+				},
+			},
+		},
+		{
+			name: "set success when col is empty",
+			want: want{
+				obj: new(T),
+			},
+		},
+		{
+			name: "return error when col is not an identifier",
+			args: args{
+				col: "attrs; DROP TABLE attrs",
+			},
+			want: want{
+				obj: new(T),
+				err: errors.NewErrInvalidOption("documentAttributesColumn", "attrs; DROP TABLE attrs"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := WithDocumentAttributesColumn(test.args.col)
+			obj := new(T)
+			if err := test.checkFunc(test.want, obj, got(obj)); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
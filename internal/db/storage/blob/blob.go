@@ -19,6 +19,7 @@ package blob
 import (
 	"context"
 	"io"
+	"time"
 )
 
 type Bucket interface {
@@ -26,4 +27,20 @@ type Bucket interface {
 	Close() error
 	Reader(ctx context.Context, key string) (io.ReadCloser, error)
 	Writer(ctx context.Context, key string) (io.WriteCloser, error)
+	// Delete removes the object of the key. It does not return an error when the key does not exist.
+	Delete(ctx context.Context, key string) error
+	// List calls f for each key which has the prefix in lexical order until f returns false.
+	List(ctx context.Context, prefix string, f func(key string) bool) error
+	// Stat returns the attributes of the object of the key. It returns ErrBlobNoSuchKey when the key does not exist.
+	Stat(ctx context.Context, key string) (*Attributes, error)
+}
+
+// Attributes represents the attributes of the object.
+type Attributes struct {
+	// Key represents the key of the object
+	Key string
+	// Size represents the size of the object in bytes
+	Size int64
+	// ModTime represents the time when the object is modified last
+	ModTime time.Time
 }
@@ -23,6 +23,7 @@ import (
 
 	"gocloud.dev/blob"
 	"gocloud.dev/blob/gcsblob"
+	"gocloud.dev/gcerrors"
 
 	iblob "github.com/vdaas/vald/internal/db/storage/blob"
 	"github.com/vdaas/vald/internal/errors"
@@ -78,3 +79,56 @@ func (c *client) Writer(ctx context.Context, key string) (io.WriteCloser, error)
 	}
 	return c.bucket.NewWriter(ctx, key, c.writerOpts)
 }
+
+func (c *client) Delete(ctx context.Context, key string) error {
+	if c.bucket == nil {
+		return errors.ErrBucketNotOpened
+	}
+	err := c.bucket.Delete(ctx, key)
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		return err
+	}
+	return nil
+}
+
+func (c *client) List(ctx context.Context, prefix string, f func(key string) bool) error {
+	if c.bucket == nil {
+		return errors.ErrBucketNotOpened
+	}
+	it := c.bucket.List(&blob.ListOptions{
+		Prefix: prefix,
+	})
+	for {
+		obj, err := it.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if obj.IsDir {
+			continue
+		}
+		if !f(obj.Key) {
+			return nil
+		}
+	}
+}
+
+func (c *client) Stat(ctx context.Context, key string) (*iblob.Attributes, error) {
+	if c.bucket == nil {
+		return nil, errors.ErrBucketNotOpened
+	}
+	attrs, err := c.bucket.Attributes(ctx, key)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, errors.NewErrBlobNoSuchKey(err, key)
+		}
+		return nil, err
+	}
+	return &iblob.Attributes{
+		Key:     key,
+		Size:    attrs.Size,
+		ModTime: attrs.ModTime,
+	}, nil
+}
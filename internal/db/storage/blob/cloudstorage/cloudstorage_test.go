@@ -1,18 +1,16 @@
-//
 // Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
 //
-//    https://www.apache.org/licenses/LICENSE-2.0
+//	https://www.apache.org/licenses/LICENSE-2.0
 //
 // Unless required by applicable law or agreed to in writing, software
 // distributed under the License is distributed on an "AS IS" BASIS,
 // WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 // See the License for the specific language governing permissions and
 // limitations under the License.
-//
 package cloudstorage
 
 import (
@@ -27,6 +25,7 @@ import (
 	"github.com/vdaas/vald/internal/test/goleak"
 	"gocloud.dev/blob"
 	"gocloud.dev/blob/gcsblob"
+	"gocloud.dev/blob/memblob"
 )
 
 func TestNew(t *testing.T) {
@@ -512,3 +511,177 @@ func Test_client_Writer(t *testing.T) {
 		})
 	}
 }
+
+func newMemBucket(t *testing.T, keys ...string) *blob.Bucket {
+	t.Helper()
+	b := memblob.OpenBucket(nil)
+	for _, key := range keys {
+		if err := b.WriteAll(context.Background(), key, []byte(key), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b
+}
+
+func Test_client_Delete(t *testing.T) {
+	type test struct {
+		name     string
+		bucket   func(*testing.T) *blob.Bucket
+		key      string
+		wantKeys []string
+		err      error
+	}
+	tests := []test{
+		{
+			name: "delete the object of the key",
+			bucket: func(t *testing.T) *blob.Bucket {
+				return newMemBucket(t, "a", "b")
+			},
+			key:      "a",
+			wantKeys: []string{"b"},
+		},
+		{
+			name: "return nil when the key does not exist",
+			bucket: func(t *testing.T) *blob.Bucket {
+				return newMemBucket(t, "b")
+			},
+			key:      "a",
+			wantKeys: []string{"b"},
+		},
+		{
+			name: "return error when the bucket is not opened",
+			bucket: func(*testing.T) *blob.Bucket {
+				return nil
+			},
+			key: "a",
+			err: errors.ErrBucketNotOpened,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			c := &client{
+				bucket: test.bucket(tt),
+			}
+			err := c.Delete(context.Background(), test.key)
+			if !errors.Is(err, test.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.err)
+			}
+			if c.bucket == nil {
+				return
+			}
+			var got []string
+			if err := c.List(context.Background(), "", func(key string) bool {
+				got = append(got, key)
+				return true
+			}); err != nil {
+				tt.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.wantKeys) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.wantKeys)
+			}
+		})
+	}
+}
+
+func Test_client_List(t *testing.T) {
+	type test struct {
+		name   string
+		bucket *blob.Bucket
+		prefix string
+		limit  int
+		want   []string
+		err    error
+	}
+	tests := []test{
+		{
+			name:   "list the keys with the prefix in lexical order",
+			bucket: newMemBucket(t, "obj/c", "obj/a", "other/b", "obj/b"),
+			prefix: "obj/",
+			want:   []string{"obj/a", "obj/b", "obj/c"},
+		},
+		{
+			name:   "stop listing when the function returns false",
+			bucket: newMemBucket(t, "obj/c", "obj/a", "obj/b"),
+			prefix: "obj/",
+			limit:  2,
+			want:   []string{"obj/a", "obj/b"},
+		},
+		{
+			name: "return error when the bucket is not opened",
+			err:  errors.ErrBucketNotOpened,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			c := &client{
+				bucket: test.bucket,
+			}
+			var got []string
+			err := c.List(context.Background(), test.prefix, func(key string) bool {
+				got = append(got, key)
+				return test.limit == 0 || len(got) < test.limit
+			})
+			if !errors.Is(err, test.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_client_Stat(t *testing.T) {
+	type test struct {
+		name     string
+		bucket   *blob.Bucket
+		key      string
+		wantSize int64
+		err      error
+	}
+	tests := []test{
+		{
+			name:     "return the attributes of the object",
+			bucket:   newMemBucket(t, "obj/a"),
+			key:      "obj/a",
+			wantSize: 5,
+		},
+		{
+			name:   "return ErrBlobNoSuchKey when the key does not exist",
+			bucket: newMemBucket(t),
+			key:    "obj/a",
+			err:    errors.NewErrBlobNoSuchKey(errors.New("blob (key \"obj/a\") (code=NotFound): blob not found"), "obj/a"),
+		},
+		{
+			name: "return error when the bucket is not opened",
+			key:  "obj/a",
+			err:  errors.ErrBucketNotOpened,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			c := &client{
+				bucket: test.bucket,
+			}
+			got, err := c.Stat(context.Background(), test.key)
+			if test.err != nil {
+				if err == nil || (!errors.Is(err, test.err) && !errors.IsErrBlobNoSuchKey(err)) {
+					tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if got.Key != test.key || got.Size != test.wantSize || got.ModTime.IsZero() {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant size: %d", got, test.wantSize)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package local provides the blob storage on the local filesystem.
+package local
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"reflect"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
+	"gocloud.dev/gcerrors"
+
+	iblob "github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+type client struct {
+	dir    string
+	bucket string
+
+	b *blob.Bucket
+}
+
+// New returns blob.Bucket implementation which stores the objects as the files under the directory.
+func New(opts ...Option) (iblob.Bucket, error) {
+	c := new(client)
+	for _, opt := range append(defaultOpts, opts...) {
+		if err := opt(c); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+
+	if len(c.dir) == 0 {
+		return nil, errors.NewErrInvalidOption("dir", c.dir)
+	}
+
+	return c, nil
+}
+
+// Open opens the bucket on the directory. The directory is created when it does not exist.
+func (c *client) Open(ctx context.Context) (err error) {
+	c.b, err = fileblob.OpenBucket(filepath.Join(c.dir, c.bucket), &fileblob.Options{
+		CreateDir: true,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) Close() error {
+	if c.b == nil {
+		return errors.ErrBucketNotOpened
+	}
+	return c.b.Close()
+}
+
+func (c *client) Reader(ctx context.Context, key string) (io.ReadCloser, error) {
+	if c.b == nil {
+		return nil, errors.ErrBucketNotOpened
+	}
+	r, err := c.b.NewReader(ctx, key, nil)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, errors.NewErrBlobNoSuchKey(err, key)
+		}
+		return nil, err
+	}
+	return r, nil
+}
+
+// Writer returns the writer of the object. The object is visible after the writer is closed successfully.
+func (c *client) Writer(ctx context.Context, key string) (io.WriteCloser, error) {
+	if c.b == nil {
+		return nil, errors.ErrBucketNotOpened
+	}
+	return c.b.NewWriter(ctx, key, nil)
+}
+
+func (c *client) Delete(ctx context.Context, key string) error {
+	if c.b == nil {
+		return errors.ErrBucketNotOpened
+	}
+	err := c.b.Delete(ctx, key)
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		return err
+	}
+	return nil
+}
+
+func (c *client) List(ctx context.Context, prefix string, f func(key string) bool) error {
+	if c.b == nil {
+		return errors.ErrBucketNotOpened
+	}
+	it := c.b.List(&blob.ListOptions{
+		Prefix: prefix,
+	})
+	for {
+		obj, err := it.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if obj.IsDir {
+			continue
+		}
+		if !f(obj.Key) {
+			return nil
+		}
+	}
+}
+
+func (c *client) Stat(ctx context.Context, key string) (*iblob.Attributes, error) {
+	if c.b == nil {
+		return nil, errors.ErrBucketNotOpened
+	}
+	attrs, err := c.b.Attributes(ctx, key)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, errors.NewErrBlobNoSuchKey(err, key)
+		}
+		return nil, err
+	}
+	return &iblob.Attributes{
+		Key:     key,
+		Size:    attrs.Size,
+		ModTime: attrs.ModTime,
+	}, nil
+}
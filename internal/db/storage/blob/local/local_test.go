@@ -0,0 +1,219 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package local provides the blob storage on the local filesystem.
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func newClient(t *testing.T) *client {
+	t.Helper()
+	b, err := New(
+		WithDir(t.TempDir()),
+		WithBucket("vald"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		b.Close()
+	})
+	return b.(*client)
+}
+
+func write(t *testing.T, c *client, key, data string) {
+	t.Helper()
+	w, err := c.Writer(context.Background(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	type test struct {
+		name string
+		opts []Option
+		want *client
+		err  error
+	}
+	tests := []test{
+		{
+			name: "returns the client with the directory",
+			opts: []Option{
+				WithDir("/var/backup"),
+				WithBucket("vald"),
+			},
+			want: &client{
+				dir:    "/var/backup",
+				bucket: "vald",
+			},
+		},
+		{
+			name: "returns the client with the directory of the file URL",
+			opts: []Option{
+				WithDir("file:///var/backup"),
+			},
+			want: &client{
+				dir: "/var/backup",
+			},
+		},
+		{
+			name: "returns error when the directory is empty",
+			err:  errors.NewErrInvalidOption("dir", ""),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			got, err := New(test.opts...)
+			if !errors.Is(err, test.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.err)
+			}
+			if test.want != nil && !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_client_ReaderWriter(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	write(t, c, "vald-agent-ngt-0/backup.tar", "vald")
+
+	if _, err := os.Stat(filepath.Join(c.dir, "vald", "vald-agent-ngt-0", "backup.tar")); err != nil {
+		t.Errorf("object is not stored under the bucket directory: %v", err)
+	}
+
+	r, err := c.Reader(ctx, "vald-agent-ngt-0/backup.tar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	got, err := ioutil.ReadAll(r)
+	if err != nil || string(got) != "vald" {
+		t.Errorf("got: %s, err: %v, want: vald", got, err)
+	}
+
+	if _, err = c.Reader(ctx, "not-found"); !errors.IsErrBlobNoSuchKey(err) {
+		t.Errorf("got_error: %v, want: ErrBlobNoSuchKey", err)
+	}
+}
+
+func Test_client_List(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	for _, key := range []string{"obj/c", "obj/a", "other/b", "obj/b"} {
+		write(t, c, key, key)
+	}
+
+	type test struct {
+		name  string
+		limit int
+		want  []string
+	}
+	tests := []test{
+		{
+			name: "list the keys with the prefix in lexical order",
+			want: []string{"obj/a", "obj/b", "obj/c"},
+		},
+		{
+			name:  "stop listing when the function returns false",
+			limit: 2,
+			want:  []string{"obj/a", "obj/b"},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			var got []string
+			err := c.List(ctx, "obj/", func(key string) bool {
+				got = append(got, key)
+				return test.limit == 0 || len(got) < test.limit
+			})
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_client_DeleteStat(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	write(t, c, "obj/a", "vald")
+
+	attrs, err := c.Stat(ctx, "obj/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attrs.Key != "obj/a" || attrs.Size != 4 || attrs.ModTime.IsZero() {
+		t.Errorf("got: %#v, want the attributes of obj/a", attrs)
+	}
+
+	if err = c.Delete(ctx, "obj/a"); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Delete(ctx, "obj/a"); err != nil {
+		t.Errorf("got_error: %v, want: nil for the deleted key", err)
+	}
+	if _, err = c.Stat(ctx, "obj/a"); !errors.IsErrBlobNoSuchKey(err) {
+		t.Errorf("got_error: %v, want: ErrBlobNoSuchKey", err)
+	}
+}
+
+func Test_client_NotOpened(t *testing.T) {
+	ctx := context.Background()
+	c := new(client)
+	if _, err := c.Reader(ctx, "a"); !errors.Is(err, errors.ErrBucketNotOpened) {
+		t.Errorf("Reader got_error: %v", err)
+	}
+	if _, err := c.Writer(ctx, "a"); !errors.Is(err, errors.ErrBucketNotOpened) {
+		t.Errorf("Writer got_error: %v", err)
+	}
+	if err := c.Delete(ctx, "a"); !errors.Is(err, errors.ErrBucketNotOpened) {
+		t.Errorf("Delete got_error: %v", err)
+	}
+	if _, err := c.Stat(ctx, "a"); !errors.Is(err, errors.ErrBucketNotOpened) {
+		t.Errorf("Stat got_error: %v", err)
+	}
+	if err := c.Close(); !errors.Is(err, errors.ErrBucketNotOpened) {
+		t.Errorf("Close got_error: %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package local provides the blob storage on the local filesystem.
+package local
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Option configures client of the local filesystem.
+type Option func(*client) error
+
+var defaultOpts = []Option{}
+
+// WithDir returns Option that sets c.dir. The directory can be specified as the file:// URL.
+func WithDir(dir string) Option {
+	return func(c *client) error {
+		if len(dir) == 0 {
+			return nil
+		}
+		if strings.HasPrefix(dir, "file://") {
+			u, err := url.Parse(dir)
+			if err != nil {
+				return err
+			}
+			dir = u.Path
+		}
+		c.dir = dir
+		return nil
+	}
+}
+
+// WithBucket returns Option that sets c.bucket. The bucket is the sub directory of the directory.
+func WithBucket(bucket string) Option {
+	return func(c *client) error {
+		c.bucket = bucket
+		return nil
+	}
+}
@@ -64,6 +64,7 @@ func WithBucket(bucket string) Option {
 }
 
 // WithReader returns the option to set the reader.
+// The reader is shared by every Reader call instead of creating a new one for each call.
 func WithReader(r reader.Reader) Option {
 	return func(c *client) error {
 		if r != nil {
@@ -73,7 +74,8 @@ func WithReader(r reader.Reader) Option {
 	}
 }
 
-// WithWriter returns the option to set the reader.
+// WithWriter returns the option to set the writer.
+// The writer is shared by every Writer call instead of creating a new one for each call.
 func WithWriter(w writer.Writer) Option {
 	return func(c *client) error {
 		if w != nil {
@@ -117,6 +119,17 @@ func WithMaxChunkSize(size string) Option {
 	}
 }
 
+// WithDownloadConcurrency returns the option to set downloadConcurrency.
+// The chunks of the max chunk size are downloaded concurrently and read in order when the value is greater than 1.
+func WithDownloadConcurrency(n int) Option {
+	return func(c *client) error {
+		if n > 0 {
+			c.downloadConcurrency = n
+		}
+		return nil
+	}
+}
+
 // WithReaderBackoff returns the option to set readerBackoffEnabled.
 func WithReaderBackoff(enabled bool) Option {
 	return func(c *client) error {
@@ -30,6 +30,7 @@ var defaultOptions = []Option{
 	WithErrGroup(errgroup.Get()),
 	WithMaxChunkSize(512 * 1024 * 1024),
 	WithBackoff(false),
+	WithConcurrency(1),
 	func(r *reader) {
 		r.ctxio = io.New()
 	},
@@ -67,6 +68,16 @@ func WithMaxChunkSize(size int64) Option {
 	}
 }
 
+// WithConcurrency returns the option to set the concurrency.
+// The chunks are downloaded concurrently by the ranged requests when the value is greater than 1.
+func WithConcurrency(n int) Option {
+	return func(r *reader) {
+		if n > 0 {
+			r.concurrency = n
+		}
+	}
+}
+
 // WithBackoff returns the option to set the backoffEnabled.
 func WithBackoff(enabled bool) Option {
 	return func(r *reader) {
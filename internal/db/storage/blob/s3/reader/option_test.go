@@ -309,6 +309,80 @@ func TestWithMaxChunkSize(t *testing.T) {
 	}
 }
 
+func TestWithConcurrency(t *testing.T) {
+	type T = reader
+	type args struct {
+		n int
+	}
+	type want struct {
+		obj *T
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, *T) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, got *T) error {
+		if !reflect.DeepEqual(got, w.obj) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.obj)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "set success when n is positive number",
+			args: args{
+				n: 4,
+			},
+			want: want{
+				obj: &T{
+					concurrency: 4,
+				},
+			},
+		},
+		{
+			name: "not set when n is zero",
+			want: want{
+				obj: new(T),
+			},
+		},
+		{
+			name: "not set when n is negative number",
+			args: args{
+				n: -1,
+			},
+			want: want{
+				obj: new(T),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := WithConcurrency(test.args.n)
+			obj := new(T)
+			got(obj)
+			if err := test.checkFunc(test.want, obj); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
 func TestWithBackoff(t *testing.T) {
 	type T = reader
 	type args struct {
@@ -49,6 +49,7 @@ type reader struct {
 	backoffOpts    []backoff.Option
 	bo             backoff.Backoff
 	maxChunkSize   int64
+	concurrency    int
 }
 
 var (
@@ -78,9 +79,10 @@ func New(opts ...Option) (Reader, error) {
 }
 
 // Open creates io.Pipe. After reading the data from s3, make it available with Read method.
+// The chunks are downloaded concurrently and written in order when the concurrency is greater than 1.
 // Open method returns an error to align the interface, but it doesn't actually return an error.
 func (r *reader) Open(ctx context.Context, key string) (err error) {
-	var pw io.WriteCloser
+	var pw *io.PipeWriter
 
 	r.pr, pw = io.Pipe()
 
@@ -88,14 +90,28 @@ func (r *reader) Open(ctx context.Context, key string) (err error) {
 	r.wg.Add(1)
 	r.eg.Go(safety.RecoverFunc(func() (err error) {
 		defer r.wg.Done()
-		defer pw.Close()
-
-		var offset int64
 
 		if r.backoffEnabled {
 			r.bo = backoff.New(r.backoffOpts...)
 		}
 
+		if r.concurrency > 1 {
+			defer func() {
+				// the error is propagated to the reader not to treat the partial object as the whole.
+				pw.CloseWithError(err)
+			}()
+			err = r.readParts(ctx, key, pw)
+			if errors.As(err, &errBlobNoSuchBucket) ||
+				errors.As(err, &errBlobNoSuchKey) {
+				log.Warn(err)
+				return nil
+			}
+			return err
+		}
+		defer pw.Close()
+
+		var offset int64
+
 		for {
 			select {
 			case <-ctx.Done():
@@ -140,6 +156,101 @@ func (r *reader) Open(ctx context.Context, key string) (err error) {
 	return nil
 }
 
+// readParts downloads the chunks of the object by the ranged requests concurrently and writes them to w in order.
+// At most r.concurrency chunks are downloaded or buffered at the same time.
+func (r *reader) readParts(ctx context.Context, key string, w io.Writer) error {
+	size, err := r.objectSize(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type part struct {
+		body io.Reader
+		err  error
+	}
+
+	// parts receives the result channels in the order of the offset.
+	parts := make(chan chan part, r.concurrency-1)
+	r.eg.Go(safety.RecoverFunc(func() error {
+		defer close(parts)
+		for offset := int64(0); offset < size; offset += r.maxChunkSize {
+			length := r.maxChunkSize
+			if size-offset < length {
+				length = size - offset
+			}
+
+			pch := make(chan part, 1)
+			select {
+			case <-ctx.Done():
+				return nil
+			case parts <- pch:
+			}
+
+			off := offset
+			r.eg.Go(safety.RecoverFunc(func() error {
+				body, err := r.getObjectWithBackoff(ctx, key, off, length)
+				pch <- part{
+					body: body,
+					err:  err,
+				}
+				return nil
+			}))
+		}
+		return nil
+	}))
+
+	for pch := range parts {
+		var p part
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case p = <-pch:
+		}
+		if p.err != nil {
+			return p.err
+		}
+
+		body, err := r.ctxio.NewReaderWithContext(ctx, p.body)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(w, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// objectSize returns the size of the object.
+// s3 returns the NotFound error code instead of NoSuchKey for HeadObject request when the key does not exist.
+func (r *reader) objectSize(ctx context.Context, key string) (int64, error) {
+	resp, err := r.service.HeadObjectWithContext(
+		ctx,
+		&s3.HeadObjectInput{
+			Bucket: aws.String(r.bucket),
+			Key:    aws.String(key),
+		},
+	)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case s3.ErrCodeNoSuchBucket:
+				return 0, errors.NewErrBlobNoSuchBucket(err, r.bucket)
+			case s3.ErrCodeNoSuchKey, s3.ErrCodeNotFound:
+				return 0, errors.NewErrBlobNoSuchKey(err, key)
+			}
+		}
+		return 0, err
+	}
+	return aws.Int64Value(resp.ContentLength), nil
+}
+
 func (r *reader) getObjectWithBackoff(ctx context.Context, key string, offset, length int64) (res io.Reader, err error) {
 	if !r.backoffEnabled || r.bo == nil {
 		return r.getObject(ctx, key, offset, length)
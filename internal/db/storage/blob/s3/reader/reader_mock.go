@@ -31,7 +31,8 @@ import (
 // MockS3API represents mock for s3iface.MMockS3API.
 type MockS3API struct {
 	s3iface.S3API
-	GetObjectWithContextFunc func(aws.Context, *s3.GetObjectInput, ...request.Option) (*s3.GetObjectOutput, error)
+	GetObjectWithContextFunc  func(aws.Context, *s3.GetObjectInput, ...request.Option) (*s3.GetObjectOutput, error)
+	HeadObjectWithContextFunc func(aws.Context, *s3.HeadObjectInput, ...request.Option) (*s3.HeadObjectOutput, error)
 }
 
 // GetObjectWithContext calls GetObjectWithContextFunc.
@@ -39,6 +40,11 @@ func (m *MockS3API) GetObjectWithContext(ctx aws.Context, in *s3.GetObjectInput,
 	return m.GetObjectWithContextFunc(ctx, in, opts...)
 }
 
+// HeadObjectWithContext calls HeadObjectWithContextFunc.
+func (m *MockS3API) HeadObjectWithContext(ctx aws.Context, in *s3.HeadObjectInput, opts ...request.Option) (*s3.HeadObjectOutput, error) {
+	return m.HeadObjectWithContextFunc(ctx, in, opts...)
+}
+
 // MockIO represents mock for io.IO.
 type MockIO struct {
 	NewReaderWithContextFunc     func(ctx context.Context, r io.Reader) (io.Reader, error)
@@ -19,11 +19,13 @@ package reader
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -81,6 +83,7 @@ func TestNew(t *testing.T) {
 					ctxio:          ctxio.New(),
 					maxChunkSize:   512 * 1024 * 1024,
 					backoffEnabled: false,
+					concurrency:    1,
 				},
 			},
 		},
@@ -90,6 +93,7 @@ func TestNew(t *testing.T) {
 			args: args{
 				opts: []Option{
 					WithBackoff(true),
+					WithConcurrency(4),
 				},
 			},
 			want: want{
@@ -98,6 +102,7 @@ func TestNew(t *testing.T) {
 					ctxio:          ctxio.New(),
 					maxChunkSize:   512 * 1024 * 1024,
 					backoffEnabled: true,
+					concurrency:    4,
 				},
 			},
 		},
@@ -483,6 +488,104 @@ func Test_reader_Open(t *testing.T) {
 	}
 }
 
+func Test_reader_Open_Concurrency(t *testing.T) {
+	data := []byte("abcdefghijklmnopq")
+	// newService returns the mock which serves the ranged requests of data.
+	// The earlier chunks are delayed to be completed after the later chunks.
+	newService := func(headErr error, failOffset int64) *MockS3API {
+		return &MockS3API{
+			HeadObjectWithContextFunc: func(aws.Context, *s3.HeadObjectInput, ...request.Option) (*s3.HeadObjectOutput, error) {
+				if headErr != nil {
+					return nil, headErr
+				}
+				return &s3.HeadObjectOutput{
+					ContentLength: aws.Int64(int64(len(data))),
+				}, nil
+			},
+			GetObjectWithContextFunc: func(_ aws.Context, in *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+				var start, end int64
+				if _, err := fmt.Sscanf(aws.StringValue(in.Range), "bytes=%d-%d", &start, &end); err != nil {
+					return nil, err
+				}
+				if start == failOffset {
+					return nil, errors.New("get error")
+				}
+				time.Sleep(time.Duration(int64(len(data))-start) * time.Millisecond)
+				return &s3.GetObjectOutput{
+					Body: io.NopCloser(bytes.NewReader(data[start : end+1])),
+				}, nil
+			},
+		}
+	}
+
+	type test struct {
+		name        string
+		service     *MockS3API
+		concurrency int
+		want        []byte
+		wantErr     bool
+	}
+	tests := []test{
+		{
+			name:        "returns the whole object in order when the concurrency is less than the chunks",
+			service:     newService(nil, -1),
+			concurrency: 2,
+			want:        data,
+		},
+		{
+			name:        "returns the whole object in order when the concurrency is greater than the chunks",
+			service:     newService(nil, -1),
+			concurrency: 10,
+			want:        data,
+		},
+		{
+			name:        "returns error when the chunk download fails",
+			service:     newService(nil, 8),
+			concurrency: 3,
+			wantErr:     true,
+		},
+		{
+			name:        "returns empty when the key does not exist",
+			service:     newService(awserr.New(s3.ErrCodeNotFound, "", nil), -1),
+			concurrency: 3,
+			want:        []byte{},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			eg, _ := errgroup.New(context.Background())
+			r, err := New(
+				WithErrGroup(eg),
+				WithService(test.service),
+				WithBucket("vald"),
+				WithMaxChunkSize(4),
+				WithConcurrency(test.concurrency),
+			)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if err = r.Open(context.Background(), "vald"); err != nil {
+				tt.Fatal(err)
+			}
+			got, err := io.ReadAll(r)
+			if (err != nil) != test.wantErr {
+				tt.Errorf("got_error: %v, want_error: %v", err, test.wantErr)
+			}
+			if !test.wantErr && !bytes.Equal(got, test.want) {
+				tt.Errorf("got: %q, want: %q", got, test.want)
+			}
+			if err = r.Close(); err != nil {
+				tt.Error(err)
+			}
+			if err = eg.Wait(); (err != nil) != test.wantErr {
+				tt.Errorf("got_error: %v, want_error: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
 func Test_reader_Close(t *testing.T) {
 	type fields struct {
 		eg      errgroup.Group
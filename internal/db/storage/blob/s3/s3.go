@@ -21,11 +21,14 @@ import (
 	"io"
 	"reflect"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/vdaas/vald/internal/backoff"
 	"github.com/vdaas/vald/internal/db/storage/blob"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/reader"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3/sdk/s3/s3iface"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/writer"
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/errors"
@@ -34,12 +37,14 @@ import (
 type client struct {
 	eg      errgroup.Group
 	session *session.Session
-	service *s3.S3
+	service s3iface.S3API
 	bucket  string
 
-	maxPartSize  int64
-	maxChunkSize int64
+	maxPartSize         int64
+	maxChunkSize        int64
+	downloadConcurrency int
 
+	// reader and writer are used instead of creating a new one for each call when they are set.
 	reader reader.Reader
 	writer writer.Writer
 
@@ -62,29 +67,6 @@ func New(opts ...Option) (b blob.Bucket, err error) {
 
 	c.service = s3.New(c.session)
 
-	if c.writer == nil {
-		c.writer = writer.New(
-			writer.WithErrGroup(c.eg),
-			writer.WithService(c.service),
-			writer.WithBucket(c.bucket),
-			writer.WithMaxPartSize(c.maxPartSize),
-		)
-	}
-
-	if c.reader == nil {
-		c.reader, err = reader.New(
-			reader.WithErrGroup(c.eg),
-			reader.WithService(c.service),
-			reader.WithBucket(c.bucket),
-			reader.WithMaxChunkSize(c.maxChunkSize),
-			reader.WithBackoff(c.readerBackoffEnabled),
-			reader.WithBackoffOpts(c.readerBackoffOpts...),
-		)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	return c, nil
 }
 
@@ -99,21 +81,99 @@ func (c *client) Close() error {
 }
 
 // Reader creates reader.Reader implementation and returns it.
+// A new reader is created for each call so that the objects can be read concurrently.
 // An error will be returned when the reader initialization fails or an error occurs in reader.Open.
 func (c *client) Reader(ctx context.Context, key string) (rc io.ReadCloser, err error) {
-	err = c.reader.Open(ctx, key)
+	r := c.reader
+	if r == nil {
+		r, err = reader.New(
+			reader.WithErrGroup(c.eg),
+			reader.WithService(c.service),
+			reader.WithBucket(c.bucket),
+			reader.WithMaxChunkSize(c.maxChunkSize),
+			reader.WithConcurrency(c.downloadConcurrency),
+			reader.WithBackoff(c.readerBackoffEnabled),
+			reader.WithBackoffOpts(c.readerBackoffOpts...),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = r.Open(ctx, key)
 	if err != nil {
 		return nil, err
 	}
-	return c.reader, nil
+	return r, nil
 }
 
 // Writer creates writer.Writer implementation and returns it.
+// A new writer is created for each call so that the objects can be written concurrently.
 // An error will be returned when the writer initialization fails or an error occurs in writer.Open.
 func (c *client) Writer(ctx context.Context, key string) (wc io.WriteCloser, err error) {
-	err = c.writer.Open(ctx, key)
+	w := c.writer
+	if w == nil {
+		w = writer.New(
+			writer.WithErrGroup(c.eg),
+			writer.WithService(c.service),
+			writer.WithBucket(c.bucket),
+			writer.WithMaxPartSize(c.maxPartSize),
+		)
+	}
+
+	err = w.Open(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Delete removes the object of the key from the bucket.
+// s3 does not return an error when the key does not exist.
+func (c *client) Delete(ctx context.Context, key string) error {
+	_, err := c.service.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+// List calls f for each key which has the prefix in lexical order until f returns false.
+func (c *client) List(ctx context.Context, prefix string, f func(key string) bool) error {
+	return c.service.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
+	}, func(out *s3.ListObjectsV2Output, last bool) bool {
+		for _, obj := range out.Contents {
+			if !f(aws.StringValue(obj.Key)) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Stat returns the attributes of the object of the key.
+// s3 returns the NotFound error code instead of NoSuchKey for HeadObject request when the key does not exist.
+func (c *client) Stat(ctx context.Context, key string) (*blob.Attributes, error) {
+	out, err := c.service.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
 	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case s3.ErrCodeNoSuchKey, "NotFound":
+				return nil, errors.NewErrBlobNoSuchKey(err, key)
+			case s3.ErrCodeNoSuchBucket:
+				return nil, errors.NewErrBlobNoSuchBucket(err, c.bucket)
+			}
+		}
 		return nil, err
 	}
-	return c.writer, nil
+	return &blob.Attributes{
+		Key:     key,
+		Size:    aws.Int64Value(out.ContentLength),
+		ModTime: aws.TimeValue(out.LastModified),
+	}, nil
 }
@@ -21,14 +21,17 @@ import (
 	"io"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/vdaas/vald/internal/backoff"
 	"github.com/vdaas/vald/internal/db/storage/blob"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/reader"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3/sdk/s3/s3iface"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/sdk/s3/s3manager"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/writer"
 	"github.com/vdaas/vald/internal/errgroup"
@@ -76,10 +79,10 @@ func TestNew(t *testing.T) {
 				return reflect.DeepEqual(want, got)
 			}),
 			comparator.Comparer(func(want, got reader.Reader) bool {
-				return want != nil && got != nil
+				return (want == nil) == (got == nil)
 			}),
 			comparator.Comparer(func(want, got writer.Writer) bool {
-				return want != nil && got != nil
+				return (want == nil) == (got == nil)
 			}),
 			comparator.Comparer(func(want, got []backoff.Option) bool {
 				return reflect.DeepEqual(want, got)
@@ -152,7 +155,7 @@ func TestNew(t *testing.T) {
 			eg := errgroup.Get()
 			writer := new(writer.MockWriter)
 			return test{
-				name: "returns bucket and nil when reader is created and no error occurs internally",
+				name: "returns bucket and nil when only the writer is set and no error occurs internally",
 				args: args{
 					opts: []Option{
 						WithSession(sess),
@@ -172,16 +175,7 @@ func TestNew(t *testing.T) {
 						service:     service,
 						bucket:      "bucket",
 						maxPartSize: 107374182400,
-						reader: func() (r reader.Reader) {
-							r, _ = reader.New(
-								reader.WithErrGroup(eg),
-								reader.WithService(service),
-								reader.WithBucket("bucket"),
-								reader.WithMaxChunkSize(107374182400),
-							)
-							return
-						}(),
-						writer: writer,
+						writer:      writer,
 					},
 					err: nil,
 				},
@@ -193,7 +187,7 @@ func TestNew(t *testing.T) {
 			eg := errgroup.Get()
 			reader := new(reader.MockReader)
 			return test{
-				name: "returns bucket and nil when writer is created and no error occurs internally",
+				name: "returns bucket and nil when only the reader is set and no error occurs internally",
 				args: args{
 					opts: []Option{
 						WithSession(sess),
@@ -214,12 +208,6 @@ func TestNew(t *testing.T) {
 						bucket:      "bucket",
 						maxPartSize: 107374182400,
 						reader:      reader,
-						writer: writer.New(
-							writer.WithErrGroup(eg),
-							writer.WithService(service),
-							writer.WithBucket("bucket"),
-							writer.WithMaxPartSize(107374182400),
-						),
 					},
 					err: nil,
 				},
@@ -230,7 +218,7 @@ func TestNew(t *testing.T) {
 			service := s3.New(sess)
 			eg := errgroup.Get()
 			return test{
-				name: "returns bucket and nil when reader and writer are created and no error occurs internally",
+				name: "returns bucket and nil without the shared reader and writer when no error occurs internally",
 				args: args{
 					opts: []Option{
 						WithSession(sess),
@@ -246,21 +234,6 @@ func TestNew(t *testing.T) {
 						service:     service,
 						bucket:      "bucket",
 						maxPartSize: 107374182400,
-						reader: func() (r reader.Reader) {
-							r, _ = reader.New(
-								reader.WithErrGroup(eg),
-								reader.WithService(service),
-								reader.WithBucket("bucket"),
-								reader.WithMaxChunkSize(107374182400),
-							)
-							return
-						}(),
-						writer: writer.New(
-							writer.WithErrGroup(eg),
-							writer.WithService(service),
-							writer.WithBucket("bucket"),
-							writer.WithMaxPartSize(107374182400),
-						),
 					},
 					err: nil,
 				},
@@ -670,6 +643,37 @@ func Test_client_Writer(t *testing.T) {
 				},
 			}
 		}(),
+		func() test {
+			sess, _ := session.NewSession(&aws.Config{
+				Region:     aws.String("us-east-1"),
+				Endpoint:   aws.String("http://127.0.0.1:0"),
+				MaxRetries: aws.Int(0),
+			})
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			return test{
+				name: "returns a new writer for each call when the writer is not set",
+				args: args{
+					ctx: ctx,
+					key: "key",
+				},
+				fields: fields{
+					eg:      errgroup.Get(),
+					service: s3.New(sess),
+					bucket:  "bucket",
+				},
+				checkFunc: func(w want, g io.WriteCloser, gerr error) error {
+					if gerr != nil {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"<nil>\"", gerr)
+					}
+					defer g.Close()
+					return nil
+				},
+				afterFunc: func(args) {
+					cancel()
+				},
+			}
+		}(),
 	}
 
 	for _, test := range tests {
@@ -698,6 +702,223 @@ func Test_client_Writer(t *testing.T) {
 			if err := test.checkFunc(test.want, got, err); err != nil {
 				tt.Errorf("error = %v", err)
 			}
+			if test.fields.writer == nil && err == nil {
+				another, err := c.Writer(test.args.ctx, test.args.key)
+				if err != nil {
+					tt.Errorf("error = %v", err)
+				} else {
+					another.Close()
+				}
+				if got == another {
+					tt.Error("the writer is shared between the calls")
+				}
+			}
+		})
+	}
+}
+
+type s3APIMock struct {
+	s3iface.S3API
+	DeleteObjectWithContextFunc       func(aws.Context, *s3.DeleteObjectInput, ...request.Option) (*s3.DeleteObjectOutput, error)
+	ListObjectsV2PagesWithContextFunc func(aws.Context, *s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool, ...request.Option) error
+	HeadObjectWithContextFunc         func(aws.Context, *s3.HeadObjectInput, ...request.Option) (*s3.HeadObjectOutput, error)
+}
+
+func (m *s3APIMock) HeadObjectWithContext(ctx aws.Context, in *s3.HeadObjectInput, opts ...request.Option) (*s3.HeadObjectOutput, error) {
+	return m.HeadObjectWithContextFunc(ctx, in, opts...)
+}
+
+func (m *s3APIMock) DeleteObjectWithContext(ctx aws.Context, in *s3.DeleteObjectInput, opts ...request.Option) (*s3.DeleteObjectOutput, error) {
+	return m.DeleteObjectWithContextFunc(ctx, in, opts...)
+}
+
+func (m *s3APIMock) ListObjectsV2PagesWithContext(ctx aws.Context, in *s3.ListObjectsV2Input, f func(*s3.ListObjectsV2Output, bool) bool, opts ...request.Option) error {
+	return m.ListObjectsV2PagesWithContextFunc(ctx, in, f, opts...)
+}
+
+func Test_client_Delete(t *testing.T) {
+	errDelete := errors.New("delete error")
+	type test struct {
+		name    string
+		service s3iface.S3API
+		key     string
+		err     error
+	}
+	tests := []test{
+		{
+			name: "delete the object of the key in the bucket",
+			service: &s3APIMock{
+				DeleteObjectWithContextFunc: func(_ aws.Context, in *s3.DeleteObjectInput, _ ...request.Option) (*s3.DeleteObjectOutput, error) {
+					if aws.StringValue(in.Bucket) != "vald" || aws.StringValue(in.Key) != "obj/a" {
+						return nil, errors.Errorf("unexpected input: %v", in)
+					}
+					return new(s3.DeleteObjectOutput), nil
+				},
+			},
+			key: "obj/a",
+		},
+		{
+			name: "return error when the deletion fails",
+			service: &s3APIMock{
+				DeleteObjectWithContextFunc: func(aws.Context, *s3.DeleteObjectInput, ...request.Option) (*s3.DeleteObjectOutput, error) {
+					return nil, errDelete
+				},
+			},
+			key: "obj/a",
+			err: errDelete,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			c := &client{
+				service: test.service,
+				bucket:  "vald",
+			}
+			if err := c.Delete(context.Background(), test.key); !errors.Is(err, test.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.err)
+			}
+		})
+	}
+}
+
+func Test_client_List(t *testing.T) {
+	pages := []*s3.ListObjectsV2Output{
+		{
+			Contents: []*s3.Object{
+				{Key: aws.String("obj/a")},
+				{Key: aws.String("obj/b")},
+			},
+		},
+		{
+			Contents: []*s3.Object{
+				{Key: aws.String("obj/c")},
+			},
+		},
+	}
+	service := &s3APIMock{
+		ListObjectsV2PagesWithContextFunc: func(_ aws.Context, in *s3.ListObjectsV2Input, f func(*s3.ListObjectsV2Output, bool) bool, _ ...request.Option) error {
+			if aws.StringValue(in.Bucket) != "vald" || aws.StringValue(in.Prefix) != "obj/" {
+				return errors.Errorf("unexpected input: %v", in)
+			}
+			for i, page := range pages {
+				if !f(page, i == len(pages)-1) {
+					return nil
+				}
+			}
+			return nil
+		},
+	}
+	type test struct {
+		name  string
+		limit int
+		want  []string
+	}
+	tests := []test{
+		{
+			name: "list all the keys over the pages",
+			want: []string{"obj/a", "obj/b", "obj/c"},
+		},
+		{
+			name:  "stop listing when the function returns false",
+			limit: 1,
+			want:  []string{"obj/a"},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			c := &client{
+				service: service,
+				bucket:  "vald",
+			}
+			var got []string
+			err := c.List(context.Background(), "obj/", func(key string) bool {
+				got = append(got, key)
+				return test.limit == 0 || len(got) < test.limit
+			})
+			if err != nil {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_client_Stat(t *testing.T) {
+	modTime := time.Date(2021, 10, 18, 0, 0, 0, 0, time.UTC)
+	errHead := errors.New("head error")
+	type test struct {
+		name    string
+		service s3iface.S3API
+		key     string
+		want    *blob.Attributes
+		err     error
+	}
+	tests := []test{
+		{
+			name: "return the attributes of the object",
+			service: &s3APIMock{
+				HeadObjectWithContextFunc: func(_ aws.Context, in *s3.HeadObjectInput, _ ...request.Option) (*s3.HeadObjectOutput, error) {
+					if aws.StringValue(in.Bucket) != "vald" || aws.StringValue(in.Key) != "obj/a" {
+						return nil, errors.Errorf("unexpected input: %v", in)
+					}
+					return &s3.HeadObjectOutput{
+						ContentLength: aws.Int64(10),
+						LastModified:  aws.Time(modTime),
+					}, nil
+				},
+			},
+			key: "obj/a",
+			want: &blob.Attributes{
+				Key:     "obj/a",
+				Size:    10,
+				ModTime: modTime,
+			},
+		},
+		func() test {
+			err := awserr.New("NotFound", "not found", nil)
+			return test{
+				name: "return ErrBlobNoSuchKey when the key does not exist",
+				service: &s3APIMock{
+					HeadObjectWithContextFunc: func(aws.Context, *s3.HeadObjectInput, ...request.Option) (*s3.HeadObjectOutput, error) {
+						return nil, err
+					},
+				},
+				key: "obj/a",
+				err: errors.NewErrBlobNoSuchKey(err, "obj/a"),
+			}
+		}(),
+		{
+			name: "return error when the request fails",
+			service: &s3APIMock{
+				HeadObjectWithContextFunc: func(aws.Context, *s3.HeadObjectInput, ...request.Option) (*s3.HeadObjectOutput, error) {
+					return nil, errHead
+				},
+			},
+			key: "obj/a",
+			err: errHead,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			c := &client{
+				service: test.service,
+				bucket:  "vald",
+			}
+			got, err := c.Stat(context.Background(), test.key)
+			if !errors.Is(err, test.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want)
+			}
 		})
 	}
 }
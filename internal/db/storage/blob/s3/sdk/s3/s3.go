@@ -26,6 +26,10 @@ type (
 	GetObjectInput = s3.GetObjectInput
 	// GetObjectOutput is type alias for s3.GetObjectOutput.
 	GetObjectOutput = s3.GetObjectOutput
+	// HeadObjectInput is type alias for s3.HeadObjectInput.
+	HeadObjectInput = s3.HeadObjectInput
+	// HeadObjectOutput is type alias for s3.HeadObjectOutput.
+	HeadObjectOutput = s3.HeadObjectOutput
 )
 
 const (
@@ -33,4 +37,6 @@ const (
 	ErrCodeNoSuchBucket = s3.ErrCodeNoSuchBucket
 	// ErrCodeNoSuchKey is an alias for s3.ErrCodeNoSuchKey.
 	ErrCodeNoSuchKey = s3.ErrCodeNoSuchKey
+	// ErrCodeNotFound is the error code of HeadObject request when the key does not exist.
+	ErrCodeNotFound = "NotFound"
 )
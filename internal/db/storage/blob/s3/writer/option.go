@@ -17,8 +17,8 @@
 package writer
 
 import (
-	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3/sdk/s3/s3iface"
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/errors"
 )
@@ -44,7 +44,7 @@ func WithErrGroup(eg errgroup.Group) Option {
 }
 
 // WithService returns the option to set s for writer.
-func WithService(s *s3.S3) Option {
+func WithService(s s3iface.S3API) Option {
 	return func(w *writer) error {
 		if s == nil {
 			return errors.NewErrInvalidOption("service", s)
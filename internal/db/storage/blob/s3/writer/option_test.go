@@ -22,6 +22,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3/sdk/s3/s3iface"
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/test/goleak"
@@ -120,10 +121,10 @@ func TestWithErrGroup(t *testing.T) {
 func TestWithService(t *testing.T) {
 	type T = writer
 	type args struct {
-		s *s3.S3
+		s s3iface.S3API
 	}
 	type fields struct {
-		service *s3.S3
+		service s3iface.S3API
 	}
 	type want struct {
 		obj *T
@@ -167,7 +168,7 @@ func TestWithService(t *testing.T) {
 
 		func() test {
 			s := new(s3.S3)
-			var ss *s3.S3
+			var ss s3iface.S3API
 			return test{
 				name: "returns error when s is nil",
 				args: args{
@@ -40,8 +40,9 @@ type writer struct {
 	contentType string
 	maxPartSize int64
 
-	pw io.WriteCloser
-	wg *sync.WaitGroup
+	pw  io.WriteCloser
+	wg  *sync.WaitGroup
+	err error
 }
 
 // Writer represents an interface to write to s3.
@@ -68,6 +69,7 @@ func New(opts ...Option) Writer {
 // Open method returns an error to align the interface, but it doesn't actually return an error.
 func (w *writer) Open(ctx context.Context, key string) (err error) {
 	w.wg = new(sync.WaitGroup)
+	w.err = nil
 
 	var pr io.ReadCloser
 
@@ -79,23 +81,29 @@ func (w *writer) Open(ctx context.Context, key string) (err error) {
 		defer w.wg.Done()
 		defer pr.Close()
 
-		return w.upload(ctx, key, pr)
+		w.err = w.upload(ctx, key, pr)
+		return w.err
 	}))
 
 	return err
 }
 
-// Close closes the writer.
-func (w *writer) Close() error {
+// Close closes the writer and waits for the upload to finish.
+// It returns the upload error if the upload has failed.
+func (w *writer) Close() (err error) {
 	if w.pw != nil {
-		return w.pw.Close()
+		err = w.pw.Close()
 	}
 
 	if w.wg != nil {
 		w.wg.Wait()
 	}
 
-	return nil
+	if err != nil {
+		return err
+	}
+
+	return w.err
 }
 
 // Write writes len(p) bytes from p to the underlying data stream. The written data will be uploaded to s3.
@@ -232,6 +232,7 @@ func Test_writer_Close(t *testing.T) {
 		maxPartSize int64
 		pw          io.WriteCloser
 		wg          *sync.WaitGroup
+		err         error
 	}
 	type want struct {
 		err error
@@ -280,6 +281,22 @@ func Test_writer_Close(t *testing.T) {
 			},
 		},
 
+		{
+			name: "returns error when upload error occurs",
+			fields: fields{
+				pw: &MockWriteCloser{
+					CloseFunc: func() error {
+						return nil
+					},
+				},
+				wg:  new(sync.WaitGroup),
+				err: errors.New("upload err"),
+			},
+			want: want{
+				err: errors.New("upload err"),
+			},
+		},
+
 		{
 			name: "returns nil when no error occurs and writer dose not exist",
 			fields: fields{
@@ -310,6 +327,7 @@ func Test_writer_Close(t *testing.T) {
 				maxPartSize: test.fields.maxPartSize,
 				pw:          test.fields.pw,
 				wg:          test.fields.wg,
+				err:         test.fields.err,
 			}
 
 			err := w.Close()
@@ -17,4 +17,91 @@
 // Package errors provides error types and function
 package errors
 
-var ErrInvalidBackupConfig = New("invalid backup config")
+var (
+	ErrInvalidBackupConfig = New("invalid backup config")
+
+	// ErrIncrementalBackupNotArchived represents an error that the incremental backup is not stored as the archive but as the chunks.
+	ErrIncrementalBackupNotArchived = New("incremental backup is not archived, it must be rebuilt from the chunks")
+
+	// ErrBackupNotEncrypted represents an error that the backup is not encrypted while the encryption is enabled and the unencrypted backup is not allowed.
+	ErrBackupNotEncrypted = New("backup is not encrypted, set allow_unencrypted to restore the unencrypted backup")
+
+	// ErrBackupRestorerNotFound represents an error that the restorer to restore the backup on demand is not configured.
+	ErrBackupRestorerNotFound = New("backup restorer not found")
+
+	// ErrAgentRunning represents an error that the agent is running and its index directory cannot be replaced by the restored backup.
+	ErrAgentRunning = New("agent is running, stop the agent to restore the index")
+
+	// ErrAgentIndexSaving represents an error that the agent is saving the index and the files are not consistent.
+	ErrAgentIndexSaving = New("agent is saving the index")
+
+	// ErrSaveGenerationChanged represents a function to generate an error that the agent saved the index during the backup of the generation.
+	ErrSaveGenerationChanged = func(want, got uint64) error {
+		return Errorf("index generation changed from %d to %d during the backup", want, got)
+	}
+
+	// ErrBackupVersionNotFound represents a function to generate an error that the backup version is not found.
+	ErrBackupVersionNotFound = func(version string) error {
+		return Errorf("backup version %s not found", version)
+	}
+
+	// ErrInvalidBackupManifest represents a function to generate an error that the manifest of the backup version is invalid.
+	ErrInvalidBackupManifest = func(key string, err error) error {
+		return Wrapf(err, "invalid backup manifest %s", key)
+	}
+
+	// ErrBackupFileNotFound represents a function to generate an error that the file in the backup manifest is not found in the backup.
+	ErrBackupFileNotFound = func(name string) error {
+		return Errorf("backup file %s is in the manifest but not found in the backup", name)
+	}
+
+	// ErrBackupFileNotInManifest represents a function to generate an error that the file in the backup is not found in the backup manifest.
+	ErrBackupFileNotInManifest = func(name string) error {
+		return Errorf("backup file %s is not in the manifest", name)
+	}
+
+	// ErrBackupFileSizeMismatch represents a function to generate an error that the size of the backup file does not match the manifest.
+	ErrBackupFileSizeMismatch = func(name string, want, got int64) error {
+		return Errorf("backup file %s size mismatch: want %d bytes, got %d bytes", name, want, got)
+	}
+
+	// ErrBackupFileChecksumMismatch represents a function to generate an error that the checksum of the backup file does not match the manifest.
+	ErrBackupFileChecksumMismatch = func(name, want, got string) error {
+		return Errorf("backup file %s sha256 mismatch: want %s, got %s", name, want, got)
+	}
+
+	// ErrBackupEncryptionKeyNotFound represents a function to generate an error that the key to encrypt or decrypt the backup is not found.
+	ErrBackupEncryptionKeyNotFound = func(id string) error {
+		return Errorf("backup encryption key %s not found", id)
+	}
+
+	// ErrInvalidBackupEncryptionKey represents a function to generate an error that the backup encryption key is invalid.
+	ErrInvalidBackupEncryptionKey = func(id string, err error) error {
+		return Wrapf(err, "invalid backup encryption key %s", id)
+	}
+
+	// ErrCloneOrdinalNotFound represents a function to generate an error that the ordinal of the agent to map the source backup is not found in its name.
+	ErrCloneOrdinalNotFound = func(name string) error {
+		return Errorf("ordinal of the agent %s not found to clone the source backup", name)
+	}
+
+	// ErrCloneReplayRequired represents a function to generate an error that the agent is assigned the multiple source backups which cannot be restored without the replay.
+	ErrCloneReplayRequired = func(name string, n int) error {
+		return Errorf("agent %s is assigned %d source backups, enable the replay to re-shard them", name, n)
+	}
+
+	// ErrInvalidCloneIndex represents a function to generate an error that the index file of the cloned backup is invalid.
+	ErrInvalidCloneIndex = func(name string, err error) error {
+		return Wrapf(err, "invalid index file %s of the cloned backup", name)
+	}
+
+	// ErrLatencyMetricNotFound represents a function to generate an error that the latency metric to throttle the backup is not found in the metrics of the agent.
+	ErrLatencyMetricNotFound = func(name string) error {
+		return Errorf("latency metric %s not found", name)
+	}
+
+	// ErrBackupDecryptionFailed represents a function to generate an error that the backup cannot be decrypted, e.g. it is tampered or truncated.
+	ErrBackupDecryptionFailed = func(err error) error {
+		return Wrap(err, "failed to decrypt the backup")
+	}
+)
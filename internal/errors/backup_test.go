@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package errors provides error types and function
+package errors
+
+import (
+	"testing"
+)
+
+func TestErrBackupVersionNotFound(t *testing.T) {
+	got := ErrBackupVersionNotFound("20211018T000000Z-00000000000000000001")
+	want := New("backup version 20211018T000000Z-00000000000000000001 not found")
+	if !Is(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func TestErrBackupFileErrors(t *testing.T) {
+	type test struct {
+		name string
+		got  error
+		want error
+	}
+	tests := []test{
+		{
+			name: "returns an ErrBackupFileNotFound error",
+			got:  ErrBackupFileNotFound("metadata.json"),
+			want: New("backup file metadata.json is in the manifest but not found in the backup"),
+		},
+		{
+			name: "returns an ErrBackupFileNotInManifest error",
+			got:  ErrBackupFileNotInManifest("metadata.json"),
+			want: New("backup file metadata.json is not in the manifest"),
+		},
+		{
+			name: "returns an ErrBackupFileSizeMismatch error",
+			got:  ErrBackupFileSizeMismatch("metadata.json", 10, 5),
+			want: New("backup file metadata.json size mismatch: want 10 bytes, got 5 bytes"),
+		},
+		{
+			name: "returns an ErrBackupFileChecksumMismatch error",
+			got:  ErrBackupFileChecksumMismatch("metadata.json", "aa", "bb"),
+			want: New("backup file metadata.json sha256 mismatch: want aa, got bb"),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			if !Is(test.got, test.want) {
+				tt.Errorf("got: %v, want: %v", test.got, test.want)
+			}
+		})
+	}
+}
+
+func TestErrBackupEncryptionErrors(t *testing.T) {
+	type test struct {
+		name string
+		got  error
+		want error
+	}
+	tests := []test{
+		{
+			name: "returns an ErrBackupEncryptionKeyNotFound error",
+			got:  ErrBackupEncryptionKeyNotFound("key-1"),
+			want: New("backup encryption key key-1 not found"),
+		},
+		{
+			name: "returns an ErrInvalidBackupEncryptionKey error",
+			got:  ErrInvalidBackupEncryptionKey("key-1", New("invalid key size 3")),
+			want: New("invalid backup encryption key key-1: invalid key size 3"),
+		},
+		{
+			name: "returns an ErrBackupDecryptionFailed error",
+			got:  ErrBackupDecryptionFailed(New("message authentication failed")),
+			want: New("failed to decrypt the backup: message authentication failed"),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			if !Is(test.got, test.want) {
+				tt.Errorf("got: %v, want: %v", test.got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package errors provides error types and function
+package errors
+
+var (
+	// ErrUnknownEnrichBackend represents a function to generate an error that the document store backend is unknown.
+	ErrUnknownEnrichBackend = func(backend string) error {
+		return Errorf("unknown enrich backend: %s", backend)
+	}
+
+	// ErrInvalidDocumentAttributes represents a function to generate an error that the attributes of the document could not be decoded.
+	ErrInvalidDocumentAttributes = func(uuid string, err error) error {
+		return Wrapf(err, "invalid attributes of document uuid: %s", uuid)
+	}
+
+	// ErrDocumentStoreNotOpened represents an error that the document store is used before it is opened.
+	ErrDocumentStoreNotOpened = New("document store is not opened")
+)
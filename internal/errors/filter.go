@@ -23,4 +23,31 @@ var (
 	ErrTargetFilterNotFound = func(addr string) error {
 		return Errorf("target filter not found addr: %s", addr)
 	}
+	ErrFilterStageFailed = func(pipeline, stage string, err error) error {
+		return Wrapf(err, "filter pipeline %s stage %s failed", pipeline, stage)
+	}
+	ErrFilterResultLength = func(r, o int) error {
+		return Errorf("filter returned %d results for %d objects", r, o)
+	}
+	ErrObjectStoreNotEnabled = New("object store is not enabled")
+
+	// ErrFilterPluginTypeNotSupported represents a function to generate an error that the filter plugin type is not supported.
+	ErrFilterPluginTypeNotSupported = func(typ string) error {
+		return Errorf("filter plugin type %s is not supported", typ)
+	}
+
+	// ErrInvalidFilterPlugin represents a function to generate an error that the filter plugin name or path is empty.
+	ErrInvalidFilterPlugin = func(name, path string) error {
+		return Errorf("invalid filter plugin name: %s, path: %s", name, path)
+	}
+
+	// ErrFilterPluginLoadFailed represents a function to generate an error that the filter plugin failed to load.
+	ErrFilterPluginLoadFailed = func(name string, err error) error {
+		return Wrapf(err, "failed to load filter plugin %s", name)
+	}
+
+	// ErrFilterPluginRPCNotSupported represents a function to generate an error that the filter plugin does not support the RPC.
+	ErrFilterPluginRPCNotSupported = func(name, rpc string) error {
+		return Errorf("filter plugin %s does not support %s", name, rpc)
+	}
 )
@@ -80,3 +80,125 @@ func TestErrTargetFilterNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestErrFilterStageFailed(t *testing.T) {
+	type args struct {
+		pipeline string
+		stage    string
+		err      error
+	}
+	type want struct {
+		want error
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, got error) error {
+		if !Is(got, w.want) {
+			return Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "returns an ErrFilterStageFailed error when pipeline, stage and err are not empty",
+			args: args{
+				pipeline: "search",
+				stage:    "normalizer",
+				err:      New("filter error"),
+			},
+			want: want{
+				want: New("filter pipeline search stage normalizer failed: filter error"),
+			},
+		},
+		{
+			name: "returns an ErrFilterStageFailed error when err is nil",
+			args: args{
+				pipeline: "search",
+				stage:    "normalizer",
+			},
+			want: want{
+				want: New("filter pipeline search stage normalizer failed"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := ErrFilterStageFailed(test.args.pipeline, test.args.stage, test.args.err)
+			if err := test.checkFunc(test.want, got); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func TestErrFilterResultLength(t *testing.T) {
+	type args struct {
+		r int
+		o int
+	}
+	type want struct {
+		want error
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, got error) error {
+		if !Is(got, w.want) {
+			return Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "returns an ErrFilterResultLength error when r is 1 and o is 2",
+			args: args{
+				r: 1,
+				o: 2,
+			},
+			want: want{
+				want: New("filter returned 1 results for 2 objects"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := ErrFilterResultLength(test.args.r, test.args.o)
+			if err := test.checkFunc(test.want, got); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
@@ -32,4 +32,9 @@ var (
 	NewErrWriterNotProvided = func() error {
 		return New("io.Writer not provided")
 	}
+
+	// ErrInvalidIOPriority represents a function to generate an error that the I/O priority class or level is invalid.
+	ErrInvalidIOPriority = func(class string, level int) error {
+		return Errorf("invalid I/O priority class %s and level %d, the class must be best-effort with the level from 0 to 7 or idle", class, level)
+	}
 )
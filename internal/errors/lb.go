@@ -22,4 +22,14 @@ var (
 
 	// ErrIndexNotFound represents an error that the index not found.
 	ErrIndexNotFound = New("index not found")
+
+	// ErrInsufficientReplicaAcks represents a function to generate an error that the write was not acknowledged by enough replicas.
+	ErrInsufficientReplicaAcks = func(acks, required int) error {
+		return Errorf("write acknowledged by %d replicas, %d required", acks, required)
+	}
+
+	// ErrMixedConsistency represents a function to generate an error that the requests of a bulk write use different consistency levels.
+	ErrMixedConsistency = func(a, b string) error {
+		return Errorf("bulk write requests must share one consistency level, got %s and %s", a, b)
+	}
 )
@@ -21,4 +21,7 @@ var (
 	ErrInvalidMetaDataConfig = New("invalid metadata config")
 	ErrMetadataFileEmpty     = New("metadata file empty")
 	ErrMetadataFileNotFound  = New("metadata file not found")
+
+	// ErrSaveMarkerNotFound represents an error that the save marker file of the agent is not found.
+	ErrSaveMarkerNotFound = New("save marker file not found")
 )
@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package errors provides error types and function
+package errors
+
+var (
+	// ErrONNXRuntime represents a function to generate an error that the onnx runtime returned an error.
+	ErrONNXRuntime = func(msg string) error {
+		return Errorf("onnx runtime error: %s", msg)
+	}
+
+	// ErrONNXInvalidInputType represents a function to generate an error that the input type is not supported.
+	ErrONNXInvalidInputType = func(typ string) error {
+		return Errorf("onnx input type %s is not supported", typ)
+	}
+
+	// ErrONNXInputLength represents a function to generate an error that the input length is not a multiple of the element size.
+	ErrONNXInputLength = func(l, size int) error {
+		return Errorf("onnx input length %d is not a multiple of element size %d", l, size)
+	}
+
+	// ErrONNXInputShape represents a function to generate an error that the input elements do not fit the input shape.
+	ErrONNXInputShape = func(n int, shape []int64) error {
+		return Errorf("onnx input of %d elements does not fit shape %v", n, shape)
+	}
+
+	// ErrONNXMultipleDynamicDimensions represents a function to generate an error that the input shape has more than one dimension to infer.
+	ErrONNXMultipleDynamicDimensions = func(shape []int64) error {
+		return Errorf("onnx input shape %v has more than one dynamic dimension", shape)
+	}
+
+	// ErrONNXRuntimeNotEnabled represents an error that the binary is built without ONNX Runtime.
+	ErrONNXRuntimeNotEnabled = New("onnx runtime is not enabled, build with the onnxruntime tag")
+
+	// ErrONNXUnsupportedOutputType represents a function to generate an error that the output tensor type is not float32.
+	ErrONNXUnsupportedOutputType = func(typ int) error {
+		return Errorf("onnx output element type %d is not supported, float32 is required", typ)
+	}
+)
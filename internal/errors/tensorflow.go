@@ -37,4 +37,12 @@ var (
 	ErrNilTensorValueTF = func(v interface{}) error {
 		return Errorf("nil tensorflow tensor value %+v", v)
 	}
+
+	// ErrBatchResultLengthTF represents a function to generate an error that the batch result length is not equal to the batch size.
+	ErrBatchResultLengthTF = func(r int, b int) error {
+		return Errorf("tensorflow batch result length %d does not match batch size %d", r, b)
+	}
+
+	// ErrBatcherClosedTF represents an error that the tensorflow micro batcher is already closed.
+	ErrBatcherClosedTF = New("tensorflow batcher is closed")
 )
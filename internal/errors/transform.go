@@ -0,0 +1,50 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package errors provides error types and function
+package errors
+
+var (
+	// ErrUnknownTransformStep represents a function to generate an error that the transform step type is unknown.
+	ErrUnknownTransformStep = func(typ string) error {
+		return Errorf("unknown vector transform step: %s", typ)
+	}
+
+	// ErrTransformDimension represents a function to generate an error that the vector dimension does not match the step.
+	ErrTransformDimension = func(step string, got, want int) error {
+		return Errorf("vector transform step %s requires dimension %d but got %d", step, want, got)
+	}
+
+	// ErrInvalidQuantizationRange represents a function to generate an error that the quantization range is empty.
+	ErrInvalidQuantizationRange = func(min, max float64) error {
+		return Errorf("invalid quantization range min: %v, max: %v", min, max)
+	}
+
+	// ErrInvalidMatrixFile represents a function to generate an error that the matrix file could not be parsed.
+	ErrInvalidMatrixFile = func(path string, err error) error {
+		return Wrapf(err, "invalid matrix file: %s", path)
+	}
+
+	// ErrInvalidNpyHeader represents a function to generate an error that the npy header is not supported.
+	ErrInvalidNpyHeader = func(header string) error {
+		return Errorf("unsupported npy header: %s", header)
+	}
+
+	// ErrMatrixRowLength represents a function to generate an error that the matrix row length is not equal to the others.
+	ErrMatrixRowLength = func(row, got, want int) error {
+		return Errorf("matrix row %d has %d columns but %d is required", row, got, want)
+	}
+)
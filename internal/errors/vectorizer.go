@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package errors provides error types and function
+package errors
+
+var (
+	// ErrInvalidJSONPath represents a function to generate an error that the JSON path could not be parsed.
+	ErrInvalidJSONPath = func(path string, pos int) error {
+		return Errorf("invalid json path %s at position %d", path, pos)
+	}
+
+	// ErrJSONPathNotFound represents a function to generate an error that the JSON path does not match any value.
+	ErrJSONPathNotFound = func(path string) error {
+		return Errorf("json path %s not found in the response", path)
+	}
+
+	// ErrJSONPathNotVector represents a function to generate an error that the value of the JSON path is not a number array.
+	ErrJSONPathNotVector = func(path string) error {
+		return Errorf("json path %s is not a number array", path)
+	}
+
+	// ErrUnexpectedHTTPStatus represents a function to generate an error that the inference server returned the unexpected status.
+	ErrUnexpectedHTTPStatus = func(code int, body string) error {
+		return Errorf("unexpected http status %d: %s", code, body)
+	}
+)
@@ -0,0 +1,35 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package errors provides error types and function
+package errors
+
+var (
+	// ErrWASMImportNotSupported represents a function to generate an error that the WASM module imports a host object.
+	ErrWASMImportNotSupported = func(module, name string) error {
+		return Errorf("wasm import %s.%s is not supported", module, name)
+	}
+
+	// ErrWASMExportNotFound represents a function to generate an error that the export is not found in the WASM module.
+	ErrWASMExportNotFound = func(name string) error {
+		return Errorf("wasm export %s not found", name)
+	}
+
+	// ErrWASMTrap represents a function to generate an error that the WASM execution is trapped.
+	ErrWASMTrap = func(reason string) error {
+		return Errorf("wasm trap: %s", reason)
+	}
+)
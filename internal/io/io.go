@@ -30,11 +30,18 @@ type (
 	Closer      = io.Closer
 	ReadCloser  = io.ReadCloser
 	WriteCloser = io.WriteCloser
+	PipeWriter  = io.PipeWriter
 )
 
 var (
-	Pipe = io.Pipe
-	EOF  = io.EOF
+	Pipe        = io.Pipe
+	MultiWriter = io.MultiWriter
+	ReadFull    = io.ReadFull
+	ReadAll     = io.ReadAll
+	LimitReader = io.LimitReader
+
+	EOF              = io.EOF
+	ErrUnexpectedEOF = io.ErrUnexpectedEOF
 )
 
 type ctxReader struct {
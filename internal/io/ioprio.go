@@ -0,0 +1,45 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package io provides io functions
+package io
+
+import "github.com/vdaas/vald/internal/errors"
+
+const (
+	// IOPriorityClassBestEffort is the I/O scheduling class which is scheduled by the level from 0 (highest) to 7 (lowest).
+	IOPriorityClassBestEffort = "best-effort"
+	// IOPriorityClassIdle is the I/O scheduling class which is scheduled only when no other process needs the disk I/O.
+	IOPriorityClassIdle = "idle"
+)
+
+// SetIOPriority sets the I/O scheduling class and level of the threads in the process group of the current process.
+// It does nothing when the class is empty or the platform does not support the I/O priority.
+func SetIOPriority(class string, level int) error {
+	switch class {
+	case "":
+		return nil
+	case IOPriorityClassBestEffort:
+		if level < 0 || level > 7 {
+			return errors.ErrInvalidIOPriority(class, level)
+		}
+	case IOPriorityClassIdle:
+		level = 0
+	default:
+		return errors.ErrInvalidIOPriority(class, level)
+	}
+	return setIOPriority(class, level)
+}
@@ -0,0 +1,42 @@
+//go:build linux
+// +build linux
+
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package io provides io functions
+package io
+
+import "syscall"
+
+const (
+	ioprioWhoPgrp    = 2
+	ioprioClassShift = 13
+	ioprioClassBE    = 2
+	ioprioClassIdle  = 3
+)
+
+func setIOPriority(class string, level int) error {
+	c := ioprioClassBE
+	if class == IOPriorityClassIdle {
+		c = ioprioClassIdle
+	}
+	_, _, errno := syscall.Syscall(syscall.SYS_IOPRIO_SET, ioprioWhoPgrp, 0, uintptr(c<<ioprioClassShift|level))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
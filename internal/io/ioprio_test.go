@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package io provides io functions
+package io
+
+import (
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func TestSetIOPriority(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		class string
+		level int
+	}
+	type test struct {
+		name string
+		args args
+		err  error
+	}
+	// the valid classes are not tested not to change the I/O priority of the test process group.
+	tests := []test{
+		{
+			name: "does nothing when the class is empty",
+		},
+		{
+			name: "returns error when the class is unknown",
+			args: args{
+				class: "realtime",
+			},
+			err: errors.ErrInvalidIOPriority("realtime", 0),
+		},
+		{
+			name: "returns error when the level of best-effort is out of range",
+			args: args{
+				class: IOPriorityClassBestEffort,
+				level: 8,
+			},
+			err: errors.ErrInvalidIOPriority(IOPriorityClassBestEffort, 8),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			err := SetIOPriority(test.args.class, test.args.level)
+			if !errors.Is(err, test.err) {
+				tt.Errorf("error = %v, want %v", err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package io provides io functions
+package io
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// Limiter represents the bandwidth limiter which is shared by the readers to limit their total transfer rate.
+type Limiter interface {
+	// WaitN blocks until n bytes are allowed to be transferred or the context is done.
+	WaitN(ctx context.Context, n int) error
+	// SetRate changes the transfer rate in bytes per second. The rate less than or equal to 0 means unlimited.
+	SetRate(rate int64)
+	// Rate returns the current transfer rate in bytes per second.
+	Rate() int64
+}
+
+// limiter is the token bucket which is refilled at the rate and holds the bytes of one second at most.
+type limiter struct {
+	mu     sync.Mutex
+	rate   int64
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns the Limiter of the transfer rate in bytes per second.
+func NewLimiter(rate int64) Limiter {
+	l := new(limiter)
+	l.SetRate(rate)
+	return l
+}
+
+func (l *limiter) WaitN(ctx context.Context, n int) error {
+	for n > 0 {
+		l.mu.Lock()
+		if l.rate <= 0 {
+			l.mu.Unlock()
+			return nil
+		}
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * float64(l.rate)
+		if l.tokens > float64(l.rate) {
+			l.tokens = float64(l.rate)
+		}
+		l.last = now
+
+		// the bytes more than the bucket size are taken in several times.
+		size := n
+		if int64(size) > l.rate {
+			size = int(l.rate)
+		}
+		if l.tokens >= float64(size) {
+			l.tokens -= float64(size)
+			n -= size
+			l.mu.Unlock()
+			continue
+		}
+		wait := time.Duration((float64(size) - l.tokens) / float64(l.rate) * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return nil
+}
+
+func (l *limiter) SetRate(rate int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.last.IsZero() || l.rate <= 0 {
+		l.tokens = float64(rate)
+		l.last = time.Now()
+	}
+	if l.tokens > float64(rate) {
+		l.tokens = float64(rate)
+	}
+	l.rate = rate
+}
+
+func (l *limiter) Rate() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rate
+}
+
+type limitReader struct {
+	ctx context.Context
+	r   io.Reader
+	l   Limiter
+}
+
+// NewReaderWithLimiter returns the context-aware reader whose transfer rate is limited by the limiter.
+// The reader is not limited when the limiter is nil.
+func NewReaderWithLimiter(ctx context.Context, r io.Reader, l Limiter) (io.Reader, error) {
+	cr, err := NewReaderWithContext(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if l == nil {
+		return cr, nil
+	}
+	return &limitReader{
+		ctx: ctx,
+		r:   cr,
+		l:   l,
+	}, nil
+}
+
+// NewReadCloserWithLimiter returns the context-aware read closer whose transfer rate is limited by the limiter.
+// The reader is not limited when the limiter is nil.
+func NewReadCloserWithLimiter(ctx context.Context, r io.ReadCloser, l Limiter) (io.ReadCloser, error) {
+	cr, err := NewReadCloserWithContext(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if l == nil {
+		return cr, nil
+	}
+	return &limitReader{
+		ctx: ctx,
+		r:   cr,
+		l:   l,
+	}, nil
+}
+
+func (r *limitReader) Read(p []byte) (n int, err error) {
+	n, err = r.r.Read(p)
+	if n > 0 {
+		if werr := r.l.WaitN(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+func (r *limitReader) Close() error {
+	if c, ok := r.r.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package io provides io functions
+package io
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func TestLimiter_WaitN(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name    string
+		rate    int64
+		n       []int
+		timeout time.Duration
+		min     time.Duration
+		max     time.Duration
+		err     error
+	}
+	tests := []test{
+		{
+			name: "returns immediately when the rate is unlimited",
+			rate: 0,
+			n:    []int{1 << 30},
+			max:  100 * time.Millisecond,
+		},
+		{
+			name: "returns immediately while the bytes are in the bucket",
+			rate: 1 << 20,
+			n:    []int{1 << 19, 1 << 19},
+			max:  100 * time.Millisecond,
+		},
+		{
+			name: "waits for the bytes more than the bucket",
+			rate: 1 << 20,
+			n:    []int{1 << 20, 1 << 19},
+			min:  400 * time.Millisecond,
+			max:  time.Second,
+		},
+		{
+			name: "waits for the bytes more than the bucket size in several times",
+			rate: 1 << 20,
+			n:    []int{3 << 19},
+			min:  400 * time.Millisecond,
+			max:  time.Second,
+		},
+		{
+			name:    "returns error when the context is done",
+			rate:    1 << 10,
+			n:       []int{1 << 20},
+			timeout: 50 * time.Millisecond,
+			max:     time.Second,
+			err:     context.DeadlineExceeded,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			ctx := context.Background()
+			if test.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+			l := NewLimiter(test.rate)
+			start := time.Now()
+			var err error
+			for _, n := range test.n {
+				if err = l.WaitN(ctx, n); err != nil {
+					break
+				}
+			}
+			elapsed := time.Since(start)
+			if !errors.Is(err, test.err) {
+				tt.Errorf("error = %v, want %v", err, test.err)
+			}
+			if elapsed < test.min || elapsed > test.max {
+				tt.Errorf("elapsed = %v, want between %v and %v", elapsed, test.min, test.max)
+			}
+		})
+	}
+}
+
+func TestLimiter_SetRate(t *testing.T) {
+	t.Parallel()
+	l := NewLimiter(0)
+	if err := l.WaitN(context.Background(), 1<<30); err != nil {
+		t.Errorf("error = %v", err)
+	}
+	l.SetRate(1 << 10)
+	if got := l.Rate(); got != 1<<10 {
+		t.Errorf("rate = %d, want %d", got, 1<<10)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := l.WaitN(ctx, 1<<20); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	l.SetRate(0)
+	if err := l.WaitN(context.Background(), 1<<30); err != nil {
+		t.Errorf("error = %v", err)
+	}
+}
+
+func TestNewReaderWithLimiter(t *testing.T) {
+	t.Parallel()
+	data := bytes.Repeat([]byte("a"), 3<<18)
+	type test struct {
+		name string
+		l    Limiter
+		min  time.Duration
+	}
+	tests := []test{
+		{
+			name: "reads all data without the limiter",
+		},
+		{
+			name: "reads all data at the rate of the limiter",
+			l:    NewLimiter(1 << 19),
+			min:  400 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			r, err := NewReaderWithLimiter(context.Background(), bytes.NewReader(data), test.l)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			start := time.Now()
+			got, err := io.ReadAll(r)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if !bytes.Equal(got, data) {
+				tt.Errorf("read %d bytes, want %d bytes", len(got), len(data))
+			}
+			if elapsed := time.Since(start); elapsed < test.min {
+				tt.Errorf("elapsed = %v, want at least %v", elapsed, test.min)
+			}
+		})
+	}
+}
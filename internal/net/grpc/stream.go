@@ -23,6 +23,7 @@ import (
 	"runtime"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/errors"
@@ -148,6 +149,204 @@ func BidirectionalStream(ctx context.Context, stream ServerStream,
 	}
 }
 
+// BidirectionalStreamBatch represents gRPC bidirectional stream server handler
+// which coalesces the received messages into batches bounded by size and duration.
+// f must return one result per message and the results are sent back in the order the messages were received.
+func BidirectionalStreamBatch(ctx context.Context, stream ServerStream,
+	concurrency, size int, duration time.Duration,
+	newData func() interface{},
+	f func(context.Context, []interface{}) ([]interface{}, error)) (err error) {
+	if size <= 1 || duration <= 0 {
+		return BidirectionalStream(ctx, stream, concurrency, newData,
+			func(ctx context.Context, data interface{}) (interface{}, error) {
+				res, err := f(ctx, []interface{}{data})
+				if len(res) == 0 {
+					return nil, err
+				}
+				return res[0], err
+			})
+	}
+	ctx, span := trace.StartSpan(stream.Context(), apiName+"/BidirectionalStreamBatch")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+
+	type batch struct {
+		data []interface{}
+		res  chan []interface{}
+	}
+
+	eg, ctx := errgroup.New(ctx)
+	weg, wctx := errgroup.New(ctx)
+	if concurrency > 0 {
+		weg.Limitation(concurrency)
+	} else {
+		concurrency = 1
+	}
+
+	errMap := sync.Map{}
+	reqs := make(chan interface{}, size)
+	pending := make(chan *batch, concurrency)
+
+	var cnt uint64
+	eg.Go(safety.RecoverFunc(func() error {
+		defer close(pending)
+		buf := make([]interface{}, 0, size)
+		flush := func() {
+			if len(buf) == 0 {
+				return
+			}
+			b := &batch{
+				data: buf,
+				res:  make(chan []interface{}, 1),
+			}
+			buf = make([]interface{}, 0, size)
+			pending <- b
+			weg.Go(safety.RecoverWithoutPanicFunc(func() (err error) {
+				defer close(b.res)
+				id := atomic.AddUint64(&cnt, 1)
+				ctx, sspan := trace.StartSpan(wctx, fmt.Sprintf("%s/BidirectionalStreamBatch/batch-%020d", apiName, id))
+				defer func() {
+					if sspan != nil {
+						sspan.End()
+					}
+				}()
+				res, err := f(ctx, b.data)
+				if err != nil {
+					runtime.Gosched()
+					errMap.Store(err.Error(), err)
+					st, msg, err := status.ParseError(err, codes.Internal, fmt.Sprintf("failed to parse BidirectionalStreamBatch id= %020d gRPC error response", id))
+					if sspan != nil {
+						sspan.SetStatus(trace.FromGRPCStatus(st.Code(), msg))
+					}
+					if err != nil {
+						log.Error(err)
+					}
+				}
+				b.res <- res
+				return nil
+			}))
+		}
+		var (
+			timer *time.Timer
+			tc    <-chan time.Time
+		)
+		for {
+			select {
+			case data, ok := <-reqs:
+				if !ok {
+					if timer != nil {
+						timer.Stop()
+					}
+					flush()
+					return nil
+				}
+				if len(buf) == 0 {
+					timer = time.NewTimer(duration)
+					tc = timer.C
+				}
+				buf = append(buf, data)
+				if len(buf) >= size {
+					timer.Stop()
+					tc = nil
+					flush()
+				}
+			case <-tc:
+				tc = nil
+				flush()
+			}
+		}
+	}))
+
+	eg.Go(safety.RecoverFunc(func() error {
+		var serr error
+		for b := range pending {
+			var results []interface{}
+			select {
+			case <-wctx.Done():
+			case results = <-b.res:
+			}
+			for _, res := range results {
+				if res == nil || serr != nil {
+					continue
+				}
+				serr = stream.SendMsg(res)
+				if serr != nil {
+					runtime.Gosched()
+					st, msg, err := status.ParseError(serr, codes.Internal, "failed to parse BidirectionalStreamBatch.SendMsg gRPC error response",
+						&errdetails.RequestInfo{
+							RequestId:   apiName + "/BidirectionalStreamBatch/SendMsg",
+							ServingData: errdetails.Serialize(res),
+						})
+					if span != nil {
+						span.SetStatus(trace.FromGRPCStatus(st.Code(), msg))
+					}
+					errMap.Store(err.Error(), err)
+				}
+			}
+		}
+		return nil
+	}))
+
+	finalize := func() error {
+		close(reqs)
+		var errs error
+		err = eg.Wait()
+		if werr := weg.Wait(); werr != nil {
+			errMap.Store(werr.Error(), werr)
+		}
+		if err != nil {
+			errMap.Store(err.Error(), err)
+		}
+		errMap.Range(func(_, e interface{}) bool {
+			err, ok := e.(error)
+			if !ok || err == nil {
+				return true
+			}
+			if errs == nil {
+				errs = err
+			} else {
+				errs = errors.Wrap(err, errs.Error())
+			}
+			return true
+		})
+		if errs == nil {
+			return nil
+		}
+		st, msg, err := status.ParseError(errs, codes.Internal, "failed to parse BidirectionalStreamBatch final gRPC error response")
+		if span != nil {
+			span.SetStatus(trace.FromGRPCStatus(st.Code(), msg))
+		}
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return finalize()
+		default:
+			data := newData()
+			err = stream.RecvMsg(data)
+			if err != nil {
+				if err != io.EOF && !errors.Is(err, io.EOF) {
+					log.Errorf("failed to receive stream message: %v", err)
+					errMap.Store(err.Error(), err)
+				}
+				return finalize()
+			}
+			if data != nil {
+				select {
+				case <-ctx.Done():
+					return finalize()
+				case reqs <- data:
+				}
+			}
+		}
+	}
+}
+
 // BidirectionalStreamClient is gRPC client stream.
 func BidirectionalStreamClient(stream ClientStream,
 	dataProvider, newData func() interface{},
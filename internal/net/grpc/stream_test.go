@@ -19,10 +19,16 @@ package grpc
 
 import (
 	"context"
+	"reflect"
+	"sort"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
 	"github.com/vdaas/vald/internal/test/goleak"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestBidirectionalStream(t *testing.T) {
@@ -110,6 +116,212 @@ func TestBidirectionalStream(t *testing.T) {
 	}
 }
 
+type streamBatchMock struct {
+	ctx     context.Context
+	mu      sync.Mutex
+	recv    []int
+	pause   map[int]time.Duration
+	pos     int
+	sent    []int
+	sendErr error
+}
+
+func (s *streamBatchMock) SetHeader(metadata.MD) error  { return nil }
+func (s *streamBatchMock) SendHeader(metadata.MD) error { return nil }
+func (s *streamBatchMock) SetTrailer(metadata.MD)       {}
+func (s *streamBatchMock) Context() context.Context     { return s.ctx }
+
+func (s *streamBatchMock) SendMsg(m interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, *(m.(*int)))
+	return nil
+}
+
+func (s *streamBatchMock) RecvMsg(m interface{}) error {
+	if s.pos >= len(s.recv) {
+		return io.EOF
+	}
+	if d, ok := s.pause[s.pos]; ok {
+		time.Sleep(d)
+	}
+	*(m.(*int)) = s.recv[s.pos]
+	s.pos++
+	return nil
+}
+
+func TestBidirectionalStreamBatch(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		stream      *streamBatchMock
+		concurrency int
+		size        int
+		duration    time.Duration
+		f           func(context.Context, []interface{}) ([]interface{}, error)
+	}
+	type want struct {
+		err     error
+		sent    []int
+		batches [][]int
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, error, []int, [][]int) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, err error, sent []int, batches [][]int) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(sent, w.sent) {
+			return errors.Errorf("got_sent: \"%#v\",\n\t\t\t\twant: \"%#v\"", sent, w.sent)
+		}
+		// batches are processed concurrently, so only their contents are compared.
+		sort.Slice(batches, func(i, j int) bool {
+			return batches[i][0] < batches[j][0]
+		})
+		if w.batches != nil && !reflect.DeepEqual(batches, w.batches) {
+			return errors.Errorf("got_batches: \"%#v\",\n\t\t\t\twant: \"%#v\"", batches, w.batches)
+		}
+		return nil
+	}
+	double := func(_ context.Context, data []interface{}) ([]interface{}, error) {
+		res := make([]interface{}, 0, len(data))
+		for _, d := range data {
+			v := *(d.(*int)) * 2
+			res = append(res, &v)
+		}
+		return res, nil
+	}
+	tests := []test{
+		{
+			name: "return nil and send the results in order when the messages are batched by size",
+			args: args{
+				stream: &streamBatchMock{
+					ctx:  context.Background(),
+					recv: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+				},
+				concurrency: 4,
+				size:        4,
+				duration:    time.Minute,
+				f:           double,
+			},
+			want: want{
+				sent:    []int{2, 4, 6, 8, 10, 12, 14, 16, 18, 20},
+				batches: [][]int{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10}},
+			},
+		},
+		{
+			name: "return nil and flush the batch when the duration has passed",
+			args: args{
+				stream: &streamBatchMock{
+					ctx:  context.Background(),
+					recv: []int{1, 2, 3, 4, 5},
+					pause: map[int]time.Duration{
+						3: 200 * time.Millisecond,
+					},
+				},
+				concurrency: 1,
+				size:        100,
+				duration:    10 * time.Millisecond,
+				f:           double,
+			},
+			want: want{
+				sent:    []int{2, 4, 6, 8, 10},
+				batches: [][]int{{1, 2, 3}, {4, 5}},
+			},
+		},
+		{
+			name: "return error and send the results when f returns error",
+			args: args{
+				stream: &streamBatchMock{
+					ctx:  context.Background(),
+					recv: []int{1, 2},
+				},
+				concurrency: 1,
+				size:        2,
+				duration:    time.Minute,
+				f: func(ctx context.Context, data []interface{}) ([]interface{}, error) {
+					res, _ := double(ctx, data)
+					return res, errors.New("batch failed")
+				},
+			},
+			want: want{
+				sent: []int{2, 4},
+			},
+			checkFunc: func(w want, err error, sent []int, _ [][]int) error {
+				if err == nil {
+					return errors.New("got_error: nil, want: error")
+				}
+				if !reflect.DeepEqual(sent, w.sent) {
+					return errors.Errorf("got_sent: \"%#v\",\n\t\t\t\twant: \"%#v\"", sent, w.sent)
+				}
+				return nil
+			},
+		},
+		{
+			name: "return nil when the batching is disabled",
+			args: args{
+				stream: &streamBatchMock{
+					ctx:  context.Background(),
+					recv: []int{1},
+				},
+				concurrency: 1,
+				size:        1,
+				duration:    time.Minute,
+				f:           double,
+			},
+			want: want{
+				sent:    []int{2},
+				batches: [][]int{{1}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			var (
+				mu      sync.Mutex
+				batches [][]int
+			)
+			f := func(ctx context.Context, data []interface{}) ([]interface{}, error) {
+				b := make([]int, 0, len(data))
+				for _, d := range data {
+					b = append(b, *(d.(*int)))
+				}
+				mu.Lock()
+				batches = append(batches, b)
+				mu.Unlock()
+				return test.args.f(ctx, data)
+			}
+			err := BidirectionalStreamBatch(context.Background(), test.args.stream, test.args.concurrency,
+				test.args.size, test.args.duration, func() interface{} { return new(int) }, f)
+			if err := test.checkFunc(test.want, err, test.args.stream.sent, batches); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
 func TestBidirectionalStreamClient(t *testing.T) {
 	t.Parallel()
 	type args struct {
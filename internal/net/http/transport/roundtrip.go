@@ -51,7 +51,17 @@ func (e *ert) RoundTrip(req *http.Request) (res *http.Response, err error) {
 	if e.bo == nil {
 		return e.roundTrip(req)
 	}
+	var cnt int
 	_, err = e.bo.Do(req.Context(), func(ctx context.Context) (interface{}, bool, error) {
+		// the request body is consumed by the previous attempt, so it must be rewound before retrying.
+		if cnt > 0 && req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, false, err
+			}
+			req.Body = body
+		}
+		cnt++
 		r, err := e.roundTrip(req)
 		if err != nil {
 			return nil, errors.Is(err, errors.ErrTransportRetryable), err
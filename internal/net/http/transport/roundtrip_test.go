@@ -299,6 +299,54 @@ func Test_ert_RoundTrip(t *testing.T) {
 				err: errors.Wrap(errors.ErrTransportRetryable, "error"),
 			},
 		},
+		func() test {
+			req := httptest.NewRequest(http.MethodPost, "http://localhost", bytes.NewBufferString("body"))
+			req.GetBody = func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(bytes.NewBufferString("body")), nil
+			}
+			var cnt int
+			return test{
+				name: "return response with the rewound request body when the first attempt is retryable",
+				args: args{
+					req: req,
+				},
+				fields: fields{
+					transport: &roundTripMock{
+						RoundTripFunc: func(r *http.Request) (*http.Response, error) {
+							b, err := ioutil.ReadAll(r.Body)
+							if err != nil {
+								return nil, err
+							}
+							if string(b) != "body" {
+								return nil, errors.Errorf("unexpected body: %s", string(b))
+							}
+							cnt++
+							if cnt == 1 {
+								return nil, errors.ErrTransportRetryable
+							}
+							return &http.Response{
+								Status: "200",
+							}, nil
+						},
+					},
+					bo: &backoffMock{
+						DoFunc: func(ctx context.Context, fn func(context.Context) (interface{}, bool, error)) (interface{}, error) {
+							for {
+								val, retryable, err := fn(ctx)
+								if err == nil || !retryable {
+									return val, err
+								}
+							}
+						},
+					},
+				},
+				want: want{
+					wantRes: &http.Response{
+						Status: "200",
+					},
+				},
+			}
+		}(),
 	}
 
 	for _, test := range tests {
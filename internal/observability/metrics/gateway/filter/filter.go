@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package filter provides functions for filter gateway stats
+package filter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vdaas/vald/internal/observability/metrics"
+	"github.com/vdaas/vald/pkg/gateway/filter/service"
+)
+
+const (
+	stageStatusSucceeded = "succeeded"
+	stageStatusSkipped   = "skipped"
+	stageStatusFailed    = "failed"
+)
+
+type filterMetrics struct {
+	stageTotal   metrics.Int64Measure
+	stageLatency metrics.Float64Measure
+
+	pipelineKey metrics.Key
+	stageKey    metrics.Key
+	statusKey   metrics.Key
+
+	mu sync.Mutex
+	ms []metrics.MeasurementWithTags
+}
+
+type MetricsHook interface {
+	metrics.Metric
+	service.Hook
+}
+
+func New() (MetricsHook, error) {
+	var err error
+	fm := new(filterMetrics)
+
+	fm.stageTotal = *metrics.Int64(
+		metrics.ValdOrg+"/gateway/filter/pipeline_stage_total",
+		"cumulative count of filter pipeline stage execution",
+		metrics.UnitDimensionless)
+
+	fm.stageLatency = *metrics.Float64(
+		metrics.ValdOrg+"/gateway/filter/pipeline_stage_latency",
+		"filter pipeline stage latency",
+		metrics.UnitMilliseconds)
+
+	fm.pipelineKey, err = metrics.NewKey("gateway_filter_pipeline")
+	if err != nil {
+		return nil, err
+	}
+
+	fm.stageKey, err = metrics.NewKey("gateway_filter_pipeline_stage")
+	if err != nil {
+		return nil, err
+	}
+
+	fm.statusKey, err = metrics.NewKey("gateway_filter_pipeline_stage_status")
+	if err != nil {
+		return nil, err
+	}
+
+	fm.ms = make([]metrics.MeasurementWithTags, 0)
+
+	return fm, nil
+}
+
+func (fm *filterMetrics) Measurement(ctx context.Context) ([]metrics.Measurement, error) {
+	return []metrics.Measurement{}, nil
+}
+
+func (fm *filterMetrics) MeasurementWithTags(ctx context.Context) ([]metrics.MeasurementWithTags, error) {
+	fm.mu.Lock()
+	defer func() {
+		fm.ms = make([]metrics.MeasurementWithTags, 0)
+		fm.mu.Unlock()
+	}()
+
+	return fm.ms, nil
+}
+
+func (fm *filterMetrics) View() []*metrics.View {
+	stageKeys := []metrics.Key{
+		fm.pipelineKey,
+		fm.stageKey,
+		fm.statusKey,
+	}
+
+	return []*metrics.View{
+		{
+			Name:        "gateway_filter_pipeline_stage_total",
+			Description: fm.stageTotal.Description(),
+			TagKeys:     stageKeys,
+			Measure:     &fm.stageTotal,
+			Aggregation: metrics.Count(),
+		},
+		{
+			Name:        "gateway_filter_pipeline_stage_latency",
+			Description: fm.stageLatency.Description(),
+			TagKeys:     stageKeys,
+			Measure:     &fm.stageLatency,
+			Aggregation: metrics.DefaultMillisecondsDistribution,
+		},
+	}
+}
+
+func (fm *filterMetrics) AfterStage(ctx context.Context, info *service.StageInfo) error {
+	status := stageStatusSucceeded
+	switch {
+	case info.Skipped:
+		status = stageStatusSkipped
+	case info.Err != nil:
+		status = stageStatusFailed
+	}
+	tags := map[metrics.Key]string{
+		fm.pipelineKey: info.Pipeline,
+		fm.stageKey:    info.Stage,
+		fm.statusKey:   status,
+	}
+
+	latencyMillis := float64(info.EndTime.Sub(info.StartTime)) / float64(time.Millisecond)
+
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	fm.ms = append(
+		fm.ms,
+		metrics.MeasurementWithTags{
+			Measurement: fm.stageTotal.M(1),
+			Tags:        tags,
+		},
+		metrics.MeasurementWithTags{
+			Measurement: fm.stageLatency.M(latencyMillis),
+			Tags:        tags,
+		},
+	)
+
+	return nil
+}
@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package filter provides functions for filter gateway stats
+package filter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/gateway/filter/service"
+)
+
+func Test_filterMetrics_AfterStage(t *testing.T) {
+	type args struct {
+		infos []*service.StageInfo
+	}
+	type want struct {
+		statuses []string
+	}
+	type test struct {
+		name string
+		args args
+		want want
+	}
+	now := time.Now()
+	tests := []test{
+		{
+			name: "return the stage total and latency measurements for each stage status",
+			args: args{
+				infos: []*service.StageInfo{
+					{
+						Pipeline:  "search",
+						Stage:     "normalizer",
+						StartTime: now,
+						EndTime:   now.Add(time.Millisecond),
+					},
+					{
+						Pipeline:  "search",
+						Stage:     "pca",
+						StartTime: now,
+						EndTime:   now.Add(time.Millisecond),
+						Err:       errors.New("stage error"),
+						Skipped:   true,
+					},
+					{
+						Pipeline:  "search",
+						Stage:     "quantizer",
+						StartTime: now,
+						EndTime:   now.Add(time.Millisecond),
+						Err:       errors.New("stage error"),
+					},
+				},
+			},
+			want: want{
+				statuses: []string{
+					stageStatusSucceeded,
+					stageStatusSucceeded,
+					stageStatusSkipped,
+					stageStatusSkipped,
+					stageStatusFailed,
+					stageStatusFailed,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			m, err := New()
+			if err != nil {
+				tt.Fatal(err)
+			}
+			fm := m.(*filterMetrics)
+			for _, info := range test.args.infos {
+				if err := m.AfterStage(context.Background(), info); err != nil {
+					tt.Fatal(err)
+				}
+			}
+			got, err := m.MeasurementWithTags(context.Background())
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if len(got) != len(test.want.statuses) {
+				tt.Fatalf("got: %d,\n\t\t\t\twant: %d", len(got), len(test.want.statuses))
+			}
+			for i, mwt := range got {
+				if status := mwt.Tags[fm.statusKey]; status != test.want.statuses[i] {
+					tt.Errorf("got_status: %s,\n\t\t\t\twant: %s", status, test.want.statuses[i])
+				}
+			}
+			if got, err = m.MeasurementWithTags(context.Background()); err != nil || len(got) != 0 {
+				tt.Errorf("got: %d measurements after flush, err: %v", len(got), err)
+			}
+			if len(m.View()) != 2 {
+				tt.Errorf("got_views: %d,\n\t\t\t\twant: %d", len(m.View()), 2)
+			}
+		})
+	}
+}
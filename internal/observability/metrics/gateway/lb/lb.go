@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package lb provides functions for lb gateway stats
+package lb
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/internal/observability/metrics"
+	"github.com/vdaas/vald/pkg/gateway/lb/service"
+)
+
+type searchCacheMetrics struct {
+	cache      service.SearchCache
+	hitCount   metrics.Int64Measure
+	missCount  metrics.Int64Measure
+	entryCount metrics.Int64Measure
+}
+
+func New(c service.SearchCache) metrics.Metric {
+	return &searchCacheMetrics{
+		cache: c,
+		hitCount: *metrics.Int64(
+			metrics.ValdOrg+"/gateway/lb/search_cache_hit_count",
+			"search cache hit count",
+			metrics.UnitDimensionless),
+		missCount: *metrics.Int64(
+			metrics.ValdOrg+"/gateway/lb/search_cache_miss_count",
+			"search cache miss count",
+			metrics.UnitDimensionless),
+		entryCount: *metrics.Int64(
+			metrics.ValdOrg+"/gateway/lb/search_cache_entry_count",
+			"number of cached search results",
+			metrics.UnitDimensionless),
+	}
+}
+
+func (s *searchCacheMetrics) Measurement(ctx context.Context) ([]metrics.Measurement, error) {
+	return []metrics.Measurement{
+		s.hitCount.M(int64(s.cache.Hits())),
+		s.missCount.M(int64(s.cache.Misses())),
+		s.entryCount.M(int64(s.cache.Len())),
+	}, nil
+}
+
+func (s *searchCacheMetrics) MeasurementWithTags(ctx context.Context) ([]metrics.MeasurementWithTags, error) {
+	return []metrics.MeasurementWithTags{}, nil
+}
+
+func (s *searchCacheMetrics) View() []*metrics.View {
+	return []*metrics.View{
+		{
+			Name:        "gateway_lb_search_cache_hit_count",
+			Description: s.hitCount.Description(),
+			Measure:     &s.hitCount,
+			Aggregation: metrics.LastValue(),
+		},
+		{
+			Name:        "gateway_lb_search_cache_miss_count",
+			Description: s.missCount.Description(),
+			Measure:     &s.missCount,
+			Aggregation: metrics.LastValue(),
+		},
+		{
+			Name:        "gateway_lb_search_cache_entry_count",
+			Description: s.entryCount.Description(),
+			Measure:     &s.entryCount,
+			Aggregation: metrics.LastValue(),
+		},
+	}
+}
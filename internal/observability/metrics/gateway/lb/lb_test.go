@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package lb provides functions for lb gateway stats
+package lb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/observability/metrics"
+	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/gateway/lb/service"
+)
+
+func Test_searchCacheMetrics_Measurement(t *testing.T) {
+	type want struct {
+		count int
+	}
+	type test struct {
+		name       string
+		beforeFunc func(service.SearchCache)
+		want       want
+	}
+	tests := []test{
+		{
+			name: "return the hit, miss and entry count measurements",
+			beforeFunc: func(c service.SearchCache) {
+				c.Get(new(payload.Search_Request))
+			},
+			want: want{
+				count: 3,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent(),
+				goleak.IgnoreTopFunction("github.com/kpango/fastime.(*Fastime).StartTimerD.func1"))
+			c, err := service.NewSearchCache()
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if test.beforeFunc != nil {
+				test.beforeFunc(c)
+			}
+			m := New(c)
+			got, err := m.Measurement(context.Background())
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if len(got) != test.want.count {
+				tt.Errorf("got: %d,\n\t\t\t\twant: %d", len(got), test.want.count)
+			}
+			if cnt := metrics.MeasurementsCount(m); cnt != test.want.count {
+				tt.Errorf("got_measurements_count: %d,\n\t\t\t\twant: %d", cnt, test.want.count)
+			}
+			if len(m.View()) != test.want.count {
+				tt.Errorf("got_views: %d,\n\t\t\t\twant: %d", len(m.View()), test.want.count)
+			}
+		})
+	}
+}
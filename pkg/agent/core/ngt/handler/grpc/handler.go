@@ -1820,3 +1820,21 @@ func (s *server) IndexInfo(ctx context.Context, _ *payload.Empty) (res *payload.
 		Saving:      s.ngt.IsSaving(),
 	}, nil
 }
+
+func (s *server) IndexUUIDs(ctx context.Context, req *payload.Info_Index_UUIDs_Request) (res *payload.Info_Index_UUIDs, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+".IndexUUIDs")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	limit := int(req.GetLimit())
+	uuids := s.ngt.ListUUIDs(ctx, limit, req.GetOrdered(), req.GetCursor())
+	res = &payload.Info_Index_UUIDs{
+		Uuids: uuids,
+	}
+	if req.GetOrdered() && limit > 0 && len(uuids) >= limit {
+		res.NextCursor = uuids[len(uuids)-1]
+	}
+	return res, nil
+}
@@ -128,6 +128,7 @@ func (b *bidi) DeleteInverse(val uint32) (key string, ok bool) {
 }
 
 // Range retrieves all set keys and values and calls the callback function f.
+// The ranging of each shard stops when f returns false.
 func (b *bidi) Range(ctx context.Context, f func(string, uint32) bool) {
 	var wg sync.WaitGroup
 	for i := range b.uo {
@@ -135,7 +136,9 @@ func (b *bidi) Range(ctx context.Context, f func(string, uint32) bool) {
 		wg.Add(1)
 		b.eg.Go(safety.RecoverFunc(func() (err error) {
 			b.uo[idx].Range(func(uuid string, oid uint32) bool {
-				f(uuid, oid)
+				if !f(uuid, oid) {
+					return false
+				}
 				select {
 				case <-ctx.Done():
 					return false
@@ -18,6 +18,7 @@ package kvs
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"reflect"
 	"runtime"
@@ -1565,6 +1566,47 @@ func Test_bidi_Range(t *testing.T) {
 				},
 			}
 		}(),
+		func() test {
+			fields := fields{
+				l: 0,
+			}
+			for i := 0; i < slen; i++ {
+				fields.ou[i] = new(ou)
+				fields.uo[i] = new(uo)
+			}
+
+			const size = slen * 10
+			var called uint64
+
+			return test{
+				name: "range stops each shard when the callback returns false",
+				args: args{
+					ctx: context.Background(),
+					f: func(s string, u uint32) bool {
+						atomic.AddUint64(&called, 1)
+						return false
+					},
+				},
+				beforeFunc: func(a args, bm BidiMap) {
+					for i := 0; i < size; i++ {
+						bm.Set(fmt.Sprintf("%d-c85f-11ea-87d0", i), uint32(i))
+					}
+				},
+				checkFunc: func(w want, bm *bidi) error {
+					if got := atomic.LoadUint64(&called); got == 0 || got > slen {
+						return errors.Errorf("called got: \"%d\",\n\t\t\t\twant: at most \"%d\"", got, slen)
+					}
+					if want, got := w.wantLen, atomic.LoadUint64(&bm.l); want != got {
+						return errors.Errorf("l got: \"%d\",\n\t\t\t\tl want: \"%d\"", got, want)
+					}
+					return nil
+				},
+				fields: fields,
+				want: want{
+					wantLen: size,
+				},
+			}
+		}(),
 	}
 
 	for _, tc := range tests {
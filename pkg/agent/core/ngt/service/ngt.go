@@ -18,6 +18,7 @@
 package service
 
 import (
+	"container/heap"
 	"context"
 	"encoding/gob"
 	"io/fs"
@@ -26,6 +27,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -72,6 +74,7 @@ type NGT interface {
 	NumberOfCreateIndexExecution() uint64
 	NumberOfProactiveGCExecution() uint64
 	UUIDs(context.Context) (uuids []string)
+	ListUUIDs(ctx context.Context, limit int, ordered bool, cursor string) (uuids []string)
 	DeleteVQueueBufferLen() uint64
 	InsertVQueueBufferLen() uint64
 	GetDimensionSize() int
@@ -778,6 +781,24 @@ func (n *ngt) saveIndex(ctx context.Context) (err error) {
 	defer n.gc()
 	defer n.saving.Store(false)
 
+	// the save marker tells the sidecar not to back up the files until the index of the next generation is saved.
+	markerPath := filepath.Join(n.path, metadata.SaveMarkerFileName)
+	var generation uint64
+	marker, err := metadata.LoadSaveMarker(markerPath)
+	if err != nil && !errors.Is(err, errors.ErrSaveMarkerNotFound) {
+		log.Warnf("cannot read save marker from %s: %s", metadata.SaveMarkerFileName, err)
+	}
+	if marker != nil {
+		generation = marker.Generation
+	}
+	err = metadata.StoreSaveMarker(markerPath, &metadata.SaveMarker{
+		Generation: generation,
+		Saving:     true,
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Debug("cleanup invalid index started")
 	n.removeInvalidIndex(ctx)
 	log.Debug("cleanup invalid index finished")
@@ -875,7 +896,7 @@ func (n *ngt) saveIndex(ctx context.Context) (err error) {
 		return err
 	}
 
-	return metadata.Store(
+	err = metadata.Store(
 		filepath.Join(n.path, metadata.AgentMetadataFileName),
 		&metadata.Metadata{
 			IsInvalid: false,
@@ -884,6 +905,14 @@ func (n *ngt) saveIndex(ctx context.Context) (err error) {
 			},
 		},
 	)
+	if err != nil {
+		return err
+	}
+
+	return metadata.StoreSaveMarker(markerPath, &metadata.SaveMarker{
+		Generation: generation + 1,
+		SavedAt:    time.Now(),
+	})
 }
 
 func (n *ngt) CreateAndSaveIndex(ctx context.Context, poolSize uint32) (err error) {
@@ -979,6 +1008,67 @@ func (n *ngt) UUIDs(ctx context.Context) (uuids []string) {
 	return uuids
 }
 
+// ListUUIDs returns at most limit UUIDs, and all the UUIDs when the limit is 0.
+// When ordered is true, the UUIDs after the cursor are returned in the lexicographical order to page through them,
+// and only the limit UUIDs are held while ranging. Otherwise the ranging stops once the limit is reached.
+func (n *ngt) ListUUIDs(ctx context.Context, limit int, ordered bool, cursor string) (uuids []string) {
+	if limit <= 0 && !ordered {
+		return n.UUIDs(ctx)
+	}
+	var mu sync.Mutex
+	if !ordered {
+		uuids = make([]string, 0, limit)
+		n.kvs.Range(ctx, func(uuid string, oid uint32) bool {
+			mu.Lock()
+			defer mu.Unlock()
+			if len(uuids) >= limit {
+				return false
+			}
+			uuids = append(uuids, uuid)
+			return true
+		})
+		return uuids
+	}
+
+	h := new(uuidHeap)
+	n.kvs.Range(ctx, func(uuid string, oid uint32) bool {
+		if uuid <= cursor {
+			return true
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case limit <= 0 || h.Len() < limit:
+			heap.Push(h, uuid)
+		case uuid < (*h)[0]:
+			(*h)[0] = uuid
+			heap.Fix(h, 0)
+		}
+		return true
+	})
+	uuids = []string(*h)
+	sort.Strings(uuids)
+	return uuids
+}
+
+// uuidHeap is the max heap of the UUIDs to keep the smallest UUIDs.
+type uuidHeap []string
+
+func (h uuidHeap) Len() int           { return len(h) }
+func (h uuidHeap) Less(i, j int) bool { return h[i] > h[j] }
+func (h uuidHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *uuidHeap) Push(x interface{}) {
+	*h = append(*h, x.(string))
+}
+
+func (h *uuidHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
 func (n *ngt) NumberOfCreateIndexExecution() uint64 {
 	return atomic.LoadUint64(&n.nocie)
 }
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package metadata provides agent metadata structs and info.
+package metadata
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+)
+
+const (
+	// SaveMarkerFileName is the name of the marker file which the agent publishes when it starts and finishes saving the index.
+	SaveMarkerFileName = "save_marker.json"
+)
+
+// SaveMarker represents the handshake between the agent and the sidecar.
+// The files in the index directory are consistent with the generation only while Saving is false.
+type SaveMarker struct {
+	// Generation is incremented every time the agent finishes saving the index.
+	Generation uint64 `json:"generation" yaml:"generation"`
+	// Saving represents the agent is saving the index of the next generation.
+	Saving bool `json:"saving" yaml:"saving"`
+	// SavedAt represents the time when the index of the generation is saved.
+	SavedAt time.Time `json:"saved_at,omitempty" yaml:"saved_at"`
+}
+
+// LoadSaveMarker loads the save marker of the path.
+func LoadSaveMarker(path string) (*SaveMarker, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.ErrSaveMarkerNotFound
+		}
+		return nil, err
+	}
+	m := new(SaveMarker)
+	err = json.Unmarshal(data, m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StoreSaveMarker stores the save marker to the path atomically by renaming the temporary file,
+// so that the sidecar never reads the partially written marker.
+func StoreSaveMarker(path string, m *SaveMarker) (err error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	f, err := file.Open(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); cerr != nil {
+		err = errors.Wrap(err, cerr.Error())
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package metadata provides agent metadata structs and info.
+package metadata
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func TestStoreSaveMarker(t *testing.T) {
+	t.Parallel()
+	savedAt := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	type test struct {
+		name    string
+		markers []*SaveMarker
+		want    *SaveMarker
+	}
+	tests := []test{
+		{
+			name: "stores the marker of the saving index",
+			markers: []*SaveMarker{
+				{
+					Generation: 1,
+					Saving:     true,
+				},
+			},
+			want: &SaveMarker{
+				Generation: 1,
+				Saving:     true,
+			},
+		},
+		{
+			name: "replaces the marker when the index is saved",
+			markers: []*SaveMarker{
+				{
+					Generation: 1,
+					Saving:     true,
+				},
+				{
+					Generation: 2,
+					SavedAt:    savedAt,
+				},
+			},
+			want: &SaveMarker{
+				Generation: 2,
+				SavedAt:    savedAt,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			dir := tt.TempDir()
+			path := filepath.Join(dir, SaveMarkerFileName)
+			for _, m := range test.markers {
+				if err := StoreSaveMarker(path, m); err != nil {
+					tt.Fatal(err)
+				}
+			}
+			got, err := LoadSaveMarker(path)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("got: %#v, want: %#v", got, test.want)
+			}
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if len(entries) != 1 {
+				tt.Errorf("the temporary file is left: %v", entries)
+			}
+		})
+	}
+}
+
+func TestLoadSaveMarker(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	if _, err := LoadSaveMarker(filepath.Join(dir, SaveMarkerFileName)); !errors.Is(err, errors.ErrSaveMarkerNotFound) {
+		t.Errorf("error = %v, want %v", err, errors.ErrSaveMarkerNotFound)
+	}
+
+	path := filepath.Join(dir, "broken.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSaveMarker(path); err == nil {
+		t.Error("the broken marker must not be loaded")
+	}
+}
@@ -18,12 +18,21 @@
 package grpc
 
 import (
+	"context"
+
 	"github.com/vdaas/vald/apis/grpc/v1/agent/sidecar"
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/observer"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
 )
 
 type server struct {
 	so observer.StorageObserver
+	st storage.Storage
 	sidecar.UnimplementedSidecarServer
 }
 
@@ -35,3 +44,107 @@ func New(opts ...Option) sidecar.SidecarServer {
 	}
 	return s
 }
+
+func (s *server) Backup(ctx context.Context, req *payload.Backup_Request) (res *payload.Backup_Info, err error) {
+	ctx, span := trace.StartSpan(ctx, "vald/agent-sidecar.Backup")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	bi, err := s.so.Backup(ctx, req.GetLabel())
+	if err != nil {
+		if span != nil {
+			span.SetStatus(trace.StatusCodeInternal(err.Error()))
+		}
+		return nil, status.WrapWithInternal("Backup API failed to back up the index", err, req, info.Get())
+	}
+	return &payload.Backup_Info{
+		Version:    bi.Version,
+		Generation: bi.Generation,
+		Label:      bi.Label,
+		CreatedAt:  bi.StartTime.UnixNano(),
+		Bytes:      bi.Bytes,
+	}, nil
+}
+
+func (s *server) ListBackups(ctx context.Context, _ *payload.Empty) (res *payload.Backup_Infos, err error) {
+	ctx, span := trace.StartSpan(ctx, "vald/agent-sidecar.ListBackups")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	// the manifests are returned in order from the newest, and the nil manifest represents the unversioned backup without the manifest.
+	ms, err := s.st.Manifests(ctx, "")
+	if err != nil {
+		if span != nil {
+			span.SetStatus(trace.StatusCodeInternal(err.Error()))
+		}
+		return nil, status.WrapWithInternal("ListBackups API failed to list the backups", err, info.Get())
+	}
+	res = &payload.Backup_Infos{
+		Infos: make([]*payload.Backup_Info, 0, len(ms)),
+	}
+	for _, m := range ms {
+		if m == nil {
+			continue
+		}
+		res.Infos = append(res.Infos, &payload.Backup_Info{
+			Version:     m.Version,
+			Generation:  m.Generation,
+			Label:       m.Label,
+			CreatedAt:   m.CreatedAt.UnixNano(),
+			Bytes:       m.Bytes,
+			IndexCount:  m.IndexCount,
+			Incremental: m.Incremental,
+		})
+	}
+	return res, nil
+}
+
+func (s *server) Restore(ctx context.Context, req *payload.Backup_RestoreRequest) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(ctx, "vald/agent-sidecar.Restore")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	err = s.so.Restore(ctx, req.GetVersion())
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.ErrBackupRestorerNotFound):
+			err = status.WrapWithUnimplemented("Restore API restorer is not configured", err, req, info.Get())
+			if span != nil {
+				span.SetStatus(trace.StatusCodeUnimplemented(err.Error()))
+			}
+		case errors.Is(err, errors.ErrAgentRunning):
+			err = status.WrapWithFailedPrecondition("Restore API agent is running", err, req, info.Get())
+			if span != nil {
+				span.SetStatus(trace.StatusCodeFailedPrecondition(err.Error()))
+			}
+		case len(req.GetVersion()) != 0 && errors.Is(err, errors.ErrBackupVersionNotFound(req.GetVersion())):
+			err = status.WrapWithNotFound("Restore API backup version "+req.GetVersion()+" not found", err, req, info.Get())
+			if span != nil {
+				span.SetStatus(trace.StatusCodeNotFound(err.Error()))
+			}
+		default:
+			err = status.WrapWithInternal("Restore API failed to restore the index", err, req, info.Get())
+			if span != nil {
+				span.SetStatus(trace.StatusCodeInternal(err.Error()))
+			}
+		}
+		return nil, err
+	}
+	return new(payload.Empty), nil
+}
+
+func (s *server) Status(ctx context.Context, _ *payload.Empty) (res *payload.Backup_Status, err error) {
+	_, span := trace.StartSpan(ctx, "vald/agent-sidecar.Status")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return s.so.Status(), nil
+}
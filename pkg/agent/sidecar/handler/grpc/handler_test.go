@@ -18,14 +18,27 @@
 package grpc
 
 import (
+	"context"
+	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/vdaas/vald/apis/grpc/v1/agent/sidecar"
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
 	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/observer"
 )
 
+func TestMain(m *testing.M) {
+	info.Init("")
+	os.Exit(m.Run())
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -98,3 +111,177 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+type storageObserverMock struct {
+	observer.StorageObserver
+	BackupFunc  func(ctx context.Context, label string) (*observer.BackupInfo, error)
+	RestoreFunc func(ctx context.Context, version string) error
+	StatusFunc  func() *payload.Backup_Status
+}
+
+func (m *storageObserverMock) Backup(ctx context.Context, label string) (*observer.BackupInfo, error) {
+	return m.BackupFunc(ctx, label)
+}
+
+func (m *storageObserverMock) Restore(ctx context.Context, version string) error {
+	return m.RestoreFunc(ctx, version)
+}
+
+func (m *storageObserverMock) Status() *payload.Backup_Status {
+	return m.StatusFunc()
+}
+
+func Test_server_Backup(t *testing.T) {
+	t.Parallel()
+	start := time.Unix(0, 100)
+	type want struct {
+		want *payload.Backup_Info
+		code codes.Code
+	}
+	type test struct {
+		name string
+		so   observer.StorageObserver
+		want want
+	}
+	tests := []test{
+		{
+			name: "returns the backup information with the label",
+			so: &storageObserverMock{
+				BackupFunc: func(_ context.Context, label string) (*observer.BackupInfo, error) {
+					return &observer.BackupInfo{
+						StartTime:  start,
+						Bytes:      10,
+						Version:    "v1",
+						Generation: 1,
+						Label:      label,
+					}, nil
+				},
+			},
+			want: want{
+				want: &payload.Backup_Info{
+					Version:    "v1",
+					Generation: 1,
+					Label:      "label",
+					CreatedAt:  100,
+					Bytes:      10,
+				},
+				code: codes.OK,
+			},
+		},
+		{
+			name: "returns internal error when the backup fails",
+			so: &storageObserverMock{
+				BackupFunc: func(context.Context, string) (*observer.BackupInfo, error) {
+					return nil, errors.New("failed")
+				},
+			},
+			want: want{
+				code: codes.Internal,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			s := New(WithStorageObserver(test.so))
+			got, err := s.Backup(context.Background(), &payload.Backup_Request{Label: "label"})
+			if err != nil {
+				st, _ := status.FromError(err)
+				if st.Code() != test.want.code {
+					tt.Errorf("code = %v, want %v", st.Code(), test.want.code)
+				}
+				return
+			}
+			if test.want.code != codes.OK {
+				tt.Errorf("code = %v, want %v", codes.OK, test.want.code)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: %v, want: %v", got, test.want.want)
+			}
+		})
+	}
+}
+
+func Test_server_Restore(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name    string
+		version string
+		err     error
+		want    codes.Code
+	}
+	tests := []test{
+		{
+			name:    "restores the version",
+			version: "v1",
+			want:    codes.OK,
+		},
+		{
+			name:    "returns not found error when the version is not found",
+			version: "v1",
+			err:     errors.ErrBackupVersionNotFound("v1"),
+			want:    codes.NotFound,
+		},
+		{
+			name: "returns failed precondition error when the agent is running",
+			err:  errors.ErrAgentRunning,
+			want: codes.FailedPrecondition,
+		},
+		{
+			name: "returns unimplemented error when the restorer is not configured",
+			err:  errors.ErrBackupRestorerNotFound,
+			want: codes.Unimplemented,
+		},
+		{
+			name: "returns internal error when the restore fails",
+			err:  errors.ErrBackupFileNotFound("grp"),
+			want: codes.Internal,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			var version string
+			s := New(WithStorageObserver(&storageObserverMock{
+				RestoreFunc: func(_ context.Context, v string) error {
+					version = v
+					return test.err
+				},
+			}))
+			_, err := s.Restore(context.Background(), &payload.Backup_RestoreRequest{Version: test.version})
+			st, _ := status.FromError(err)
+			if st.Code() != test.want {
+				tt.Errorf("code = %v, want %v", st.Code(), test.want)
+			}
+			if version != test.version {
+				tt.Errorf("version = %s, want %s", version, test.version)
+			}
+		})
+	}
+}
+
+func Test_server_Status(t *testing.T) {
+	t.Parallel()
+	want := &payload.Backup_Status{
+		LastSuccess: &payload.Backup_Result{
+			Version:  "v1",
+			Duration: 10,
+		},
+	}
+	s := New(WithStorageObserver(&storageObserverMock{
+		StatusFunc: func() *payload.Backup_Status {
+			return want
+		},
+	}))
+	got, err := s.Status(context.Background(), new(payload.Empty))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
@@ -17,7 +17,10 @@
 // Package grpc provides grpc server logic
 package grpc
 
-import "github.com/vdaas/vald/pkg/agent/sidecar/service/observer"
+import (
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/observer"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
 
 type Option func(*server)
 
@@ -30,3 +33,12 @@ func WithStorageObserver(so observer.StorageObserver) Option {
 		}
 	}
 }
+
+// WithBlobStorage returns the option to set the blob storage to list the backups.
+func WithBlobStorage(st storage.Storage) Option {
+	return func(s *server) {
+		if st != nil {
+			s.st = st
+		}
+	}
+}
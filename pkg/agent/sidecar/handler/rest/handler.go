@@ -21,12 +21,17 @@ import (
 	"net/http"
 
 	"github.com/vdaas/vald/apis/grpc/v1/agent/sidecar"
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/internal/net/http/dump"
 	"github.com/vdaas/vald/internal/net/http/json"
 )
 
 type Handler interface {
 	Index(w http.ResponseWriter, r *http.Request) (int, error)
+	Backup(w http.ResponseWriter, r *http.Request) (int, error)
+	ListBackups(w http.ResponseWriter, r *http.Request) (int, error)
+	Restore(w http.ResponseWriter, r *http.Request) (int, error)
+	Status(w http.ResponseWriter, r *http.Request) (int, error)
 }
 
 type handler struct {
@@ -48,3 +53,31 @@ func (h *handler) Index(w http.ResponseWriter, r *http.Request) (int, error) {
 		return dump.Request(nil, data, r)
 	})
 }
+
+func (h *handler) Backup(w http.ResponseWriter, r *http.Request) (code int, err error) {
+	var req *payload.Backup_Request
+	return json.Handler(w, r, &req, func() (interface{}, error) {
+		return h.sc.Backup(r.Context(), req)
+	})
+}
+
+func (h *handler) ListBackups(w http.ResponseWriter, r *http.Request) (code int, err error) {
+	var req *payload.Empty
+	return json.Handler(w, r, &req, func() (interface{}, error) {
+		return h.sc.ListBackups(r.Context(), req)
+	})
+}
+
+func (h *handler) Restore(w http.ResponseWriter, r *http.Request) (code int, err error) {
+	var req *payload.Backup_RestoreRequest
+	return json.Handler(w, r, &req, func() (interface{}, error) {
+		return h.sc.Restore(r.Context(), req)
+	})
+}
+
+func (h *handler) Status(w http.ResponseWriter, r *http.Request) (code int, err error) {
+	var req *payload.Empty
+	return json.Handler(w, r, &req, func() (interface{}, error) {
+		return h.sc.Status(r.Context(), req)
+	})
+}
@@ -49,5 +49,37 @@ func New(opts ...Option) http.Handler {
 				"/",
 				h.Index,
 			},
+			{
+				"Backup",
+				[]string{
+					http.MethodPost,
+				},
+				"/backup",
+				h.Backup,
+			},
+			{
+				"ListBackups",
+				[]string{
+					http.MethodGet,
+				},
+				"/backups",
+				h.ListBackups,
+			},
+			{
+				"Restore",
+				[]string{
+					http.MethodPost,
+				},
+				"/restore",
+				h.Restore,
+			},
+			{
+				"Status",
+				[]string{
+					http.MethodGet,
+				},
+				"/backup/status",
+				h.Status,
+			},
 		}...))
 }
@@ -0,0 +1,782 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/db/storage/blob/local"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/observer"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/restorer"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+const (
+	testBucket   = "vald"
+	testFilename = "vald-agent-ngt-0"
+)
+
+// harness runs the observer and the restorer against the blob storage on the local filesystem.
+type harness struct {
+	root        string
+	src         string
+	incremental bool
+	st          storage.Storage
+}
+
+func newHarness(t *testing.T, algorithm string, versioning, incremental bool, opts ...storage.Option) *harness {
+	t.Helper()
+	h := &harness{
+		root:        t.TempDir(),
+		src:         t.TempDir(),
+		incremental: incremental,
+	}
+	h.st = newStorage(t, h.root, algorithm, versioning, opts...)
+	return h
+}
+
+// newStorage returns the started storage on the local blob storage of the root directory.
+func newStorage(t *testing.T, root, algorithm string, versioning bool, opts ...storage.Option) storage.Storage {
+	t.Helper()
+	st, err := storage.New(
+		append([]storage.Option{
+			storage.WithType("local"),
+			storage.WithBucketName(testBucket),
+			storage.WithFilename(testFilename),
+			storage.WithFilenameSuffix(".tar"),
+			storage.WithCompressAlgorithm(algorithm),
+			storage.WithVersioning(versioning),
+			storage.WithLocalOpts(
+				local.WithDir("file://" + root),
+			),
+		}, opts...)...,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err = st.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		st.Stop(ctx)
+	})
+	return st
+}
+
+// backup writes the files into the source directory and backs it up.
+func (h *harness) backup(t *testing.T, files map[string]string) {
+	t.Helper()
+	h.write(t, files)
+
+	o, err := observer.New(
+		observer.WithDir(h.src),
+		observer.WithBlobStorage(h.st),
+		observer.WithWatch(false),
+		observer.WithTicker(false),
+		observer.WithIncremental(h.incremental),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = o.Backup(context.Background(), ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// write replaces the files in the source directory.
+func (h *harness) write(t *testing.T, files map[string]string) {
+	t.Helper()
+	if err := os.RemoveAll(h.src); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range files {
+		path := filepath.Join(h.src, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// restore restores the version into a new directory and returns the restored files.
+func (h *harness) restore(t *testing.T, version string) (map[string]string, error) {
+	t.Helper()
+	dst := t.TempDir()
+	r, err := restorer.New(
+		restorer.WithDir(dst),
+		restorer.WithBlobStorage(h.st),
+		restorer.WithVersion(version),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = r.Restore(context.Background()); err != nil {
+		return nil, err
+	}
+	return readFiles(t, dst), nil
+}
+
+// readFiles returns the files in the directory.
+func readFiles(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(data)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// path returns the path of the object in the local blob storage.
+func (h *harness) path(key string) string {
+	return filepath.Join(h.root, testBucket, filepath.FromSlash(key))
+}
+
+// latest returns the manifest of the latest backup version.
+func (h *harness) latest(t *testing.T) *storage.Manifest {
+	t.Helper()
+	ms, err := h.st.Versions(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) == 0 {
+		t.Fatal("no backup version is found")
+	}
+	return ms[0]
+}
+
+func chunkKey(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return testFilename + "/chunks/" + hex.EncodeToString(sum[:])
+}
+
+var (
+	oldFiles = map[string]string{
+		"metadata.json": `{"is_invalid":false,"ngt":{"index_count":2}}`,
+		"grp":           "old graph",
+		"obj":           "old objects",
+		"tre/tree":      "tree",
+	}
+	newFiles = map[string]string{
+		"metadata.json": `{"is_invalid":false,"ngt":{"index_count":3}}`,
+		"grp":           "new graph",
+		"obj":           "new objects",
+		"tre/tree":      "tree",
+	}
+)
+
+func TestBackupRestore(t *testing.T) {
+	type test struct {
+		name        string
+		algorithm   string
+		versioning  bool
+		incremental bool
+	}
+	var tests []test
+	for _, algorithm := range []string{"gob", "gzip", "lz4", "zstd"} {
+		tests = append(tests,
+			test{
+				name:      algorithm + " unversioned archive",
+				algorithm: algorithm,
+			},
+			test{
+				name:       algorithm + " versioned archive",
+				algorithm:  algorithm,
+				versioning: true,
+			},
+			test{
+				name:        algorithm + " incremental",
+				algorithm:   algorithm,
+				versioning:  true,
+				incremental: true,
+			},
+		)
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			h := newHarness(tt, test.algorithm, test.versioning, test.incremental)
+			h.backup(tt, oldFiles)
+			h.backup(tt, newFiles)
+
+			got, err := h.restore(tt, "")
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, newFiles) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, newFiles)
+			}
+		})
+	}
+}
+
+func TestBackupRestore_Failure(t *testing.T) {
+	type test struct {
+		name        string
+		incremental bool
+		version     func(*testing.T, *harness) string
+		inject      func(*testing.T, *harness)
+		want        map[string]string
+		wantErr     bool
+	}
+	tests := []test{
+		{
+			name: "fall back to the previous version when the latest archive is truncated",
+			inject: func(t *testing.T, h *harness) {
+				t.Helper()
+				if err := os.Truncate(h.path(h.latest(t).Key), 10); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: oldFiles,
+		},
+		{
+			name: "fall back to the previous version when the latest archive is deleted",
+			inject: func(t *testing.T, h *harness) {
+				t.Helper()
+				if err := os.Remove(h.path(h.latest(t).Key)); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: oldFiles,
+		},
+		{
+			name: "fall back to the previous version when the latest manifest is broken",
+			inject: func(t *testing.T, h *harness) {
+				t.Helper()
+				path := h.path(testFilename + "/" + h.latest(t).Version + ".manifest.json")
+				if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: oldFiles,
+		},
+		{
+			name:        "fall back to the previous version when a chunk of the latest version is deleted",
+			incremental: true,
+			inject: func(t *testing.T, h *harness) {
+				t.Helper()
+				if err := os.Remove(h.path(chunkKey(newFiles["grp"]))); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: oldFiles,
+		},
+		{
+			name:        "fall back to the previous version when a chunk of the latest version is corrupted",
+			incremental: true,
+			inject: func(t *testing.T, h *harness) {
+				t.Helper()
+				if err := os.WriteFile(h.path(chunkKey(newFiles["obj"])), []byte("corrupted"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: oldFiles,
+		},
+		{
+			name: "return error when all versions are corrupted",
+			inject: func(t *testing.T, h *harness) {
+				t.Helper()
+				ms, err := h.st.Versions(context.Background())
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, m := range ms {
+					if err := os.Truncate(h.path(m.Key), 10); err != nil {
+						t.Fatal(err)
+					}
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name:        "return error when the shared chunk is deleted",
+			incremental: true,
+			inject: func(t *testing.T, h *harness) {
+				t.Helper()
+				if err := os.Remove(h.path(chunkKey(newFiles["tre/tree"]))); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "restore the specified version",
+			version: func(t *testing.T, h *harness) string {
+				t.Helper()
+				ms, err := h.st.Versions(context.Background())
+				if err != nil {
+					t.Fatal(err)
+				}
+				return ms[len(ms)-1].Version
+			},
+			want: oldFiles,
+		},
+		{
+			name: "return error when the specified version does not exist",
+			version: func(*testing.T, *harness) string {
+				return "20060102T150405Z-00000000000000000001"
+			},
+			wantErr: true,
+		},
+		{
+			name: "return error when the specified version is corrupted",
+			version: func(t *testing.T, h *harness) string {
+				t.Helper()
+				return h.latest(t).Version
+			},
+			inject: func(t *testing.T, h *harness) {
+				t.Helper()
+				if err := os.Truncate(h.path(h.latest(t).Key), 10); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			h := newHarness(tt, "gzip", true, test.incremental)
+			h.backup(tt, oldFiles)
+			h.backup(tt, newFiles)
+
+			var version string
+			if test.version != nil {
+				version = test.version(tt, h)
+			}
+			if test.inject != nil {
+				test.inject(tt, h)
+			}
+
+			got, err := h.restore(tt, version)
+			if (err != nil) != test.wantErr {
+				tt.Fatalf("got_error: %v, want_error: %v", err, test.wantErr)
+			}
+			if !test.wantErr && !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want)
+			}
+		})
+	}
+}
+
+func TestBackupRestore_Encryption(t *testing.T) {
+	key := func(id string, b byte) *config.EncryptionKey {
+		return &config.EncryptionKey{
+			ID:  id,
+			Key: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, 32)),
+		}
+	}
+
+	for _, incremental := range []bool{false, true} {
+		h := newHarness(t, "zstd", true, incremental,
+			storage.WithEncryption(true, "key-1"),
+			storage.WithEncryptionKeys(key("key-1", 1)),
+		)
+		h.backup(t, oldFiles)
+		old := h.latest(t)
+
+		// the key is rotated, and the old key is kept to restore the old backup.
+		h.st = newStorage(t, h.root, "zstd", true,
+			storage.WithEncryption(true, "key-2"),
+			storage.WithEncryptionKeys(key("key-1", 1), key("key-2", 2)),
+		)
+		h.backup(t, newFiles)
+		if id := h.latest(t).KeyID; id != "key-2" {
+			t.Errorf("incremental %v: got key id: %s, want: key-2", incremental, id)
+		}
+
+		for version, want := range map[string]map[string]string{
+			"":          newFiles,
+			old.Version: oldFiles,
+		} {
+			got, err := h.restore(t, version)
+			if err != nil {
+				t.Fatalf("incremental %v: %v", incremental, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("incremental %v: got: \"%#v\",\n\t\t\t\twant: \"%#v\"", incremental, got, want)
+			}
+		}
+
+		// the backup data is not readable without the key.
+		h.st = newStorage(t, h.root, "zstd", true,
+			storage.WithEncryptionKeys(key("key-2", 2)),
+		)
+		if _, err := h.restore(t, old.Version); err == nil {
+			t.Errorf("incremental %v: the old backup is restored without its key", incremental)
+		}
+	}
+}
+
+func TestBackupRestore_OnDemand(t *testing.T) {
+	for _, incremental := range []bool{false, true} {
+		incremental := incremental
+		name := "archive"
+		if incremental {
+			name = "incremental"
+		}
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			h := newHarness(t, "gob", true, incremental)
+
+			var versions []string
+			for _, b := range []struct {
+				label string
+				files map[string]string
+			}{
+				{"before", oldFiles},
+				{"after", newFiles},
+			} {
+				h.write(t, b.files)
+				o, err := observer.New(
+					observer.WithDir(h.src),
+					observer.WithBlobStorage(h.st),
+					observer.WithWatch(false),
+					observer.WithTicker(false),
+					observer.WithIncremental(incremental),
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+				bi, err := o.Backup(ctx, b.label)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if bi.Label != b.label || len(bi.Version) == 0 {
+					t.Fatalf("unexpected backup info: %#v", bi)
+				}
+				st := o.Status()
+				if st.GetRunning() || st.GetLastFailure() != nil {
+					t.Fatalf("unexpected status: %v", st)
+				}
+				if got := st.GetLastSuccess(); got.GetVersion() != bi.Version || got.GetLabel() != b.label ||
+					got.GetBytes() != bi.Bytes || got.GetDuration() <= 0 {
+					t.Fatalf("unexpected last success: %v", got)
+				}
+				versions = append(versions, bi.Version)
+			}
+
+			ms, err := h.st.Versions(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the versions are listed in order from the newest with the labels.
+			labels := make([]string, 0, len(ms))
+			for _, m := range ms {
+				labels = append(labels, m.Label)
+			}
+			if want := []string{"after", "before"}; !reflect.DeepEqual(labels, want) {
+				t.Fatalf("labels = %v, want %v", labels, want)
+			}
+
+			// the running agent directory has the newer files and a file which is not in the backup.
+			if err := os.WriteFile(filepath.Join(h.src, "stale"), []byte("stale"), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			r, err := restorer.New(
+				restorer.WithDir(h.src),
+				restorer.WithBlobStorage(h.st),
+				restorer.WithOverwrite(true),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			o, err := observer.New(
+				observer.WithDir(h.src),
+				observer.WithBlobStorage(h.st),
+				observer.WithWatch(false),
+				observer.WithTicker(false),
+				observer.WithRestorer(r),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := o.Restore(ctx, versions[0]); err != nil {
+				t.Fatal(err)
+			}
+			if got := readFiles(t, h.src); !reflect.DeepEqual(got, oldFiles) {
+				t.Errorf("restored files = %v, want %v", got, oldFiles)
+			}
+			if err := o.Restore(ctx, ""); err != nil {
+				t.Fatal(err)
+			}
+			if got := readFiles(t, h.src); !reflect.DeepEqual(got, newFiles) {
+				t.Errorf("restored files = %v, want %v", got, newFiles)
+			}
+
+			err = o.Restore(ctx, "unknown")
+			if want := errors.ErrBackupVersionNotFound("unknown"); !errors.Is(err, want) {
+				t.Errorf("error = %v, want %v", err, want)
+			}
+		})
+	}
+}
+
+func TestBackupRestore_OnDemandFailure(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t, "gob", true, false)
+
+	o, err := observer.New(
+		observer.WithDir(h.src),
+		observer.WithBlobStorage(h.st),
+		observer.WithWatch(false),
+		observer.WithTicker(false),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the backup fails because the directory is removed.
+	if err := os.RemoveAll(h.src); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.Backup(ctx, "failed"); err == nil {
+		t.Fatal("backup of the directory which does not exist must fail")
+	}
+	st := o.Status()
+	if st.GetRunning() || st.GetLastSuccess() != nil {
+		t.Errorf("unexpected status: %v", st)
+	}
+	if got := st.GetLastFailure(); got.GetLabel() != "failed" || len(got.GetError()) == 0 {
+		t.Errorf("unexpected last failure: %v", got)
+	}
+
+	if err := o.Restore(ctx, ""); !errors.Is(err, errors.ErrBackupRestorerNotFound) {
+		t.Errorf("error = %v, want %v", err, errors.ErrBackupRestorerNotFound)
+	}
+}
+
+// duringUpload runs the function when the observer starts to upload the backup, it emulates the agent which runs during the backup.
+type duringUpload struct {
+	storage.Storage
+	f func()
+}
+
+func (d *duringUpload) Chunks(ctx context.Context) (map[string]struct{}, error) {
+	d.f()
+	return d.Storage.Chunks(ctx)
+}
+
+func (d *duringUpload) VersionWriter(ctx context.Context) (io.WriteCloser, *storage.Manifest, error) {
+	d.f()
+	return d.Storage.VersionWriter(ctx)
+}
+
+func TestBackupRestore_Coordinated(t *testing.T) {
+	type test struct {
+		name        string
+		incremental bool
+		// marker is the save marker published before the backup.
+		marker *metadata.SaveMarker
+		// during runs in the source directory during the upload.
+		during  func(t *testing.T, dir string)
+		want    map[string]string
+		wantErr error
+	}
+	saved := &metadata.SaveMarker{
+		Generation: 1,
+	}
+	tests := []test{
+		{
+			name:   "backs up the saved generation",
+			marker: saved,
+			want:   oldFiles,
+		},
+		{
+			name:        "backs up the saved generation incrementally",
+			incremental: true,
+			marker:      saved,
+			want:        oldFiles,
+		},
+		{
+			name: "does not back up while the agent is saving",
+			marker: &metadata.SaveMarker{
+				Generation: 1,
+				Saving:     true,
+			},
+			wantErr: errors.ErrAgentIndexSaving,
+		},
+		{
+			name:    "does not back up before the agent publishes the save marker",
+			wantErr: errors.ErrSaveMarkerNotFound,
+		},
+		{
+			name:   "backs up the snapshot when the file is replaced during the upload",
+			marker: saved,
+			during: func(t *testing.T, dir string) {
+				t.Helper()
+				tmp := filepath.Join(dir, "grp.tmp")
+				if err := os.WriteFile(tmp, []byte("new graph"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Rename(tmp, filepath.Join(dir, "grp")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: oldFiles,
+		},
+		{
+			name:   "does not commit the backup when the agent starts to save during the upload",
+			marker: saved,
+			during: func(t *testing.T, dir string) {
+				t.Helper()
+				err := metadata.StoreSaveMarker(filepath.Join(dir, metadata.SaveMarkerFileName), &metadata.SaveMarker{
+					Generation: 1,
+					Saving:     true,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: errors.ErrAgentIndexSaving,
+		},
+		{
+			name:   "does not commit the backup when the agent saves the next generation during the upload",
+			marker: saved,
+			during: func(t *testing.T, dir string) {
+				t.Helper()
+				err := metadata.StoreSaveMarker(filepath.Join(dir, metadata.SaveMarkerFileName), &metadata.SaveMarker{
+					Generation: 2,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: errors.ErrSaveGenerationChanged(1, 2),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			ctx := context.Background()
+			h := newHarness(tt, "gob", true, test.incremental)
+			h.write(tt, oldFiles)
+			if test.marker != nil {
+				if err := metadata.StoreSaveMarker(filepath.Join(h.src, metadata.SaveMarkerFileName), test.marker); err != nil {
+					tt.Fatal(err)
+				}
+			}
+
+			st := &duringUpload{
+				Storage: h.st,
+				f: func() {
+					if test.during != nil {
+						test.during(tt, h.src)
+					}
+				},
+			}
+			o, err := observer.New(
+				observer.WithDir(h.src),
+				observer.WithBlobStorage(st),
+				observer.WithWatch(false),
+				observer.WithTicker(false),
+				observer.WithIncremental(test.incremental),
+				observer.WithCoordinatedBackup(true),
+			)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			_, err = o.Backup(ctx, "")
+			if test.wantErr != nil {
+				if !errors.Is(err, test.wantErr) {
+					tt.Fatalf("error = %v, want %v", err, test.wantErr)
+				}
+				ms, err := h.st.Versions(ctx)
+				if err != nil {
+					tt.Fatal(err)
+				}
+				if len(ms) != 0 {
+					tt.Errorf("the backup is committed: %v", ms[0].Version)
+				}
+				// the data of the aborted backup is deleted not to be left in the bucket.
+				data, err := filepath.Glob(filepath.Join(h.root, testBucket, testFilename, "*.tar"))
+				if err != nil {
+					tt.Fatal(err)
+				}
+				if len(data) != 0 {
+					tt.Errorf("the data of the aborted backup is left: %v", data)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			if got := h.latest(tt).SaveGeneration; got != test.marker.Generation {
+				tt.Errorf("save generation = %d, want %d", got, test.marker.Generation)
+			}
+			if _, err := os.Stat(filepath.Join(h.src, ".snapshot")); !os.IsNotExist(err) {
+				tt.Errorf("the snapshot directory is left: %v", err)
+			}
+
+			got, err := h.restore(tt, "")
+			if err != nil {
+				tt.Fatal(err)
+			}
+			delete(got, metadata.SaveMarkerFileName)
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("restored files = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestBackupRestore_InvalidStorageType(t *testing.T) {
+	st, err := storage.New(
+		storage.WithType("unknown"),
+		storage.WithBucketName(testBucket),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = st.Start(context.Background()); !errors.Is(err, errors.ErrInvalidStorageType) {
+		t.Errorf("got_error: %v, want: %v", err, errors.ErrInvalidStorageType)
+	}
+}
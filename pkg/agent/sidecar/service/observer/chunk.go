@@ -0,0 +1,179 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package observer provides storage observer
+package observer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+// backupChunks splits the files in the directory into the chunks keyed by their checksums and uploads only the chunks which are not stored yet,
+// and returns the manifest which references the chunks and is not committed yet.
+func (o *observer) backupChunks(ctx context.Context, bi *BackupInfo, dir string) (*storage.Manifest, error) {
+	manifest, err := o.storage.NewManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	manifest.Key = ""
+	manifest.Incremental = true
+	manifest.ChunkSize = o.chunkSize
+
+	chunks, err := o.storage.Chunks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, o.chunkSize)
+	err = filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if fi.IsDir() && path == filepath.Join(dir, snapshotDirName) {
+			return filepath.SkipDir
+		}
+
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("writing: ", path)
+
+		f, uploaded, err := o.uploadChunks(ctx, path, buf, chunks)
+		if err != nil {
+			return err
+		}
+		f.Name = filepath.ToSlash(rel)
+		f.Mode = uint32(fi.Mode().Perm())
+		manifest.Files = append(manifest.Files, f)
+		manifest.Bytes += f.Size
+		bi.Bytes += uploaded
+
+		if f.Name == metadata.AgentMetadataFileName {
+			meta, err := metadata.Load(path)
+			if err != nil {
+				log.Warnf("failed to load %s: %s", path, err)
+			} else {
+				manifest.SetMetadata(meta)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("uploaded %d bytes of %d bytes as the chunks", bi.Bytes, manifest.Bytes)
+
+	return manifest, nil
+}
+
+// uploadChunks uploads the chunks of the file which are not in the stored chunks, and returns the file information with the chunk checksums and the uploaded bytes.
+func (o *observer) uploadChunks(ctx context.Context, path string, buf []byte, chunks map[string]struct{}) (mf *storage.ManifestFile, uploaded int64, err error) {
+	f, err := file.Open(path, os.O_RDONLY, fs.ModePerm)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		e := f.Close()
+		if e != nil {
+			log.Errorf("failed to close %s: %s", path, e)
+		}
+	}()
+
+	r, err := io.NewReaderWithLimiter(ctx, f, o.readLimiter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	h := sha256.New()
+	mf = new(storage.ManifestFile)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			data := buf[:n]
+			h.Write(data)
+			mf.Size += int64(n)
+
+			s := sha256.Sum256(data)
+			sum := hex.EncodeToString(s[:])
+			mf.Chunks = append(mf.Chunks, sum)
+			if _, ok := chunks[sum]; !ok {
+				if err := o.uploadChunk(ctx, sum, data); err != nil {
+					return nil, 0, err
+				}
+				chunks[sum] = struct{}{}
+				uploaded += int64(n)
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	mf.SHA256 = hex.EncodeToString(h.Sum(nil))
+
+	return mf, uploaded, nil
+}
+
+func (o *observer) uploadChunk(ctx context.Context, sum string, data []byte) (err error) {
+	w, err := o.storage.ChunkWriter(ctx, sum)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if w != nil {
+			e := w.Close()
+			if e != nil {
+				err = errors.Wrap(err, e.Error())
+			}
+		}
+	}()
+
+	r, err := io.NewReaderWithLimiter(ctx, bytes.NewReader(data), o.uploadLimiter)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	return err
+}
@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package observer provides storage observer
+package observer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func Test_observer_backupChunks(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	ctx := context.Background()
+	dir := t.TempDir()
+	write := func(name, data string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("metadata.json", `{"is_invalid":false,"ngt":{"index_count":100}}`)
+	write("ngt-meta.kvsdb", strings.Repeat("a", 8)+strings.Repeat("b", 8)+"c")
+
+	st := &storageMock{
+		chunks: make(map[string][]byte),
+	}
+	o := &observer{
+		dir:                dir,
+		eg:                 errgroup.Get(),
+		storage:            st,
+		incrementalEnabled: true,
+		chunkSize:          8,
+	}
+
+	if err := o.backup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	m := st.manifest
+	if m == nil || !m.Incremental || m.ChunkSize != 8 || m.IndexCount != 100 {
+		t.Fatalf("manifest = %#v, want the incremental manifest", m)
+	}
+	var kvs []string
+	for _, f := range m.Files {
+		if f.Name == "ngt-meta.kvsdb" {
+			kvs = f.Chunks
+			sum := sha256.Sum256([]byte(strings.Repeat("a", 8) + strings.Repeat("b", 8) + "c"))
+			if f.SHA256 != hex.EncodeToString(sum[:]) || f.Size != 17 || f.Mode != 0o600 {
+				t.Errorf("file = %#v, want the checksum, size and mode of the file", f)
+			}
+		}
+	}
+	if len(kvs) != 3 || string(st.chunks[kvs[2]]) != "c" {
+		t.Errorf("chunks = %v, want 3 chunks", kvs)
+	}
+	stored := len(st.chunks)
+
+	// only the changed chunk is uploaded by the next backup.
+	write("ngt-meta.kvsdb", strings.Repeat("a", 8)+strings.Repeat("b", 8)+"d")
+	if err := o.backup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(st.chunks); got != stored+1 {
+		t.Errorf("stored chunks = %d, want %d", got, stored+1)
+	}
+}
+
+func TestWithChunkSize(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name    string
+		size    string
+		want    int64
+		wantErr bool
+	}
+	tests := []test{
+		{
+			name: "sets the chunk size",
+			size: "1MB",
+			want: 1 << 20,
+		},
+		{
+			name: "does nothing when the size is empty",
+		},
+		{
+			name:    "returns error when the size is zero",
+			size:    "0",
+			wantErr: true,
+		},
+		{
+			name:    "returns error when the size is invalid",
+			size:    "invalid",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			o := new(observer)
+			err := WithChunkSize(test.size)(o)
+			if (err != nil) != test.wantErr {
+				tt.Errorf("err = %v, wantErr %v", err, test.wantErr)
+			}
+			if o.chunkSize != test.want {
+				tt.Errorf("chunk size = %d, want %d", o.chunkSize, test.want)
+			}
+		})
+	}
+}
@@ -29,6 +29,13 @@ type BackupInfo struct {
 	EndTime   time.Time
 	Bytes     int64
 
+	// Version and Generation represent the written backup version, they are empty when the versioning is disabled.
+	Version    string
+	Generation uint64
+
+	// Label represents the label given when the backup is requested.
+	Label string
+
 	*storage.StorageInfo
 }
 
@@ -19,8 +19,11 @@ package observer
 
 import (
 	"archive/tar"
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"io/fs"
+	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -28,6 +31,8 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/encoding/json"
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/file"
@@ -37,12 +42,20 @@ import (
 	"github.com/vdaas/vald/internal/observability/trace"
 	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/restorer"
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
 )
 
 type StorageObserver interface {
 	Start(ctx context.Context) (<-chan error, error)
 	PostStop(ctx context.Context) error
+	// Backup backs up the directory to the blob storage immediately, and records the label in the manifest of the backup.
+	Backup(ctx context.Context, label string) (*BackupInfo, error)
+	// Restore restores the directory from the backup version, or the latest readable version when it is empty.
+	// The backups are not run during the restore.
+	Restore(ctx context.Context, version string) error
+	// Status returns the results of the last succeeded and failed backups.
+	Status() *payload.Backup_Status
 }
 
 type observer struct {
@@ -52,6 +65,7 @@ type observer struct {
 	checkDuration time.Duration
 
 	metadataPath string
+	markerPath   string
 
 	postStopTimeout time.Duration
 
@@ -60,9 +74,45 @@ type observer struct {
 
 	storage storage.Storage
 
+	incrementalEnabled bool
+	chunkSize          int64
+
+	// coordinatedEnabled represents the backup is triggered by the save marker of the agent instead of the metadata.
+	coordinatedEnabled bool
+
 	ch chan struct{}
 
 	hooks []Hook
+
+	restorer restorer.Restorer
+
+	// readLimiter and uploadLimiter limit the bandwidth of reading the files and uploading the backup.
+	readLimiter   io.Limiter
+	uploadLimiter io.Limiter
+	readRate      int64
+	uploadRate    int64
+
+	ioPriorityClass string
+	ioPriorityLevel int
+
+	// throttleEnabled represents the rates are lowered to the throttledRate while the gRPC latency of the agent exceeds the threshold.
+	throttleEnabled  bool
+	throttleDuration time.Duration
+	throttledRate    int64
+	latencyThreshold time.Duration
+	latencyMetric    string
+	metricsURL       string
+	client           *http.Client
+
+	// mu serializes the backups and the restores.
+	mu sync.Mutex
+
+	smu         sync.RWMutex
+	running     bool
+	lastSuccess *payload.Backup_Result
+	lastFailure *payload.Backup_Result
+	// generation represents the last backed up generation of the index saved by the agent.
+	generation uint64
 }
 
 func New(opts ...Option) (so StorageObserver, err error) {
@@ -73,6 +123,16 @@ func New(opts ...Option) (so StorageObserver, err error) {
 		}
 	}
 
+	err = io.SetIOPriority(o.ioPriorityClass, o.ioPriorityLevel)
+	if err != nil {
+		return nil, err
+	}
+	o.readLimiter = io.NewLimiter(o.readRate)
+	o.uploadLimiter = io.NewLimiter(o.uploadRate)
+	if o.client == nil {
+		o.client = http.DefaultClient
+	}
+
 	o.w, err = watch.New(
 		watch.WithDirs(o.dir),
 		watch.WithErrGroup(o.eg),
@@ -89,7 +149,7 @@ func New(opts ...Option) (so StorageObserver, err error) {
 func (o *observer) Start(ctx context.Context) (<-chan error, error) {
 	ech := make(chan error, 100)
 
-	var wech, tech, sech, bech <-chan error
+	var wech, tech, sech, bech, thech <-chan error
 	var err error
 
 	if o.watchEnabled {
@@ -119,6 +179,14 @@ func (o *observer) Start(ctx context.Context) (<-chan error, error) {
 		return nil, err
 	}
 
+	if o.throttleEnabled {
+		thech, err = o.startThrottle(ctx)
+		if err != nil {
+			close(ech)
+			return nil, err
+		}
+	}
+
 	o.eg.Go(safety.RecoverFunc(func() (err error) {
 		defer close(ech)
 
@@ -130,6 +198,7 @@ func (o *observer) Start(ctx context.Context) (<-chan error, error) {
 			case err = <-tech:
 			case err = <-sech:
 			case err = <-bech:
+			case err = <-thech:
 			}
 			if err != nil {
 				select {
@@ -175,7 +244,13 @@ func (o *observer) PostStop(ctx context.Context) (err error) {
 	defer close(ch)
 
 	f := func(ctx context.Context, name string) error {
-		if name == o.metadataPath {
+		if o.coordinatedEnabled && name == o.markerPath {
+			if o.hasNewGeneration() {
+				ch <- struct{}{}
+			}
+			return nil
+		}
+		if !o.coordinatedEnabled && name == o.metadataPath {
 			ch <- struct{}{}
 			return nil
 		}
@@ -230,6 +305,62 @@ func (o *observer) PostStop(ctx context.Context) (err error) {
 	}
 }
 
+func (o *observer) Backup(ctx context.Context, label string) (*BackupInfo, error) {
+	bi, err := o.backupWithLabel(ctx, label)
+	if err != nil {
+		return nil, err
+	}
+	return bi, nil
+}
+
+func (o *observer) Restore(ctx context.Context, version string) error {
+	if o.restorer == nil {
+		return errors.ErrBackupRestorerNotFound
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.restorer.RestoreVersion(ctx, version)
+}
+
+func (o *observer) Status() *payload.Backup_Status {
+	o.smu.RLock()
+	defer o.smu.RUnlock()
+	return &payload.Backup_Status{
+		Running:     o.running,
+		LastSuccess: o.lastSuccess,
+		LastFailure: o.lastFailure,
+	}
+}
+
+// setRunning records the backup is started or finished, and the result of the finished backup.
+func (o *observer) setRunning(running bool, bi *BackupInfo, err error) {
+	o.smu.Lock()
+	defer o.smu.Unlock()
+	o.running = running
+	if running || bi == nil {
+		return
+	}
+	if bi.EndTime.IsZero() {
+		bi.EndTime = time.Now()
+	}
+	res := &payload.Backup_Result{
+		Version:    bi.Version,
+		Label:      bi.Label,
+		StartedAt:  bi.StartTime.UnixNano(),
+		FinishedAt: bi.EndTime.UnixNano(),
+		Bytes:      bi.Bytes,
+		Duration:   int64(bi.EndTime.Sub(bi.StartTime)),
+	}
+	if err != nil {
+		res.Error = err.Error()
+		o.lastFailure = res
+		return
+	}
+	o.lastSuccess = res
+}
+
 func (o *observer) startTicker(ctx context.Context) (<-chan error, error) {
 	ech := make(chan error, 100)
 	o.eg.Go(safety.RecoverFunc(func() (err error) {
@@ -263,6 +394,11 @@ func (o *observer) startTicker(ctx context.Context) (<-chan error, error) {
 					continue
 				}
 
+				if o.coordinatedEnabled && !o.hasNewGeneration() {
+					log.Debug("backup skipped because the agent has not saved the new index")
+					continue
+				}
+
 				err = o.requestBackup(ctx)
 				if err != nil {
 					ech <- err
@@ -325,6 +461,10 @@ func (o *observer) onWrite(ctx context.Context, name string) error {
 		}
 	}()
 
+	if o.coordinatedEnabled && name == o.markerPath {
+		return o.onSaved(ctx)
+	}
+
 	if name != o.metadataPath {
 		return nil
 	}
@@ -336,6 +476,10 @@ func (o *observer) onWrite(ctx context.Context, name string) error {
 	}
 
 	if ok {
+		if o.coordinatedEnabled {
+			// the backup is requested when the agent publishes the save marker.
+			return nil
+		}
 		return o.requestBackup(ctx)
 	}
 
@@ -350,6 +494,10 @@ func (o *observer) onCreate(ctx context.Context, name string) error {
 		}
 	}()
 
+	if o.coordinatedEnabled && name == o.markerPath {
+		return o.onSaved(ctx)
+	}
+
 	if name != o.metadataPath {
 		return nil
 	}
@@ -361,12 +509,24 @@ func (o *observer) onCreate(ctx context.Context, name string) error {
 	}
 
 	if ok {
+		if o.coordinatedEnabled {
+			// the backup is requested when the agent publishes the save marker.
+			return nil
+		}
 		return o.requestBackup(ctx)
 	}
 
 	return o.terminate()
 }
 
+// onSaved requests the backup when the agent has saved the index of the new generation.
+func (o *observer) onSaved(ctx context.Context) error {
+	if !o.hasNewGeneration() {
+		return nil
+	}
+	return o.requestBackup(ctx)
+}
+
 func (o *observer) isValidMetadata() (bool, error) {
 	metadata, err := metadata.Load(o.metadataPath)
 	if err != nil {
@@ -397,16 +557,31 @@ func (o *observer) requestBackup(ctx context.Context) error {
 	return nil
 }
 
-func (o *observer) backup(ctx context.Context) (err error) {
-	bi := &BackupInfo{
+func (o *observer) backup(ctx context.Context) error {
+	_, err := o.backupWithLabel(ctx, "")
+	return err
+}
+
+// backupWithLabel backs up the directory and returns the information of the backup. The result is recorded to the status.
+func (o *observer) backupWithLabel(ctx context.Context, label string) (bi *BackupInfo, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	bi = &BackupInfo{
+		Label:       label,
 		StorageInfo: o.storage.StorageInfo(),
 	}
 	bi.StartTime = time.Now()
 
+	o.setRunning(true, nil, nil)
+	defer func() {
+		o.setRunning(false, bi, err)
+	}()
+
 	for _, hook := range o.hooks {
 		ctx, err = hook.BeforeProcess(ctx, bi)
 		if err != nil {
-			return err
+			return bi, err
 		}
 	}
 
@@ -426,6 +601,119 @@ func (o *observer) backup(ctx context.Context) (err error) {
 
 	log.Infof("started to backup directory %s", o.dir)
 
+	dir := o.dir
+	var marker *metadata.SaveMarker
+	if o.coordinatedEnabled {
+		marker, err = o.savedMarker()
+		if err != nil {
+			return bi, err
+		}
+		dir, err = o.snapshot(ctx)
+		if err != nil {
+			return bi, err
+		}
+		defer func() {
+			e := os.RemoveAll(dir)
+			if e != nil {
+				log.Errorf("error on removing snapshot directory %s: %s", dir, e)
+			}
+		}()
+		log.Infof("snapshotted index generation %d", marker.Generation)
+	}
+
+	var manifest *storage.Manifest
+	if o.incrementalEnabled {
+		manifest, err = o.backupChunks(ctx, bi, dir)
+	} else {
+		manifest, err = o.backupArchive(ctx, bi, dir)
+	}
+	if err != nil {
+		return bi, err
+	}
+	manifest.Label = label
+	committed := false
+	defer func() {
+		if err != nil && !committed {
+			o.abort(ctx, manifest)
+		}
+	}()
+
+	if marker != nil {
+		// the backup is not committed when the agent has started to save the index during the backup,
+		// because the files which are not replaced but modified in place are shared with the snapshot.
+		err = o.verifyGeneration(marker.Generation)
+		if err != nil {
+			return bi, err
+		}
+		manifest.SaveGeneration = marker.Generation
+	}
+	if len(manifest.Version) != 0 {
+		bi.Version = manifest.Version
+		bi.Generation = manifest.Generation
+		if span != nil {
+			span.AddAttributes(
+				trace.StringAttribute("version", manifest.Version),
+			)
+		}
+	}
+
+	// the manifest must be committed after the backup data is uploaded.
+	err = o.storage.Commit(ctx, manifest)
+	if err != nil {
+		return bi, err
+	}
+	committed = true
+
+	if marker != nil {
+		o.smu.Lock()
+		o.generation = marker.Generation
+		o.smu.Unlock()
+	}
+
+	bi.EndTime = time.Now()
+	for _, hook := range o.hooks {
+		err = hook.AfterProcess(ctx, bi)
+		if err != nil {
+			return bi, err
+		}
+	}
+
+	log.Infof("finished to backup directory %s", o.dir)
+
+	if len(manifest.Version) != 0 {
+		log.Infof("backup version %s is written", manifest.Version)
+		deleted, err := o.storage.Prune(ctx)
+		if err != nil {
+			log.Warn("failed to prune the old backup versions:", err)
+		}
+		for _, version := range deleted {
+			log.Infof("backup version %s is pruned", version)
+		}
+	}
+
+	if o.incrementalEnabled {
+		deleted, err := o.storage.PruneChunks(ctx)
+		if err != nil {
+			log.Warn("failed to prune the unreferenced chunks:", err)
+		}
+		if len(deleted) != 0 {
+			log.Infof("%d unreferenced chunks are pruned", len(deleted))
+		}
+	}
+
+	return bi, nil
+}
+
+// abort deletes the data of the backup which is not committed. The data which is not deleted here is deleted by the storage prune after the grace period.
+func (o *observer) abort(ctx context.Context, m *storage.Manifest) {
+	err := o.storage.Abort(ctx, m)
+	if err != nil {
+		log.Warnf("failed to delete the data of the uncommitted backup: %s", err)
+	}
+}
+
+// backupArchive uploads the directory as the tar archive, and returns the manifest which is not committed yet.
+func (o *observer) backupArchive(ctx context.Context, bi *BackupInfo, dir string) (_ *storage.Manifest, err error) {
 	pr, pw := io.Pipe()
 	defer func() {
 		e := pr.Close()
@@ -434,24 +722,33 @@ func (o *observer) backup(ctx context.Context) (err error) {
 		}
 	}()
 
-	sw, err := o.storage.Writer(ctx)
+	sw, manifest, err := o.storage.VersionWriter(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	closed := false
 	defer func() {
-		e := sw.Close()
-		if e != nil {
-			log.Errorf("error on closing blob-storage writer: %s", e)
+		if !closed {
+			e := sw.Close()
+			if e != nil {
+				log.Errorf("error on closing blob-storage writer: %s", e)
+			}
+		}
+		// the data is deleted after the writer is closed not to be uploaded again.
+		if err != nil {
+			o.abort(ctx, manifest)
 		}
 	}()
 
-	wg := new(sync.WaitGroup)
-	wg.Add(1)
+	// tech receives the result of the archiving to prevent the partial backup from being committed.
+	tech := make(chan error, 1)
 
 	o.eg.Go(safety.RecoverFunc(func() (err error) {
-		defer wg.Done()
 		defer func() {
-			e := pw.Close()
+			tech <- err
+		}()
+		defer func() {
+			e := pw.CloseWithError(err)
 			if e != nil {
 				log.Errorf("error on closing pipe writer: %s", e)
 			}
@@ -465,7 +762,7 @@ func (o *observer) backup(ctx context.Context) (err error) {
 			}
 		}()
 
-		return filepath.Walk(o.dir, func(path string, fi os.FileInfo, err error) error {
+		return filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
@@ -476,12 +773,16 @@ func (o *observer) backup(ctx context.Context) (err error) {
 				return err
 			}
 
+			if fi.IsDir() && path == filepath.Join(dir, snapshotDirName) {
+				return filepath.SkipDir
+			}
+
 			header, err := tar.FileInfoHeader(fi, path)
 			if err != nil {
 				return err
 			}
 
-			rel, err := filepath.Rel(o.dir, path)
+			rel, err := filepath.Rel(dir, path)
 			if err != nil {
 				return err
 			}
@@ -510,40 +811,58 @@ func (o *observer) backup(ctx context.Context) (err error) {
 				}
 			}()
 
-			d, err := io.NewReaderWithContext(ctx, data)
+			d, err := io.NewReaderWithLimiter(ctx, data, o.readLimiter)
 			if err != nil {
 				return err
 			}
 
-			_, err = io.Copy(tw, d)
+			// the checksum and the metadata are recorded from the archived bytes to verify the backup on restore.
+			h := sha256.New()
+			var buf bytes.Buffer
+			w := io.MultiWriter(tw, h)
+			if header.Name == metadata.AgentMetadataFileName {
+				w = io.MultiWriter(w, &buf)
+			}
+			n, err := io.Copy(w, d)
 			if err != nil {
 				return err
 			}
+			manifest.AddFile(header.Name, n, h.Sum(nil))
+
+			if buf.Len() != 0 {
+				meta := new(metadata.Metadata)
+				if err := json.Unmarshal(buf.Bytes(), meta); err != nil {
+					log.Warnf("failed to decode %s: %s", path, err)
+				} else {
+					manifest.SetMetadata(meta)
+				}
+			}
 			return nil
 		})
 	}))
 
-	prr, err := io.NewReaderWithContext(ctx, pr)
+	prr, err := io.NewReaderWithLimiter(ctx, pr, o.uploadLimiter)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	bi.Bytes, err = io.Copy(sw, prr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	wg.Wait()
-
-	bi.EndTime = time.Now()
-	for _, hook := range o.hooks {
-		err = hook.AfterProcess(ctx, bi)
-		if err != nil {
-			return err
-		}
+	err = <-tech
+	if err != nil {
+		return nil, err
 	}
 
-	log.Infof("finished to backup directory %s", o.dir)
+	// the writer is closed to wait for the upload before the manifest is committed.
+	closed = true
+	err = sw.Close()
+	if err != nil {
+		return nil, err
+	}
+	manifest.Bytes = bi.Bytes
 
-	return nil
+	return manifest, nil
 }
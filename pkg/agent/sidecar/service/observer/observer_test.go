@@ -18,7 +18,12 @@
 package observer
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -26,10 +31,72 @@ import (
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/file/watch"
+	"github.com/vdaas/vald/internal/io"
 	"github.com/vdaas/vald/internal/test/goleak"
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
 )
 
+type storageMock struct {
+	storage.Storage
+	buf      bytes.Buffer
+	manifest *storage.Manifest
+	chunks   map[string][]byte
+}
+
+type backupWriterMock struct {
+	io.Writer
+	close func() error
+}
+
+func (w *backupWriterMock) Close() error {
+	if w.close != nil {
+		return w.close()
+	}
+	return nil
+}
+
+func (s *storageMock) StorageInfo() *storage.StorageInfo {
+	return new(storage.StorageInfo)
+}
+
+func (s *storageMock) VersionWriter(ctx context.Context) (io.WriteCloser, *storage.Manifest, error) {
+	return &backupWriterMock{Writer: &s.buf}, &storage.Manifest{
+		Key: "backup.tar",
+	}, nil
+}
+
+func (s *storageMock) Commit(ctx context.Context, m *storage.Manifest) error {
+	s.manifest = m
+	return nil
+}
+
+func (s *storageMock) NewManifest(ctx context.Context) (*storage.Manifest, error) {
+	return new(storage.Manifest), nil
+}
+
+func (s *storageMock) Chunks(ctx context.Context) (map[string]struct{}, error) {
+	chunks := make(map[string]struct{}, len(s.chunks))
+	for sum := range s.chunks {
+		chunks[sum] = struct{}{}
+	}
+	return chunks, nil
+}
+
+func (s *storageMock) ChunkWriter(ctx context.Context, sum string) (io.WriteCloser, error) {
+	buf := new(bytes.Buffer)
+	return &backupWriterMock{
+		Writer: buf,
+		close: func() error {
+			s.chunks[sum] = buf.Bytes()
+			return nil
+		},
+	}, nil
+}
+
+func (s *storageMock) PruneChunks(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -1280,30 +1347,52 @@ func Test_observer_backup(t *testing.T) {
 		return nil
 	}
 	tests := []test{
-		// TODO test cases
-		/*
-		   {
-		       name: "test_case_1",
-		       args: args {
-		           ctx: nil,
-		       },
-		       fields: fields {
-		           w: nil,
-		           dir: "",
-		           eg: nil,
-		           checkDuration: nil,
-		           metadataPath: "",
-		           postStopTimeout: nil,
-		           watchEnabled: false,
-		           tickerEnabled: false,
-		           storage: nil,
-		           ch: nil,
-		           hooks: nil,
-		       },
-		       want: want{},
-		       checkFunc: defaultCheckFunc,
-		   },
-		*/
+		func() test {
+			dir := t.TempDir()
+			files := map[string]string{
+				"metadata.json":  `{"is_invalid":false,"ngt":{"index_count":100}}`,
+				"ngt-meta.kvsdb": "vald",
+			}
+			for name, data := range files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			st := new(storageMock)
+			return test{
+				name: "records the checksums and the agent metadata in the manifest",
+				args: args{
+					ctx: context.Background(),
+				},
+				fields: fields{
+					dir:     dir,
+					eg:      errgroup.Get(),
+					storage: st,
+				},
+				checkFunc: func(w want, err error) error {
+					if err := defaultCheckFunc(w, err); err != nil {
+						return err
+					}
+					m := st.manifest
+					if m == nil {
+						return errors.New("manifest is not committed")
+					}
+					if m.IndexCount != 100 || m.Bytes != int64(st.buf.Len()) {
+						return errors.Errorf("index count = %d, bytes = %d, want 100, %d", m.IndexCount, m.Bytes, st.buf.Len())
+					}
+					if len(m.Files) != len(files) {
+						return errors.Errorf("files = %d, want %d", len(m.Files), len(files))
+					}
+					for _, f := range m.Files {
+						sum := sha256.Sum256([]byte(files[f.Name]))
+						if f.SHA256 != hex.EncodeToString(sum[:]) || f.Size != int64(len(files[f.Name])) {
+							return errors.Errorf("file %s = %#v, want the checksum of %s", f.Name, f, files[f.Name])
+						}
+					}
+					return nil
+				},
+			}
+		}(),
 
 		// TODO test cases
 		/*
@@ -18,11 +18,16 @@
 package observer
 
 import (
+	"math"
+	"net/http"
 	"path/filepath"
 
 	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/timeutil"
+	"github.com/vdaas/vald/internal/unit"
 	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/restorer"
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
 )
 
@@ -34,6 +39,11 @@ var defaultOptions = []Option{
 	WithPostStopTimeout("2m"),
 	WithWatch(true),
 	WithTicker(true),
+	WithChunkSize("4MB"),
+	WithThrottleDuration("5s"),
+	WithThrottleMetricsURL("http://localhost:6061/metrics"),
+	WithThrottleLatencyMetric(defaultLatencyMetric),
+	WithThrottleLatencyThreshold("100ms"),
 }
 
 func WithBackupDuration(dur string) Option {
@@ -80,6 +90,161 @@ func WithTicker(enabled bool) Option {
 	}
 }
 
+// WithIncremental returns the option to enable the incremental backup which uploads only the new chunks of the files.
+func WithIncremental(enabled bool) Option {
+	return func(o *observer) error {
+		o.incrementalEnabled = enabled
+
+		return nil
+	}
+}
+
+// WithCoordinatedBackup returns the option to back up the index only when the agent has saved it,
+// which is notified by the save marker, and to snapshot the saved generation before it is uploaded.
+func WithCoordinatedBackup(enabled bool) Option {
+	return func(o *observer) error {
+		o.coordinatedEnabled = enabled
+
+		return nil
+	}
+}
+
+// WithChunkSize returns the option to set the maximum size of the chunks of the incremental backup, e.g. 4MB.
+func WithChunkSize(size string) Option {
+	return func(o *observer) error {
+		if size == "" {
+			return nil
+		}
+		b, err := unit.ParseBytes(size)
+		if err != nil {
+			return err
+		}
+		if b == 0 || b > math.MaxInt32 {
+			return errors.NewErrInvalidOption("chunkSize", size)
+		}
+		o.chunkSize = int64(b)
+
+		return nil
+	}
+}
+
+// WithReadRate returns the option to limit the bytes per second to read the files for the backup, e.g. 100MB. It is not limited when the rate is empty.
+func WithReadRate(rate string) Option {
+	return func(o *observer) (err error) {
+		o.readRate, err = parseRate(rate)
+		return err
+	}
+}
+
+// WithUploadRate returns the option to limit the bytes per second of the backup stream written to the blob storage before it is compressed, e.g. 50MB.
+// It is not limited when the rate is empty.
+func WithUploadRate(rate string) Option {
+	return func(o *observer) (err error) {
+		o.uploadRate, err = parseRate(rate)
+		return err
+	}
+}
+
+// WithIOPriority returns the option to set the I/O scheduling class and level of the sidecar process.
+// The class must be best-effort with the level from 0 (highest) to 7 (lowest) or idle, and the default priority is kept when it is empty.
+func WithIOPriority(class string, level int) Option {
+	return func(o *observer) error {
+		o.ioPriorityClass = class
+		o.ioPriorityLevel = level
+		return nil
+	}
+}
+
+// WithAdaptiveThrottle returns the option to lower the read and upload rates while the gRPC latency of the agent exceeds the threshold.
+func WithAdaptiveThrottle(enabled bool) Option {
+	return func(o *observer) error {
+		o.throttleEnabled = enabled
+		return nil
+	}
+}
+
+// WithThrottleMetricsURL returns the option to set the URL of the prometheus metrics of the agent, e.g. http://localhost:6061/metrics.
+func WithThrottleMetricsURL(url string) Option {
+	return func(o *observer) error {
+		if url != "" {
+			o.metricsURL = url
+		}
+		return nil
+	}
+}
+
+// WithThrottleLatencyMetric returns the option to set the name of the prometheus histogram of the gRPC server latency in milliseconds.
+func WithThrottleLatencyMetric(name string) Option {
+	return func(o *observer) error {
+		if name != "" {
+			o.latencyMetric = name
+		}
+		return nil
+	}
+}
+
+// WithThrottleLatencyThreshold returns the option to set the average latency to start throttling.
+func WithThrottleLatencyThreshold(dur string) Option {
+	return func(o *observer) error {
+		if dur == "" {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return nil
+		}
+		o.latencyThreshold = d
+		return nil
+	}
+}
+
+// WithThrottleDuration returns the option to set the interval to check the latency of the agent.
+func WithThrottleDuration(dur string) Option {
+	return func(o *observer) error {
+		if dur == "" {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return nil
+		}
+		o.throttleDuration = d
+		return nil
+	}
+}
+
+// WithThrottledRate returns the option to set the bytes per second of the read and upload rates while the latency exceeds the threshold, e.g. 10MB.
+func WithThrottledRate(rate string) Option {
+	return func(o *observer) (err error) {
+		o.throttledRate, err = parseRate(rate)
+		return err
+	}
+}
+
+// WithHTTPClient returns the option to set the HTTP client to get the metrics of the agent.
+func WithHTTPClient(c *http.Client) Option {
+	return func(o *observer) error {
+		if c != nil {
+			o.client = c
+		}
+		return nil
+	}
+}
+
+func parseRate(rate string) (int64, error) {
+	if rate == "" {
+		return 0, nil
+	}
+	b, err := unit.ParseBytes(rate)
+	if err != nil {
+		return 0, err
+	}
+	if b > math.MaxInt64 {
+		return 0, errors.NewErrInvalidOption("rate", rate)
+	}
+	return int64(b), nil
+}
+
 func WithErrGroup(eg errgroup.Group) Option {
 	return func(o *observer) error {
 		if eg != nil {
@@ -97,6 +262,7 @@ func WithDir(dir string) Option {
 
 		o.dir = dir
 		o.metadataPath = filepath.Join(dir, metadata.AgentMetadataFileName)
+		o.markerPath = filepath.Join(dir, metadata.SaveMarkerFileName)
 
 		return nil
 	}
@@ -111,6 +277,16 @@ func WithBlobStorage(storage storage.Storage) Option {
 	}
 }
 
+// WithRestorer returns the option to set the restorer to restore the directory on demand.
+func WithRestorer(r restorer.Restorer) Option {
+	return func(o *observer) error {
+		if r != nil {
+			o.restorer = r
+		}
+		return nil
+	}
+}
+
 func WithHooks(hooks ...Hook) Option {
 	return func(o *observer) error {
 		if hooks == nil {
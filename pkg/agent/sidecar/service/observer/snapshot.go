@@ -0,0 +1,167 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package observer provides storage observer
+package observer
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+)
+
+// snapshotDirName is the name of the directory to snapshot the saved index before it is uploaded.
+const snapshotDirName = ".snapshot"
+
+// savedMarker returns the save marker of the agent, and returns an error when the agent is saving the index.
+func (o *observer) savedMarker() (*metadata.SaveMarker, error) {
+	m, err := metadata.LoadSaveMarker(o.markerPath)
+	if err != nil {
+		return nil, err
+	}
+	if m.Saving {
+		return nil, errors.ErrAgentIndexSaving
+	}
+	return m, nil
+}
+
+// hasNewGeneration returns true when the agent has saved the generation which is not backed up yet.
+func (o *observer) hasNewGeneration() bool {
+	m, err := o.savedMarker()
+	if err != nil {
+		log.Debug("the saved index is not available:", err)
+		return false
+	}
+	o.smu.RLock()
+	defer o.smu.RUnlock()
+	return m.Generation > o.generation
+}
+
+// verifyGeneration returns an error when the agent has started to save the index after the generation is saved,
+// because the files of the generation may have been modified.
+func (o *observer) verifyGeneration(generation uint64) error {
+	m, err := o.savedMarker()
+	if err != nil {
+		return err
+	}
+	if m.Generation != generation {
+		return errors.ErrSaveGenerationChanged(generation, m.Generation)
+	}
+	return nil
+}
+
+// snapshot links the files in the directory into the snapshot directory to keep the files of the saved generation
+// even when the agent replaces them, and copies the file when it cannot be linked, e.g. the filesystem does not support the hardlink.
+// It returns the snapshot directory, which must be removed after the backup.
+func (o *observer) snapshot(ctx context.Context) (dir string, err error) {
+	dir = filepath.Join(o.dir, snapshotDirName)
+	err = os.RemoveAll(dir)
+	if err != nil {
+		return "", err
+	}
+	err = os.MkdirAll(dir, 0o700)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err != nil {
+			e := os.RemoveAll(dir)
+			if e != nil {
+				log.Errorf("error on removing snapshot directory %s: %s", dir, e)
+			}
+		}
+	}()
+
+	err = filepath.Walk(o.dir, func(path string, fi os.FileInfo, err error) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if path == dir {
+			return filepath.SkipDir
+		}
+
+		rel, err := filepath.Rel(o.dir, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, rel)
+
+		switch {
+		case fi.IsDir():
+			return os.MkdirAll(target, fi.Mode().Perm()|0o700)
+		case !fi.Mode().IsRegular():
+			return nil
+		}
+
+		err = os.Link(path, target)
+		if err == nil {
+			return nil
+		}
+		log.Debugf("failed to link %s, copying the file: %s", path, err)
+		return copyFile(ctx, path, target, fi.Mode().Perm(), o.readLimiter)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// copyFile copies the file from the path to the target with the bandwidth limited by l.
+func copyFile(ctx context.Context, path, target string, mode fs.FileMode, l io.Limiter) (err error) {
+	src, err := file.Open(path, os.O_RDONLY, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		e := src.Close()
+		if e != nil {
+			log.Errorf("failed to close %s: %s", path, e)
+		}
+	}()
+
+	dst, err := file.Open(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		e := dst.Close()
+		if e != nil {
+			err = errors.Wrap(err, e.Error())
+		}
+	}()
+
+	r, err := io.NewReaderWithLimiter(ctx, src, l)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, r)
+	return err
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package observer provides storage observer
+package observer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+)
+
+func Test_observer_hasNewGeneration(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name       string
+		marker     *metadata.SaveMarker
+		generation uint64
+		want       bool
+	}
+	tests := []test{
+		{
+			name: "returns false when the save marker is not found",
+			want: false,
+		},
+		{
+			name: "returns false when the agent is saving",
+			marker: &metadata.SaveMarker{
+				Generation: 2,
+				Saving:     true,
+			},
+			generation: 1,
+			want:       false,
+		},
+		{
+			name: "returns false when the generation is backed up",
+			marker: &metadata.SaveMarker{
+				Generation: 2,
+			},
+			generation: 2,
+			want:       false,
+		},
+		{
+			name: "returns true when the new generation is saved",
+			marker: &metadata.SaveMarker{
+				Generation: 2,
+			},
+			generation: 1,
+			want:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			dir := tt.TempDir()
+			o := &observer{
+				markerPath: filepath.Join(dir, metadata.SaveMarkerFileName),
+				generation: test.generation,
+			}
+			if test.marker != nil {
+				if err := metadata.StoreSaveMarker(o.markerPath, test.marker); err != nil {
+					tt.Fatal(err)
+				}
+			}
+			if got := o.hasNewGeneration(); got != test.want {
+				tt.Errorf("got: %v, want: %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package observer provides storage observer
+package observer
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+const (
+	// defaultLatencyMetric is the histogram of the gRPC server latency in milliseconds exported by the agent.
+	defaultLatencyMetric = "vald_grpc_io_server_server_latency"
+	// maxErrorBodySize is the maximum bytes of the response body to be included in the error.
+	maxErrorBodySize = 1024
+)
+
+// latencySample is the cumulative sum and count of the latency histogram.
+type latencySample struct {
+	sum   float64
+	count uint64
+}
+
+// startThrottle starts the loop to lower the read and upload rates while the gRPC latency of the agent exceeds the threshold.
+func (o *observer) startThrottle(ctx context.Context) (<-chan error, error) {
+	ech := make(chan error, 100)
+	o.eg.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+
+		tt := time.NewTicker(o.throttleDuration)
+		defer tt.Stop()
+
+		finalize := func() (err error) {
+			err = ctx.Err()
+			if err != nil && err != context.Canceled {
+				return err
+			}
+			return nil
+		}
+
+		var prev *latencySample
+		for {
+			select {
+			case <-ctx.Done():
+				return finalize()
+			case <-tt.C:
+				cur, err := o.sampleLatency(ctx)
+				if err != nil {
+					log.Warn("cannot get the latency of the agent:", err)
+					select {
+					case <-ctx.Done():
+						return finalize()
+					case ech <- err:
+					}
+					continue
+				}
+				if prev != nil {
+					o.throttle(prev, cur)
+				}
+				prev = cur
+			}
+		}
+	}))
+
+	return ech, nil
+}
+
+// sampleLatency returns the cumulative latency of all the gRPC methods of the agent.
+func (o *observer) sampleLatency(ctx context.Context) (*latencySample, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.metricsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		e := res.Body.Close()
+		if e != nil {
+			log.Warn("failed to close the metrics response body:", e)
+		}
+	}()
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+		return nil, errors.ErrUnexpectedHTTPStatus(res.StatusCode, string(msg))
+	}
+
+	var parser expfmt.TextParser
+	mfs, err := parser.TextToMetricFamilies(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	mf, ok := mfs[o.latencyMetric]
+	if !ok {
+		return nil, errors.ErrLatencyMetricNotFound(o.latencyMetric)
+	}
+
+	s := new(latencySample)
+	for _, m := range mf.GetMetric() {
+		h := m.GetHistogram()
+		if h == nil {
+			continue
+		}
+		s.sum += h.GetSampleSum()
+		s.count += h.GetSampleCount()
+	}
+	return s, nil
+}
+
+// throttle sets the rates to the throttled rate when the average latency between the samples exceeds the threshold, and restores them otherwise.
+func (o *observer) throttle(prev, cur *latencySample) {
+	var throttled bool
+	// the counters are reset when the agent is restarted.
+	if cur.count > prev.count && cur.sum >= prev.sum {
+		avg := time.Duration((cur.sum - prev.sum) / float64(cur.count-prev.count) * float64(time.Millisecond))
+		throttled = avg > o.latencyThreshold
+		if throttled && o.readLimiter.Rate() == o.readRate && o.uploadLimiter.Rate() == o.uploadRate {
+			log.Infof("throttling the backup because the average latency of the agent %s exceeds %s", avg, o.latencyThreshold)
+		}
+	}
+
+	readRate, uploadRate := o.readRate, o.uploadRate
+	if throttled {
+		readRate, uploadRate = throttledRate(o.readRate, o.throttledRate), throttledRate(o.uploadRate, o.throttledRate)
+	} else if o.readLimiter.Rate() != o.readRate || o.uploadLimiter.Rate() != o.uploadRate {
+		log.Info("the backup is no longer throttled")
+	}
+	o.readLimiter.SetRate(readRate)
+	o.uploadLimiter.SetRate(uploadRate)
+}
+
+// throttledRate returns the lower rate of the base and the throttled rate, where the rate less than or equal to 0 means unlimited.
+func throttledRate(base, throttled int64) int64 {
+	if throttled <= 0 || (base > 0 && base < throttled) {
+		return base
+	}
+	return throttled
+}
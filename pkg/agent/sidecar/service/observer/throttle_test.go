@@ -0,0 +1,224 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package observer provides storage observer
+package observer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+)
+
+func Test_observer_sampleLatency(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name    string
+		status  int
+		body    string
+		want    *latencySample
+		wantErr error
+	}
+	tests := []test{
+		{
+			name:   "returns the sum and count of all the methods",
+			status: http.StatusOK,
+			body: `# HELP vald_grpc_io_server_server_latency Distribution of latency
+# TYPE vald_grpc_io_server_server_latency histogram
+vald_grpc_io_server_server_latency_bucket{grpc_server_method="vald.v1.Search/Search",le="+Inf"} 4
+vald_grpc_io_server_server_latency_sum{grpc_server_method="vald.v1.Search/Search"} 40
+vald_grpc_io_server_server_latency_count{grpc_server_method="vald.v1.Search/Search"} 4
+vald_grpc_io_server_server_latency_bucket{grpc_server_method="vald.v1.Insert/Insert",le="+Inf"} 1
+vald_grpc_io_server_server_latency_sum{grpc_server_method="vald.v1.Insert/Insert"} 10
+vald_grpc_io_server_server_latency_count{grpc_server_method="vald.v1.Insert/Insert"} 1
+`,
+			want: &latencySample{
+				sum:   50,
+				count: 5,
+			},
+		},
+		{
+			name:    "returns error when the metric is not found",
+			status:  http.StatusOK,
+			body:    "",
+			wantErr: errors.ErrLatencyMetricNotFound(defaultLatencyMetric),
+		},
+		{
+			name:    "returns error when the status is not ok",
+			status:  http.StatusServiceUnavailable,
+			body:    "unavailable",
+			wantErr: errors.ErrUnexpectedHTTPStatus(http.StatusServiceUnavailable, "unavailable"),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer srv.Close()
+
+			o := &observer{
+				metricsURL:    srv.URL,
+				latencyMetric: defaultLatencyMetric,
+				client:        srv.Client(),
+			}
+			got, err := o.sampleLatency(context.Background())
+			if !errors.Is(err, test.wantErr) {
+				tt.Fatalf("error = %v, want %v", err, test.wantErr)
+			}
+			if test.want != nil && *got != *test.want {
+				tt.Errorf("got = %v, want %v", *got, *test.want)
+			}
+		})
+	}
+}
+
+func Test_observer_throttle(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name       string
+		prev       *latencySample
+		cur        *latencySample
+		readRate   int64
+		uploadRate int64
+		wantRead   int64
+		wantUpload int64
+	}
+	tests := []test{
+		{
+			name:       "throttles the rates when the latency exceeds the threshold",
+			prev:       &latencySample{sum: 100, count: 10},
+			cur:        &latencySample{sum: 2100, count: 20},
+			readRate:   0,
+			uploadRate: 500,
+			wantRead:   100,
+			wantUpload: 100,
+		},
+		{
+			name:       "keeps the lower base rate when the latency exceeds the threshold",
+			prev:       &latencySample{sum: 100, count: 10},
+			cur:        &latencySample{sum: 2100, count: 20},
+			readRate:   50,
+			uploadRate: 500,
+			wantRead:   50,
+			wantUpload: 100,
+		},
+		{
+			name:       "restores the rates when the latency is below the threshold",
+			prev:       &latencySample{sum: 100, count: 10},
+			cur:        &latencySample{sum: 200, count: 20},
+			readRate:   0,
+			uploadRate: 500,
+			wantRead:   0,
+			wantUpload: 500,
+		},
+		{
+			name:       "restores the rates when the counters are reset",
+			prev:       &latencySample{sum: 2100, count: 20},
+			cur:        &latencySample{sum: 100, count: 1},
+			readRate:   0,
+			uploadRate: 500,
+			wantRead:   0,
+			wantUpload: 500,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			o := &observer{
+				readRate:         test.readRate,
+				uploadRate:       test.uploadRate,
+				readLimiter:      io.NewLimiter(1),
+				uploadLimiter:    io.NewLimiter(1),
+				throttledRate:    100,
+				latencyThreshold: 100 * time.Millisecond,
+			}
+			o.throttle(test.prev, test.cur)
+			if got := o.readLimiter.Rate(); got != test.wantRead {
+				tt.Errorf("read rate = %d, want %d", got, test.wantRead)
+			}
+			if got := o.uploadLimiter.Rate(); got != test.wantUpload {
+				tt.Errorf("upload rate = %d, want %d", got, test.wantUpload)
+			}
+		})
+	}
+}
+
+func Test_observer_startThrottle(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	var requests int32
+	eg, _ := errgroup.New(context.Background())
+	o := &observer{
+		eg:               eg,
+		throttleDuration: time.Millisecond,
+		metricsURL:       srv.URL,
+		latencyMetric:    defaultLatencyMetric,
+		client: &http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&requests, 1)
+				return http.DefaultTransport.RoundTrip(r)
+			}),
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ech, err := o.startThrottle(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the errors are not received, so that the error channel is full while the metrics endpoint is down.
+	for atomic.LoadInt32(&requests) <= int32(cap(ech)) {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- eg.Wait()
+	}()
+	select {
+	case err = <-done:
+		if err != nil {
+			t.Errorf("error = %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("throttle loop is not finished after the context is canceled")
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,232 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package replayer provides replayer service
+package replayer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/gob"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+)
+
+const (
+	// kvsFileName is the name of the file which has the map of the UUIDs to the object IDs of the agent.
+	kvsFileName = "ngt-meta.kvsdb"
+	// propertyFileName is the name of the NGT property file.
+	propertyFileName = "prf"
+	// objectFileName is the name of the NGT object repository file.
+	objectFileName = "obj"
+
+	// the markers of the removed and the stored objects in the NGT object repository.
+	removedObject = '-'
+	storedObject  = '+'
+)
+
+// index reads the vectors of the NGT index restored from the backup without loading the index.
+// The object repository is the object count followed by the marker and the binary data of each object of the ID, and the object of ID 0 is not used.
+// The layout is of the index saved by NGT v1.13.7 (versions/NGT_VERSION), and it is checked against the index saved by NGT in index_ngt_test.go.
+type index struct {
+	dim   int
+	float bool
+	uuids []string
+	size  int
+
+	f   *os.File
+	r   *bufio.Reader
+	buf []byte
+	id  int
+}
+
+// openIndex opens the NGT index in the directory.
+func openIndex(dir string) (x *index, err error) {
+	x = new(index)
+	err = x.loadProperty(filepath.Join(dir, propertyFileName))
+	if err != nil {
+		return nil, err
+	}
+	ids, err := loadKVS(filepath.Join(dir, kvsFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dir, objectFileName)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+		}
+	}()
+	x.f, x.r = f, bufio.NewReader(f)
+	var n uint64
+	err = binary.Read(x.r, binary.LittleEndian, &n)
+	if err != nil {
+		return nil, errors.ErrInvalidCloneIndex(objectFileName, err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	// every object has the marker at least, the count is checked not to allocate the UUIDs by the broken count.
+	if n > uint64(fi.Size()) {
+		return nil, errors.ErrInvalidCloneIndex(objectFileName, errors.Errorf("object count %d exceeds the file size %d", n, fi.Size()))
+	}
+	x.uuids = make([]string, n)
+	for uuid, id := range ids {
+		if uint64(id) >= n || id == 0 || len(x.uuids[id]) != 0 {
+			return nil, errors.ErrInvalidCloneIndex(kvsFileName, errors.Errorf("object id %d of %s is out of the object repository", id, uuid))
+		}
+		x.uuids[id] = uuid
+	}
+	x.buf = make([]byte, x.size)
+	return x, nil
+}
+
+// loadProperty loads the dimension and the object type of the NGT property file.
+func (x *index) loadProperty(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var typ string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		switch fields[0] {
+		case "Dimension":
+			x.dim, err = strconv.Atoi(fields[1])
+			if err != nil {
+				return errors.ErrInvalidCloneIndex(propertyFileName, err)
+			}
+		case "ObjectType":
+			typ = fields[1]
+		}
+	}
+	if err = sc.Err(); err != nil {
+		return errors.ErrInvalidCloneIndex(propertyFileName, err)
+	}
+	if x.dim <= 0 {
+		return errors.ErrInvalidCloneIndex(propertyFileName, errors.Errorf("invalid dimension %d", x.dim))
+	}
+	switch typ {
+	case "Float":
+		x.float, x.size = true, x.dim*4
+	case "Integer-1":
+		x.float, x.size = false, x.dim
+	default:
+		return errors.ErrInvalidCloneIndex(propertyFileName, errors.Errorf("unsupported object type %s", typ))
+	}
+	return nil
+}
+
+// loadKVS loads the map of the UUIDs to the object IDs encoded by the agent.
+func loadKVS(path string) (map[string]uint32, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m := make(map[string]uint32)
+	err = gob.NewDecoder(f).Decode(&m)
+	if err != nil {
+		return nil, errors.ErrInvalidCloneIndex(kvsFileName, err)
+	}
+	return m, nil
+}
+
+// Next returns the vector of the next object which has the UUID, and returns io.EOF after the last object.
+// The object repository must end with the last object, so that the unexpected layout is not read as the broken vectors.
+func (x *index) Next() (*payload.Object_Vector, error) {
+	for x.id < len(x.uuids) {
+		id := x.id
+		x.id++
+		marker, err := x.r.ReadByte()
+		if err != nil {
+			return nil, errors.ErrInvalidCloneIndex(objectFileName, io.ErrUnexpectedEOF)
+		}
+		switch marker {
+		case removedObject:
+			if len(x.uuids[id]) != 0 {
+				return nil, errors.ErrInvalidCloneIndex(objectFileName, errors.Errorf("object %d of %s is removed", id, x.uuids[id]))
+			}
+			continue
+		case storedObject:
+		default:
+			return nil, errors.ErrInvalidCloneIndex(objectFileName, errors.Errorf("unexpected marker %q of object %d", marker, id))
+		}
+		_, err = io.ReadFull(x.r, x.buf)
+		if err != nil {
+			return nil, errors.ErrInvalidCloneIndex(objectFileName, io.ErrUnexpectedEOF)
+		}
+		// the object which is not in the map is removed from the agent but remains in the repository until the index is rebuilt.
+		if len(x.uuids[id]) == 0 {
+			continue
+		}
+		return &payload.Object_Vector{
+			Id:     x.uuids[id],
+			Vector: x.vector(),
+		}, nil
+	}
+	if _, err := x.r.ReadByte(); !errors.Is(err, io.EOF) {
+		return nil, errors.ErrInvalidCloneIndex(objectFileName, errors.New("unexpected data after the last object"))
+	}
+	return nil, io.EOF
+}
+
+// vector decodes the object in the buffer as the float32 vector.
+func (x *index) vector() []float32 {
+	vec := make([]float32, x.dim)
+	for i := range vec {
+		if x.float {
+			vec[i] = math.Float32frombits(binary.LittleEndian.Uint32(x.buf[i*4:]))
+		} else {
+			vec[i] = float32(x.buf[i])
+		}
+	}
+	return vec
+}
+
+// Len returns the number of the objects which have the UUIDs.
+func (x *index) Len() (n int) {
+	for _, uuid := range x.uuids {
+		if len(uuid) != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func (x *index) Close() error {
+	return x.f.Close()
+}
@@ -0,0 +1,145 @@
+//go:build cgo
+// +build cgo
+
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package replayer provides replayer service
+package replayer
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/vdaas/vald/internal/core/algorithm/ngt"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+)
+
+// Test_openIndex_NGT reads the index saved by NGT, so that the layout of the object repository is checked against the NGT version of the agent.
+func Test_openIndex_NGT(t *testing.T) {
+	type test struct {
+		name       string
+		objectType string
+		float      bool
+		vecs       [][]float32
+	}
+	tests := []test{
+		{
+			name:       "reads the float vectors of the index saved by NGT",
+			objectType: "float",
+			float:      true,
+			vecs: [][]float32{
+				{0.5, -1, 2.25},
+				{3, 4, 5},
+				{-6.5, 7, 8.125},
+				{9, -10, 11},
+				{12.5, 13, -14},
+			},
+		},
+		{
+			name:       "reads the uint8 vectors of the index saved by NGT",
+			objectType: "uint8",
+			vecs: [][]float32{
+				{0, 1, 2},
+				{3, 4, 5},
+				{255, 7, 8},
+				{9, 10, 11},
+				{12, 128, 14},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			dir := tt.TempDir()
+			n, err := ngt.New(
+				ngt.WithIndexPath(dir),
+				ngt.WithDimension(len(test.vecs[0])),
+				ngt.WithObjectTypeByString(test.objectType),
+				ngt.WithDistanceTypeByString("l2"),
+			)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			defer n.Close()
+
+			ids := make(map[string]uint32, len(test.vecs))
+			want := make(map[string][]float32, len(test.vecs))
+			for i, vec := range test.vecs {
+				id, err := n.Insert(vec)
+				if err != nil {
+					tt.Fatal(err)
+				}
+				uuid := strconv.Itoa(i)
+				ids[uuid] = uint32(id)
+				want[uuid] = vec
+			}
+			if err = n.CreateIndex(1); err != nil {
+				tt.Fatal(err)
+			}
+			// the removed object remains in the object repository as the removed marker.
+			if err = n.Remove(uint(ids["1"])); err != nil {
+				tt.Fatal(err)
+			}
+			delete(ids, "1")
+			delete(want, "1")
+			if err = n.SaveIndex(); err != nil {
+				tt.Fatal(err)
+			}
+			for uuid, id := range ids {
+				vec, err := n.GetVector(uint(id))
+				if err != nil {
+					tt.Fatal(err)
+				}
+				if !reflect.DeepEqual(vec, want[uuid]) {
+					tt.Fatalf("vector of %s in NGT = %v, want %v", uuid, vec, want[uuid])
+				}
+			}
+			writeIndexFiles(tt, dir, map[string][]byte{
+				kvsFileName: newKVS(tt, ids),
+			})
+
+			x, err := openIndex(dir)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			defer x.Close()
+			if x.float != test.float || x.dim != len(test.vecs[0]) {
+				tt.Errorf("float = %v, dim = %d, want float = %v, dim = %d", x.float, x.dim, test.float, len(test.vecs[0]))
+			}
+			got := make(map[string][]float32, len(ids))
+			for {
+				vec, err := x.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					tt.Fatal(err)
+				}
+				got[vec.GetId()] = vec.GetVector()
+			}
+			if !reflect.DeepEqual(got, want) {
+				tt.Errorf("vectors = %v, want %v", got, want)
+			}
+			if x.Len() != len(ids) {
+				tt.Errorf("len = %d, want %d", x.Len(), len(ids))
+			}
+		})
+	}
+}
@@ -0,0 +1,365 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package replayer provides replayer service
+package replayer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+)
+
+// object represents the object of the NGT object repository, the vector of the removed object is not written.
+type object struct {
+	removed bool
+	vec     []float32
+}
+
+// newIndexFiles returns the property, the object repository and the kvsdb files of the index which has the objects from ID 1.
+func newIndexFiles(t *testing.T, typ string, dim int, objs []object, ids map[string]uint32) map[string][]byte {
+	t.Helper()
+	obj := new(bytes.Buffer)
+	if err := binary.Write(obj, binary.LittleEndian, uint64(len(objs)+1)); err != nil {
+		t.Fatal(err)
+	}
+	obj.WriteByte(removedObject)
+	for _, o := range objs {
+		if o.removed {
+			obj.WriteByte(removedObject)
+			continue
+		}
+		obj.WriteByte(storedObject)
+		for _, v := range o.vec {
+			if typ == "Float" {
+				if err := binary.Write(obj, binary.LittleEndian, math.Float32bits(v)); err != nil {
+					t.Fatal(err)
+				}
+			} else {
+				obj.WriteByte(byte(v))
+			}
+		}
+	}
+	return map[string][]byte{
+		propertyFileName: newProperty(typ, dim),
+		objectFileName:   obj.Bytes(),
+		kvsFileName:      newKVS(t, ids),
+	}
+}
+
+func newProperty(typ string, dim int) []byte {
+	return []byte("Dimension\t" + strconv.Itoa(dim) + "\nDistanceType\tL2\nObjectType\t" + typ + "\n")
+}
+
+func newKVS(t *testing.T, ids map[string]uint32) []byte {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(&ids); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func writeIndexFiles(t *testing.T, dir string, files map[string][]byte) {
+	t.Helper()
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func Test_index_Next(t *testing.T) {
+	t.Parallel()
+	type want struct {
+		vecs map[string][]float32
+		ids  []string
+		len  int
+		err  error
+	}
+	type test struct {
+		name  string
+		files func(t *testing.T) map[string][]byte
+		want  want
+	}
+	tests := []test{
+		{
+			name: "reads the float vectors and skips the removed objects and the objects without uuid",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				return newIndexFiles(t, "Float", 2, []object{
+					{vec: []float32{0.5, -1}},
+					{removed: true},
+					{vec: []float32{3, 4}},
+					{vec: []float32{5.25, 6}},
+				}, map[string]uint32{
+					"a": 1,
+					"c": 4,
+				})
+			},
+			want: want{
+				vecs: map[string][]float32{
+					"a": {0.5, -1},
+					"c": {5.25, 6},
+				},
+				ids: []string{"a", "c"},
+				len: 2,
+			},
+		},
+		{
+			name: "reads the uint8 vectors of the object repository written by NGT",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				// the index saved by NGT in internal/core/algorithm/ngt.
+				dir := filepath.Join("..", "..", "..", "..", "..", "internal", "core", "algorithm", "ngt", "assets", "index")
+				files := map[string][]byte{
+					kvsFileName: newKVS(t, map[string]uint32{
+						"1": 1,
+						"2": 2,
+						"6": 6,
+					}),
+				}
+				for _, name := range []string{propertyFileName, objectFileName} {
+					data, err := os.ReadFile(filepath.Join(dir, name))
+					if err != nil {
+						t.Fatal(err)
+					}
+					files[name] = data
+				}
+				return files
+			},
+			want: want{
+				vecs: map[string][]float32{
+					"1": {1, 0, 0, 0, 0, 0},
+					"2": {0, 1, 0, 0, 0, 0},
+					"6": {1, 1, 0, 0, 0, 0},
+				},
+				ids: []string{"1", "2", "6"},
+				len: 3,
+			},
+		},
+		{
+			name: "returns error when the uuid is mapped to the removed object",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				return newIndexFiles(t, "Float", 2, []object{
+					{vec: []float32{1, 2}},
+					{removed: true},
+				}, map[string]uint32{
+					"a": 1,
+					"b": 2,
+				})
+			},
+			want: want{
+				vecs: map[string][]float32{
+					"a": {1, 2},
+				},
+				ids: []string{"a"},
+				len: 2,
+				err: errors.ErrInvalidCloneIndex(objectFileName, errors.Errorf("object %d of %s is removed", 2, "b")),
+			},
+		},
+		{
+			name: "returns error when the object repository has the data after the last object",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				files := newIndexFiles(t, "Float", 2, []object{
+					{vec: []float32{1, 2}},
+				}, map[string]uint32{
+					"a": 1,
+				})
+				files[objectFileName] = append(files[objectFileName], storedObject)
+				return files
+			},
+			want: want{
+				vecs: map[string][]float32{
+					"a": {1, 2},
+				},
+				ids: []string{"a"},
+				len: 1,
+				err: errors.ErrInvalidCloneIndex(objectFileName, errors.New("unexpected data after the last object")),
+			},
+		},
+		{
+			name: "returns error when the object repository is truncated",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				files := newIndexFiles(t, "Float", 2, []object{
+					{vec: []float32{1, 2}},
+					{vec: []float32{3, 4}},
+				}, map[string]uint32{
+					"a": 1,
+					"b": 2,
+				})
+				files[objectFileName] = files[objectFileName][:len(files[objectFileName])-1]
+				return files
+			},
+			want: want{
+				vecs: map[string][]float32{
+					"a": {1, 2},
+				},
+				ids: []string{"a"},
+				len: 2,
+				err: errors.ErrInvalidCloneIndex(objectFileName, io.ErrUnexpectedEOF),
+			},
+		},
+		{
+			name: "returns error when the object marker is unexpected",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				files := newIndexFiles(t, "Integer-1", 1, []object{
+					{vec: []float32{1}},
+				}, map[string]uint32{
+					"a": 1,
+				})
+				files[objectFileName][9] = 'x'
+				return files
+			},
+			want: want{
+				vecs: map[string][]float32{},
+				len:  1,
+				err:  errors.ErrInvalidCloneIndex(objectFileName, errors.Errorf("unexpected marker %q of object %d", 'x', 1)),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			dir := tt.TempDir()
+			writeIndexFiles(tt, dir, test.files(tt))
+			x, err := openIndex(dir)
+			if err != nil {
+				tt.Fatalf("failed to open index: %v", err)
+			}
+			defer x.Close()
+			if got := x.Len(); got != test.want.len {
+				tt.Errorf("Len() = %d, want %d", got, test.want.len)
+			}
+			vecs := make(map[string][]float32)
+			ids := make([]string, 0, len(test.want.ids))
+			for {
+				vec, err := x.Next()
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						err = nil
+					}
+					if !errors.Is(err, test.want.err) {
+						tt.Errorf("error = %v, want %v", err, test.want.err)
+					}
+					break
+				}
+				vecs[vec.GetId()] = vec.GetVector()
+				ids = append(ids, vec.GetId())
+			}
+			if !reflect.DeepEqual(vecs, test.want.vecs) {
+				tt.Errorf("vecs = %v, want %v", vecs, test.want.vecs)
+			}
+			if !reflect.DeepEqual(ids, test.want.ids) && len(ids)+len(test.want.ids) != 0 {
+				tt.Errorf("ids = %v, want %v", ids, test.want.ids)
+			}
+		})
+	}
+}
+
+func Test_openIndex(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name  string
+		files func(t *testing.T) map[string][]byte
+		want  error
+	}
+	tests := []test{
+		{
+			name: "returns error when the object id is out of the object repository",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				return newIndexFiles(t, "Float", 2, []object{
+					{vec: []float32{1, 2}},
+				}, map[string]uint32{
+					"a": 2,
+				})
+			},
+			want: errors.ErrInvalidCloneIndex(kvsFileName, errors.Errorf("object id %d of %s is out of the object repository", 2, "a")),
+		},
+		{
+			name: "returns error when the object id is 0",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				return newIndexFiles(t, "Float", 2, []object{
+					{vec: []float32{1, 2}},
+				}, map[string]uint32{
+					"a": 0,
+				})
+			},
+			want: errors.ErrInvalidCloneIndex(kvsFileName, errors.Errorf("object id %d of %s is out of the object repository", 0, "a")),
+		},
+		{
+			name: "returns error when the object count exceeds the file size",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				files := newIndexFiles(t, "Float", 2, nil, nil)
+				binary.LittleEndian.PutUint64(files[objectFileName], math.MaxUint32)
+				return files
+			},
+			want: errors.ErrInvalidCloneIndex(objectFileName, errors.Errorf("object count %d exceeds the file size %d", math.MaxUint32, 9)),
+		},
+		{
+			name: "returns error when the object type is not supported",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				return newIndexFiles(t, "Float16", 2, nil, nil)
+			},
+			want: errors.ErrInvalidCloneIndex(propertyFileName, errors.Errorf("unsupported object type %s", "Float16")),
+		},
+		{
+			name: "returns error when the kvsdb is broken",
+			files: func(t *testing.T) map[string][]byte {
+				t.Helper()
+				files := newIndexFiles(t, "Float", 2, nil, nil)
+				files[kvsFileName] = []byte("broken")
+				return files
+			},
+			want: errors.ErrInvalidCloneIndex(kvsFileName, errors.New("unexpected EOF")),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			dir := tt.TempDir()
+			writeIndexFiles(tt, dir, test.files(tt))
+			x, err := openIndex(dir)
+			if err == nil {
+				x.Close()
+			}
+			if !errors.Is(err, test.want) {
+				tt.Errorf("error = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
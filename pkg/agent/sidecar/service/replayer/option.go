@@ -0,0 +1,115 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package replayer provides replayer service
+package replayer
+
+import (
+	"github.com/vdaas/vald/internal/client/v1/client/vald"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/timeutil"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+type Option func(r *replayer) error
+
+var defaultOptions = []Option{
+	WithErrGroup(errgroup.Get()),
+	WithBatchSize(100),
+	WithCheckDuration("10s"),
+}
+
+func WithErrGroup(eg errgroup.Group) Option {
+	return func(r *replayer) error {
+		if eg != nil {
+			r.eg = eg
+		}
+		return nil
+	}
+}
+
+// WithGatewayClient returns the option to set the client of the gateway of the target cluster.
+func WithGatewayClient(c vald.Client) Option {
+	return func(r *replayer) error {
+		if c != nil {
+			r.gateway = c
+		}
+		return nil
+	}
+}
+
+// WithSource returns the option to add the backup of the source agent to replay.
+func WithSource(name string, st storage.Storage) Option {
+	return func(r *replayer) error {
+		if len(name) != 0 && st != nil {
+			r.sources = append(r.sources, &source{
+				name:    name,
+				storage: st,
+			})
+		}
+		return nil
+	}
+}
+
+// WithVersion returns the option to set the version of the source backups to replay.
+func WithVersion(version string) Option {
+	return func(r *replayer) error {
+		r.version = version
+		return nil
+	}
+}
+
+// WithWorkDir returns the option to set the directory to restore the source backups into during the replay.
+func WithWorkDir(dir string) Option {
+	return func(r *replayer) error {
+		if len(dir) != 0 {
+			r.dir = dir
+		}
+		return nil
+	}
+}
+
+// WithBatchSize returns the option to set the number of the vectors inserted through the gateway at once.
+func WithBatchSize(size int) Option {
+	return func(r *replayer) error {
+		if size > 0 {
+			r.batchSize = size
+		}
+		return nil
+	}
+}
+
+func WithCheckDuration(dur string) Option {
+	return func(r *replayer) error {
+		if dur == "" {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return nil
+		}
+		r.checkDuration = d
+		return nil
+	}
+}
+
+// WithCheckpointPath returns the option to set the file path to record the finished replay.
+func WithCheckpointPath(path string) Option {
+	return func(r *replayer) error {
+		r.checkpointPath = path
+		return nil
+	}
+}
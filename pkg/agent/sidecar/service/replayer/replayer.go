@@ -0,0 +1,352 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package replayer provides replayer service
+package replayer
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/client/v1/client/vald"
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/restorer"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+// Replayer represents the interface to replay the vectors of the source backups through the gateway of the target cluster.
+type Replayer interface {
+	Start(ctx context.Context) (<-chan error, error)
+}
+
+type replayer struct {
+	eg             errgroup.Group
+	gateway        vald.Client
+	sources        []*source
+	version        string
+	dir            string
+	batchSize      int
+	checkDuration  time.Duration
+	checkpointPath string
+}
+
+// source represents the backup of the source agent to replay.
+type source struct {
+	name    string
+	storage storage.Storage
+}
+
+// checkpoint represents the replayed sources recorded in the checkpoint file.
+type checkpoint struct {
+	Sources    []string  `json:"sources"`
+	Replayed   int       `json:"replayed"`
+	FinishedAt time.Time `json:"finished_at"`
+}
+
+// New returns the Replayer implementation if no error occurs.
+func New(opts ...Option) (Replayer, error) {
+	r := new(replayer)
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(r); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	if r.gateway == nil {
+		return nil, errors.ErrGRPCClientNotFound
+	}
+	if len(r.dir) == 0 {
+		r.dir = os.TempDir()
+	}
+	return r, nil
+}
+
+// Start replays the source backups once the gateway is available, and retries it every check duration until it succeeds.
+func (r *replayer) Start(ctx context.Context) (<-chan error, error) {
+	gech, err := r.gateway.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cp, err := r.loadCheckpoint()
+	if err != nil {
+		log.Warnf("failed to load replay checkpoint from %s: %v", r.checkpointPath, err)
+		cp = new(checkpoint)
+	}
+	done := !cp.FinishedAt.IsZero()
+	if done {
+		log.Infof("skipping the replay because it has been finished according to %s", r.checkpointPath)
+	}
+	ech := make(chan error, 100)
+	r.eg.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+		ct := time.NewTicker(r.checkDuration)
+		defer ct.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+				if err != nil && err != context.Canceled {
+					return err
+				}
+				return nil
+			case err = <-gech:
+			case <-ct.C:
+				if !done {
+					err = r.replay(ctx, cp)
+					if err != nil {
+						log.Error("an error occurred during replay", err)
+					}
+					done = err == nil
+				}
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+	}))
+	return ech, nil
+}
+
+// replay replays the sources which are not replayed yet in order, and records each replayed source in the checkpoint to resume the interrupted replay.
+func (r *replayer) replay(ctx context.Context, cp *checkpoint) (err error) {
+	ctx, span := trace.StartSpan(ctx, "vald/agent-sidecar/service/replayer/Replayer.replay")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+
+	replayed := make(map[string]struct{}, len(cp.Sources))
+	for _, name := range cp.Sources {
+		replayed[name] = struct{}{}
+	}
+	for _, src := range r.sources {
+		if _, ok := replayed[src.name]; ok {
+			continue
+		}
+		n, err := r.replaySource(ctx, src)
+		if err != nil {
+			return err
+		}
+		cp.Sources = append(cp.Sources, src.name)
+		cp.Replayed += n
+		err = r.storeCheckpoint(cp)
+		if err != nil {
+			return err
+		}
+	}
+	cp.FinishedAt = time.Now()
+	err = r.storeCheckpoint(cp)
+	if err != nil {
+		return err
+	}
+	log.Infof("finished to replay %d vectors of %d source backups through the gateway", cp.Replayed, len(r.sources))
+	return nil
+}
+
+// replaySource restores the source backup into the working directory, and inserts all vectors of its index through the gateway in batches.
+// The vectors are read from the restored files, so that the target agent does not keep the copy of the source index besides the replicas inserted by the gateway.
+func (r *replayer) replaySource(ctx context.Context, src *source) (replayed int, err error) {
+	dir := filepath.Join(r.dir, src.name)
+	err = os.RemoveAll(dir)
+	if err != nil {
+		return 0, err
+	}
+	err = os.MkdirAll(dir, 0o700)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		e := os.RemoveAll(dir)
+		if e != nil {
+			log.Errorf("error on removing replay directory %s: %s", dir, e)
+		}
+	}()
+
+	_, err = src.storage.Start(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		e := src.storage.Stop(ctx)
+		if e != nil {
+			log.Errorf("error on stopping blob storage of %s: %s", src.name, e)
+		}
+	}()
+
+	rs, err := restorer.New(
+		restorer.WithErrGroup(r.eg),
+		restorer.WithDir(dir),
+		restorer.WithBlobStorage(src.storage),
+		restorer.WithVersion(r.version),
+	)
+	if err != nil {
+		return 0, err
+	}
+	err = rs.Restore(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// the source agent which has never saved the index has no files in the backup.
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	if len(entries) == 0 {
+		log.Warnf("skipping the replay of %s because its backup has no index", src.name)
+		return 0, nil
+	}
+
+	x, err := openIndex(dir)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		e := x.Close()
+		if e != nil {
+			log.Errorf("error on closing index of %s: %s", src.name, e)
+		}
+	}()
+	log.Infof("started to replay %d vectors of %s through the gateway", x.Len(), src.name)
+
+	reqs := make([]*payload.Insert_Request, 0, r.batchSize)
+	for {
+		vec, err := x.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return replayed, err
+		}
+		reqs = append(reqs, &payload.Insert_Request{
+			Vector: vec,
+		})
+		if len(reqs) < r.batchSize {
+			continue
+		}
+		err = r.insert(ctx, reqs)
+		if err != nil {
+			return replayed, err
+		}
+		replayed += len(reqs)
+		reqs = make([]*payload.Insert_Request, 0, r.batchSize)
+	}
+	if len(reqs) != 0 {
+		err = r.insert(ctx, reqs)
+		if err != nil {
+			return replayed, err
+		}
+		replayed += len(reqs)
+	}
+	log.Infof("finished to replay %d vectors of %s through the gateway", replayed, src.name)
+	return replayed, nil
+}
+
+// insert inserts the vectors through the gateway.
+// The vectors which already exist are treated as replayed, so that the interrupted replay can be resumed from the beginning.
+func (r *replayer) insert(ctx context.Context, reqs []*payload.Insert_Request) error {
+	_, err := r.gateway.MultiInsert(ctx, &payload.Insert_MultiRequest{
+		Requests: reqs,
+	})
+	if err == nil || !alreadyExists(err) {
+		return err
+	}
+	for _, req := range reqs {
+		_, err = r.gateway.Insert(ctx, req)
+		if err != nil && !alreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func alreadyExists(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st != nil && st.Code() == codes.AlreadyExists
+}
+
+// loadCheckpoint loads the replayed sources, it returns the empty checkpoint when the checkpoint file does not exist.
+func (r *replayer) loadCheckpoint() (cp *checkpoint, err error) {
+	cp = new(checkpoint)
+	if r.checkpointPath == "" {
+		return cp, nil
+	}
+	exists, fi, err := file.ExistsWithDetail(r.checkpointPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if !exists || fi == nil || fi.Size() == 0 {
+		return cp, nil
+	}
+	f, err := file.Open(r.checkpointPath, os.O_RDONLY|os.O_SYNC, fs.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if f != nil {
+			derr := f.Close()
+			if derr != nil {
+				err = errors.Wrap(err, derr.Error())
+			}
+		}
+	}()
+	err = json.Decode(f, cp)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return cp, nil
+}
+
+func (r *replayer) storeCheckpoint(cp *checkpoint) (err error) {
+	if r.checkpointPath == "" {
+		return nil
+	}
+	f, err := file.Open(r.checkpointPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if f != nil {
+			derr := f.Close()
+			if derr != nil {
+				err = errors.Wrap(err, derr.Error())
+			}
+		}
+	}()
+	err = json.Encode(f, cp)
+	if err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,391 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package replayer provides replayer service
+package replayer
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/client/v1/client/vald"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+type storageMock struct {
+	storage.Storage
+	backup  []byte
+	started int32
+	stopped int32
+}
+
+type backupReaderMock struct {
+	*bytes.Reader
+}
+
+func (r *backupReaderMock) Close() error {
+	return nil
+}
+
+func (s *storageMock) Start(ctx context.Context) (<-chan error, error) {
+	atomic.AddInt32(&s.started, 1)
+	return nil, nil
+}
+
+func (s *storageMock) Stop(ctx context.Context) error {
+	atomic.AddInt32(&s.stopped, 1)
+	return nil
+}
+
+func (s *storageMock) Manifests(ctx context.Context, version string) ([]*storage.Manifest, error) {
+	return []*storage.Manifest{nil}, nil
+}
+
+func (s *storageMock) Open(ctx context.Context, m *storage.Manifest) (io.ReadCloser, error) {
+	if s.backup == nil {
+		return nil, io.EOF
+	}
+	return &backupReaderMock{bytes.NewReader(s.backup)}, nil
+}
+
+// newBackup returns the tar archive of the files.
+func newBackup(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, data := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o600,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = tw.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+type gatewayClientMock struct {
+	vald.Client
+	mu      sync.Mutex
+	err     error
+	batches []int
+	vecs    map[string][]float32
+}
+
+func (m *gatewayClientMock) MultiInsert(ctx context.Context, in *payload.Insert_MultiRequest, opts ...grpc.CallOption) (*payload.Object_Locations, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	for _, req := range in.GetRequests() {
+		if _, ok := m.vecs[req.GetVector().GetId()]; ok {
+			return nil, status.Error(codes.AlreadyExists, req.GetVector().GetId())
+		}
+	}
+	m.batches = append(m.batches, len(in.GetRequests()))
+	for _, req := range in.GetRequests() {
+		m.vecs[req.GetVector().GetId()] = req.GetVector().GetVector()
+	}
+	return new(payload.Object_Locations), nil
+}
+
+func (m *gatewayClientMock) Insert(ctx context.Context, in *payload.Insert_Request, opts ...grpc.CallOption) (*payload.Object_Location, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.vecs[in.GetVector().GetId()]; ok {
+		return nil, status.Error(codes.AlreadyExists, in.GetVector().GetId())
+	}
+	m.batches = append(m.batches, 1)
+	m.vecs[in.GetVector().GetId()] = in.GetVector().GetVector()
+	return new(payload.Object_Location), nil
+}
+
+func Test_replayer_replay(t *testing.T) {
+	t.Parallel()
+	// the backup of agent-0 has the removed object and the object removed from the agent before the index is rebuilt.
+	backup0 := newBackup(t, newIndexFiles(t, "Float", 2, []object{
+		{vec: []float32{1, 2}},
+		{removed: true},
+		{vec: []float32{3, 4}},
+		{vec: []float32{0, 0}},
+		{vec: []float32{5, 6}},
+	}, map[string]uint32{
+		"a": 1,
+		"b": 3,
+		"c": 5,
+	}))
+	backup2 := newBackup(t, newIndexFiles(t, "Float", 2, []object{
+		{vec: []float32{7, 8}},
+		{vec: []float32{9, 10}},
+	}, map[string]uint32{
+		"d": 1,
+		"e": 2,
+	}))
+	all := map[string][]float32{
+		"a": {1, 2},
+		"b": {3, 4},
+		"c": {5, 6},
+		"d": {7, 8},
+		"e": {9, 10},
+	}
+	errGateway := errors.New("gateway error")
+	type want struct {
+		batches    []int
+		vecs       map[string][]float32
+		checkpoint *checkpoint
+		err        error
+	}
+	type test struct {
+		name       string
+		backups    [][]byte
+		checkpoint *checkpoint
+		gateway    *gatewayClientMock
+		want       want
+	}
+	tests := []test{
+		{
+			name:    "replays the vectors of every source backup in batches and skips the removed objects",
+			backups: [][]byte{backup0, backup2},
+			gateway: &gatewayClientMock{
+				vecs: map[string][]float32{},
+			},
+			want: want{
+				batches: []int{2, 1, 2},
+				vecs:    all,
+				checkpoint: &checkpoint{
+					Sources:  []string{"vald-agent-ngt-0", "vald-agent-ngt-2"},
+					Replayed: 5,
+				},
+			},
+		},
+		{
+			name:    "skips the sources replayed according to the checkpoint",
+			backups: [][]byte{backup0, backup2},
+			checkpoint: &checkpoint{
+				Sources:  []string{"vald-agent-ngt-0"},
+				Replayed: 3,
+			},
+			gateway: &gatewayClientMock{
+				vecs: map[string][]float32{},
+			},
+			want: want{
+				batches: []int{2},
+				vecs: map[string][]float32{
+					"d": {7, 8},
+					"e": {9, 10},
+				},
+				checkpoint: &checkpoint{
+					Sources:  []string{"vald-agent-ngt-0", "vald-agent-ngt-2"},
+					Replayed: 5,
+				},
+			},
+		},
+		{
+			name:    "treats the existing vectors as replayed to resume the interrupted replay",
+			backups: [][]byte{backup0, backup2},
+			gateway: &gatewayClientMock{
+				vecs: map[string][]float32{
+					"a": {1, 2},
+					"d": {7, 8},
+				},
+			},
+			want: want{
+				batches: []int{1, 1, 1},
+				vecs:    all,
+				checkpoint: &checkpoint{
+					Sources:  []string{"vald-agent-ngt-0", "vald-agent-ngt-2"},
+					Replayed: 5,
+				},
+			},
+		},
+		{
+			name:    "skips the source backup which has no index",
+			backups: [][]byte{nil, backup2},
+			gateway: &gatewayClientMock{
+				vecs: map[string][]float32{},
+			},
+			want: want{
+				batches: []int{2},
+				vecs: map[string][]float32{
+					"d": {7, 8},
+					"e": {9, 10},
+				},
+				checkpoint: &checkpoint{
+					Sources:  []string{"vald-agent-ngt-0", "vald-agent-ngt-2"},
+					Replayed: 2,
+				},
+			},
+		},
+		{
+			name:    "returns error when the gateway fails",
+			backups: [][]byte{backup0, backup2},
+			gateway: &gatewayClientMock{
+				err:  errGateway,
+				vecs: map[string][]float32{},
+			},
+			want: want{
+				vecs:       map[string][]float32{},
+				checkpoint: &checkpoint{},
+				err:        errGateway,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			dir := tt.TempDir()
+			r := &replayer{
+				eg:             errgroup.Get(),
+				gateway:        test.gateway,
+				dir:            filepath.Join(dir, "work"),
+				batchSize:      2,
+				checkpointPath: filepath.Join(dir, "replay.json"),
+			}
+			sts := make([]*storageMock, 0, len(test.backups))
+			for i, backup := range test.backups {
+				st := &storageMock{
+					backup: backup,
+				}
+				sts = append(sts, st)
+				r.sources = append(r.sources, &source{
+					name:    "vald-agent-ngt-" + strconv.Itoa(i*2),
+					storage: st,
+				})
+			}
+			cp := test.checkpoint
+			if cp == nil {
+				cp = new(checkpoint)
+			}
+			err := r.replay(context.Background(), cp)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("error = %v, want %v", err, test.want.err)
+			}
+			if !reflect.DeepEqual(test.gateway.batches, test.want.batches) {
+				tt.Errorf("batches = %v, want %v", test.gateway.batches, test.want.batches)
+			}
+			if !reflect.DeepEqual(test.gateway.vecs, test.want.vecs) {
+				tt.Errorf("vecs = %v, want %v", test.gateway.vecs, test.want.vecs)
+			}
+			for i, st := range sts {
+				if st.started != st.stopped {
+					tt.Errorf("storage %d is started %d times but stopped %d times", i, st.started, st.stopped)
+				}
+			}
+
+			got, err := r.loadCheckpoint()
+			if err != nil {
+				tt.Fatalf("failed to load checkpoint: %v", err)
+			}
+			if (test.want.err == nil) == got.FinishedAt.IsZero() {
+				tt.Errorf("finished at = %v, want finished %v", got.FinishedAt, test.want.err == nil)
+			}
+			got.FinishedAt = time.Time{}
+			if !reflect.DeepEqual(got, test.want.checkpoint) {
+				tt.Errorf("checkpoint = %#v, want %#v", got, test.want.checkpoint)
+			}
+
+			// the restored source index must not remain in the target agent.
+			for _, src := range r.sources {
+				if _, err := os.Stat(filepath.Join(r.dir, src.name)); !os.IsNotExist(err) {
+					tt.Errorf("work directory of %s remains: %v", src.name, err)
+				}
+			}
+		})
+	}
+}
+
+func Test_replayer_loadCheckpoint(t *testing.T) {
+	type want struct {
+		checkpoint *checkpoint
+		err        bool
+	}
+	type test struct {
+		name string
+		path func(t *testing.T, dir string) string
+		want want
+	}
+	tests := []test{
+		{
+			name: "start a new replay when the checkpoint does not exist",
+			path: func(t *testing.T, dir string) string {
+				t.Helper()
+				return filepath.Join(dir, "replay.json")
+			},
+			want: want{
+				checkpoint: new(checkpoint),
+			},
+		},
+		{
+			name: "return error when the checkpoint cannot be inspected",
+			path: func(t *testing.T, dir string) string {
+				t.Helper()
+				// the parent of the checkpoint is a regular file.
+				parent := filepath.Join(dir, "file")
+				if err := os.WriteFile(parent, nil, 0o600); err != nil {
+					t.Fatal(err)
+				}
+				return filepath.Join(parent, "replay.json")
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			r := &replayer{
+				checkpointPath: test.path(tt, tt.TempDir()),
+			}
+			got, err := r.loadCheckpoint()
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v,\n\t\t\t\twant error: %v", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.checkpoint) {
+				tt.Errorf("got: %#v,\n\t\t\t\twant: %#v", got, test.want.checkpoint)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package restorer provides restorer service
+package restorer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+// chunkReader reads the chunks of the incremental backup in order as a single stream.
+type chunkReader struct {
+	ctx     context.Context
+	storage storage.Storage
+	chunks  []string
+	r       io.ReadCloser
+}
+
+func (c *chunkReader) Read(p []byte) (n int, err error) {
+	for {
+		if c.r == nil {
+			if len(c.chunks) == 0 {
+				return 0, io.EOF
+			}
+			c.r, err = c.storage.ChunkReader(c.ctx, c.chunks[0])
+			if err != nil {
+				return 0, err
+			}
+			c.chunks = c.chunks[1:]
+		}
+
+		n, err = c.r.Read(p)
+		if !errors.Is(err, io.EOF) {
+			return n, err
+		}
+		err = c.r.Close()
+		c.r = nil
+		if err != nil || n > 0 {
+			return n, err
+		}
+	}
+}
+
+func (c *chunkReader) Close() error {
+	if c.r != nil {
+		return c.r.Close()
+	}
+	return nil
+}
+
+// rebuild rebuilds the files of the incremental backup from the chunks into the staging directory,
+// and returns the integrity information of the rebuilt files.
+func (r *restorer) rebuild(ctx context.Context, m *storage.Manifest, staging string) (files []*storage.ManifestFile, err error) {
+	files = make([]*storage.ManifestFile, 0, len(m.Files))
+	for _, f := range m.Files {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		target := filepath.Join(staging, filepath.FromSlash(f.Name))
+
+		log.Debug("restoring: ", target)
+
+		if strings.Contains(target, "..") {
+			log.Warn(errors.ErrPathNotAllowed(target))
+			continue
+		}
+
+		err = os.MkdirAll(filepath.Dir(target), 0o700)
+		if err != nil {
+			return nil, err
+		}
+
+		mode := fs.FileMode(f.Mode)
+		if mode == 0 {
+			mode = 0o600
+		}
+
+		cr := &chunkReader{
+			ctx:     ctx,
+			storage: r.storage,
+			chunks:  f.Chunks,
+		}
+		h := sha256.New()
+		n, err := copyFile(ctx, target, h, cr, mode)
+		if e := cr.Close(); e != nil {
+			log.Errorf("error on closing chunk reader: %s", e)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, &storage.ManifestFile{
+			Name:   f.Name,
+			Size:   n,
+			SHA256: hex.EncodeToString(h.Sum(nil)),
+		})
+	}
+
+	return files, nil
+}
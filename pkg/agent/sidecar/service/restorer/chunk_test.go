@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package restorer provides restorer service
+package restorer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+// newIncrementalBackup returns the chunks of the files and the manifest which references them.
+func newIncrementalBackup(version string, files map[string][]string) (map[string][]byte, *storage.Manifest) {
+	chunks := make(map[string][]byte)
+	m := &storage.Manifest{
+		Version:     version,
+		Incremental: true,
+	}
+	for name, data := range files {
+		f := &storage.ManifestFile{
+			Name: name,
+			Mode: 0o600,
+		}
+		h := sha256.New()
+		for _, chunk := range data {
+			s := sha256.Sum256([]byte(chunk))
+			sum := hex.EncodeToString(s[:])
+			chunks[sum] = []byte(chunk)
+			f.Chunks = append(f.Chunks, sum)
+			f.Size += int64(len(chunk))
+			h.Write([]byte(chunk))
+		}
+		f.SHA256 = hex.EncodeToString(h.Sum(nil))
+		m.Files = append(m.Files, f)
+	}
+	return chunks, m
+}
+
+func Test_restorer_rebuild(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	ctx := context.Background()
+
+	t.Run("rebuilds the files from the chunks", func(tt *testing.T) {
+		dir := tt.TempDir()
+		chunks, m := newIncrementalBackup("latest", map[string][]string{
+			"ngt-meta.kvsdb":   {"aaaa", "bbbb", "c"},
+			"backup/ngt.index": {"index"},
+		})
+		r := &restorer{
+			dir: dir,
+			eg:  errgroup.Get(),
+			storage: &storageMock{
+				manifests: []*storage.Manifest{m},
+				chunks:    chunks,
+			},
+		}
+		if err := r.restore(ctx); err != nil {
+			tt.Fatal(err)
+		}
+		for name, want := range map[string]string{
+			"ngt-meta.kvsdb":   "aaaabbbbc",
+			"backup/ngt.index": "index",
+		} {
+			got, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+			if err != nil || string(got) != want {
+				tt.Errorf("%s = %s, err = %v, want %s", name, got, err, want)
+			}
+		}
+	})
+
+	t.Run("falls back to the previous backup when the chunk is missing", func(tt *testing.T) {
+		dir := tt.TempDir()
+		latest, lm := newIncrementalBackup("latest", map[string][]string{
+			"ngt-meta.kvsdb": {"aaaa", "dddd"},
+		})
+		previous, pm := newIncrementalBackup("previous", map[string][]string{
+			"ngt-meta.kvsdb": {"aaaa", "bbbb"},
+		})
+		for sum, data := range previous {
+			latest[sum] = data
+		}
+		delete(latest, lm.Files[0].Chunks[1])
+		r := &restorer{
+			dir: dir,
+			eg:  errgroup.Get(),
+			storage: &storageMock{
+				manifests: []*storage.Manifest{lm, pm},
+				chunks:    latest,
+			},
+		}
+		if err := r.restore(ctx); err != nil {
+			tt.Fatal(err)
+		}
+		got, err := os.ReadFile(filepath.Join(dir, "ngt-meta.kvsdb"))
+		if err != nil || string(got) != "aaaabbbb" {
+			tt.Errorf("restored = %s, err = %v, want aaaabbbb", got, err)
+		}
+	})
+}
@@ -18,6 +18,8 @@
 package restorer
 
 import (
+	"net/http"
+
 	"github.com/vdaas/vald/internal/backoff"
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
@@ -78,3 +80,40 @@ func WithBackoffOpts(opts ...backoff.Option) Option {
 		return nil
 	}
 }
+
+// WithVersion returns the option to set the backup version to restore.
+// The latest readable version is restored when it is empty.
+func WithVersion(version string) Option {
+	return func(r *restorer) error {
+		r.version = version
+		return nil
+	}
+}
+
+// WithOverwrite returns the option to replace the existing files in the directory with the restored files.
+// It is used to restore the directory of the running agent, and the existing files are kept when it is disabled.
+func WithOverwrite(enabled bool) Option {
+	return func(r *restorer) error {
+		r.overwrite = enabled
+		return nil
+	}
+}
+
+// WithAgentLivenessURL returns the option to set the URL of the liveness endpoint of the agent.
+// The restore on demand is refused while the endpoint responds, the agent must be stopped to replace its directory.
+func WithAgentLivenessURL(url string) Option {
+	return func(r *restorer) error {
+		r.livenessURL = url
+		return nil
+	}
+}
+
+// WithHTTPClient returns the option to set the HTTP client to check the liveness of the agent.
+func WithHTTPClient(c *http.Client) Option {
+	return func(r *restorer) error {
+		if c != nil {
+			r.client = c
+		}
+		return nil
+	}
+}
@@ -20,7 +20,10 @@ package restorer
 import (
 	"archive/tar"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"io/fs"
+	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -38,16 +41,38 @@ import (
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
 )
 
+const (
+	// stagingDirName is the name of the directory to extract the backup before it is verified.
+	stagingDirName = ".restore"
+
+	// replacingDirName is the name of the directory to move the existing files into while they are replaced.
+	replacingDirName = ".restore-replacing"
+
+	// replacedDirName is the name of the directory which has all of the replaced files.
+	// replacingDirName is renamed to it after all of the existing files are moved, and the rename commits the swap.
+	replacedDirName = ".restore-replaced"
+)
+
 type Restorer interface {
 	Start(ctx context.Context) (<-chan error, error)
 	PreStop(ctx context.Context) error
+	// Restore restores the directory from the blob storage once without the backoff.
+	Restore(ctx context.Context) error
+	// RestoreVersion restores the directory from the backup version once without the backoff.
+	// The latest readable version is restored when the version is empty.
+	RestoreVersion(ctx context.Context, version string) error
 }
 
 type restorer struct {
 	dir string
 	eg  errgroup.Group
 
-	storage storage.Storage
+	storage   storage.Storage
+	version   string
+	overwrite bool
+
+	livenessURL string
+	client      *http.Client
 
 	backoffEnabled bool
 	backoffOpts    []backoff.Option
@@ -64,6 +89,9 @@ func New(opts ...Option) (Restorer, error) {
 	if r.backoffEnabled {
 		r.bo = backoff.New(r.backoffOpts...)
 	}
+	if r.client == nil {
+		r.client = http.DefaultClient
+	}
 
 	return r, nil
 }
@@ -119,6 +147,43 @@ func (r *restorer) PreStop(ctx context.Context) error {
 	return nil
 }
 
+func (r *restorer) Restore(ctx context.Context) error {
+	return r.restore(ctx)
+}
+
+func (r *restorer) RestoreVersion(ctx context.Context, version string) error {
+	err := r.checkAgent(ctx)
+	if err != nil {
+		return err
+	}
+	return r.restoreVersion(ctx, version)
+}
+
+// checkAgent returns error when the liveness endpoint of the agent responds, the directory of the running agent must not be replaced.
+// The agent is regarded as stopped when the liveness endpoint is not reachable.
+func (r *restorer) checkAgent(ctx context.Context) error {
+	if len(r.livenessURL) == 0 {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.livenessURL, nil)
+	if err != nil {
+		return err
+	}
+	res, err := r.client.Do(req)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+		log.Debugf("agent liveness endpoint %s is not reachable, the agent is regarded as stopped: %s", r.livenessURL, err)
+		return nil
+	}
+	err = res.Body.Close()
+	if err != nil {
+		log.Errorf("error on closing agent liveness response body: %s", err)
+	}
+	return errors.ErrAgentRunning
+}
+
 func (r *restorer) startRestore(ctx context.Context) (<-chan error, error) {
 	ech := make(chan error, 100)
 
@@ -173,7 +238,12 @@ func (r *restorer) startRestore(ctx context.Context) (<-chan error, error) {
 	return ech, nil
 }
 
-func (r *restorer) restore(ctx context.Context) (err error) {
+func (r *restorer) restore(ctx context.Context) error {
+	return r.restoreVersion(ctx, r.version)
+}
+
+// restoreVersion restores the backup version, or the backup versions in order from the newest until it succeeds when the version is empty.
+func (r *restorer) restoreVersion(ctx context.Context, version string) (err error) {
 	ctx, span := trace.StartSpan(ctx, "vald/agent-sidecar/service/restorer/Restorer.restore")
 	defer func() {
 		if span != nil {
@@ -183,13 +253,107 @@ func (r *restorer) restore(ctx context.Context) (err error) {
 
 	log.Infof("started to restore directory %s", r.dir)
 
+	ms, err := r.storage.Manifests(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, m := range ms {
+		err = r.restoreBackup(ctx, m)
+		if err == nil {
+			log.Infof("finished to restore directory %s finished", r.dir)
+			return nil
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, errors.ErrAgentRunning) {
+			return err
+		}
+		if m != nil && len(m.Version) != 0 {
+			log.Warnf("failed to restore backup version %s, falling back to the previous version: %s", m.Version, err)
+		}
+		errs = errors.Wrap(errs, err.Error())
+	}
+
+	return errs
+}
+
+// restoreBackup extracts or rebuilds the backup into the staging directory and verifies it with the manifest,
+// then moves the extracted files into the directory. The backup is not verified when the manifest is nil.
+func (r *restorer) restoreBackup(ctx context.Context, m *storage.Manifest) (err error) {
+	if m != nil && len(m.Version) != 0 {
+		log.Infof("restoring backup version %s", m.Version)
+	}
+
+	// the swap interrupted by the crash or the failure is recovered before the staging directory is cleared.
+	err = r.recoverSwap()
+	if err != nil {
+		return err
+	}
+
+	staging := filepath.Join(r.dir, stagingDirName)
+	err = os.RemoveAll(staging)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(staging, 0o700)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// the staging directory has the rest of the files of the committed swap until it is completed.
+		if exist, _, _ := file.ExistsWithDetail(filepath.Join(r.dir, replacedDirName)); exist {
+			return
+		}
+		e := os.RemoveAll(staging)
+		if e != nil {
+			log.Errorf("error on removing staging directory %s: %s", staging, e)
+		}
+	}()
+
+	var files []*storage.ManifestFile
+	if m != nil && m.Incremental {
+		files, err = r.rebuild(ctx, m, staging)
+	} else {
+		files, err = r.extract(ctx, m, staging)
+	}
+	if err != nil {
+		return err
+	}
+
+	if m != nil {
+		err = m.Verify(files)
+		if err != nil {
+			return err
+		}
+		log.Infof("verified %d files of the backup, index count: %d", len(files), m.IndexCount)
+	} else {
+		log.Warn("the backup does not have the manifest, skipping verification")
+	}
+
+	// the agent may be started during the extraction.
+	err = r.checkAgent(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.swap(staging)
+}
+
+// extract extracts the backup into the staging directory and returns the integrity information of the extracted files.
+func (r *restorer) extract(ctx context.Context, m *storage.Manifest, staging string) (files []*storage.ManifestFile, err error) {
 	pr, pw := io.Pipe()
 	defer pr.Close()
 
 	r.eg.Go(safety.RecoverFunc(func() (err error) {
-		defer pw.Close()
+		defer func() {
+			// the reader error is propagated to the tar reader not to regard the truncated backup as the complete one.
+			e := pw.CloseWithError(err)
+			if e != nil {
+				log.Errorf("error on closing pipe writer: %s", e)
+			}
+		}()
 
-		sr, err := r.storage.Reader(ctx)
+		sr, err := r.storage.Open(ctx, m)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				return nil
@@ -223,7 +387,7 @@ func (r *restorer) restore(ctx context.Context) (err error) {
 	for {
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return nil, ctx.Err()
 		default:
 		}
 
@@ -233,10 +397,10 @@ func (r *restorer) restore(ctx context.Context) (err error) {
 				break
 			}
 
-			return err
+			return nil, err
 		}
 
-		target := filepath.Join(r.dir, header.Name)
+		target := filepath.Join(staging, header.Name)
 
 		log.Debug("restoring: ", target)
 
@@ -254,35 +418,163 @@ func (r *restorer) restore(ctx context.Context) (err error) {
 				}
 				err = os.MkdirAll(target, 0o700)
 				if err != nil {
-					return err
+					return nil, err
 				}
 			}
 		case tar.TypeReg:
-			err = copyFile(ctx, target, tr, fs.FileMode(header.Mode))
+			h := sha256.New()
+			n, err := copyFile(ctx, target, h, tr, fs.FileMode(header.Mode))
 			if err != nil {
-				if errors.Is(err, errors.ErrFileAlreadyExists(target)) {
-					log.Warn(err)
-					return nil
-				}
-				return err
+				return nil, err
 			}
+			files = append(files, &storage.ManifestFile{
+				Name:   header.Name,
+				Size:   n,
+				SHA256: hex.EncodeToString(h.Sum(nil)),
+			})
+		}
+	}
+
+	return files, nil
+}
+
+// swap moves the extracted files from the staging directory into the directory.
+// The existing files are kept unless the overwrite is enabled, otherwise the directory is replaced with the extracted files.
+func (r *restorer) swap(staging string) error {
+	if r.overwrite {
+		return r.replace(staging)
+	}
+	entries, err := os.ReadDir(staging)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		target := filepath.Join(r.dir, entry.Name())
+		exist, _, err := file.ExistsWithDetail(target)
+		if exist || err == nil {
+			log.Warn(errors.ErrFileAlreadyExists(target))
+			continue
+		}
+		err = os.Rename(filepath.Join(staging, entry.Name()), target)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replace replaces the files in the directory with the extracted files.
+// The existing files are moved aside and the rename of their directory commits the swap,
+// so that the interrupted swap is rolled back or completed by recoverSwap instead of leaving the directory empty.
+func (r *restorer) replace(staging string) (err error) {
+	replacing := filepath.Join(r.dir, replacingDirName)
+	err = os.MkdirAll(replacing, 0o700)
+	if err != nil {
+		return err
+	}
+	err = moveEntries(r.dir, replacing)
+	if err == nil {
+		err = os.Rename(replacing, filepath.Join(r.dir, replacedDirName))
+	}
+	if err != nil {
+		// the directory has only the existing files until the swap is committed.
+		if e := r.recoverSwap(); e != nil {
+			err = errors.Wrap(err, e.Error())
 		}
+		return err
 	}
+	err = syncDir(r.dir)
+	if err != nil {
+		return err
+	}
+
+	// the swap is committed, the staging directory is kept to be completed by recoverSwap on failure.
+	return r.recoverSwap()
+}
 
-	log.Infof("finished to restore directory %s finished", r.dir)
+// recoverSwap recovers the directory from the interrupted swap.
+// The swap is rolled back when it is not committed, otherwise the rest of the verified files in the staging directory are moved into the directory.
+func (r *restorer) recoverSwap() error {
+	replacing := filepath.Join(r.dir, replacingDirName)
+	exist, _, err := file.ExistsWithDetail(replacing)
+	switch {
+	case exist:
+		err = moveEntries(replacing, r.dir)
+		if err != nil {
+			return err
+		}
+		err = os.RemoveAll(replacing)
+		if err != nil {
+			return err
+		}
+	case err != nil && !os.IsNotExist(err):
+		return err
+	}
 
+	replaced := filepath.Join(r.dir, replacedDirName)
+	exist, _, err = file.ExistsWithDetail(replaced)
+	switch {
+	case exist:
+		err = moveEntries(filepath.Join(r.dir, stagingDirName), r.dir)
+		if err != nil {
+			return err
+		}
+		return os.RemoveAll(replaced)
+	case err != nil && !os.IsNotExist(err):
+		return err
+	}
 	return nil
 }
 
-func copyFile(ctx context.Context, target string, tr io.Reader, mode fs.FileMode) (err error) {
+func isRestoreDir(name string) bool {
+	switch name {
+	case stagingDirName, replacingDirName, replacedDirName:
+		return true
+	}
+	return false
+}
+
+// moveEntries moves the entries in the src directory into the dst directory except the directories of the restore.
+func moveEntries(src, dst string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if isRestoreDir(entry.Name()) {
+			continue
+		}
+		err = os.Rename(filepath.Join(src, entry.Name()), filepath.Join(dst, entry.Name()))
+		if err != nil {
+			return err
+		}
+	}
+	return syncDir(dst)
+}
+
+// syncDir flushes the entries of the directory to persist the renames.
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = f.Sync()
+	if e := f.Close(); e != nil {
+		err = errors.Wrap(err, e.Error())
+	}
+	return err
+}
+
+// copyFile copies the file from the reader to the target, and writes the copied data to the digest.
+func copyFile(ctx context.Context, target string, digest io.Writer, tr io.Reader, mode fs.FileMode) (n int64, err error) {
 	exist, fi, err := file.ExistsWithDetail(target)
 	switch {
 	case err == nil, exist:
-		return errors.ErrFileAlreadyExists(target)
+		return 0, errors.ErrFileAlreadyExists(target)
 	case err != nil && !os.IsNotExist(err):
-		return err
+		return 0, err
 	case fi != nil && fi.Size() != 0:
-		return errors.ErrFileAlreadyExists(target)
+		return 0, errors.ErrFileAlreadyExists(target)
 	}
 
 	f, err := file.Open(
@@ -291,7 +583,7 @@ func copyFile(ctx context.Context, target string, tr io.Reader, mode fs.FileMode
 		os.FileMode(mode),
 	)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer func() {
 		if f != nil {
@@ -304,16 +596,16 @@ func copyFile(ctx context.Context, target string, tr io.Reader, mode fs.FileMode
 
 	fw, err := io.NewWriterWithContext(ctx, f)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	_, err = io.Copy(fw, tr)
+	n, err = io.Copy(io.MultiWriter(fw, digest), tr)
 	if err != nil && !errors.Is(err, io.EOF) {
-		return err
+		return 0, err
 	}
 	err = f.Sync()
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return n, nil
 }
@@ -18,9 +18,18 @@
 package restorer
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/vdaas/vald/internal/backoff"
@@ -31,6 +40,76 @@ import (
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
 )
 
+type storageMock struct {
+	storage.Storage
+	manifests []*storage.Manifest
+	backups   map[string][]byte
+	chunks    map[string][]byte
+}
+
+type backupReaderMock struct {
+	*bytes.Reader
+}
+
+func (r *backupReaderMock) Close() error {
+	return nil
+}
+
+func (s *storageMock) Manifests(ctx context.Context, version string) ([]*storage.Manifest, error) {
+	return s.manifests, nil
+}
+
+func (s *storageMock) Open(ctx context.Context, m *storage.Manifest) (io.ReadCloser, error) {
+	data, ok := s.backups[m.Key]
+	if !ok {
+		return nil, errors.Errorf("backup not found: %s", m.Key)
+	}
+	return &backupReaderMock{bytes.NewReader(data)}, nil
+}
+
+func (s *storageMock) ChunkReader(ctx context.Context, sum string) (io.ReadCloser, error) {
+	data, ok := s.chunks[sum]
+	if !ok {
+		return nil, errors.Errorf("chunk not found: %s", sum)
+	}
+	return &backupReaderMock{bytes.NewReader(data)}, nil
+}
+
+// newBackup returns the tar archive of the files and its manifest.
+// The archived data of the files are replaced by the tampered data to simulate the corrupted backup.
+func newBackup(t *testing.T, key string, files, tampered map[string]string) ([]byte, *storage.Manifest) {
+	t.Helper()
+	m := &storage.Manifest{
+		Version: key,
+		Key:     key,
+	}
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, data := range files {
+		sum := sha256.Sum256([]byte(data))
+		m.AddFile(name, int64(len(data)), sum[:])
+		if d, ok := tampered[name]; ok {
+			data = d
+		}
+		err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o600,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = tw.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes(), m
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -359,26 +438,133 @@ func Test_restorer_restore(t *testing.T) {
 		}
 		return nil
 	}
+	checkRestored := func(dir, want string) error {
+		data, err := os.ReadFile(filepath.Join(dir, "ngt-meta.kvsdb"))
+		if err != nil {
+			return err
+		}
+		if string(data) != want {
+			return errors.Errorf("restored = %s, want %s", data, want)
+		}
+		if _, err := os.Stat(filepath.Join(dir, stagingDirName)); !os.IsNotExist(err) {
+			return errors.Errorf("staging directory is not removed: %v", err)
+		}
+		return nil
+	}
 	tests := []test{
-		// TODO test cases
-		/*
-		   {
-		       name: "test_case_1",
-		       args: args {
-		           ctx: nil,
-		       },
-		       fields: fields {
-		           dir: "",
-		           eg: nil,
-		           storage: nil,
-		           backoffEnabled: false,
-		           backoffOpts: nil,
-		           bo: nil,
-		       },
-		       want: want{},
-		       checkFunc: defaultCheckFunc,
-		   },
-		*/
+		func() test {
+			dir := t.TempDir()
+			latest, lm := newBackup(t, "latest", map[string]string{"ngt-meta.kvsdb": "latest"}, nil)
+			previous, pm := newBackup(t, "previous", map[string]string{"ngt-meta.kvsdb": "previous"}, nil)
+			return test{
+				name: "restores the latest backup when the checksum matches",
+				args: args{
+					ctx: context.Background(),
+				},
+				fields: fields{
+					dir: dir,
+					eg:  errgroup.Get(),
+					storage: &storageMock{
+						manifests: []*storage.Manifest{lm, pm},
+						backups: map[string][]byte{
+							lm.Key: latest,
+							pm.Key: previous,
+						},
+					},
+				},
+				checkFunc: func(w want, err error) error {
+					if err := defaultCheckFunc(w, err); err != nil {
+						return err
+					}
+					return checkRestored(dir, "latest")
+				},
+			}
+		}(),
+		func() test {
+			dir := t.TempDir()
+			latest, lm := newBackup(t, "latest", map[string]string{"ngt-meta.kvsdb": "latest"}, map[string]string{"ngt-meta.kvsdb": "broken"})
+			previous, pm := newBackup(t, "previous", map[string]string{"ngt-meta.kvsdb": "previous"}, nil)
+			return test{
+				name: "falls back to the previous backup when the checksum mismatches",
+				args: args{
+					ctx: context.Background(),
+				},
+				fields: fields{
+					dir: dir,
+					eg:  errgroup.Get(),
+					storage: &storageMock{
+						manifests: []*storage.Manifest{lm, pm},
+						backups: map[string][]byte{
+							lm.Key: latest,
+							pm.Key: previous,
+						},
+					},
+				},
+				checkFunc: func(w want, err error) error {
+					if err := defaultCheckFunc(w, err); err != nil {
+						return err
+					}
+					return checkRestored(dir, "previous")
+				},
+			}
+		}(),
+		func() test {
+			dir := t.TempDir()
+			latest, lm := newBackup(t, "latest", map[string]string{"ngt-meta.kvsdb": "latest"}, nil)
+			previous, pm := newBackup(t, "previous", map[string]string{"ngt-meta.kvsdb": "previous"}, nil)
+			return test{
+				name: "falls back to the previous backup when the latest backup is truncated",
+				args: args{
+					ctx: context.Background(),
+				},
+				fields: fields{
+					dir: dir,
+					eg:  errgroup.Get(),
+					storage: &storageMock{
+						manifests: []*storage.Manifest{lm, pm},
+						backups: map[string][]byte{
+							lm.Key: latest[:len(latest)/4],
+							pm.Key: previous,
+						},
+					},
+				},
+				checkFunc: func(w want, err error) error {
+					if err := defaultCheckFunc(w, err); err != nil {
+						return err
+					}
+					return checkRestored(dir, "previous")
+				},
+			}
+		}(),
+		func() test {
+			dir := t.TempDir()
+			latest, lm := newBackup(t, "latest", map[string]string{"ngt-meta.kvsdb": "latest"}, map[string]string{"ngt-meta.kvsdb": "broken"})
+			return test{
+				name: "returns error and restores nothing when every backup is corrupted",
+				args: args{
+					ctx: context.Background(),
+				},
+				fields: fields{
+					dir: dir,
+					eg:  errgroup.Get(),
+					storage: &storageMock{
+						manifests: []*storage.Manifest{lm},
+						backups: map[string][]byte{
+							lm.Key: latest,
+						},
+					},
+				},
+				checkFunc: func(w want, err error) error {
+					if err == nil {
+						return errors.New("got no error, want checksum mismatch")
+					}
+					if _, err := os.Stat(filepath.Join(dir, "ngt-meta.kvsdb")); !os.IsNotExist(err) {
+						return errors.Errorf("corrupted file is restored: %v", err)
+					}
+					return nil
+				},
+			}
+		}(),
 
 		// TODO test cases
 		/*
@@ -434,6 +620,227 @@ func Test_restorer_restore(t *testing.T) {
 	}
 }
 
+// writeFiles writes the files into the directory.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// readFiles returns the files in the directory, the directories are read as the nested files.
+func readFiles(dir string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[name] = string(data)
+		return nil
+	})
+	return files, err
+}
+
+func Test_restorer_RestoreVersion(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name     string
+		liveness func(calls *int32) http.HandlerFunc
+		stopped  bool
+		want     map[string]string
+		err      error
+	}
+	running := map[string]string{
+		"ngt-meta.kvsdb": "running",
+		"stale":          "stale",
+	}
+	tests := []test{
+		{
+			name: "returns error and keeps the directory when the agent is running",
+			liveness: func(*int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}
+			},
+			want: running,
+			err:  errors.ErrAgentRunning,
+		},
+		{
+			name: "returns error and keeps the directory when the agent is started during the restore",
+			liveness: func(calls *int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					if atomic.AddInt32(calls, 1) == 1 {
+						// the connection is closed without the response as the agent which is not started yet.
+						conn, _, err := w.(http.Hijacker).Hijack()
+						if err == nil {
+							conn.Close()
+						}
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+				}
+			},
+			want: running,
+			err:  errors.ErrAgentRunning,
+		},
+		{
+			name: "replaces the directory when the agent is stopped",
+			liveness: func(*int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}
+			},
+			stopped: true,
+			want: map[string]string{
+				"ngt-meta.kvsdb": "latest",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			dir := tt.TempDir()
+			writeFiles(tt, dir, running)
+			backup, m := newBackup(tt, "latest", map[string]string{"ngt-meta.kvsdb": "latest"}, nil)
+
+			var calls int32
+			srv := httptest.NewServer(test.liveness(&calls))
+			defer srv.Close()
+			if test.stopped {
+				srv.Close()
+			}
+
+			r, err := New(
+				WithDir(dir),
+				WithBlobStorage(&storageMock{
+					manifests: []*storage.Manifest{m},
+					backups: map[string][]byte{
+						m.Key: backup,
+					},
+				}),
+				WithOverwrite(true),
+				WithAgentLivenessURL(srv.URL),
+			)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			err = r.RestoreVersion(context.Background(), "")
+			if !errors.Is(err, test.err) {
+				tt.Errorf("got_error: %v, want: %v", err, test.err)
+			}
+			got, err := readFiles(dir)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("got: %v, want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_restorer_recoverSwap(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name  string
+		files map[string]map[string]string
+		want  map[string]string
+	}
+	tests := []test{
+		{
+			name: "rolls back the swap which is interrupted before it is committed",
+			files: map[string]map[string]string{
+				"": {
+					"a": "old-a",
+				},
+				replacingDirName: {
+					"b": "old-b",
+				},
+				stagingDirName: {
+					"a": "new-a",
+					"b": "new-b",
+				},
+			},
+			want: map[string]string{
+				"a":                                "old-a",
+				"b":                                "old-b",
+				filepath.Join(stagingDirName, "a"): "new-a",
+				filepath.Join(stagingDirName, "b"): "new-b",
+			},
+		},
+		{
+			name: "completes the swap which is interrupted after it is committed",
+			files: map[string]map[string]string{
+				"": {
+					"a": "new-a",
+				},
+				replacedDirName: {
+					"a": "old-a",
+					"b": "old-b",
+				},
+				stagingDirName: {
+					"b": "new-b",
+				},
+			},
+			want: map[string]string{
+				"a": "new-a",
+				"b": "new-b",
+			},
+		},
+		{
+			name: "does nothing when the swap is not interrupted",
+			files: map[string]map[string]string{
+				"": {
+					"a": "old-a",
+				},
+			},
+			want: map[string]string{
+				"a": "old-a",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			dir := tt.TempDir()
+			for sub, files := range test.files {
+				writeFiles(tt, filepath.Join(dir, sub), files)
+			}
+			r := &restorer{
+				dir: dir,
+			}
+			if err := r.recoverSwap(); err != nil {
+				tt.Fatal(err)
+			}
+			got, err := readFiles(dir)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("got: %v, want: %v", got, test.want)
+			}
+		})
+	}
+}
+
 func Test_restorer_PreStop(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -543,64 +950,88 @@ func Test_copyFile(t *testing.T) {
 	type args struct {
 		ctx    context.Context
 		target string
+		digest *bytes.Buffer
 		tr     io.Reader
 		mode   fs.FileMode
 	}
 	type want struct {
-		err error
+		want int64
+		err  error
 	}
 	type test struct {
 		name       string
 		args       args
 		want       want
-		checkFunc  func(want, error) error
+		checkFunc  func(want, args, int64, error) error
 		beforeFunc func(args)
 		afterFunc  func(args)
 	}
-	defaultCheckFunc := func(w want, err error) error {
+	defaultCheckFunc := func(w want, _ args, got int64, err error) error {
 		if !errors.Is(err, w.err) {
 			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
 		}
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
 		return nil
 	}
+	dir := t.TempDir()
 	tests := []test{
-		// TODO test cases
-		/*
-		   {
-		       name: "test_case_1",
-		       args: args {
-		           ctx: nil,
-		           target: "",
-		           tr: nil,
-		           mode: nil,
-		       },
-		       want: want{},
-		       checkFunc: defaultCheckFunc,
-		   },
-		*/
-
-		// TODO test cases
-		/*
-		   func() test {
-		       return test {
-		           name: "test_case_2",
-		           args: args {
-		           ctx: nil,
-		           target: "",
-		           tr: nil,
-		           mode: nil,
-		           },
-		           want: want{},
-		           checkFunc: defaultCheckFunc,
-		       }
-		   }(),
-		*/
+		func() test {
+			target := filepath.Join(dir, "copied")
+			return test{
+				name: "copies the data to the target and the digest",
+				args: args{
+					ctx:    context.Background(),
+					target: target,
+					digest: new(bytes.Buffer),
+					tr:     strings.NewReader("vald"),
+					mode:   0o600,
+				},
+				want: want{
+					want: 4,
+				},
+				checkFunc: func(w want, a args, got int64, err error) error {
+					if err := defaultCheckFunc(w, a, got, err); err != nil {
+						return err
+					}
+					data, err := os.ReadFile(a.target)
+					if err != nil {
+						return err
+					}
+					if string(data) != "vald" || a.digest.String() != "vald" {
+						return errors.Errorf("file = %s, digest = %s, want vald", data, a.digest.String())
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			target := filepath.Join(dir, "exists")
+			return test{
+				name: "returns error when the target already exists",
+				args: args{
+					ctx:    context.Background(),
+					target: target,
+					digest: new(bytes.Buffer),
+					tr:     strings.NewReader("vald"),
+					mode:   0o600,
+				},
+				want: want{
+					err: errors.ErrFileAlreadyExists(target),
+				},
+				beforeFunc: func(a args) {
+					if err := os.WriteFile(a.target, []byte("exists"), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+			}
+		}(),
 	}
 
 	for _, tc := range tests {
 		test := tc
 		t.Run(test.name, func(tt *testing.T) {
-			tt.Parallel()
 			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
 			if test.beforeFunc != nil {
 				test.beforeFunc(test.args)
@@ -612,8 +1043,8 @@ func Test_copyFile(t *testing.T) {
 				test.checkFunc = defaultCheckFunc
 			}
 
-			err := copyFile(test.args.ctx, test.args.target, test.args.tr, test.args.mode)
-			if err := test.checkFunc(test.want, err); err != nil {
+			got, err := copyFile(test.args.ctx, test.args.target, test.args.digest, test.args.tr, test.args.mode)
+			if err := test.checkFunc(test.want, test.args, got, err); err != nil {
 				tt.Errorf("error = %v", err)
 			}
 		})
@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package storage provides blob storage service
+package storage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+)
+
+// chunkDirName is the directory name of the chunks of the incremental backup.
+const chunkDirName = "chunks"
+
+func (b *bs) chunkPrefix() string {
+	return b.versionPrefix() + chunkDirName + "/"
+}
+
+func (b *bs) chunkKey(sum string) string {
+	return b.chunkPrefix() + sum
+}
+
+func (b *bs) Chunks(ctx context.Context) (map[string]struct{}, error) {
+	prefix := b.chunkPrefix()
+	chunks := make(map[string]struct{})
+	err := b.bucket.List(ctx, prefix, func(key string) bool {
+		chunks[strings.TrimPrefix(key, prefix)] = struct{}{}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return chunks, nil
+}
+
+func (b *bs) ChunkReader(ctx context.Context, sum string) (io.ReadCloser, error) {
+	return b.reader(ctx, b.chunkKey(sum))
+}
+
+func (b *bs) ChunkWriter(ctx context.Context, sum string) (io.WriteCloser, error) {
+	return b.writer(ctx, b.chunkKey(sum))
+}
+
+// referencedChunks returns the chunks referenced by the valid backups.
+// Unlike Versions, it fails when any manifest is not readable not to delete the chunks of the backup which is temporarily unreadable.
+func (b *bs) referencedChunks(ctx context.Context) (map[string]struct{}, error) {
+	keys := []string{
+		b.manifestKey(""),
+	}
+	if b.versioningEnabled {
+		keys = keys[:0]
+		err := b.bucket.List(ctx, b.versionPrefix(), func(key string) bool {
+			if strings.HasSuffix(key, manifestSuffix) {
+				keys = append(keys, key)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	chunks := make(map[string]struct{})
+	for _, key := range keys {
+		m, err := b.readManifest(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range m.Files {
+			for _, sum := range f.Chunks {
+				chunks[sum] = struct{}{}
+			}
+		}
+	}
+	return chunks, nil
+}
+
+func (b *bs) PruneChunks(ctx context.Context) ([]string, error) {
+	referenced, err := b.referencedChunks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chunks, err := b.Chunks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := make([]string, 0, len(chunks))
+	for sum := range chunks {
+		if _, ok := referenced[sum]; ok {
+			continue
+		}
+		err = b.bucket.Delete(ctx, b.chunkKey(sum))
+		if err != nil {
+			return deleted, err
+		}
+		log.Debugf("chunk %s is pruned", sum)
+		deleted = append(deleted, sum)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,180 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package storage provides blob storage service
+package storage
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func writeChunk(ctx context.Context, b *bs, sum, data string) error {
+	w, err := b.ChunkWriter(ctx, sum)
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write([]byte(data)); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func commitChunks(ctx context.Context, b *bs, chunks ...string) (*Manifest, error) {
+	m, err := b.NewManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.Key = ""
+	m.Incremental = true
+	m.Files = []*ManifestFile{
+		{
+			Name:   "ngt-meta.kvsdb",
+			Chunks: chunks,
+		},
+	}
+	return m, b.Commit(ctx, m)
+}
+
+func TestChunks(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	ctx := context.Background()
+	b, bucket := newVersionedStorage(true, 0, 0)
+	for _, sum := range []string{"aa", "bb"} {
+		if err := writeChunk(ctx, b, sum, sum); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := bucket.objs["vald-agent-ngt-0/chunks/aa"]; !ok {
+		t.Error("chunk is not written under the chunk prefix")
+	}
+
+	got, err := b.Chunks(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]struct{}{
+		"aa": {},
+		"bb": {},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("chunks = %v, want %v", got, want)
+	}
+
+	r, err := b.ChunkReader(ctx, "bb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	data := make([]byte, 2)
+	if _, err = r.Read(data); err != nil || string(data) != "bb" {
+		t.Errorf("chunk = %s, err = %v, want bb", data, err)
+	}
+}
+
+func TestOpen_Incremental(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	b, _ := newVersionedStorage(true, 0, 0)
+	m, err := commitChunks(ctx, b, "aa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms, err := b.Manifests(ctx, "")
+	if err != nil || len(ms) != 1 || !ms[0].Incremental {
+		t.Fatalf("manifests = %v, err = %v, want the incremental manifest", ms, err)
+	}
+	if _, err = b.Open(ctx, m); err == nil {
+		t.Error("incremental backup is opened as the archive")
+	}
+}
+
+func TestPruneChunks(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	ctx := context.Background()
+
+	type test struct {
+		name      string
+		versioned bool
+		keepLast  int
+		want      []string
+	}
+	tests := []test{
+		{
+			name:      "deletes the chunks which are not referenced by the retained versions",
+			versioned: true,
+			keepLast:  2,
+			want:      []string{"aa"},
+		},
+		{
+			name: "deletes the chunks which are not referenced by the unversioned backup",
+			want: []string{"aa", "bb"},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			b, _ := newVersionedStorage(test.versioned, test.keepLast, 0)
+			for _, sum := range []string{"aa", "bb", "cc", "dd"} {
+				if err := writeChunk(ctx, b, sum, sum); err != nil {
+					tt.Fatal(err)
+				}
+			}
+			for _, chunks := range [][]string{{"aa", "bb"}, {"bb", "cc"}, {"cc", "dd"}} {
+				if _, err := commitChunks(ctx, b, chunks...); err != nil {
+					tt.Fatal(err)
+				}
+			}
+			if _, err := b.Prune(ctx); err != nil {
+				tt.Fatal(err)
+			}
+
+			got, err := b.PruneChunks(ctx)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("deleted = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPruneChunks_UnreadableManifest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	b, bucket := newVersionedStorage(true, 0, 0)
+	if err := writeChunk(ctx, b, "aa", "aa"); err != nil {
+		t.Fatal(err)
+	}
+	bucket.objs[b.manifestKey("20211018T000000Z-00000000000000000001")] = []byte("{")
+
+	if _, err := b.PruneChunks(ctx); err == nil {
+		t.Error("got no error, want the manifest error")
+	}
+	if _, ok := bucket.objs[b.chunkKey("aa")]; !ok {
+		t.Error("chunk is deleted although the manifest is unreadable")
+	}
+}
@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package storage provides blob storage service
+package storage
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// CloneFilenames returns the backup filenames of the source agents to clone for the agent of the name.
+// The ordinal of the agent is the suffix of the StatefulSet pod name, e.g. vald-agent-ngt-0, and the source agent of the ordinal s
+// is assigned to the agent of the ordinal s mod targetReplicas, so that every source backup is assigned to a target agent when the numbers of the agents differ.
+// The targetReplicas is regarded as the sourceReplicas when it is not positive, and the agent which has no source agent has no backup to clone.
+func CloneFilenames(name, sourceFilename string, sourceReplicas, targetReplicas int) ([]string, error) {
+	idx := strings.LastIndex(name, "-")
+	if idx < 0 {
+		return nil, errors.ErrCloneOrdinalNotFound(name)
+	}
+	ordinal, err := strconv.Atoi(name[idx+1:])
+	if err != nil || ordinal < 0 {
+		return nil, errors.ErrCloneOrdinalNotFound(name)
+	}
+	if targetReplicas <= 0 {
+		targetReplicas = sourceReplicas
+	}
+	if ordinal >= targetReplicas {
+		return nil, nil
+	}
+	var filenames []string
+	for s := ordinal; s < sourceReplicas; s += targetReplicas {
+		filenames = append(filenames, sourceFilename+"-"+strconv.Itoa(s))
+	}
+	return filenames, nil
+}
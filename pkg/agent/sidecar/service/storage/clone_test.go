@@ -0,0 +1,148 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package storage provides blob storage service
+package storage
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func TestCloneFilenames(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		name           string
+		sourceFilename string
+		sourceReplicas int
+		targetReplicas int
+	}
+	type want struct {
+		filenames []string
+		err       error
+	}
+	type test struct {
+		name string
+		args args
+		want want
+	}
+	tests := []test{
+		{
+			name: "returns the source filename of the same ordinal",
+			args: args{
+				name:           "staging-agent-ngt-2",
+				sourceFilename: "vald-agent-ngt",
+				sourceReplicas: 3,
+			},
+			want: want{
+				filenames: []string{"vald-agent-ngt-2"},
+			},
+		},
+		{
+			name: "returns nothing when the ordinal is out of the source agents",
+			args: args{
+				name:           "staging-agent-ngt-3",
+				sourceFilename: "vald-agent-ngt",
+				sourceReplicas: 3,
+				targetReplicas: 5,
+			},
+		},
+		{
+			name: "returns the source filenames of the ordinals assigned by the modulo when the target has fewer agents",
+			args: args{
+				name:           "staging-agent-ngt-1",
+				sourceFilename: "vald-agent-ngt",
+				sourceReplicas: 5,
+				targetReplicas: 2,
+			},
+			want: want{
+				filenames: []string{"vald-agent-ngt-1", "vald-agent-ngt-3"},
+			},
+		},
+		{
+			name: "returns nothing when the ordinal is out of the target agents",
+			args: args{
+				name:           "staging-agent-ngt-2",
+				sourceFilename: "vald-agent-ngt",
+				sourceReplicas: 5,
+				targetReplicas: 2,
+			},
+		},
+		{
+			name: "returns error when the name does not have the ordinal",
+			args: args{
+				name:           "staging-agent-ngt",
+				sourceFilename: "vald-agent-ngt",
+				sourceReplicas: 3,
+			},
+			want: want{
+				err: errors.ErrCloneOrdinalNotFound("staging-agent-ngt"),
+			},
+		},
+		{
+			name: "returns error when the name does not have the separator",
+			args: args{
+				name:           "agent",
+				sourceFilename: "vald-agent-ngt",
+				sourceReplicas: 3,
+			},
+			want: want{
+				err: errors.ErrCloneOrdinalNotFound("agent"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			filenames, err := CloneFilenames(test.args.name, test.args.sourceFilename, test.args.sourceReplicas, test.args.targetReplicas)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("error = %v, want %v", err, test.want.err)
+			}
+			if !reflect.DeepEqual(filenames, test.want.filenames) {
+				tt.Errorf("got = %v, want %v", filenames, test.want.filenames)
+			}
+		})
+	}
+}
+
+// TestCloneFilenames_Assignment tests every source backup is assigned to exactly one target agent.
+func TestCloneFilenames_Assignment(t *testing.T) {
+	t.Parallel()
+	for _, sourceReplicas := range []int{1, 3, 5, 8} {
+		for _, targetReplicas := range []int{1, 2, 3, 5, 8, 13} {
+			assigned := make(map[string]int)
+			for ordinal := 0; ordinal < targetReplicas; ordinal++ {
+				filenames, err := CloneFilenames("staging-agent-ngt-"+strconv.Itoa(ordinal), "vald-agent-ngt", sourceReplicas, targetReplicas)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, filename := range filenames {
+					assigned[filename]++
+				}
+			}
+			for s := 0; s < sourceReplicas; s++ {
+				if n := assigned["vald-agent-ngt-"+strconv.Itoa(s)]; n != 1 {
+					t.Errorf("source %d of %d is assigned to %d of %d targets, want 1", s, sourceReplicas, n, targetReplicas)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,297 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package storage provides blob storage service
+package storage
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"os"
+	"strings"
+
+	"github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+)
+
+// The encrypted object consists of the header and the segments sealed by AES-GCM individually.
+// The header is the magic, the format version, the length prefixed key ID and the nonce prefix, and it is authenticated as the additional data of each segment.
+// The nonce of each segment is the nonce prefix, the big endian segment counter and the last segment flag,
+// so that the reordered, dropped or truncated segments are detected.
+const (
+	// encryptionMagic starts with the null byte which does not start any of the compressed streams.
+	encryptionMagic             = "\x00VALDENC"
+	encryptionVersion      byte = 1
+	encryptionSegment           = 64 * 1024
+	noncePrefixSize             = 7
+	maxEncryptionKeyIDSize      = 255
+)
+
+// parseEncryptionKey returns the AEAD of the base64 encoded key, or the key in the key file when the key file is set.
+func parseEncryptionKey(key *config.EncryptionKey) (cipher.AEAD, error) {
+	enc := key.Key
+	if len(key.KeyFile) != 0 {
+		b, err := os.ReadFile(key.KeyFile)
+		if err != nil {
+			return nil, errors.ErrInvalidBackupEncryptionKey(key.ID, err)
+		}
+		enc = string(b)
+	}
+	k, err := base64.StdEncoding.DecodeString(strings.TrimSpace(enc))
+	if err != nil {
+		return nil, errors.ErrInvalidBackupEncryptionKey(key.ID, err)
+	}
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, errors.ErrInvalidBackupEncryptionKey(key.ID, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.ErrInvalidBackupEncryptionKey(key.ID, err)
+	}
+	return aead, nil
+}
+
+// encryptionHeader returns the header of the encrypted object.
+func encryptionHeader(id string, prefix []byte) []byte {
+	h := make([]byte, 0, len(encryptionMagic)+2+len(id)+len(prefix))
+	h = append(h, encryptionMagic...)
+	h = append(h, encryptionVersion, byte(len(id)))
+	h = append(h, id...)
+	return append(h, prefix...)
+}
+
+type segmentCipher struct {
+	aead    cipher.AEAD
+	header  []byte
+	nonce   []byte
+	counter uint32
+}
+
+func (s *segmentCipher) next(last bool) ([]byte, error) {
+	if s.counter == ^uint32(0) {
+		return nil, errors.ErrBackupDecryptionFailed(errors.New("too many segments"))
+	}
+	binary.BigEndian.PutUint32(s.nonce[noncePrefixSize:], s.counter)
+	s.nonce[len(s.nonce)-1] = 0
+	if last {
+		s.nonce[len(s.nonce)-1] = 1
+	}
+	s.counter++
+	return s.nonce, nil
+}
+
+type encryptWriter struct {
+	segmentCipher
+	dst io.WriteCloser
+	buf []byte
+	out []byte
+}
+
+// newEncryptWriter writes the header to dst and returns the writer which encrypts the data by the key of the id.
+// dst is closed when it fails.
+func newEncryptWriter(dst io.WriteCloser, id string, aead cipher.AEAD) (w io.WriteCloser, err error) {
+	defer func() {
+		if err != nil {
+			err = closeOnError(dst, err)
+		}
+	}()
+	if len(id) > maxEncryptionKeyIDSize {
+		return nil, errors.ErrInvalidBackupEncryptionKey(id, errors.New("key id is too long"))
+	}
+	prefix := make([]byte, noncePrefixSize)
+	if _, err = rand.Read(prefix); err != nil {
+		return nil, err
+	}
+	header := encryptionHeader(id, prefix)
+	if _, err = dst.Write(header); err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, prefix)
+	return &encryptWriter{
+		segmentCipher: segmentCipher{
+			aead:   aead,
+			header: header,
+			nonce:  nonce,
+		},
+		dst: dst,
+		buf: make([]byte, 0, encryptionSegment),
+	}, nil
+}
+
+// Write buffers p and writes the full segments. The last segment is written on Close.
+func (e *encryptWriter) Write(p []byte) (n int, err error) {
+	for len(p) != 0 {
+		if len(e.buf) == cap(e.buf) {
+			if err = e.seal(false); err != nil {
+				return n, err
+			}
+		}
+		c := copy(e.buf[len(e.buf):cap(e.buf)], p)
+		e.buf = e.buf[:len(e.buf)+c]
+		p = p[c:]
+		n += c
+	}
+	return n, nil
+}
+
+func (e *encryptWriter) seal(last bool) error {
+	nonce, err := e.next(last)
+	if err != nil {
+		return err
+	}
+	e.out = e.aead.Seal(e.out[:0], nonce, e.buf, e.header)
+	e.buf = e.buf[:0]
+	_, err = e.dst.Write(e.out)
+	return err
+}
+
+// Close writes the last segment and closes dst.
+func (e *encryptWriter) Close() (err error) {
+	err = e.seal(true)
+	if err != nil {
+		return closeOnError(e.dst, err)
+	}
+	return e.dst.Close()
+}
+
+type decryptReader struct {
+	segmentCipher
+	src   io.Closer
+	r     *bufio.Reader
+	seg   []byte
+	plain []byte
+	done  bool
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// newDecryptReader returns the reader which decrypts src by the key of the ID in the header.
+// src is read as it is when it does not start with the header and allowUnencrypted is true,
+// otherwise the unencrypted src is rejected so that it cannot be swapped for the encrypted backup.
+// src is closed when it fails.
+func newDecryptReader(src io.ReadCloser, keys map[string]cipher.AEAD, allowUnencrypted bool) (r io.ReadCloser, err error) {
+	defer func() {
+		if err != nil {
+			err = closeOnError(src, err)
+		}
+	}()
+	br := bufio.NewReaderSize(src, encryptionSegment)
+	magic, err := br.Peek(len(encryptionMagic))
+	if err != nil || string(magic) != encryptionMagic {
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if !allowUnencrypted {
+			return nil, errors.ErrBackupNotEncrypted
+		}
+		return &readCloser{
+			Reader: br,
+			Closer: src,
+		}, nil
+	}
+
+	header := make([]byte, len(encryptionMagic)+2)
+	if _, err = io.ReadFull(br, header); err != nil {
+		return nil, errors.ErrBackupDecryptionFailed(err)
+	}
+	if v := header[len(header)-2]; v != encryptionVersion {
+		return nil, errors.ErrBackupDecryptionFailed(errors.Errorf("unsupported format version %d", v))
+	}
+	rest := make([]byte, int(header[len(header)-1])+noncePrefixSize)
+	if _, err = io.ReadFull(br, rest); err != nil {
+		return nil, errors.ErrBackupDecryptionFailed(err)
+	}
+	header = append(header, rest...)
+
+	id := string(rest[:len(rest)-noncePrefixSize])
+	aead, ok := keys[id]
+	if !ok {
+		return nil, errors.ErrBackupEncryptionKeyNotFound(id)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, rest[len(rest)-noncePrefixSize:])
+
+	return &decryptReader{
+		segmentCipher: segmentCipher{
+			aead:   aead,
+			header: header,
+			nonce:  nonce,
+		},
+		src: src,
+		r:   br,
+		seg: make([]byte, encryptionSegment+aead.Overhead()),
+	}, nil
+}
+
+// Read reads up to len(p) bytes of the decrypted data.
+func (d *decryptReader) Read(p []byte) (n int, err error) {
+	for len(d.plain) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err = d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n = copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+// open reads and decrypts the next segment. The segment is the last one when it is shorter than the full segment or no data follows it.
+func (d *decryptReader) open() error {
+	n, err := io.ReadFull(d.r, d.seg)
+	switch {
+	case err == nil:
+		_, err = d.r.Peek(1)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		d.done = errors.Is(err, io.EOF)
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		d.done = true
+	case errors.Is(err, io.EOF):
+		// the last segment is always written, so that the stream is truncated.
+		return errors.ErrBackupDecryptionFailed(io.ErrUnexpectedEOF)
+	default:
+		return err
+	}
+
+	nonce, err := d.next(d.done)
+	if err != nil {
+		return err
+	}
+	d.plain, err = d.aead.Open(d.seg[:0], nonce, d.seg[:n], d.header)
+	if err != nil {
+		return errors.ErrBackupDecryptionFailed(err)
+	}
+	return nil
+}
+
+// Close closes the source.
+func (d *decryptReader) Close() error {
+	return d.src.Close()
+}
@@ -0,0 +1,494 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package storage provides blob storage service
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+)
+
+type bufferCloser struct {
+	bytes.Buffer
+}
+
+func (*bufferCloser) Close() error {
+	return nil
+}
+
+// closeCounter counts the calls of Close.
+type closeCounter struct {
+	io.Reader
+	io.Writer
+	closed   int
+	closeErr error
+}
+
+func (c *closeCounter) Close() error {
+	c.closed++
+	return c.closeErr
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
+func newEncryptionKey(id string, b byte) *config.EncryptionKey {
+	return &config.EncryptionKey{
+		ID:  id,
+		Key: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, 32)),
+	}
+}
+
+func newKeys(t *testing.T, keys ...*config.EncryptionKey) map[string]cipher.AEAD {
+	t.Helper()
+	b := new(bs)
+	if err := WithEncryptionKeys(keys...)(b); err != nil {
+		t.Fatal(err)
+	}
+	return b.encryptionKeys
+}
+
+func encrypt(t *testing.T, id string, keys map[string]cipher.AEAD, data []byte) []byte {
+	t.Helper()
+	buf := new(bufferCloser)
+	w, err := newEncryptWriter(buf, id, keys[id])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the data is written in the small pieces to cross the segment boundaries.
+	for p := data; len(p) != 0; {
+		n := 1000
+		if n > len(p) {
+			n = len(p)
+		}
+		if _, err = w.Write(p[:n]); err != nil {
+			t.Fatal(err)
+		}
+		p = p[n:]
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decrypt(keys map[string]cipher.AEAD, data []byte, allowUnencrypted bool) ([]byte, error) {
+	src := &closeCounter{
+		Reader: bytes.NewReader(data),
+	}
+	got, err := func() ([]byte, error) {
+		r, err := newDecryptReader(src, keys, allowUnencrypted)
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	}()
+	// src is closed by the reader, or by newDecryptReader when it fails.
+	if src.closed != 1 {
+		return nil, errors.Errorf("src is closed %d times: %v", src.closed, err)
+	}
+	return got, err
+}
+
+func TestEncryption(t *testing.T) {
+	keys := newKeys(t, newEncryptionKey("key-1", 1), newEncryptionKey("key-2", 2))
+	for _, size := range []int{0, 1, encryptionSegment - 1, encryptionSegment, encryptionSegment + 1, 3 * encryptionSegment} {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(i % 251)
+		}
+		enc := encrypt(t, "key-2", keys, data)
+		if !bytes.HasPrefix(enc, []byte(encryptionMagic)) {
+			t.Errorf("size %d: the encrypted data does not start with the header", size)
+		}
+		if size > 16 && bytes.Contains(enc, data) {
+			t.Errorf("size %d: the encrypted data contains the plain data", size)
+		}
+		got, err := decrypt(keys, enc, false)
+		if err != nil {
+			t.Errorf("size %d: got_error: %v", size, err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("size %d: the decrypted data does not match", size)
+		}
+	}
+}
+
+func TestEncryption_Failure(t *testing.T) {
+	keys := newKeys(t, newEncryptionKey("key-1", 1))
+	data := bytes.Repeat([]byte("vald"), encryptionSegment)
+	enc := encrypt(t, "key-1", keys, data)
+	header := len(encryptionHeader("key-1", make([]byte, noncePrefixSize)))
+	segment := encryptionSegment + keys["key-1"].Overhead()
+
+	type test struct {
+		name             string
+		keys             map[string]cipher.AEAD
+		data             func() []byte
+		allowUnencrypted bool
+		want             error
+	}
+	tests := []test{
+		{
+			name: "returns error when the key is not found",
+			keys: newKeys(t, newEncryptionKey("key-2", 1)),
+			data: func() []byte {
+				return enc
+			},
+			want: errors.ErrBackupEncryptionKeyNotFound("key-1"),
+		},
+		{
+			name: "returns error when the key is wrong",
+			keys: map[string]cipher.AEAD{
+				"key-1": newKeys(t, newEncryptionKey("key-1", 2))["key-1"],
+			},
+			data: func() []byte {
+				return enc
+			},
+			want: errors.ErrBackupDecryptionFailed(errors.New("cipher: message authentication failed")),
+		},
+		{
+			name: "returns error when the segment is tampered",
+			keys: keys,
+			data: func() []byte {
+				b := append([]byte{}, enc...)
+				b[header+segment+10] ^= 1
+				return b
+			},
+			want: errors.ErrBackupDecryptionFailed(errors.New("cipher: message authentication failed")),
+		},
+		{
+			name: "returns error when the header is tampered",
+			keys: keys,
+			data: func() []byte {
+				b := append([]byte{}, enc...)
+				b[header-1] ^= 1
+				return b
+			},
+			want: errors.ErrBackupDecryptionFailed(errors.New("cipher: message authentication failed")),
+		},
+		{
+			name: "returns error when the data is truncated at the segment boundary",
+			keys: keys,
+			data: func() []byte {
+				return enc[:header+2*segment]
+			},
+			want: errors.ErrBackupDecryptionFailed(errors.New("cipher: message authentication failed")),
+		},
+		{
+			name: "returns error when the last segment is dropped",
+			keys: keys,
+			data: func() []byte {
+				return enc[:len(enc)-keys["key-1"].Overhead()]
+			},
+			want: errors.ErrBackupDecryptionFailed(errors.New("cipher: message authentication failed")),
+		},
+		{
+			name: "returns error when the header is truncated",
+			keys: keys,
+			data: func() []byte {
+				return enc[:len(encryptionMagic)+3]
+			},
+			want: errors.ErrBackupDecryptionFailed(io.ErrUnexpectedEOF),
+		},
+		{
+			name: "returns error when the data is not encrypted",
+			keys: keys,
+			data: func() []byte {
+				return data
+			},
+			want: errors.ErrBackupNotEncrypted,
+		},
+		{
+			name: "returns error when the data is empty",
+			keys: keys,
+			data: func() []byte {
+				return nil
+			},
+			want: errors.ErrBackupNotEncrypted,
+		},
+		{
+			name: "returns error when the header is tampered and the unencrypted data is allowed",
+			keys: keys,
+			data: func() []byte {
+				b := append([]byte{}, enc...)
+				b[header-1] ^= 1
+				return b
+			},
+			allowUnencrypted: true,
+			want:             errors.ErrBackupDecryptionFailed(errors.New("cipher: message authentication failed")),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			_, err := decrypt(test.keys, test.data(), test.allowUnencrypted)
+			if !errors.Is(err, test.want) {
+				tt.Errorf("got_error: %v, want: %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestEncryptWriter_Failure(t *testing.T) {
+	keys := newKeys(t, newEncryptionKey("key-1", 1))
+	errWrite := errors.New("write failed")
+	errClose := errors.New("close failed")
+
+	// dst is closed when the writer is not created.
+	dst := &closeCounter{
+		Writer: new(bytes.Buffer),
+	}
+	id := string(bytes.Repeat([]byte("k"), maxEncryptionKeyIDSize+1))
+	if _, err := newEncryptWriter(dst, id, keys["key-1"]); err == nil || dst.closed != 1 {
+		t.Errorf("got_error: %v, closed: %d, want the error and the closed dst", err, dst.closed)
+	}
+
+	type test struct {
+		name     string
+		closeErr error
+		check    func(error) bool
+	}
+	tests := []test{
+		{
+			name: "returns the write error when the last segment is not written",
+			check: func(err error) bool {
+				return err == errWrite
+			},
+		},
+		{
+			name:     "returns the write error wrapped by the close error when dst is not closed",
+			closeErr: errClose,
+			check: func(err error) bool {
+				return errors.Unwrap(err) == errWrite && strings.Contains(err.Error(), errClose.Error())
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			header := true
+			dst := &closeCounter{
+				Writer: writerFunc(func(p []byte) (int, error) {
+					if header {
+						header = false
+						return len(p), nil
+					}
+					return 0, errWrite
+				}),
+				closeErr: test.closeErr,
+			}
+			w, err := newEncryptWriter(dst, "key-1", keys["key-1"])
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if err = w.Close(); !test.check(err) || dst.closed != 1 {
+				tt.Errorf("got_error: %#v, closed: %d", err, dst.closed)
+			}
+		})
+	}
+}
+
+func TestEncryption_Unencrypted(t *testing.T) {
+	data := []byte("vald")
+	got, err := decrypt(nil, data, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("got: %s, want: %s", got, data)
+	}
+}
+
+func TestWithEncryptionKeys(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "backup.key")
+	if err := os.WriteFile(keyFile, []byte(newEncryptionKey("", 1).Key+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	type test struct {
+		name    string
+		keys    []*config.EncryptionKey
+		wantIDs []string
+		wantErr bool
+	}
+	tests := []test{
+		{
+			name: "set the keys from the key and the key file",
+			keys: []*config.EncryptionKey{
+				newEncryptionKey("key-1", 1),
+				{
+					ID:      "key-2",
+					KeyFile: keyFile,
+				},
+			},
+			wantIDs: []string{"key-1", "key-2"},
+		},
+		{
+			name: "returns error when the key is not base64 encoded",
+			keys: []*config.EncryptionKey{
+				{
+					ID:  "key-1",
+					Key: "!",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns error when the key size is invalid",
+			keys: []*config.EncryptionKey{
+				{
+					ID:  "key-1",
+					Key: base64.StdEncoding.EncodeToString([]byte("vald")),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns error when the key file does not exist",
+			keys: []*config.EncryptionKey{
+				{
+					ID:      "key-1",
+					KeyFile: filepath.Join(dir, "not-found"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns error when the key id is duplicated",
+			keys: []*config.EncryptionKey{
+				newEncryptionKey("key-1", 1),
+				newEncryptionKey("key-1", 2),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			b := new(bs)
+			err := WithEncryptionKeys(test.keys...)(b)
+			if (err != nil) != test.wantErr {
+				tt.Fatalf("got_error: %v, want_error: %v", err, test.wantErr)
+			}
+			for _, id := range test.wantIDs {
+				if _, ok := b.encryptionKeys[id]; !ok {
+					tt.Errorf("key %s is not set", id)
+				}
+			}
+		})
+	}
+}
+
+func TestVersionWriter_Encryption(t *testing.T) {
+	ctx := context.Background()
+	b, bucket := newVersionedStorage(true, 0, 0)
+	b.encryptionKeys = newKeys(t, newEncryptionKey("key-1", 1), newEncryptionKey("key-2", 2))
+	b.encryptionEnabled = true
+	b.encryptionKeyID = "key-1"
+
+	old, err := writeVersion(ctx, b, "old", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old.KeyID != "key-1" {
+		t.Errorf("got: %s, want: key-1", old.KeyID)
+	}
+	if bytes.Contains(bucket.objs[old.Key], []byte("old")) {
+		t.Error("the backup data is not encrypted")
+	}
+
+	// the key is rotated, and the old backup is still readable by the old key.
+	b.encryptionKeyID = "key-2"
+	latest, err := writeVersion(ctx, b, "latest", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for m, want := range map[*Manifest]string{old: "old", latest: "latest"} {
+		r, err := b.Open(ctx, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil || string(got) != want {
+			t.Errorf("got: %s, err: %v, want: %s", got, err, want)
+		}
+	}
+
+	// the backup is not readable after its key is removed.
+	delete(b.encryptionKeys, "key-1")
+	if _, err = b.Open(ctx, old); !errors.Is(err, errors.ErrBackupEncryptionKeyNotFound("key-1")) {
+		t.Errorf("got_error: %v, want: %v", err, errors.ErrBackupEncryptionKeyNotFound("key-1"))
+	}
+	if bucket.opened != 0 {
+		t.Errorf("the bucket reader is not closed: %d", bucket.opened)
+	}
+
+	// the unencrypted backup is rejected after the encryption is enabled.
+	b.encryptionEnabled = false
+	plain, err := writeVersion(ctx, b, "plain", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.encryptionEnabled = true
+	if _, err = b.Open(ctx, plain); !errors.Is(err, errors.ErrBackupNotEncrypted) {
+		t.Errorf("got_error: %v, want: %v", err, errors.ErrBackupNotEncrypted)
+	}
+	if bucket.opened != 0 {
+		t.Errorf("the bucket reader is not closed: %d", bucket.opened)
+	}
+
+	// the unencrypted backup is readable when it is allowed explicitly.
+	b.allowUnencrypted = true
+	r, err := b.Open(ctx, plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if got, err := ioutil.ReadAll(r); err != nil || string(got) != "plain" {
+		t.Errorf("got: %s, err: %v, want: plain", got, err)
+	}
+}
+
+func TestNew_Encryption(t *testing.T) {
+	_, err := New(
+		WithEncryption(true, "key-2"),
+		WithEncryptionKeys(newEncryptionKey("key-1", 1)),
+	)
+	if !errors.Is(err, errors.ErrBackupEncryptionKeyNotFound("key-2")) {
+		t.Errorf("got_error: %v, want: %v", err, errors.ErrBackupEncryptionKeyNotFound("key-2"))
+	}
+}
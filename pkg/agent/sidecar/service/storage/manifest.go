@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package storage provides blob storage service
+package storage
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+)
+
+// Manifest represents the manifest of a backup.
+// A backup version is valid only when its manifest exists, because the manifest is written after the backup is uploaded.
+type Manifest struct {
+	// Version represents the version name which consists of the timestamp and the generation. It is empty when the versioning is disabled
+	Version string `json:"version,omitempty"`
+	// Generation represents the backup generation which increases for each version
+	Generation uint64 `json:"generation,omitempty"`
+	// Label represents the label given when the backup is requested
+	Label string `json:"label,omitempty"`
+	// CreatedAt represents the time when the backup is started
+	CreatedAt time.Time `json:"created_at"`
+	// Key represents the key of the backup data
+	Key string `json:"key"`
+	// Bytes represents the uncompressed size of the backup data
+	Bytes int64 `json:"bytes"`
+	// CompressAlgorithm represents the compression algorithm of the backup data
+	CompressAlgorithm string `json:"compress_algorithm,omitempty"`
+	// Files represents the integrity information of the regular files in the backup
+	Files []*ManifestFile `json:"files,omitempty"`
+	// Metadata represents the content of the agent metadata file in the backup
+	Metadata *metadata.Metadata `json:"metadata,omitempty"`
+	// IndexCount represents the NGT index count recorded in the agent metadata
+	IndexCount uint64 `json:"index_count"`
+	// Incremental represents the backup is stored as the chunks referenced by the files instead of the archive
+	Incremental bool `json:"incremental,omitempty"`
+	// ChunkSize represents the maximum size of the chunks of the incremental backup
+	ChunkSize int64 `json:"chunk_size,omitempty"`
+	// SaveGeneration represents the generation of the index saved by the agent. It is set only when the backup is coordinated with the agent
+	SaveGeneration uint64 `json:"save_generation,omitempty"`
+	// KeyID represents the ID of the key which encrypts the backup data. The chunks shared with the older backups may be encrypted by the older keys
+	KeyID string `json:"key_id,omitempty"`
+}
+
+// ManifestFile represents the integrity information of a file in the backup.
+type ManifestFile struct {
+	// Name represents the slash separated path relative to the backup directory
+	Name string `json:"name"`
+	// Size represents the size of the file
+	Size int64 `json:"size"`
+	// SHA256 represents the hex encoded SHA-256 checksum of the file
+	SHA256 string `json:"sha256"`
+	// Mode represents the permission bits of the file
+	Mode uint32 `json:"mode,omitempty"`
+	// Chunks represents the hex encoded SHA-256 checksums of the chunks of the file in order. It is set only for the incremental backup
+	Chunks []string `json:"chunks,omitempty"`
+}
+
+// AddFile records the integrity information of the file in the backup.
+func (m *Manifest) AddFile(name string, size int64, sum []byte) {
+	m.Files = append(m.Files, &ManifestFile{
+		Name:   name,
+		Size:   size,
+		SHA256: hex.EncodeToString(sum),
+	})
+}
+
+// SetMetadata records the agent metadata and its index count.
+func (m *Manifest) SetMetadata(meta *metadata.Metadata) {
+	m.Metadata = meta
+	if meta != nil && meta.NGT != nil {
+		m.IndexCount = meta.NGT.IndexCount
+	}
+}
+
+// Verify returns an error when the files do not match the files recorded in the manifest.
+func (m *Manifest) Verify(files []*ManifestFile) error {
+	want := make(map[string]*ManifestFile, len(m.Files))
+	for _, f := range m.Files {
+		want[f.Name] = f
+	}
+	for _, got := range files {
+		f, ok := want[got.Name]
+		if !ok {
+			return errors.ErrBackupFileNotInManifest(got.Name)
+		}
+		if f.Size != got.Size {
+			return errors.ErrBackupFileSizeMismatch(got.Name, f.Size, got.Size)
+		}
+		if f.SHA256 != got.SHA256 {
+			return errors.ErrBackupFileChecksumMismatch(got.Name, f.SHA256, got.SHA256)
+		}
+		delete(want, got.Name)
+	}
+	for _, f := range m.Files {
+		if _, ok := want[f.Name]; ok {
+			return errors.ErrBackupFileNotFound(f.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package storage provides blob storage service
+package storage
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+)
+
+func TestManifest_Verify(t *testing.T) {
+	t.Parallel()
+	sum := func(data string) []byte {
+		s := sha256.Sum256([]byte(data))
+		return s[:]
+	}
+	m := new(Manifest)
+	m.AddFile("ngt-meta.kvsdb", 4, sum("vald"))
+	m.AddFile("metadata.json", 2, sum("{}"))
+	files := func(fs ...*ManifestFile) []*ManifestFile {
+		return fs
+	}
+	file := func(name, data string) *ManifestFile {
+		f := new(Manifest)
+		f.AddFile(name, int64(len(data)), sum(data))
+		return f.Files[0]
+	}
+	type test struct {
+		name  string
+		files []*ManifestFile
+		want  error
+	}
+	tests := []test{
+		{
+			name:  "returns nil when the files match the manifest",
+			files: files(file("metadata.json", "{}"), file("ngt-meta.kvsdb", "vald")),
+		},
+		{
+			name:  "returns error when the file is missing",
+			files: files(file("ngt-meta.kvsdb", "vald")),
+			want:  errors.ErrBackupFileNotFound("metadata.json"),
+		},
+		{
+			name:  "returns error when the file is not in the manifest",
+			files: files(file("ngt-meta.kvsdb", "vald"), file("metadata.json", "{}"), file("unknown", "")),
+			want:  errors.ErrBackupFileNotInManifest("unknown"),
+		},
+		{
+			name:  "returns error when the size mismatches",
+			files: files(file("ngt-meta.kvsdb", "val"), file("metadata.json", "{}")),
+			want:  errors.ErrBackupFileSizeMismatch("ngt-meta.kvsdb", 4, 3),
+		},
+		{
+			name:  "returns error when the checksum mismatches",
+			files: files(file("ngt-meta.kvsdb", "vale"), file("metadata.json", "{}")),
+			want:  errors.ErrBackupFileChecksumMismatch("ngt-meta.kvsdb", m.Files[0].SHA256, file("", "vale").SHA256),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			if err := m.Verify(test.files); !errors.Is(err, test.want) {
+				tt.Errorf("got_error: %v, want: %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestManifest_SetMetadata(t *testing.T) {
+	t.Parallel()
+	m := new(Manifest)
+	meta := &metadata.Metadata{
+		NGT: &metadata.NGT{
+			IndexCount: 100,
+		},
+	}
+	m.SetMetadata(meta)
+	if m.Metadata != meta || m.IndexCount != 100 {
+		t.Errorf("metadata = %v, index count = %d, want %v, 100", m.Metadata, m.IndexCount, meta)
+	}
+
+	m = new(Manifest)
+	m.SetMetadata(new(metadata.Metadata))
+	if m.IndexCount != 0 {
+		t.Errorf("index count = %d, want 0", m.IndexCount)
+	}
+}
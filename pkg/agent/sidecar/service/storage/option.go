@@ -18,11 +18,17 @@
 package storage
 
 import (
+	"crypto/cipher"
+
+	"github.com/vdaas/vald/internal/config"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage/urlopener"
+	"github.com/vdaas/vald/internal/db/storage/blob/local"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/session"
 	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/timeutil"
 )
 
 type Option func(b *bs) error
@@ -32,6 +38,7 @@ var defaultOptions = []Option{
 	WithCompressAlgorithm("gzip"),
 	WithCompressionLevel(-1),
 	WithFilenameSuffix(".tar.gz"),
+	WithUncommittedGracePeriod("24h"),
 }
 
 func WithErrGroup(eg errgroup.Group) Option {
@@ -69,6 +76,80 @@ func WithFilenameSuffix(sf string) Option {
 	}
 }
 
+// WithEncryption returns the option to encrypt the backups by the key of the key ID.
+func WithEncryption(enabled bool, keyID string) Option {
+	return func(b *bs) error {
+		b.encryptionEnabled = enabled
+		b.encryptionKeyID = keyID
+		return nil
+	}
+}
+
+// WithAllowUnencrypted returns the option to read the unencrypted backups while the encryption is enabled.
+func WithAllowUnencrypted(allow bool) Option {
+	return func(b *bs) error {
+		b.allowUnencrypted = allow
+		return nil
+	}
+}
+
+// WithEncryptionKeys returns the option to add the keys to encrypt and decrypt the backups.
+func WithEncryptionKeys(keys ...*config.EncryptionKey) Option {
+	return func(b *bs) error {
+		for _, key := range keys {
+			if key == nil {
+				continue
+			}
+			if _, ok := b.encryptionKeys[key.ID]; ok {
+				return errors.ErrInvalidBackupEncryptionKey(key.ID, errors.New("duplicated key id"))
+			}
+			aead, err := parseEncryptionKey(key)
+			if err != nil {
+				return err
+			}
+			if b.encryptionKeys == nil {
+				b.encryptionKeys = make(map[string]cipher.AEAD, len(keys))
+			}
+			b.encryptionKeys[key.ID] = aead
+		}
+		return nil
+	}
+}
+
+// WithVersioning returns the option to write each backup under the versioned key with its manifest.
+func WithVersioning(enabled bool) Option {
+	return func(b *bs) error {
+		b.versioningEnabled = enabled
+		return nil
+	}
+}
+
+// WithRetention returns the option to set the retention policy of the backup versions.
+// The last keepLast versions and the newest version of each day for keepDailyDays days are kept.
+func WithRetention(keepLast, keepDailyDays int) Option {
+	return func(b *bs) error {
+		b.keepLast = keepLast
+		b.keepDailyDays = keepDailyDays
+		return nil
+	}
+}
+
+// WithUncommittedGracePeriod returns the option to set the period to keep the data of the version which does not have the manifest.
+// The data older than the period is deleted by Prune, because its backup has failed before the manifest is committed.
+func WithUncommittedGracePeriod(dur string) Option {
+	return func(b *bs) error {
+		if dur == "" {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return nil
+		}
+		b.uncommittedGracePeriod = d
+		return nil
+	}
+}
+
 func WithS3Opts(opts ...s3.Option) Option {
 	return func(b *bs) error {
 		if b.s3Opts == nil {
@@ -107,6 +188,14 @@ func WithCloudStorageOpts(opts ...cloudstorage.Option) Option {
 	}
 }
 
+func WithLocalOpts(opts ...local.Option) Option {
+	return func(b *bs) error {
+		b.localOpts = append(b.localOpts, opts...)
+
+		return nil
+	}
+}
+
 func WithCloudStorageURLOpenerOpts(opts ...urlopener.Option) Option {
 	return func(b *bs) error {
 		if b.cloudStorageURLOpenerOpts == nil {
@@ -19,13 +19,16 @@ package storage
 
 import (
 	"context"
+	"crypto/cipher"
 	"reflect"
+	"time"
 
 	"github.com/vdaas/vald/internal/compress"
 	"github.com/vdaas/vald/internal/config"
 	"github.com/vdaas/vald/internal/db/storage/blob"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage/urlopener"
+	"github.com/vdaas/vald/internal/db/storage/blob/local"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/session"
 	"github.com/vdaas/vald/internal/errgroup"
@@ -39,6 +42,39 @@ type Storage interface {
 	Reader(ctx context.Context) (io.ReadCloser, error)
 	Writer(ctx context.Context) (io.WriteCloser, error)
 
+	// Manifests returns the manifests of the backups to restore in order of preference.
+	// Only the specified version is returned when the version is not empty, otherwise the valid versions are returned in order from the newest.
+	// It returns the manifest of the unversioned backup when the versioning is disabled or there is no version, and the nil manifest when the backup does not have the manifest.
+	Manifests(ctx context.Context, version string) ([]*Manifest, error)
+	// Open returns the reader of the backup data of the manifest. It reads the unversioned backup when the manifest is nil.
+	// The incremental backup is not readable as the archive and must be rebuilt from the chunks.
+	Open(ctx context.Context, m *Manifest) (io.ReadCloser, error)
+	// VersionReader returns the reader of the first readable backup in the Manifests and its manifest.
+	VersionReader(ctx context.Context, version string) (io.ReadCloser, *Manifest, error)
+	// NewManifest returns the manifest of a new backup. The backup is not valid until the manifest is committed.
+	NewManifest(ctx context.Context) (*Manifest, error)
+	// VersionWriter returns the writer of a new backup and its manifest. The backup is not valid until the manifest is committed.
+	// It writes the unversioned backup when the versioning is disabled.
+	VersionWriter(ctx context.Context) (io.WriteCloser, *Manifest, error)
+	// Commit writes the manifest to make the backup valid. It must be called after the backup data is written successfully.
+	Commit(ctx context.Context, m *Manifest) error
+	// Abort deletes the data and the manifest of the backup version which is not committed. It does nothing for the unversioned backup.
+	Abort(ctx context.Context, m *Manifest) error
+	// Versions returns the manifests of the valid backup versions in order from the newest.
+	Versions(ctx context.Context) ([]*Manifest, error)
+	// Prune deletes the backup versions which are not kept by the retention policy and the data of the versions
+	// which are not committed within the grace period, and returns the deleted versions.
+	Prune(ctx context.Context) ([]string, error)
+
+	// Chunks returns the checksums of the stored chunks of the incremental backup.
+	Chunks(ctx context.Context) (map[string]struct{}, error)
+	// ChunkReader returns the reader of the chunk of the checksum.
+	ChunkReader(ctx context.Context, sum string) (io.ReadCloser, error)
+	// ChunkWriter returns the writer of the chunk of the checksum.
+	ChunkWriter(ctx context.Context, sum string) (io.WriteCloser, error)
+	// PruneChunks deletes the chunks which are not referenced by any valid backup, and returns the checksums of the deleted chunks.
+	PruneChunks(ctx context.Context) ([]string, error)
+
 	StorageInfo() *StorageInfo
 }
 
@@ -55,9 +91,22 @@ type bs struct {
 	cloudStorageOpts          []cloudstorage.Option
 	cloudStorageURLOpenerOpts []urlopener.Option
 
+	localOpts []local.Option
+
 	compressAlgorithm string
 	compressionLevel  int
 
+	versioningEnabled bool
+	keepLast          int
+	keepDailyDays     int
+
+	uncommittedGracePeriod time.Duration
+
+	encryptionEnabled bool
+	encryptionKeyID   string
+	encryptionKeys    map[string]cipher.AEAD
+	allowUnencrypted  bool
+
 	bucket     blob.Bucket
 	compressor compress.Compressor
 }
@@ -75,6 +124,12 @@ func New(opts ...Option) (Storage, error) {
 		return nil, err
 	}
 
+	if b.encryptionEnabled {
+		if _, ok := b.encryptionKeys[b.encryptionKeyID]; !ok {
+			return nil, errors.ErrBackupEncryptionKeyNotFound(b.encryptionKeyID)
+		}
+	}
+
 	return b, nil
 }
 
@@ -145,6 +200,16 @@ func (b *bs) initBucket(ctx context.Context) (err error) {
 		if err != nil {
 			return err
 		}
+	case config.Local:
+		b.bucket, err = local.New(
+			append(
+				b.localOpts,
+				local.WithBucket(b.bucketName),
+			)...,
+		)
+		if err != nil {
+			return err
+		}
 	default:
 		return errors.ErrInvalidStorageType
 	}
@@ -176,37 +241,71 @@ func (b *bs) Stop(ctx context.Context) error {
 }
 
 func (b *bs) Reader(ctx context.Context) (r io.ReadCloser, err error) {
-	r, err = b.bucket.Reader(ctx, b.filename+b.suffix)
+	return b.reader(ctx, b.filename+b.suffix)
+}
+
+func (b *bs) reader(ctx context.Context, key string) (r io.ReadCloser, err error) {
+	r, err = b.bucket.Reader(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// the bucket reader is closed by newDecryptReader when it fails.
+	r, err = newDecryptReader(r, b.encryptionKeys, !b.encryptionEnabled || b.allowUnencrypted)
 	if err != nil {
 		return nil, err
 	}
 
 	if b.compressor != nil {
-		r, err = b.compressor.Reader(r)
+		var cr io.ReadCloser
+		cr, err = b.compressor.Reader(r)
 		if err != nil {
-			return nil, err
+			return nil, closeOnError(r, err)
 		}
+		r = cr
 	}
 
 	return r, nil
 }
 
 func (b *bs) Writer(ctx context.Context) (w io.WriteCloser, err error) {
-	w, err = b.bucket.Writer(ctx, b.filename+b.suffix)
+	return b.writer(ctx, b.filename+b.suffix)
+}
+
+func (b *bs) writer(ctx context.Context, key string) (w io.WriteCloser, err error) {
+	w, err = b.bucket.Writer(ctx, key)
 	if err != nil {
 		return nil, err
 	}
 
-	if b.compressor != nil {
-		w, err = b.compressor.Writer(w)
+	if b.encryptionEnabled {
+		// the bucket writer is closed by newEncryptWriter when it fails.
+		w, err = newEncryptWriter(w, b.encryptionKeyID, b.encryptionKeys[b.encryptionKeyID])
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	if b.compressor != nil {
+		var cw io.WriteCloser
+		cw, err = b.compressor.Writer(w)
+		if err != nil {
+			return nil, closeOnError(w, err)
+		}
+		w = cw
+	}
+
 	return w, nil
 }
 
+// closeOnError closes c which is not returned because of err, and returns err with the close error.
+func closeOnError(c io.Closer, err error) error {
+	if cerr := c.Close(); cerr != nil {
+		return errors.Wrap(err, cerr.Error())
+	}
+	return err
+}
+
 func (b *bs) StorageInfo() *StorageInfo {
 	return &StorageInfo{
 		Type:       config.AtoBST(b.storageType).String(),
@@ -985,3 +985,28 @@ func Test_bs_Stop(t *testing.T) {
 		})
 	}
 }
+
+func Test_bs_ReaderWriter_CompressorFailure(t *testing.T) {
+	ctx := context.Background()
+	errCompressor := errors.New("compressor failed")
+	b, bucket := newVersionedStorage(false, 0, 0)
+	bucket.objs[b.filename+b.suffix] = []byte("vald")
+	b.compressor = &compress.MockCompressor{
+		ReaderFunc: func(io.ReadCloser) (io.ReadCloser, error) {
+			return nil, errCompressor
+		},
+		WriterFunc: func(io.WriteCloser) (io.WriteCloser, error) {
+			return nil, errCompressor
+		},
+	}
+
+	if _, err := b.Reader(ctx); !errors.Is(err, errCompressor) {
+		t.Errorf("got_error: %v, want: %v", err, errCompressor)
+	}
+	if _, err := b.Writer(ctx); !errors.Is(err, errCompressor) {
+		t.Errorf("got_error: %v, want: %v", err, errCompressor)
+	}
+	if bucket.opened != 0 {
+		t.Errorf("the bucket reader or writer is not closed: %d", bucket.opened)
+	}
+}
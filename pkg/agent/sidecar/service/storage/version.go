@@ -0,0 +1,363 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package storage provides blob storage service
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+)
+
+const (
+	// manifestSuffix is the key suffix of the manifest of a backup version.
+	manifestSuffix = ".manifest.json"
+	// versionTimeFormat is the timestamp format of the version name.
+	versionTimeFormat = "20060102T150405Z"
+)
+
+// versionName returns the version name which is sorted in the creation order.
+func versionName(t time.Time, generation uint64) string {
+	return fmt.Sprintf("%s-%020d", t.UTC().Format(versionTimeFormat), generation)
+}
+
+func (b *bs) versionPrefix() string {
+	return b.filename + "/"
+}
+
+func (b *bs) versionKey(version string) string {
+	return b.versionPrefix() + version + b.suffix
+}
+
+// manifestKey returns the manifest key of the version, or the key of the unversioned backup manifest when the version is empty.
+func (b *bs) manifestKey(version string) string {
+	if len(version) == 0 {
+		return b.filename + b.suffix + manifestSuffix
+	}
+	return b.versionPrefix() + version + manifestSuffix
+}
+
+func (b *bs) Versions(ctx context.Context) ([]*Manifest, error) {
+	var keys []string
+	err := b.bucket.List(ctx, b.versionPrefix(), func(key string) bool {
+		if strings.HasSuffix(key, manifestSuffix) {
+			keys = append(keys, key)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ms := make([]*Manifest, 0, len(keys))
+	for _, key := range keys {
+		m, err := b.readManifest(ctx, key)
+		if err == nil && len(m.Version) == 0 {
+			err = errors.ErrInvalidBackupManifest(key, errors.New("version is empty"))
+		}
+		if err != nil {
+			// the broken manifest makes the version invalid, and the other versions are still available.
+			log.Warn(err)
+			continue
+		}
+		ms = append(ms, m)
+	}
+	sort.Slice(ms, func(i, j int) bool {
+		if ms[i].Generation != ms[j].Generation {
+			return ms[i].Generation > ms[j].Generation
+		}
+		return ms[i].CreatedAt.After(ms[j].CreatedAt)
+	})
+	return ms, nil
+}
+
+func (b *bs) readManifest(ctx context.Context, key string) (m *Manifest, err error) {
+	r, err := b.bucket.Reader(ctx, key)
+	if err != nil {
+		return nil, errors.ErrInvalidBackupManifest(key, err)
+	}
+	defer func() {
+		if cerr := r.Close(); cerr != nil {
+			log.Warn(cerr)
+		}
+	}()
+	m = new(Manifest)
+	if err = json.Decode(r, m); err != nil {
+		return nil, errors.ErrInvalidBackupManifest(key, err)
+	}
+	if len(m.Key) == 0 && !m.Incremental {
+		return nil, errors.ErrInvalidBackupManifest(key, errors.New("key is empty"))
+	}
+	return m, nil
+}
+
+func (b *bs) writeManifest(ctx context.Context, m *Manifest) (err error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	w, err := b.bucket.Writer(ctx, b.manifestKey(m.Version))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := w.Close(); cerr != nil {
+			err = errors.Wrap(err, cerr.Error())
+		}
+	}()
+	_, err = io.Copy(w, bytes.NewReader(data))
+	return err
+}
+
+func (b *bs) Manifests(ctx context.Context, version string) ([]*Manifest, error) {
+	if b.versioningEnabled {
+		ms, err := b.Versions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(version) != 0 {
+			for _, m := range ms {
+				if m.Version == version {
+					return []*Manifest{m}, nil
+				}
+			}
+			return nil, errors.ErrBackupVersionNotFound(version)
+		}
+		if len(ms) != 0 {
+			return ms, nil
+		}
+		// the backup written before the versioning is enabled is restored.
+	}
+
+	m, err := b.readManifest(ctx, b.manifestKey(""))
+	if err != nil {
+		// the backup written before the manifest is introduced does not have the manifest.
+		log.Warnf("the manifest of the unversioned backup is not available, the backup is not verified: %s", err)
+		return []*Manifest{nil}, nil
+	}
+	return []*Manifest{m}, nil
+}
+
+func (b *bs) Open(ctx context.Context, m *Manifest) (io.ReadCloser, error) {
+	if m == nil {
+		return b.Reader(ctx)
+	}
+	if m.Incremental {
+		return nil, errors.ErrIncrementalBackupNotArchived
+	}
+	if _, ok := b.encryptionKeys[m.KeyID]; len(m.KeyID) != 0 && !ok {
+		return nil, errors.ErrBackupEncryptionKeyNotFound(m.KeyID)
+	}
+	return b.reader(ctx, m.Key)
+}
+
+func (b *bs) VersionReader(ctx context.Context, version string) (io.ReadCloser, *Manifest, error) {
+	ms, err := b.Manifests(ctx, version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var errs error
+	for _, m := range ms {
+		r, err := b.Open(ctx, m)
+		if err == nil {
+			return r, m, nil
+		}
+		if m != nil {
+			log.Warnf("backup version %s is not readable, falling back to the previous version: %s", m.Version, err)
+		}
+		errs = errors.Wrap(errs, err.Error())
+	}
+	return nil, nil, errs
+}
+
+func (b *bs) NewManifest(ctx context.Context) (*Manifest, error) {
+	m := &Manifest{
+		CreatedAt:         time.Now(),
+		Key:               b.filename + b.suffix,
+		CompressAlgorithm: b.compressAlgorithm,
+	}
+	if b.encryptionEnabled {
+		m.KeyID = b.encryptionKeyID
+	}
+	if b.versioningEnabled {
+		ms, err := b.Versions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m.Generation = 1
+		if len(ms) != 0 {
+			m.Generation = ms[0].Generation + 1
+		}
+		m.Version = versionName(m.CreatedAt, m.Generation)
+		m.Key = b.versionKey(m.Version)
+	}
+	return m, nil
+}
+
+func (b *bs) VersionWriter(ctx context.Context) (io.WriteCloser, *Manifest, error) {
+	m, err := b.NewManifest(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w, err := b.writer(ctx, m.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return w, m, nil
+}
+
+func (b *bs) Commit(ctx context.Context, m *Manifest) error {
+	if m == nil {
+		return nil
+	}
+	return b.writeManifest(ctx, m)
+}
+
+func (b *bs) Abort(ctx context.Context, m *Manifest) error {
+	// the incremental backup does not have its data, and its chunks are deleted by PruneChunks when they are not referenced.
+	if m == nil || len(m.Version) == 0 || m.Incremental {
+		return nil
+	}
+	// the manifest may be written when the commit has failed after the upload.
+	err := b.bucket.Delete(ctx, b.manifestKey(m.Version))
+	if err != nil {
+		return err
+	}
+	return b.bucket.Delete(ctx, m.Key)
+}
+
+// expired returns the versions which are not kept by the retention policy.
+// The versions are kept when both of the keep last and keep daily days are not set.
+func (b *bs) expired(ms []*Manifest, now time.Time) []*Manifest {
+	if b.keepLast <= 0 && b.keepDailyDays <= 0 {
+		return nil
+	}
+	days := make(map[string]struct{}, b.keepDailyDays)
+	since := now.UTC().AddDate(0, 0, -b.keepDailyDays)
+	expired := make([]*Manifest, 0, len(ms))
+	for i, m := range ms {
+		day := m.CreatedAt.UTC().Format("20060102")
+		if i < b.keepLast {
+			days[day] = struct{}{}
+			continue
+		}
+		if b.keepDailyDays > 0 && m.CreatedAt.UTC().After(since) {
+			// the versions are sorted from the newest, so that the newest version of each day is kept.
+			if _, ok := days[day]; !ok {
+				days[day] = struct{}{}
+				continue
+			}
+		}
+		expired = append(expired, m)
+	}
+	return expired
+}
+
+func (b *bs) Prune(ctx context.Context) ([]string, error) {
+	if !b.versioningEnabled {
+		return nil, nil
+	}
+	ms, err := b.Versions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	expired := b.expired(ms, now)
+	deleted := make([]string, 0, len(expired))
+	for _, m := range expired {
+		// the manifest is deleted first to invalidate the version before its data is deleted.
+		err = b.bucket.Delete(ctx, b.manifestKey(m.Version))
+		if err == nil {
+			err = b.bucket.Delete(ctx, m.Key)
+		}
+		if err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, m.Version)
+	}
+
+	uncommitted, err := b.uncommitted(ctx, now)
+	if err != nil {
+		return deleted, err
+	}
+	for _, version := range uncommitted {
+		err = b.bucket.Delete(ctx, b.versionKey(version))
+		if err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, version)
+	}
+	return deleted, nil
+}
+
+// uncommitted returns the versions which have the data but do not have the manifest, and are older than the grace period.
+// The data is left when the backup has failed before it is committed and the data is not deleted by Abort.
+func (b *bs) uncommitted(ctx context.Context, now time.Time) ([]string, error) {
+	prefix := b.versionPrefix()
+	data := make(map[string]struct{})
+	manifests := make(map[string]struct{})
+	err := b.bucket.List(ctx, prefix, func(key string) bool {
+		name := strings.TrimPrefix(key, prefix)
+		switch {
+		case strings.HasSuffix(name, manifestSuffix):
+			manifests[strings.TrimSuffix(name, manifestSuffix)] = struct{}{}
+		case strings.HasSuffix(name, b.suffix):
+			data[strings.TrimSuffix(name, b.suffix)] = struct{}{}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(data))
+	for version := range data {
+		if _, ok := manifests[version]; ok {
+			continue
+		}
+		// the keys which are not the version data, e.g., the chunks of the incremental backup, are not parsed as the version.
+		t, ok := versionTime(version)
+		if !ok || now.Sub(t) < b.uncommittedGracePeriod {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions, nil
+}
+
+// versionTime returns the creation time of the version name.
+func versionTime(version string) (time.Time, bool) {
+	if len(version) <= len(versionTimeFormat) || version[len(versionTimeFormat)] != '-' {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(versionTimeFormat, version[:len(versionTimeFormat)])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,520 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package storage provides blob storage service
+package storage
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+type bucketMock struct {
+	mu   sync.Mutex
+	objs map[string][]byte
+	// opened is the number of the readers and writers which are not closed.
+	opened int
+}
+
+type bucketReaderMock struct {
+	*bytes.Reader
+	close func()
+}
+
+func (r *bucketReaderMock) Close() error {
+	r.close()
+	return nil
+}
+
+type bucketWriterMock struct {
+	bytes.Buffer
+	close func([]byte)
+}
+
+func (w *bucketWriterMock) Close() error {
+	w.close(w.Bytes())
+	return nil
+}
+
+func (b *bucketMock) Open(ctx context.Context) error {
+	return nil
+}
+
+func (b *bucketMock) Close() error {
+	return nil
+}
+
+func (b *bucketMock) Reader(ctx context.Context, key string) (io.ReadCloser, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	obj, ok := b.objs[key]
+	if !ok {
+		return nil, errors.Errorf("blob not found: %s", key)
+	}
+	b.opened++
+	return &bucketReaderMock{
+		Reader: bytes.NewReader(obj),
+		close: func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			b.opened--
+		},
+	}, nil
+}
+
+func (b *bucketMock) Writer(ctx context.Context, key string) (io.WriteCloser, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.opened++
+	return &bucketWriterMock{
+		close: func(obj []byte) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			b.objs[key] = obj
+			b.opened--
+		},
+	}, nil
+}
+
+func (b *bucketMock) Delete(ctx context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.objs, key)
+	return nil
+}
+
+func (b *bucketMock) List(ctx context.Context, prefix string, f func(key string) bool) error {
+	b.mu.Lock()
+	keys := make([]string, 0, len(b.objs))
+	for key := range b.objs {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	b.mu.Unlock()
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !f(key) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (b *bucketMock) Stat(ctx context.Context, key string) (*blob.Attributes, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	obj, ok := b.objs[key]
+	if !ok {
+		return nil, errors.NewErrBlobNoSuchKey(errors.New("not found"), key)
+	}
+	return &blob.Attributes{
+		Key:  key,
+		Size: int64(len(obj)),
+	}, nil
+}
+
+func newVersionedStorage(enabled bool, keepLast, keepDailyDays int) (*bs, *bucketMock) {
+	bucket := &bucketMock{
+		objs: make(map[string][]byte),
+	}
+	return &bs{
+		filename:          "vald-agent-ngt-0",
+		suffix:            ".tar",
+		versioningEnabled: enabled,
+		keepLast:          keepLast,
+		keepDailyDays:     keepDailyDays,
+		bucket:            bucket,
+	}, bucket
+}
+
+func writeVersion(ctx context.Context, b *bs, data string, commit bool) (*Manifest, error) {
+	w, m, err := b.VersionWriter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write([]byte(data)); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	if commit {
+		if err = b.Commit(ctx, m); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func readVersion(ctx context.Context, b *bs, version string) (string, *Manifest, error) {
+	r, m, err := b.VersionReader(ctx, version)
+	if err != nil {
+		return "", nil, err
+	}
+	defer r.Close()
+	var buf bytes.Buffer
+	if _, err = buf.ReadFrom(r); err != nil {
+		return "", nil, err
+	}
+	return buf.String(), m, nil
+}
+
+func TestVersionWriter(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	ctx := context.Background()
+
+	t.Run("writes the versions with the increasing generation", func(tt *testing.T) {
+		b, bucket := newVersionedStorage(true, 0, 0)
+		m1, err := writeVersion(ctx, b, "first", true)
+		if err != nil {
+			tt.Fatal(err)
+		}
+		m2, err := writeVersion(ctx, b, "second", true)
+		if err != nil {
+			tt.Fatal(err)
+		}
+		if m1.Generation != 1 || m2.Generation != 2 {
+			tt.Errorf("generations = %d, %d, want 1, 2", m1.Generation, m2.Generation)
+		}
+		if !strings.HasPrefix(m2.Key, "vald-agent-ngt-0/") || !strings.HasSuffix(m2.Key, ".tar") {
+			tt.Errorf("key = %s, want versioned key", m2.Key)
+		}
+		if _, ok := bucket.objs["vald-agent-ngt-0.tar"]; ok {
+			tt.Error("unversioned backup is written")
+		}
+
+		ms, err := b.Versions(ctx)
+		if err != nil {
+			tt.Fatal(err)
+		}
+		got := make([]string, 0, len(ms))
+		for _, m := range ms {
+			got = append(got, m.Version)
+		}
+		if want := []string{m2.Version, m1.Version}; !reflect.DeepEqual(got, want) {
+			tt.Errorf("versions = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("does not list the uncommitted version", func(tt *testing.T) {
+		b, _ := newVersionedStorage(true, 0, 0)
+		m1, err := writeVersion(ctx, b, "first", true)
+		if err != nil {
+			tt.Fatal(err)
+		}
+		if _, err = writeVersion(ctx, b, "partial", false); err != nil {
+			tt.Fatal(err)
+		}
+		got, m, err := readVersion(ctx, b, "")
+		if err != nil {
+			tt.Fatal(err)
+		}
+		if got != "first" || m.Version != m1.Version {
+			tt.Errorf("got = %s (%s), want first (%s)", got, m.Version, m1.Version)
+		}
+	})
+
+	t.Run("writes the unversioned backup when the versioning is disabled", func(tt *testing.T) {
+		b, bucket := newVersionedStorage(false, 0, 0)
+		m, err := writeVersion(ctx, b, "legacy", true)
+		if err != nil {
+			tt.Fatal(err)
+		}
+		if len(m.Version) != 0 || m.Key != "vald-agent-ngt-0.tar" {
+			tt.Errorf("manifest = %#v, want unversioned manifest", m)
+		}
+		if got := string(bucket.objs["vald-agent-ngt-0.tar"]); got != "legacy" {
+			tt.Errorf("got = %s, want legacy", got)
+		}
+		if _, ok := bucket.objs["vald-agent-ngt-0.tar.manifest.json"]; !ok {
+			tt.Error("unversioned manifest is not written")
+		}
+		got, rm, err := readVersion(ctx, b, "")
+		if err != nil || got != "legacy" || rm == nil || rm.Key != m.Key {
+			tt.Errorf("got = %s, manifest = %v, err = %v, want legacy with manifest", got, rm, err)
+		}
+	})
+}
+
+func TestVersionReader(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	ctx := context.Background()
+
+	t.Run("reads the latest and the specified version", func(tt *testing.T) {
+		b, _ := newVersionedStorage(true, 0, 0)
+		m1, err := writeVersion(ctx, b, "first", true)
+		if err != nil {
+			tt.Fatal(err)
+		}
+		if _, err = writeVersion(ctx, b, "second", true); err != nil {
+			tt.Fatal(err)
+		}
+		if got, _, err := readVersion(ctx, b, ""); err != nil || got != "second" {
+			tt.Errorf("latest = %s, err = %v, want second", got, err)
+		}
+		if got, _, err := readVersion(ctx, b, m1.Version); err != nil || got != "first" {
+			tt.Errorf("specified = %s, err = %v, want first", got, err)
+		}
+	})
+
+	t.Run("returns error when the version is not found", func(tt *testing.T) {
+		b, _ := newVersionedStorage(true, 0, 0)
+		if _, err := writeVersion(ctx, b, "first", true); err != nil {
+			tt.Fatal(err)
+		}
+		_, _, err := readVersion(ctx, b, "unknown")
+		if want := errors.ErrBackupVersionNotFound("unknown"); !errors.Is(err, want) {
+			tt.Errorf("err = %v, want %v", err, want)
+		}
+	})
+
+	t.Run("falls back to the previous version when the latest one is not readable", func(tt *testing.T) {
+		b, bucket := newVersionedStorage(true, 0, 0)
+		if _, err := writeVersion(ctx, b, "first", true); err != nil {
+			tt.Fatal(err)
+		}
+		m2, err := writeVersion(ctx, b, "second", true)
+		if err != nil {
+			tt.Fatal(err)
+		}
+		delete(bucket.objs, m2.Key)
+		if got, _, err := readVersion(ctx, b, ""); err != nil || got != "first" {
+			tt.Errorf("got = %s, err = %v, want first", got, err)
+		}
+	})
+
+	t.Run("skips the broken manifest", func(tt *testing.T) {
+		b, bucket := newVersionedStorage(true, 0, 0)
+		if _, err := writeVersion(ctx, b, "first", true); err != nil {
+			tt.Fatal(err)
+		}
+		bucket.objs[b.manifestKey("99999999T999999Z-99999999999999999999")] = []byte("{")
+		if got, _, err := readVersion(ctx, b, ""); err != nil || got != "first" {
+			tt.Errorf("got = %s, err = %v, want first", got, err)
+		}
+	})
+
+	t.Run("reads the unversioned backup when there is no version", func(tt *testing.T) {
+		b, bucket := newVersionedStorage(true, 0, 0)
+		bucket.objs["vald-agent-ngt-0.tar"] = []byte("legacy")
+		got, m, err := readVersion(ctx, b, "")
+		if err != nil || got != "legacy" || m != nil {
+			tt.Errorf("got = %s, manifest = %v, err = %v, want legacy", got, m, err)
+		}
+	})
+}
+
+func Test_bs_expired(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 10, 18, 12, 0, 0, 0, time.UTC)
+	// versions are sorted from the newest: two versions per day for 5 days.
+	ms := make([]*Manifest, 0, 10)
+	for i := 0; i < 10; i++ {
+		createdAt := now.AddDate(0, 0, -(i / 2))
+		if i%2 == 1 {
+			createdAt = createdAt.Add(-time.Hour)
+		}
+		ms = append(ms, &Manifest{
+			Version:    versionName(createdAt, uint64(10-i)),
+			Generation: uint64(10 - i),
+			CreatedAt:  createdAt,
+		})
+	}
+	generations := func(ms []*Manifest) []uint64 {
+		gs := make([]uint64, 0, len(ms))
+		for _, m := range ms {
+			gs = append(gs, m.Generation)
+		}
+		return gs
+	}
+	type args struct {
+		keepLast      int
+		keepDailyDays int
+	}
+	type test struct {
+		name string
+		args args
+		want []uint64
+	}
+	tests := []test{
+		{
+			name: "keeps all versions when the retention is not set",
+			want: []uint64{},
+		},
+		{
+			name: "keeps the last versions",
+			args: args{
+				keepLast: 3,
+			},
+			want: []uint64{7, 6, 5, 4, 3, 2, 1},
+		},
+		{
+			name: "keeps the newest version of each day",
+			args: args{
+				keepDailyDays: 3,
+			},
+			want: []uint64{9, 7, 5, 4, 3, 2, 1},
+		},
+		{
+			name: "keeps the last versions and the newest version of the other days",
+			args: args{
+				keepLast:      3,
+				keepDailyDays: 3,
+			},
+			want: []uint64{7, 5, 4, 3, 2, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			b := &bs{
+				keepLast:      test.args.keepLast,
+				keepDailyDays: test.args.keepDailyDays,
+			}
+			if got := generations(b.expired(ms, now)); !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_bs_Prune(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	ctx := context.Background()
+	b, bucket := newVersionedStorage(true, 2, 0)
+	written := make([]*Manifest, 0, 4)
+	for _, data := range []string{"1", "2", "3", "4"} {
+		m, err := writeVersion(ctx, b, data, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		written = append(written, m)
+	}
+
+	deleted, err := b.Prune(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{written[1].Version, written[0].Version}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted = %v, want %v", deleted, want)
+	}
+	for _, m := range written[:2] {
+		if _, ok := bucket.objs[m.Key]; ok {
+			t.Errorf("data of %s is not deleted", m.Version)
+		}
+		if _, ok := bucket.objs[b.manifestKey(m.Version)]; ok {
+			t.Errorf("manifest of %s is not deleted", m.Version)
+		}
+	}
+	ms, err := b.Versions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) != 2 || ms[0].Version != written[3].Version {
+		t.Errorf("versions = %v, want the last 2 versions", ms)
+	}
+}
+
+func Test_bs_Abort(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	ctx := context.Background()
+	b, bucket := newVersionedStorage(true, 0, 0)
+	committed, err := writeVersion(ctx, b, "1", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aborted, err := writeVersion(ctx, b, "2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = b.Abort(ctx, aborted); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := bucket.objs[aborted.Key]; ok {
+		t.Errorf("data of %s is not deleted", aborted.Version)
+	}
+	if _, ok := bucket.objs[committed.Key]; !ok {
+		t.Errorf("data of %s is deleted", committed.Version)
+	}
+	if err = b.Abort(ctx, nil); err != nil {
+		t.Errorf("got_error: \"%#v\", want the nil manifest is ignored", err)
+	}
+
+	ub, ubucket := newVersionedStorage(false, 0, 0)
+	m, err := writeVersion(ctx, ub, "1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ub.Abort(ctx, m); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ubucket.objs[m.Key]; !ok {
+		t.Error("the unversioned backup is deleted")
+	}
+}
+
+func Test_bs_Prune_Uncommitted(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	ctx := context.Background()
+	b, bucket := newVersionedStorage(true, 0, 0)
+	b.uncommittedGracePeriod = time.Hour
+
+	old := versionName(time.Now().Add(-2*time.Hour), 1)
+	bucket.objs[b.versionKey(old)] = []byte("old")
+	committed, err := writeVersion(ctx, b, "2", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recent, err := writeVersion(ctx, b, "3", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunk := b.chunkKey("sum")
+	bucket.objs[chunk] = []byte("chunk")
+
+	deleted, err := b.Prune(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{old}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted = %v, want %v", deleted, want)
+	}
+	for _, key := range []string{committed.Key, recent.Key, chunk} {
+		if _, ok := bucket.objs[key]; !ok {
+			t.Errorf("%s is deleted", key)
+		}
+	}
+}
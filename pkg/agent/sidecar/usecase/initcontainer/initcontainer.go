@@ -18,16 +18,19 @@ package initcontainer
 
 import (
 	"context"
-	"errors"
 	"io"
+	"os"
+	"syscall"
 
 	"github.com/vdaas/vald/apis/grpc/v1/agent/sidecar"
 	iconf "github.com/vdaas/vald/internal/config"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage/urlopener"
+	"github.com/vdaas/vald/internal/db/storage/blob/local"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/session"
 	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/log"
 	"github.com/vdaas/vald/internal/net"
 	"github.com/vdaas/vald/internal/net/grpc"
@@ -102,11 +105,39 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		return nil, err
 	}
 
+	filename, version, restore := cfg.AgentSidecar.Filename, cfg.AgentSidecar.RestoreVersion, true
+	if cfg.AgentSidecar.Clone.Enabled {
+		filenames, err := storage.CloneFilenames(
+			cfg.AgentSidecar.Filename,
+			cfg.AgentSidecar.Clone.SourceFilename,
+			cfg.AgentSidecar.Clone.SourceReplicas,
+			cfg.AgentSidecar.Clone.TargetReplicas,
+		)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case cfg.AgentSidecar.Clone.Replay.Enabled:
+			// the sidecar replays the assigned backups through the gateway, the agent must not keep their copies.
+			restore = false
+			log.Infof("%s is assigned %d source backups to replay, starting with the empty index", cfg.AgentSidecar.Filename, len(filenames))
+		case len(filenames) > 1:
+			return nil, errors.ErrCloneReplayRequired(cfg.AgentSidecar.Filename, len(filenames))
+		case len(filenames) == 1:
+			filename = filenames[0]
+			log.Infof("cloning the backup of %s into %s", filename, cfg.AgentSidecar.Filename)
+		default:
+			restore = false
+			log.Infof("%s has no source agent to clone, starting with the empty index", cfg.AgentSidecar.Filename)
+		}
+		version = cfg.AgentSidecar.Clone.Version
+	}
+
 	bs, err = storage.New(
 		storage.WithErrGroup(eg),
 		storage.WithType(cfg.AgentSidecar.BlobStorage.StorageType),
 		storage.WithBucketName(cfg.AgentSidecar.BlobStorage.Bucket),
-		storage.WithFilename(cfg.AgentSidecar.Filename),
+		storage.WithFilename(filename),
 		storage.WithFilenameSuffix(cfg.AgentSidecar.FilenameSuffix),
 		storage.WithS3SessionOpts(
 			session.WithEndpoint(cfg.AgentSidecar.BlobStorage.S3.Endpoint),
@@ -130,6 +161,7 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		storage.WithS3Opts(
 			s3.WithMaxPartSize(cfg.AgentSidecar.BlobStorage.S3.MaxPartSize),
 			s3.WithMaxChunkSize(cfg.AgentSidecar.BlobStorage.S3.MaxChunkSize),
+			s3.WithDownloadConcurrency(cfg.AgentSidecar.BlobStorage.S3.DownloadConcurrency),
 			s3.WithReaderBackoff(cfg.AgentSidecar.RestoreBackoffEnabled),
 			s3.WithReaderBackoffOpts(cfg.AgentSidecar.RestoreBackoff.Opts()...),
 		),
@@ -147,22 +179,40 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 			cloudstorage.WithWriteContentLanguage(cfg.AgentSidecar.BlobStorage.CloudStorage.WriteContentLanguage),
 			cloudstorage.WithWriteContentType(cfg.AgentSidecar.BlobStorage.CloudStorage.WriteContentType),
 		),
+		storage.WithLocalOpts(
+			local.WithDir(cfg.AgentSidecar.BlobStorage.Local.Path),
+		),
 		storage.WithCompressAlgorithm(cfg.AgentSidecar.Compress.CompressAlgorithm),
 		storage.WithCompressionLevel(cfg.AgentSidecar.Compress.CompressionLevel),
+		storage.WithVersioning(cfg.AgentSidecar.VersioningEnabled),
+		storage.WithEncryption(
+			cfg.AgentSidecar.Encryption.Enabled,
+			cfg.AgentSidecar.Encryption.KeyID,
+		),
+		storage.WithEncryptionKeys(cfg.AgentSidecar.Encryption.Keys...),
+		storage.WithAllowUnencrypted(cfg.AgentSidecar.Encryption.AllowUnencrypted),
+		storage.WithRetention(
+			cfg.AgentSidecar.Retention.KeepLast,
+			cfg.AgentSidecar.Retention.KeepDailyDays,
+		),
+		storage.WithUncommittedGracePeriod(cfg.AgentSidecar.Retention.UncommittedGracePeriod),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	rs, err = restorer.New(
-		restorer.WithErrGroup(eg),
-		restorer.WithDir(cfg.AgentSidecar.WatchDir),
-		restorer.WithBlobStorage(bs),
-		restorer.WithBackoff(cfg.AgentSidecar.RestoreBackoffEnabled),
-		restorer.WithBackoffOpts(cfg.AgentSidecar.RestoreBackoff.Opts()...),
-	)
-	if err != nil {
-		return nil, err
+	if restore {
+		rs, err = restorer.New(
+			restorer.WithErrGroup(eg),
+			restorer.WithDir(cfg.AgentSidecar.WatchDir),
+			restorer.WithBlobStorage(bs),
+			restorer.WithBackoff(cfg.AgentSidecar.RestoreBackoffEnabled),
+			restorer.WithBackoffOpts(cfg.AgentSidecar.RestoreBackoff.Opts()...),
+			restorer.WithVersion(version),
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	g := handler.New()
@@ -239,6 +289,13 @@ func (r *run) Start(ctx context.Context) (<-chan error, error) {
 			close(ech)
 			return nil, err
 		}
+	} else {
+		// nothing to restore, finish the initcontainer in the same way as the restorer.
+		err = terminate()
+		if err != nil {
+			close(ech)
+			return nil, err
+		}
 	}
 	sech = r.server.ListenAndServe(ctx)
 	r.eg.Go(safety.RecoverFunc(func() (err error) {
@@ -283,3 +340,13 @@ func (r *run) Stop(ctx context.Context) error {
 func (r *run) PostStop(ctx context.Context) error {
 	return nil
 }
+
+// terminate sends SIGTERM to the process to finish the initcontainer.
+func terminate() error {
+	// TODO: related to #403.
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		return err
+	}
+	return p.Signal(syscall.SIGTERM)
+}
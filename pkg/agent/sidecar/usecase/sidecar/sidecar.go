@@ -20,9 +20,11 @@ import (
 	"context"
 
 	"github.com/vdaas/vald/apis/grpc/v1/agent/sidecar"
+	"github.com/vdaas/vald/internal/client/v1/client/vald"
 	iconf "github.com/vdaas/vald/internal/config"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage/urlopener"
+	"github.com/vdaas/vald/internal/db/storage/blob/local"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/session"
 	"github.com/vdaas/vald/internal/errgroup"
@@ -42,6 +44,8 @@ import (
 	"github.com/vdaas/vald/pkg/agent/sidecar/handler/rest"
 	"github.com/vdaas/vald/pkg/agent/sidecar/router"
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/observer"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/replayer"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/restorer"
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
 )
 
@@ -51,6 +55,7 @@ type run struct {
 	server        starter.Server
 	observability observability.Observability
 	so            observer.StorageObserver
+	rp            replayer.Replayer
 }
 
 func New(cfg *config.Data) (r runner.Runner, err error) {
@@ -92,11 +97,11 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		return nil, err
 	}
 
-	bs, err = storage.New(
+	// the options except the filename are shared with the storages of the source backups to replay.
+	storageOpts := []storage.Option{
 		storage.WithErrGroup(eg),
 		storage.WithType(cfg.AgentSidecar.BlobStorage.StorageType),
 		storage.WithBucketName(cfg.AgentSidecar.BlobStorage.Bucket),
-		storage.WithFilename(cfg.AgentSidecar.Filename),
 		storage.WithFilenameSuffix(cfg.AgentSidecar.FilenameSuffix),
 		storage.WithS3SessionOpts(
 			session.WithEndpoint(cfg.AgentSidecar.BlobStorage.S3.Endpoint),
@@ -120,6 +125,7 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		storage.WithS3Opts(
 			s3.WithMaxPartSize(cfg.AgentSidecar.BlobStorage.S3.MaxPartSize),
 			s3.WithMaxChunkSize(cfg.AgentSidecar.BlobStorage.S3.MaxChunkSize),
+			s3.WithDownloadConcurrency(cfg.AgentSidecar.BlobStorage.S3.DownloadConcurrency),
 			s3.WithReaderBackoff(cfg.AgentSidecar.RestoreBackoffEnabled),
 			s3.WithReaderBackoffOpts(cfg.AgentSidecar.RestoreBackoff.Opts()...),
 		),
@@ -137,21 +143,74 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 			cloudstorage.WithWriteContentLanguage(cfg.AgentSidecar.BlobStorage.CloudStorage.WriteContentLanguage),
 			cloudstorage.WithWriteContentType(cfg.AgentSidecar.BlobStorage.CloudStorage.WriteContentType),
 		),
+		storage.WithLocalOpts(
+			local.WithDir(cfg.AgentSidecar.BlobStorage.Local.Path),
+		),
 		storage.WithCompressAlgorithm(cfg.AgentSidecar.Compress.CompressAlgorithm),
 		storage.WithCompressionLevel(cfg.AgentSidecar.Compress.CompressionLevel),
-	)
+		storage.WithVersioning(cfg.AgentSidecar.VersioningEnabled),
+		storage.WithEncryption(
+			cfg.AgentSidecar.Encryption.Enabled,
+			cfg.AgentSidecar.Encryption.KeyID,
+		),
+		storage.WithEncryptionKeys(cfg.AgentSidecar.Encryption.Keys...),
+		storage.WithAllowUnencrypted(cfg.AgentSidecar.Encryption.AllowUnencrypted),
+		storage.WithRetention(
+			cfg.AgentSidecar.Retention.KeepLast,
+			cfg.AgentSidecar.Retention.KeepDailyDays,
+		),
+		storage.WithUncommittedGracePeriod(cfg.AgentSidecar.Retention.UncommittedGracePeriod),
+	}
+
+	bs, err = storage.New(append([]storage.Option{
+		storage.WithFilename(cfg.AgentSidecar.Filename),
+	}, storageOpts...)...)
 	if err != nil {
 		return nil, err
 	}
 
+	// the restorer restores the backup on demand into the directory of the agent while the agent is stopped.
+	// it is not configured without the liveness endpoint, because the directory of the running agent must not be replaced.
+	var rs restorer.Restorer
+	if len(cfg.AgentSidecar.AgentLivenessURL) != 0 {
+		rs, err = restorer.New(
+			restorer.WithErrGroup(eg),
+			restorer.WithDir(cfg.AgentSidecar.WatchDir),
+			restorer.WithBlobStorage(bs),
+			restorer.WithOverwrite(true),
+			restorer.WithAgentLivenessURL(cfg.AgentSidecar.AgentLivenessURL),
+			restorer.WithHTTPClient(client),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	observerOpts := []observer.Option{
 		observer.WithErrGroup(eg),
 		observer.WithWatch(cfg.AgentSidecar.WatchEnabled),
 		observer.WithTicker(cfg.AgentSidecar.AutoBackupEnabled),
 		observer.WithBackupDuration(cfg.AgentSidecar.AutoBackupDuration),
+		observer.WithCoordinatedBackup(cfg.AgentSidecar.CoordinatedBackupEnabled),
 		observer.WithPostStopTimeout(cfg.AgentSidecar.PostStopTimeout),
 		observer.WithDir(cfg.AgentSidecar.WatchDir),
 		observer.WithBlobStorage(bs),
+		observer.WithIncremental(cfg.AgentSidecar.Incremental.Enabled),
+		observer.WithChunkSize(cfg.AgentSidecar.Incremental.ChunkSize),
+		observer.WithRestorer(rs),
+		observer.WithReadRate(cfg.AgentSidecar.Throttle.ReadRate),
+		observer.WithUploadRate(cfg.AgentSidecar.Throttle.UploadRate),
+		observer.WithIOPriority(
+			cfg.AgentSidecar.Throttle.IOPriorityClass,
+			cfg.AgentSidecar.Throttle.IOPriorityLevel,
+		),
+		observer.WithAdaptiveThrottle(cfg.AgentSidecar.Throttle.Adaptive.Enabled),
+		observer.WithThrottleMetricsURL(cfg.AgentSidecar.Throttle.Adaptive.MetricsURL),
+		observer.WithThrottleLatencyMetric(cfg.AgentSidecar.Throttle.Adaptive.LatencyMetric),
+		observer.WithThrottleLatencyThreshold(cfg.AgentSidecar.Throttle.Adaptive.LatencyThreshold),
+		observer.WithThrottleDuration(cfg.AgentSidecar.Throttle.Adaptive.CheckDuration),
+		observer.WithThrottledRate(cfg.AgentSidecar.Throttle.Adaptive.ThrottledRate),
+		observer.WithHTTPClient(client),
 	}
 
 	var metricsHook metrics.MetricsHook
@@ -172,7 +231,18 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		return nil, err
 	}
 
-	g := handler.New(handler.WithStorageObserver(so))
+	var rp replayer.Replayer
+	if cfg.AgentSidecar.Clone.Enabled && cfg.AgentSidecar.Clone.Replay.Enabled {
+		rp, err = newReplayer(eg, cfg.AgentSidecar, storageOpts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	g := handler.New(
+		handler.WithStorageObserver(so),
+		handler.WithBlobStorage(bs),
+	)
 
 	grpcServerOptions := []server.Option{
 		server.WithGRPCRegistFunc(func(srv *grpc.Server) {
@@ -232,9 +302,57 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		server:        srv,
 		observability: obs,
 		so:            so,
+		rp:            rp,
 	}, nil
 }
 
+// newReplayer returns the replayer to replay the vectors of the source backups assigned to the agent through the gateway of the target cluster.
+func newReplayer(eg errgroup.Group, cfg *iconf.AgentSidecar, storageOpts []storage.Option) (replayer.Replayer, error) {
+	filenames, err := storage.CloneFilenames(
+		cfg.Filename,
+		cfg.Clone.SourceFilename,
+		cfg.Clone.SourceReplicas,
+		cfg.Clone.TargetReplicas,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	gcopts, err := cfg.Clone.Replay.GatewayClient.Opts()
+	if err != nil {
+		return nil, err
+	}
+	gc, err := vald.New(
+		vald.WithAddrs(cfg.Clone.Replay.GatewayClient.Addrs...),
+		vald.WithClient(grpc.New(gcopts...)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []replayer.Option{
+		replayer.WithErrGroup(eg),
+		replayer.WithGatewayClient(gc),
+		replayer.WithVersion(cfg.Clone.Version),
+		replayer.WithWorkDir(cfg.Clone.Replay.WorkDir),
+		replayer.WithBatchSize(cfg.Clone.Replay.BatchSize),
+		replayer.WithCheckDuration(cfg.Clone.Replay.CheckDuration),
+		replayer.WithCheckpointPath(cfg.Clone.Replay.CheckpointPath),
+	}
+	for _, filename := range filenames {
+		st, err := storage.New(append([]storage.Option{
+			storage.WithFilename(filename),
+		}, storageOpts...)...)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, replayer.WithSource(filename, st))
+	}
+	log.Infof("%s is assigned %d source backups to replay", cfg.Filename, len(filenames))
+
+	return replayer.New(opts...)
+}
+
 func (r *run) PreStart(ctx context.Context) error {
 	if r.observability != nil {
 		return r.observability.PreStart(ctx)
@@ -244,7 +362,7 @@ func (r *run) PreStart(ctx context.Context) error {
 
 func (r *run) Start(ctx context.Context) (<-chan error, error) {
 	ech := make(chan error, 5)
-	var soech, sech, oech <-chan error
+	var soech, rpech, sech, oech <-chan error
 	var err error
 	if r.observability != nil {
 		oech = r.observability.Start(ctx)
@@ -256,6 +374,13 @@ func (r *run) Start(ctx context.Context) (<-chan error, error) {
 			return nil, err
 		}
 	}
+	if r.rp != nil {
+		rpech, err = r.rp.Start(ctx)
+		if err != nil {
+			close(ech)
+			return nil, err
+		}
+	}
 	sech = r.server.ListenAndServe(ctx)
 	r.eg.Go(safety.RecoverFunc(func() (err error) {
 		defer close(ech)
@@ -265,6 +390,7 @@ func (r *run) Start(ctx context.Context) (<-chan error, error) {
 				return ctx.Err()
 			case err = <-oech:
 			case err = <-soech:
+			case err = <-rpech:
 			case err = <-sech:
 			}
 			if err != nil {
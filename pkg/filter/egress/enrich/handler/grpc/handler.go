@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package grpc provides grpc server logic
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vdaas/vald/apis/grpc/v1/filter/egress"
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/pkg/filter/egress/enrich/service"
+)
+
+type Server egress.FilterServer
+
+type server struct {
+	egress.UnimplementedFilterServer
+	enricher service.Enricher
+}
+
+func New(opts ...Option) Server {
+	s := new(server)
+
+	for _, opt := range append(defaultOptions, opts...) {
+		opt(s)
+	}
+	return s
+}
+
+// FilterDistance attaches the document to the result.
+// When drop_missing is enabled and the document is not found, it returns NotFound so that the filter gateway drops the result.
+func (s *server) FilterDistance(ctx context.Context, req *payload.Object_Distance) (*payload.Object_Distance, error) {
+	ctx, span := trace.StartSpan(ctx, "vald/filter-egress-enrich/FilterDistance")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	results, err := s.enricher.Enrich(ctx, []*payload.Object_Distance{req})
+	if err != nil {
+		if span != nil {
+			span.SetStatus(trace.StatusCodeUnavailable(err.Error()))
+		}
+		return nil, status.WrapWithUnavailable(fmt.Sprintf("FilterDistance API id %s's document could not be fetched", req.GetId()), err, info.Get())
+	}
+	if len(results) == 0 {
+		err = errors.ErrObjectIDNotFound(req.GetId())
+		if span != nil {
+			span.SetStatus(trace.StatusCodeNotFound(err.Error()))
+		}
+		return nil, status.WrapWithNotFound(fmt.Sprintf("FilterDistance API id %s's document is not found", req.GetId()), err, info.Get())
+	}
+	return req, nil
+}
+
+// FilterSearchResponse attaches the documents to the search results and drops the results whose document is not found when drop_missing is enabled.
+func (s *server) FilterSearchResponse(ctx context.Context, req *payload.Filter_SearchResponseRequest) (*payload.Search_Response, error) {
+	ctx, span := trace.StartSpan(ctx, "vald/filter-egress-enrich/FilterSearchResponse")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	res := req.GetResponse()
+	results, err := s.enricher.Enrich(ctx, res.GetResults())
+	if err != nil {
+		if span != nil {
+			span.SetStatus(trace.StatusCodeUnavailable(err.Error()))
+		}
+		return nil, status.WrapWithUnavailable(fmt.Sprintf("FilterSearchResponse API request id %s's documents could not be fetched", res.GetRequestId()), err, info.Get())
+	}
+	return &payload.Search_Response{
+		RequestId: res.GetRequestId(),
+		Results:   results,
+	}, nil
+}
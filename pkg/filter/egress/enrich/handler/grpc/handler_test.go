@@ -0,0 +1,263 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package grpc provides grpc server logic
+package grpc
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/filter/egress/enrich/service"
+)
+
+func TestMain(m *testing.M) {
+	info.Init("")
+	os.Exit(m.Run())
+}
+
+type enricherMock struct {
+	service.Enricher
+	EnrichFunc func(context.Context, []*payload.Object_Distance) ([]*payload.Object_Distance, error)
+}
+
+func (m *enricherMock) Enrich(ctx context.Context, results []*payload.Object_Distance) ([]*payload.Object_Distance, error) {
+	return m.EnrichFunc(ctx, results)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	e := new(enricherMock)
+	got := New(WithEnricher(e))
+	s, ok := got.(*server)
+	if !ok {
+		t.Fatalf("got: %T, want: *server", got)
+	}
+	if s.enricher != e {
+		t.Errorf("got: %#v, want: %#v", s.enricher, e)
+	}
+}
+
+func Test_server_FilterDistance(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		req *payload.Object_Distance
+	}
+	type want struct {
+		want *payload.Object_Distance
+		code codes.Code
+		err  bool
+	}
+	type test struct {
+		name     string
+		enricher service.Enricher
+		args     args
+		want     want
+	}
+	tests := []test{
+		{
+			name: "returns the distance with the document",
+			enricher: &enricherMock{
+				EnrichFunc: func(_ context.Context, results []*payload.Object_Distance) ([]*payload.Object_Distance, error) {
+					results[0].Document = []byte("doc-1")
+					return results, nil
+				},
+			},
+			args: args{
+				req: &payload.Object_Distance{
+					Id:       "uuid-1",
+					Distance: 0.1,
+				},
+			},
+			want: want{
+				want: &payload.Object_Distance{
+					Id:       "uuid-1",
+					Distance: 0.1,
+					Document: []byte("doc-1"),
+				},
+			},
+		},
+		{
+			name: "returns the distance as it is when the document is not found and the result is kept",
+			enricher: &enricherMock{
+				EnrichFunc: func(_ context.Context, results []*payload.Object_Distance) ([]*payload.Object_Distance, error) {
+					return results, nil
+				},
+			},
+			args: args{
+				req: &payload.Object_Distance{
+					Id:       "uuid-1",
+					Distance: 0.1,
+				},
+			},
+			want: want{
+				want: &payload.Object_Distance{
+					Id:       "uuid-1",
+					Distance: 0.1,
+				},
+			},
+		},
+		{
+			name: "returns NotFound error when the document is not found and the result is dropped",
+			enricher: &enricherMock{
+				EnrichFunc: func(_ context.Context, results []*payload.Object_Distance) ([]*payload.Object_Distance, error) {
+					return results[:0], nil
+				},
+			},
+			args: args{
+				req: &payload.Object_Distance{
+					Id:       "uuid-1",
+					Distance: 0.1,
+				},
+			},
+			want: want{
+				code: codes.NotFound,
+				err:  true,
+			},
+		},
+		{
+			name: "returns error when the document could not be fetched",
+			enricher: &enricherMock{
+				EnrichFunc: func(_ context.Context, results []*payload.Object_Distance) ([]*payload.Object_Distance, error) {
+					return nil, errors.ErrDocumentStoreNotOpened
+				},
+			},
+			args: args{
+				req: &payload.Object_Distance{
+					Id: "uuid-1",
+				},
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := &server{
+				enricher: test.enricher,
+			}
+			got, err := s.FilterDistance(context.Background(), test.args.req)
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v, want error: %v", err, test.want.err)
+			}
+			if st, ok := status.FromError(err); test.want.code != codes.OK && (!ok || st.Code() != test.want.code) {
+				tt.Errorf("got_error: %v, want code: %s", err, test.want.code)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+func Test_server_FilterSearchResponse(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		req *payload.Filter_SearchResponseRequest
+	}
+	type want struct {
+		want *payload.Search_Response
+		err  bool
+	}
+	type test struct {
+		name     string
+		enricher service.Enricher
+		args     args
+		want     want
+	}
+	tests := []test{
+		{
+			name: "returns the enriched results with the request id",
+			enricher: &enricherMock{
+				EnrichFunc: func(_ context.Context, results []*payload.Object_Distance) ([]*payload.Object_Distance, error) {
+					results[0].Document = []byte("doc-1")
+					return results[:1], nil
+				},
+			},
+			args: args{
+				req: &payload.Filter_SearchResponseRequest{
+					Response: &payload.Search_Response{
+						RequestId: "req-1",
+						Results: []*payload.Object_Distance{
+							{Id: "uuid-1", Distance: 0.1},
+							{Id: "uuid-2", Distance: 0.2},
+						},
+					},
+				},
+			},
+			want: want{
+				want: &payload.Search_Response{
+					RequestId: "req-1",
+					Results: []*payload.Object_Distance{
+						{Id: "uuid-1", Distance: 0.1, Document: []byte("doc-1")},
+					},
+				},
+			},
+		},
+		{
+			name: "returns error when the documents could not be fetched",
+			enricher: &enricherMock{
+				EnrichFunc: func(_ context.Context, results []*payload.Object_Distance) ([]*payload.Object_Distance, error) {
+					return nil, errors.ErrDocumentStoreNotOpened
+				},
+			},
+			args: args{
+				req: &payload.Filter_SearchResponseRequest{
+					Response: &payload.Search_Response{
+						RequestId: "req-1",
+						Results: []*payload.Object_Distance{
+							{Id: "uuid-1", Distance: 0.1},
+						},
+					},
+				},
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := &server{
+				enricher: test.enricher,
+			}
+			got, err := s.FilterSearchResponse(context.Background(), test.args.req)
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v, want error: %v", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
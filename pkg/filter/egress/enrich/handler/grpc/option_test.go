@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package grpc provides grpc server logic
+package grpc
+
+import (
+	"testing"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/filter/egress/enrich/service"
+)
+
+func TestWithEnricher(t *testing.T) {
+	type test struct {
+		name     string
+		enricher service.Enricher
+	}
+	tests := []test{
+		{
+			name:     "set enricher",
+			enricher: new(enricherMock),
+		},
+		{
+			name: "set nil enricher",
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := new(server)
+			WithEnricher(test.enricher)(s)
+			if s.enricher != test.enricher {
+				tt.Errorf("got: %#v, want: %#v", s.enricher, test.enricher)
+			}
+		})
+	}
+}
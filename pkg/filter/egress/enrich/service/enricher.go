@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the document lookups of the enrich egress filter
+package service
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/observability/trace"
+)
+
+// Enricher represents the interface to attach the stored documents to the search results.
+type Enricher interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+	Enrich(ctx context.Context, results []*payload.Object_Distance) ([]*payload.Object_Distance, error)
+}
+
+type enricher struct {
+	store       Store
+	dropMissing bool
+}
+
+// New returns the Enricher which looks up the documents from the store.
+func New(opts ...Option) (Enricher, error) {
+	e := new(enricher)
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(e); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	if e.store == nil {
+		return nil, errors.NewErrInvalidOption("store", e.store)
+	}
+	return e, nil
+}
+
+// Start opens the connection of the store.
+func (e *enricher) Start(ctx context.Context) error {
+	return e.store.Open(ctx)
+}
+
+// Stop closes the connection of the store.
+func (e *enricher) Stop(ctx context.Context) error {
+	return e.store.Close(ctx)
+}
+
+// Enrich looks up the documents of the results in a request to the store and attaches them to the results in place.
+// The results whose document is not found are dropped when dropMissing is enabled, otherwise they are returned as they are.
+func (e *enricher) Enrich(ctx context.Context, results []*payload.Object_Distance) ([]*payload.Object_Distance, error) {
+	ctx, span := trace.StartSpan(ctx, "vald/filter-egress-enrich/service/Enricher.Enrich")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	if len(results) == 0 {
+		return results, nil
+	}
+	uuids := make([]string, 0, len(results))
+	seen := make(map[string]struct{}, len(results))
+	for _, r := range results {
+		if _, ok := seen[r.GetId()]; !ok {
+			seen[r.GetId()] = struct{}{}
+			uuids = append(uuids, r.GetId())
+		}
+	}
+	records, err := e.store.Get(ctx, uuids...)
+	if err != nil {
+		return nil, err
+	}
+
+	enriched := results[:0]
+	if !e.dropMissing {
+		enriched = results
+	}
+	for _, r := range results {
+		rec, ok := records[r.GetId()]
+		if ok {
+			r.Document = rec.Document
+			r.Attributes = rec.Attributes
+		}
+		if e.dropMissing && ok {
+			enriched = append(enriched, r)
+		}
+	}
+	return enriched, nil
+}
@@ -0,0 +1,211 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the document lookups of the enrich egress filter
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+type storeMock struct {
+	OpenFunc  func(ctx context.Context) error
+	CloseFunc func(ctx context.Context) error
+	GetFunc   func(ctx context.Context, uuids ...string) (map[string]*Record, error)
+}
+
+func (s *storeMock) Open(ctx context.Context) error {
+	return s.OpenFunc(ctx)
+}
+
+func (s *storeMock) Close(ctx context.Context) error {
+	return s.CloseFunc(ctx)
+}
+
+func (s *storeMock) Get(ctx context.Context, uuids ...string) (map[string]*Record, error) {
+	return s.GetFunc(ctx, uuids...)
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr bool
+	}{
+		{
+			name: "return the enricher when the store is set",
+			opts: []Option{
+				WithStore(new(storeMock)),
+				WithDropMissing(true),
+			},
+		},
+		{
+			name:    "return error when the store is not set",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			got, err := New(test.opts...)
+			if (err != nil) != test.wantErr {
+				tt.Errorf("got_error: \"%#v\", wantErr: %v", err, test.wantErr)
+			}
+			if (got == nil) != test.wantErr {
+				tt.Errorf("got: \"%#v\", wantErr: %v", got, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_enricher_Enrich(t *testing.T) {
+	type args struct {
+		results []*payload.Object_Distance
+	}
+	type fields struct {
+		store       Store
+		dropMissing bool
+	}
+	type want struct {
+		want []*payload.Object_Distance
+		err  error
+	}
+	type test struct {
+		name      string
+		args      args
+		fields    fields
+		want      want
+		checkFunc func(want, []*payload.Object_Distance, error) error
+	}
+	defaultCheckFunc := func(w want, got []*payload.Object_Distance, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	attrs := []*payload.Object_Attribute{
+		{Key: "lang", Value: "en"},
+	}
+	store := &storeMock{
+		GetFunc: func(ctx context.Context, uuids ...string) (map[string]*Record, error) {
+			if !reflect.DeepEqual(uuids, []string{"uuid-1", "uuid-2", "uuid-3"}) {
+				return nil, errors.Errorf("unexpected uuids: %v", uuids)
+			}
+			return map[string]*Record{
+				"uuid-1": {Document: []byte("doc-1"), Attributes: attrs},
+				"uuid-3": {Document: []byte("doc-3")},
+			}, nil
+		},
+	}
+	results := func() []*payload.Object_Distance {
+		return []*payload.Object_Distance{
+			{Id: "uuid-1", Distance: 0.1},
+			{Id: "uuid-2", Distance: 0.2},
+			{Id: "uuid-3", Distance: 0.3},
+			{Id: "uuid-1", Distance: 0.4},
+		}
+	}
+	tests := []test{
+		{
+			name: "attach the documents and keep the missing results",
+			args: args{
+				results: results(),
+			},
+			fields: fields{
+				store: store,
+			},
+			want: want{
+				want: []*payload.Object_Distance{
+					{Id: "uuid-1", Distance: 0.1, Document: []byte("doc-1"), Attributes: attrs},
+					{Id: "uuid-2", Distance: 0.2},
+					{Id: "uuid-3", Distance: 0.3, Document: []byte("doc-3")},
+					{Id: "uuid-1", Distance: 0.4, Document: []byte("doc-1"), Attributes: attrs},
+				},
+			},
+		},
+		{
+			name: "attach the documents and drop the missing results",
+			args: args{
+				results: results(),
+			},
+			fields: fields{
+				store:       store,
+				dropMissing: true,
+			},
+			want: want{
+				want: []*payload.Object_Distance{
+					{Id: "uuid-1", Distance: 0.1, Document: []byte("doc-1"), Attributes: attrs},
+					{Id: "uuid-3", Distance: 0.3, Document: []byte("doc-3")},
+					{Id: "uuid-1", Distance: 0.4, Document: []byte("doc-1"), Attributes: attrs},
+				},
+			},
+		},
+		{
+			name: "return the results as they are when the results are empty",
+			args: args{
+				results: []*payload.Object_Distance{},
+			},
+			fields: fields{
+				store: store,
+			},
+			want: want{
+				want: []*payload.Object_Distance{},
+			},
+		},
+		{
+			name: "return error when the store fails",
+			args: args{
+				results: results(),
+			},
+			fields: fields{
+				store: &storeMock{
+					GetFunc: func(ctx context.Context, uuids ...string) (map[string]*Record, error) {
+						return nil, errors.ErrDocumentStoreNotOpened
+					},
+				},
+			},
+			want: want{
+				err: errors.ErrDocumentStoreNotOpened,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			e := &enricher{
+				store:       test.fields.store,
+				dropMissing: test.fields.dropMissing,
+			}
+
+			got, err := e.Enrich(context.Background(), test.args.results)
+			if err := test.checkFunc(test.want, got, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the document lookups of the enrich egress filter
+package service
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/internal/db/rdb/mysql"
+)
+
+type mysqlStore struct {
+	db mysql.MySQL
+}
+
+// NewMySQLStore returns the Store which reads the document and the attributes of the vector ID from the document table.
+func NewMySQLStore(db mysql.MySQL) Store {
+	return &mysqlStore{
+		db: db,
+	}
+}
+
+func (m *mysqlStore) Open(ctx context.Context) error {
+	return m.db.Open(ctx)
+}
+
+func (m *mysqlStore) Close(ctx context.Context) error {
+	return m.db.Close(ctx)
+}
+
+func (m *mysqlStore) Get(ctx context.Context, uuids ...string) (map[string]*Record, error) {
+	docs, err := m.db.GetDocuments(ctx, uuids...)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]*Record, len(docs))
+	for _, doc := range docs {
+		attrs, err := parseAttributes(doc.GetUUID(), doc.GetAttributes())
+		if err != nil {
+			return nil, err
+		}
+		res[doc.GetUUID()] = &Record{
+			Document:   doc.GetDocument(),
+			Attributes: attrs,
+		}
+	}
+	return res, nil
+}
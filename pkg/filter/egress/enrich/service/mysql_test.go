@@ -0,0 +1,174 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the document lookups of the enrich egress filter
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/db/rdb/mysql"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+type mysqlMock struct {
+	mysql.MySQL
+	GetDocumentsFunc func(ctx context.Context, uuids ...string) ([]mysql.Document, error)
+}
+
+func (m *mysqlMock) GetDocuments(ctx context.Context, uuids ...string) ([]mysql.Document, error) {
+	return m.GetDocumentsFunc(ctx, uuids...)
+}
+
+type documentMock struct {
+	uuid       string
+	document   []byte
+	attributes []byte
+}
+
+func (d *documentMock) GetUUID() string {
+	return d.uuid
+}
+
+func (d *documentMock) GetDocument() []byte {
+	return d.document
+}
+
+func (d *documentMock) GetAttributes() []byte {
+	return d.attributes
+}
+
+func Test_mysqlStore_Get(t *testing.T) {
+	type args struct {
+		uuids []string
+	}
+	type fields struct {
+		db mysql.MySQL
+	}
+	type want struct {
+		want map[string]*Record
+		err  error
+	}
+	type test struct {
+		name      string
+		args      args
+		fields    fields
+		want      want
+		checkFunc func(want, map[string]*Record, error) error
+	}
+	defaultCheckFunc := func(w want, got map[string]*Record, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "return the documents and the attributes of the found rows",
+			args: args{
+				uuids: []string{"uuid-1", "uuid-2"},
+			},
+			fields: fields{
+				db: &mysqlMock{
+					GetDocumentsFunc: func(ctx context.Context, uuids ...string) ([]mysql.Document, error) {
+						return []mysql.Document{
+							&documentMock{
+								uuid:       "uuid-1",
+								document:   []byte("doc-1"),
+								attributes: []byte(`{"lang":"en","year":2021}`),
+							},
+						}, nil
+					},
+				},
+			},
+			want: want{
+				want: map[string]*Record{
+					"uuid-1": {
+						Document: []byte("doc-1"),
+						Attributes: []*payload.Object_Attribute{
+							{Key: "lang", Value: "en"},
+							{Key: "year", Value: "2021"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "return error when GetDocuments fails",
+			args: args{
+				uuids: []string{"uuid-1"},
+			},
+			fields: fields{
+				db: &mysqlMock{
+					GetDocumentsFunc: func(ctx context.Context, uuids ...string) ([]mysql.Document, error) {
+						return nil, errors.ErrMySQLConnectionClosed
+					},
+				},
+			},
+			want: want{
+				err: errors.ErrMySQLConnectionClosed,
+			},
+		},
+		{
+			name: "return error when the attributes are invalid",
+			args: args{
+				uuids: []string{"uuid-1"},
+			},
+			fields: fields{
+				db: &mysqlMock{
+					GetDocumentsFunc: func(ctx context.Context, uuids ...string) ([]mysql.Document, error) {
+						return []mysql.Document{
+							&documentMock{
+								uuid:       "uuid-1",
+								attributes: []byte(`invalid`),
+							},
+						}, nil
+					},
+				},
+			},
+			checkFunc: func(w want, got map[string]*Record, err error) error {
+				if err == nil {
+					return errors.New("got_error: nil,\n\t\t\t\twant: invalid attributes error")
+				}
+				if got != nil {
+					return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: nil", got)
+				}
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			m := NewMySQLStore(test.fields.db)
+
+			got, err := m.Get(context.Background(), test.args.uuids...)
+			if err := test.checkFunc(test.want, got, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
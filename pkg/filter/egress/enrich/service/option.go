@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the document lookups of the enrich egress filter
+package service
+
+// Option represents the functional option for enricher.
+type Option func(e *enricher) error
+
+var defaultOptions = []Option{}
+
+// WithStore returns the option to set the store of the documents.
+func WithStore(s Store) Option {
+	return func(e *enricher) error {
+		if s != nil {
+			e.store = s
+		}
+		return nil
+	}
+}
+
+// WithDropMissing returns the option to drop the results whose document is not found.
+func WithDropMissing(drop bool) Option {
+	return func(e *enricher) error {
+		e.dropMissing = drop
+		return nil
+	}
+}
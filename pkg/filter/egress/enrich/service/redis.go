@@ -0,0 +1,80 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the document lookups of the enrich egress filter
+package service
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/internal/db/kvs/redis"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+type redisStore struct {
+	connector redis.Connector
+	db        redis.Redis
+	prefix    string
+}
+
+// NewRedisStore returns the Store which reads the document of the vector ID from the value of the key prefix + ID.
+func NewRedisStore(connector redis.Connector, prefix string) Store {
+	return &redisStore{
+		connector: connector,
+		prefix:    prefix,
+	}
+}
+
+func (r *redisStore) Open(ctx context.Context) (err error) {
+	r.db, err = r.connector.Connect(ctx)
+	return err
+}
+
+func (r *redisStore) Close(context.Context) error {
+	if r.db == nil {
+		return nil
+	}
+	return r.db.Close()
+}
+
+func (r *redisStore) Get(ctx context.Context, uuids ...string) (map[string]*Record, error) {
+	if r.db == nil {
+		return nil, errors.ErrDocumentStoreNotOpened
+	}
+	if len(uuids) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(uuids))
+	for _, uuid := range uuids {
+		keys = append(keys, r.prefix+uuid)
+	}
+	vals, err := r.db.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]*Record, len(vals))
+	for i, val := range vals {
+		if i >= len(uuids) {
+			break
+		}
+		if s, ok := val.(string); ok {
+			res[uuids[i]] = &Record{
+				Document: []byte(s),
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,169 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the document lookups of the enrich egress filter
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/vdaas/vald/internal/db/kvs/redis"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+type connectorFunc func(ctx context.Context) (redis.Redis, error)
+
+func (f connectorFunc) Connect(ctx context.Context) (redis.Redis, error) {
+	return f(ctx)
+}
+
+func Test_redisStore_Get(t *testing.T) {
+	type args struct {
+		uuids []string
+	}
+	type fields struct {
+		db     redis.Redis
+		prefix string
+	}
+	type want struct {
+		want map[string]*Record
+		err  error
+	}
+	type test struct {
+		name      string
+		args      args
+		fields    fields
+		want      want
+		checkFunc func(want, map[string]*Record, error) error
+	}
+	defaultCheckFunc := func(w want, got map[string]*Record, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "return the documents of the found keys",
+			args: args{
+				uuids: []string{"uuid-1", "uuid-2", "uuid-3"},
+			},
+			fields: fields{
+				prefix: "doc:",
+				db: &redis.MockRedis{
+					MGetFunc: func(keys ...string) *goredis.SliceCmd {
+						if !reflect.DeepEqual(keys, []string{"doc:uuid-1", "doc:uuid-2", "doc:uuid-3"}) {
+							return goredis.NewSliceResult(nil, errors.Errorf("unexpected keys: %v", keys))
+						}
+						return goredis.NewSliceResult([]interface{}{"doc-1", nil, "doc-3"}, nil)
+					},
+				},
+			},
+			want: want{
+				want: map[string]*Record{
+					"uuid-1": {Document: []byte("doc-1")},
+					"uuid-3": {Document: []byte("doc-3")},
+				},
+			},
+		},
+		{
+			name: "return error when MGet fails",
+			args: args{
+				uuids: []string{"uuid-1"},
+			},
+			fields: fields{
+				db: &redis.MockRedis{
+					MGetFunc: func(keys ...string) *goredis.SliceCmd {
+						return goredis.NewSliceResult(nil, errors.ErrRedisConnectionPingFailed)
+					},
+				},
+			},
+			want: want{
+				err: errors.ErrRedisConnectionPingFailed,
+			},
+		},
+		{
+			name: "return error when the store is not opened",
+			args: args{
+				uuids: []string{"uuid-1"},
+			},
+			want: want{
+				err: errors.ErrDocumentStoreNotOpened,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			r := &redisStore{
+				db:     test.fields.db,
+				prefix: test.fields.prefix,
+			}
+
+			got, err := r.Get(context.Background(), test.args.uuids...)
+			if err := test.checkFunc(test.want, got, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_redisStore_Open(t *testing.T) {
+	db := new(redis.MockRedis)
+	tests := []struct {
+		name      string
+		connector redis.Connector
+		wantDB    redis.Redis
+		want      error
+	}{
+		{
+			name: "set the connected client",
+			connector: connectorFunc(func(context.Context) (redis.Redis, error) {
+				return db, nil
+			}),
+			wantDB: db,
+		},
+		{
+			name: "return error when the connection fails",
+			connector: connectorFunc(func(context.Context) (redis.Redis, error) {
+				return nil, errors.ErrRedisConnectionPingFailed
+			}),
+			want: errors.ErrRedisConnectionPingFailed,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			r := NewRedisStore(test.connector, "").(*redisStore)
+			if err := r.Open(context.Background()); !errors.Is(err, test.want) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want)
+			}
+			if r.db != test.wantDB {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", r.db, test.wantDB)
+			}
+		})
+	}
+}
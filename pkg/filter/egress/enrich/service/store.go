@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the document lookups of the enrich egress filter
+package service
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"sort"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// Record represents the document and attributes attached to a vector ID.
+type Record struct {
+	Document   []byte
+	Attributes []*payload.Object_Attribute
+}
+
+// Store represents the interface to look up the records by vector ID.
+type Store interface {
+	Open(ctx context.Context) error
+	Close(ctx context.Context) error
+	// Get returns the records of the uuids. The uuids whose record is not found are not contained in the result.
+	Get(ctx context.Context, uuids ...string) (map[string]*Record, error)
+}
+
+// parseAttributes decodes the JSON object of the attributes in the order of the keys.
+// string values are used as it is, and the other values are kept in the JSON representation.
+func parseAttributes(uuid string, b []byte) ([]*payload.Object_Attribute, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var m map[string]stdjson.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, errors.ErrInvalidDocumentAttributes(uuid, err)
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]*payload.Object_Attribute, 0, len(keys))
+	for _, k := range keys {
+		val := string(m[k])
+		if len(val) != 0 && val[0] == '"' {
+			if err := json.Unmarshal(m[k], &val); err != nil {
+				return nil, errors.ErrInvalidDocumentAttributes(uuid, err)
+			}
+		}
+		attrs = append(attrs, &payload.Object_Attribute{
+			Key:   k,
+			Value: val,
+		})
+	}
+	return attrs, nil
+}
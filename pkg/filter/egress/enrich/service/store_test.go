@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the document lookups of the enrich egress filter
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func Test_parseAttributes(t *testing.T) {
+	type args struct {
+		uuid string
+		b    []byte
+	}
+	type want struct {
+		want []*payload.Object_Attribute
+		err  error
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, []*payload.Object_Attribute, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, got []*payload.Object_Attribute, err error) error {
+		if (w.err == nil) != (err == nil) || (err != nil && err.Error() != w.err.Error()) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "return nil when the attributes are empty",
+			args: args{
+				uuid: "uuid-1",
+			},
+		},
+		{
+			name: "return the attributes sorted by the key",
+			args: args{
+				uuid: "uuid-1",
+				b:    []byte(`{"title":"vald","tags":["a","b"],"score":1.5,"public":true}`),
+			},
+			want: want{
+				want: []*payload.Object_Attribute{
+					{Key: "public", Value: "true"},
+					{Key: "score", Value: "1.5"},
+					{Key: "tags", Value: `["a","b"]`},
+					{Key: "title", Value: "vald"},
+				},
+			},
+		},
+		{
+			name: "return error when the attributes are not a JSON object",
+			args: args{
+				uuid: "uuid-1",
+				b:    []byte(`["a"]`),
+			},
+			checkFunc: func(w want, got []*payload.Object_Attribute, err error) error {
+				if err == nil || !strings.HasPrefix(err.Error(), "invalid attributes of document uuid: uuid-1") {
+					return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: invalid attributes error", err)
+				}
+				if got != nil {
+					return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: nil", got)
+				}
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got, err := parseAttributes(test.args.uuid, test.args.b)
+			if err := test.checkFunc(test.want, got, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package grpc provides grpc server logic
+package grpc
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
+	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/filter/ingress/http/service"
+)
+
+func TestMain(m *testing.M) {
+	info.Init("")
+	os.Exit(m.Run())
+}
+
+type vectorizerMock struct {
+	service.Vectorizer
+	GenVectorFunc  func(context.Context, *payload.Object_Blob) ([]float32, error)
+	GenVectorsFunc func(context.Context, ...*payload.Object_Blob) ([][]float32, error)
+}
+
+func (m *vectorizerMock) GenVector(ctx context.Context, blob *payload.Object_Blob) ([]float32, error) {
+	return m.GenVectorFunc(ctx, blob)
+}
+
+func (m *vectorizerMock) GenVectors(ctx context.Context, blobs ...*payload.Object_Blob) ([][]float32, error) {
+	return m.GenVectorsFunc(ctx, blobs...)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	v := new(vectorizerMock)
+	got := New(WithVectorizer(v))
+	s, ok := got.(*server)
+	if !ok {
+		t.Fatalf("got: %T, want: *server", got)
+	}
+	if s.vectorizer != v {
+		t.Errorf("got: %#v, want: %#v", s.vectorizer, v)
+	}
+}
+
+func Test_server_GenVector(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		req *payload.Object_Blob
+	}
+	type fields struct {
+		vectorizer service.Vectorizer
+	}
+	type want struct {
+		wantVec *payload.Object_Vector
+		err     bool
+	}
+	type test struct {
+		name   string
+		args   args
+		fields fields
+		want   want
+	}
+	tests := []test{
+		{
+			name: "returns the vector of the object",
+			args: args{
+				req: &payload.Object_Blob{
+					Id:     "a",
+					Object: []byte("hello"),
+				},
+			},
+			fields: fields{
+				vectorizer: &vectorizerMock{
+					GenVectorFunc: func(_ context.Context, blob *payload.Object_Blob) ([]float32, error) {
+						return []float32{float32(len(blob.GetObject())), 0.5}, nil
+					},
+				},
+			},
+			want: want{
+				wantVec: &payload.Object_Vector{
+					Id:     "a",
+					Vector: []float32{5, 0.5},
+				},
+			},
+		},
+		{
+			name: "returns error when the object could not vectorize",
+			args: args{
+				req: &payload.Object_Blob{
+					Id: "a",
+				},
+			},
+			fields: fields{
+				vectorizer: &vectorizerMock{
+					GenVectorFunc: func(context.Context, *payload.Object_Blob) ([]float32, error) {
+						return nil, errors.ErrUnexpectedHTTPStatus(500, "")
+					},
+				},
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := &server{
+				vectorizer: test.fields.vectorizer,
+			}
+
+			gotVec, err := s.GenVector(context.Background(), test.args.req)
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v, want error: %v", err, test.want.err)
+			}
+			if !reflect.DeepEqual(gotVec, test.want.wantVec) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", gotVec, test.want.wantVec)
+			}
+		})
+	}
+}
+
+func Test_server_GenVectors(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		reqs *payload.Object_Blobs
+	}
+	type fields struct {
+		vectorizer service.Vectorizer
+	}
+	type want struct {
+		wantVecs *payload.Object_Vectors
+		err      bool
+	}
+	type test struct {
+		name   string
+		args   args
+		fields fields
+		want   want
+	}
+	tests := []test{
+		{
+			name: "returns vectors in the order of the objects",
+			args: args{
+				reqs: &payload.Object_Blobs{
+					Blobs: []*payload.Object_Blob{
+						{
+							Id:     "a",
+							Object: []byte("1"),
+						},
+						{
+							Id:     "b",
+							Object: []byte("22"),
+						},
+					},
+				},
+			},
+			fields: fields{
+				vectorizer: &vectorizerMock{
+					GenVectorsFunc: func(_ context.Context, blobs ...*payload.Object_Blob) ([][]float32, error) {
+						vecs := make([][]float32, 0, len(blobs))
+						for _, blob := range blobs {
+							vecs = append(vecs, []float32{float32(len(blob.GetObject()))})
+						}
+						return vecs, nil
+					},
+				},
+			},
+			want: want{
+				wantVecs: &payload.Object_Vectors{
+					Vectors: []*payload.Object_Vector{
+						{
+							Id:     "a",
+							Vector: []float32{1},
+						},
+						{
+							Id:     "b",
+							Vector: []float32{2},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "returns error when the objects could not vectorize",
+			args: args{
+				reqs: &payload.Object_Blobs{
+					Blobs: []*payload.Object_Blob{
+						{
+							Id: "a",
+						},
+					},
+				},
+			},
+			fields: fields{
+				vectorizer: &vectorizerMock{
+					GenVectorsFunc: func(context.Context, ...*payload.Object_Blob) ([][]float32, error) {
+						return nil, errors.ErrFilterResultLength(0, 1)
+					},
+				},
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := &server{
+				vectorizer: test.fields.vectorizer,
+			}
+
+			gotVecs, err := s.GenVectors(context.Background(), test.args.reqs)
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v, want error: %v", err, test.want.err)
+			}
+			if !reflect.DeepEqual(gotVecs, test.want.wantVecs) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", gotVecs, test.want.wantVecs)
+			}
+		})
+	}
+}
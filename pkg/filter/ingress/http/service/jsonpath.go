@@ -0,0 +1,192 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the requests to the HTTP inference server
+package service
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+type segmentKind uint8
+
+const (
+	keySegment segmentKind = iota
+	indexSegment
+	wildcardSegment
+)
+
+type segment struct {
+	kind  segmentKind
+	key   string
+	index int
+}
+
+// jsonPath is the minimum JSON path which supports $, .key, ['key'], [n] and [*].
+type jsonPath struct {
+	raw      string
+	segments []segment
+	wildcard bool
+}
+
+func parseJSONPath(path string) (*jsonPath, error) {
+	p := &jsonPath{
+		raw: path,
+	}
+	s := strings.TrimSpace(path)
+	i := 0
+	if strings.HasPrefix(s, "$") {
+		i++
+	}
+	for i < len(s) {
+		switch s[i] {
+		case '.':
+			i++
+			start := i
+			for i < len(s) && s[i] != '.' && s[i] != '[' {
+				i++
+			}
+			if start == i {
+				return nil, errors.ErrInvalidJSONPath(path, start)
+			}
+			key := s[start:i]
+			if key == "*" {
+				p.segments = append(p.segments, segment{kind: wildcardSegment})
+				p.wildcard = true
+				continue
+			}
+			p.segments = append(p.segments, segment{kind: keySegment, key: key})
+		case '[':
+			end := strings.IndexByte(s[i:], ']')
+			if end < 0 {
+				return nil, errors.ErrInvalidJSONPath(path, i)
+			}
+			in := strings.TrimSpace(s[i+1 : i+end])
+			switch {
+			case in == "*":
+				p.segments = append(p.segments, segment{kind: wildcardSegment})
+				p.wildcard = true
+			case len(in) >= 2 && (in[0] == '\'' || in[0] == '"') && in[len(in)-1] == in[0]:
+				p.segments = append(p.segments, segment{kind: keySegment, key: in[1 : len(in)-1]})
+			default:
+				n, err := strconv.Atoi(in)
+				if err != nil || n < 0 {
+					return nil, errors.ErrInvalidJSONPath(path, i+1)
+				}
+				p.segments = append(p.segments, segment{kind: indexSegment, index: n})
+			}
+			i += end + 1
+		default:
+			if i == 0 {
+				// allow the path without the leading $ and dot, e.g. data[0].embedding
+				s = "." + s
+				continue
+			}
+			return nil, errors.ErrInvalidJSONPath(path, i)
+		}
+	}
+	return p, nil
+}
+
+// find returns the value matched to the path.
+// when the path contains wildcards, the matched values are returned as a slice.
+// the wildcard is only applied to arrays to keep the order of the values.
+func (p *jsonPath) find(v interface{}) (interface{}, error) {
+	nodes := []interface{}{v}
+	for _, seg := range p.segments {
+		next := make([]interface{}, 0, len(nodes))
+		for _, node := range nodes {
+			switch seg.kind {
+			case keySegment:
+				m, ok := node.(map[string]interface{})
+				if !ok {
+					return nil, errors.ErrJSONPathNotFound(p.raw)
+				}
+				val, ok := m[seg.key]
+				if !ok {
+					return nil, errors.ErrJSONPathNotFound(p.raw)
+				}
+				next = append(next, val)
+			case indexSegment:
+				arr, ok := node.([]interface{})
+				if !ok || seg.index >= len(arr) {
+					return nil, errors.ErrJSONPathNotFound(p.raw)
+				}
+				next = append(next, arr[seg.index])
+			case wildcardSegment:
+				arr, ok := node.([]interface{})
+				if !ok {
+					return nil, errors.ErrJSONPathNotFound(p.raw)
+				}
+				next = append(next, arr...)
+			}
+		}
+		nodes = next
+	}
+	if p.wildcard {
+		return nodes, nil
+	}
+	return nodes[0], nil
+}
+
+// vector returns the number array matched to the path.
+func (p *jsonPath) vector(v interface{}) ([]float32, error) {
+	val, err := p.find(v)
+	if err != nil {
+		return nil, err
+	}
+	return toVector(p.raw, val)
+}
+
+// vectors returns the array of the number arrays matched to the path.
+func (p *jsonPath) vectors(v interface{}) ([][]float32, error) {
+	val, err := p.find(v)
+	if err != nil {
+		return nil, err
+	}
+	arr, ok := val.([]interface{})
+	if !ok {
+		return nil, errors.ErrJSONPathNotVector(p.raw)
+	}
+	vecs := make([][]float32, 0, len(arr))
+	for _, e := range arr {
+		vec, err := toVector(p.raw, e)
+		if err != nil {
+			return nil, err
+		}
+		vecs = append(vecs, vec)
+	}
+	return vecs, nil
+}
+
+func toVector(path string, val interface{}) ([]float32, error) {
+	arr, ok := val.([]interface{})
+	if !ok {
+		return nil, errors.ErrJSONPathNotVector(path)
+	}
+	vec := make([]float32, 0, len(arr))
+	for _, e := range arr {
+		f, ok := e.(float64)
+		if !ok {
+			return nil, errors.ErrJSONPathNotVector(path)
+		}
+		vec = append(vec, float32(f))
+	}
+	return vec, nil
+}
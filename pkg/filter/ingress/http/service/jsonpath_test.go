@@ -0,0 +1,266 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the requests to the HTTP inference server
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func Test_parseJSONPath(t *testing.T) {
+	type want struct {
+		segments []segment
+		wildcard bool
+		err      error
+	}
+	type test struct {
+		name string
+		path string
+		want want
+	}
+	tests := []test{
+		{
+			name: "returns the root path when the path is $",
+			path: "$",
+		},
+		{
+			name: "returns the segments of the dot and bracket notation",
+			path: "$.data[*]['embedding'][0]",
+			want: want{
+				segments: []segment{
+					{kind: keySegment, key: "data"},
+					{kind: wildcardSegment},
+					{kind: keySegment, key: "embedding"},
+					{kind: indexSegment, index: 0},
+				},
+				wildcard: true,
+			},
+		},
+		{
+			name: "returns the segments when the path does not start with $",
+			path: "outputs[0]",
+			want: want{
+				segments: []segment{
+					{kind: keySegment, key: "outputs"},
+					{kind: indexSegment, index: 0},
+				},
+			},
+		},
+		{
+			name: "returns error when the bracket is not closed",
+			path: "$.data[0",
+			want: want{
+				err: errors.ErrInvalidJSONPath("$.data[0", 6),
+			},
+		},
+		{
+			name: "returns error when the index is negative",
+			path: "$.data[-1]",
+			want: want{
+				err: errors.ErrInvalidJSONPath("$.data[-1]", 7),
+			},
+		},
+		{
+			name: "returns error when the key is empty",
+			path: "$..data",
+			want: want{
+				err: errors.ErrInvalidJSONPath("$..data", 2),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			got, err := parseJSONPath(test.path)
+			if !errors.Is(err, test.want.err) {
+				tt.Fatalf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got.segments, test.want.segments) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got.segments, test.want.segments)
+			}
+			if got.wildcard != test.want.wildcard {
+				tt.Errorf("got wildcard: %v, want: %v", got.wildcard, test.want.wildcard)
+			}
+		})
+	}
+}
+
+func Test_jsonPath_vector(t *testing.T) {
+	type want struct {
+		want []float32
+		err  error
+	}
+	type test struct {
+		name string
+		path string
+		body string
+		want want
+	}
+	tests := []test{
+		{
+			name: "returns the vector of the key",
+			path: "$.embedding",
+			body: `{"embedding": [0.1, 0.2, 0.3]}`,
+			want: want{
+				want: []float32{0.1, 0.2, 0.3},
+			},
+		},
+		{
+			name: "returns the vector of the root",
+			path: "$",
+			body: `[1, 2]`,
+			want: want{
+				want: []float32{1, 2},
+			},
+		},
+		{
+			name: "returns the vector of the nested index",
+			path: "$.outputs[0]",
+			body: `{"outputs": [[1, 2], [3, 4]]}`,
+			want: want{
+				want: []float32{1, 2},
+			},
+		},
+		{
+			name: "returns error when the key does not exist",
+			path: "$.vector",
+			body: `{"embedding": [1, 2]}`,
+			want: want{
+				err: errors.ErrJSONPathNotFound("$.vector"),
+			},
+		},
+		{
+			name: "returns error when the index is out of range",
+			path: "$.outputs[2]",
+			body: `{"outputs": [[1, 2], [3, 4]]}`,
+			want: want{
+				err: errors.ErrJSONPathNotFound("$.outputs[2]"),
+			},
+		},
+		{
+			name: "returns error when the value is not a number array",
+			path: "$.embedding",
+			body: `{"embedding": ["a", "b"]}`,
+			want: want{
+				err: errors.ErrJSONPathNotVector("$.embedding"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			p, err := parseJSONPath(test.path)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			var v interface{}
+			if err := json.Unmarshal([]byte(test.body), &v); err != nil {
+				tt.Fatal(err)
+			}
+			got, err := p.vector(v)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+func Test_jsonPath_vectors(t *testing.T) {
+	type want struct {
+		want [][]float32
+		err  error
+	}
+	type test struct {
+		name string
+		path string
+		body string
+		want want
+	}
+	tests := []test{
+		{
+			name: "returns the vectors of the wildcard",
+			path: "$.data[*].embedding",
+			body: `{"data": [{"embedding": [1, 2]}, {"embedding": [3, 4]}]}`,
+			want: want{
+				want: [][]float32{
+					{1, 2},
+					{3, 4},
+				},
+			},
+		},
+		{
+			name: "returns the vectors of the array of arrays",
+			path: "$.embeddings",
+			body: `{"embeddings": [[1, 2], [3, 4]]}`,
+			want: want{
+				want: [][]float32{
+					{1, 2},
+					{3, 4},
+				},
+			},
+		},
+		{
+			name: "returns error when the value is not an array of arrays",
+			path: "$.embeddings",
+			body: `{"embeddings": [1, 2]}`,
+			want: want{
+				err: errors.ErrJSONPathNotVector("$.embeddings"),
+			},
+		},
+		{
+			name: "returns error when the wildcard is applied to an object",
+			path: "$.data[*]",
+			body: `{"data": {"a": [1, 2]}}`,
+			want: want{
+				err: errors.ErrJSONPathNotFound("$.data[*]"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			p, err := parseJSONPath(test.path)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			var v interface{}
+			if err := json.Unmarshal([]byte(test.body), &v); err != nil {
+				tt.Fatal(err)
+			}
+			got, err := p.vectors(v)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
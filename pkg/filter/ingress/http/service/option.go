@@ -0,0 +1,176 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the requests to the HTTP inference server
+package service
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/vdaas/vald/internal/backoff"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// Option represents the functional option for vectorizer.
+type Option func(v *vectorizer) error
+
+var defaultOptions = []Option{
+	WithMethod(http.MethodPost),
+	WithRequestTemplate(`{"id": {{ json .ID }}, "object": {{ json .Object }}}`),
+	WithVectorPath("$"),
+}
+
+// WithEndpoint returns the option to set the URL of the inference server.
+func WithEndpoint(endpoint string) Option {
+	return func(v *vectorizer) error {
+		if len(endpoint) == 0 {
+			return errors.NewErrInvalidOption("endpoint", endpoint)
+		}
+		v.endpoint = endpoint
+		return nil
+	}
+}
+
+// WithMethod returns the option to set the HTTP method of the inference request.
+func WithMethod(method string) Option {
+	return func(v *vectorizer) error {
+		if len(method) != 0 {
+			v.method = strings.ToUpper(method)
+		}
+		return nil
+	}
+}
+
+// WithHeaders returns the option to add the HTTP headers to the inference request.
+func WithHeaders(headers map[string]string) Option {
+	return func(v *vectorizer) error {
+		for k, val := range headers {
+			v.header.Set(k, val)
+		}
+		return nil
+	}
+}
+
+// WithRequestTemplate returns the option to set the Go text/template which renders the request body of a single object.
+func WithRequestTemplate(text string) Option {
+	return func(v *vectorizer) error {
+		if len(text) == 0 {
+			return nil
+		}
+		tmpl, err := parseTemplate("request", text)
+		if err != nil {
+			return err
+		}
+		v.tmpl = tmpl
+		return nil
+	}
+}
+
+// WithVectorPath returns the option to set the JSON path of the vector in the response.
+func WithVectorPath(path string) Option {
+	return func(v *vectorizer) error {
+		if len(path) == 0 {
+			return nil
+		}
+		p, err := parseJSONPath(path)
+		if err != nil {
+			return err
+		}
+		v.path = p
+		return nil
+	}
+}
+
+// WithBatchRequestTemplate returns the option to set the Go text/template which renders the request body of multiple objects.
+// the batch request is used only when the batch vector path is also set.
+func WithBatchRequestTemplate(text string) Option {
+	return func(v *vectorizer) error {
+		if len(text) == 0 {
+			return nil
+		}
+		tmpl, err := parseTemplate("batch_request", text)
+		if err != nil {
+			return err
+		}
+		v.batchTmpl = tmpl
+		return nil
+	}
+}
+
+// WithBatchVectorPath returns the option to set the JSON path of the vectors in the batch response.
+func WithBatchVectorPath(path string) Option {
+	return func(v *vectorizer) error {
+		if len(path) == 0 {
+			return nil
+		}
+		p, err := parseJSONPath(path)
+		if err != nil {
+			return err
+		}
+		v.batchPath = p
+		return nil
+	}
+}
+
+// WithMaxBatchSize returns the option to set the maximum number of objects sent in a batch request.
+// zero means all objects are sent in a request.
+func WithMaxBatchSize(size int) Option {
+	return func(v *vectorizer) error {
+		if size < 0 {
+			return errors.NewErrInvalidOption("maxBatchSize", size)
+		}
+		v.maxBatchSize = size
+		return nil
+	}
+}
+
+// WithConcurrency returns the option to set the maximum number of inference requests sent at the same time.
+func WithConcurrency(c int) Option {
+	return func(v *vectorizer) error {
+		if c < 0 {
+			return errors.NewErrInvalidOption("concurrency", c)
+		}
+		v.concurrency = c
+		return nil
+	}
+}
+
+// WithHTTPClient returns the option to set the HTTP client.
+func WithHTTPClient(c *http.Client) Option {
+	return func(v *vectorizer) error {
+		if c != nil {
+			v.client = c
+		}
+		return nil
+	}
+}
+
+// WithBackoff returns the option to enable the retry of the inference request.
+func WithBackoff(enabled bool) Option {
+	return func(v *vectorizer) error {
+		v.backoffEnabled = enabled
+		return nil
+	}
+}
+
+// WithBackoffOpts returns the option to set the backoff options.
+func WithBackoffOpts(opts ...backoff.Option) Option {
+	return func(v *vectorizer) error {
+		v.backoffOpts = append(v.backoffOpts, opts...)
+		return nil
+	}
+}
@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the requests to the HTTP inference server
+package service
+
+import (
+	"bytes"
+	"encoding/base64"
+	"text/template"
+
+	"github.com/vdaas/vald/internal/encoding/json"
+)
+
+// templateObject represents an object passed to the request templates.
+type templateObject struct {
+	ID           string
+	Object       string
+	ObjectBase64 string
+}
+
+// templateData represents the data passed to the request templates.
+// the fields of templateObject are used by the single request template and Objects is used by the batch request template.
+type templateData struct {
+	templateObject
+	Objects []templateObject
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	},
+	"base64": func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	},
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(templateFuncs).Parse(text)
+}
+
+func newTemplateObject(id string, obj []byte) templateObject {
+	return templateObject{
+		ID:           id,
+		Object:       string(obj),
+		ObjectBase64: base64.StdEncoding.EncodeToString(obj),
+	}
+}
+
+func render(tmpl *template.Template, data *templateData) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,254 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the requests to the HTTP inference server
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"text/template"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/backoff"
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/http/client"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+// Vectorizer represents the interface to convert objects to vectors via the HTTP inference server.
+type Vectorizer interface {
+	GenVector(ctx context.Context, blob *payload.Object_Blob) ([]float32, error)
+	GenVectors(ctx context.Context, blobs ...*payload.Object_Blob) ([][]float32, error)
+}
+
+type vectorizer struct {
+	client         *http.Client
+	endpoint       string
+	method         string
+	header         http.Header
+	tmpl           *template.Template
+	path           *jsonPath
+	batchTmpl      *template.Template
+	batchPath      *jsonPath
+	maxBatchSize   int
+	concurrency    int
+	backoffEnabled bool
+	backoffOpts    []backoff.Option
+	bo             backoff.Backoff
+}
+
+const (
+	apiName = "vald/filter-ingress-http/service/Vectorizer"
+
+	// maxErrorBodySize is the maximum size of the response body included in the error message.
+	maxErrorBodySize = 1024
+)
+
+// New returns the Vectorizer which sends the objects to the configured endpoint.
+func New(opts ...Option) (Vectorizer, error) {
+	v := &vectorizer{
+		header: make(http.Header),
+	}
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(v); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	if len(v.endpoint) == 0 {
+		return nil, errors.NewErrInvalidOption("endpoint", v.endpoint)
+	}
+	if v.batchTmpl == nil || v.batchPath == nil {
+		v.batchTmpl, v.batchPath = nil, nil
+	}
+	if v.client == nil {
+		c, err := client.New()
+		if err != nil {
+			return nil, err
+		}
+		v.client = c
+	}
+	if v.backoffEnabled {
+		v.bo = backoff.New(v.backoffOpts...)
+	}
+	return v, nil
+}
+
+// GenVector sends the object rendered by the request template and returns the vector extracted from the response.
+func (v *vectorizer) GenVector(ctx context.Context, blob *payload.Object_Blob) (vec []float32, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/GenVector")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	body, err := render(v.tmpl, &templateData{
+		templateObject: newTemplateObject(blob.GetId(), blob.GetObject()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, err := v.do(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return v.path.vector(res)
+}
+
+// GenVectors converts the objects to vectors.
+// When the batch request template is configured, the objects are sent in chunks of the maximum batch size,
+// otherwise each object is sent by its own request.
+func (v *vectorizer) GenVectors(ctx context.Context, blobs ...*payload.Object_Blob) (vecs [][]float32, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/GenVectors")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	vecs = make([][]float32, len(blobs))
+	size := 1
+	if v.batchTmpl != nil {
+		size = v.maxBatchSize
+		if size <= 0 {
+			size = len(blobs)
+		}
+	}
+
+	eg, egctx := errgroup.New(ctx)
+	if v.concurrency > 0 {
+		eg.Limitation(v.concurrency)
+	}
+	for i := 0; i < len(blobs); i += size {
+		begin, end := i, i+size
+		if end > len(blobs) {
+			end = len(blobs)
+		}
+		eg.Go(safety.RecoverFunc(func() error {
+			if v.batchTmpl == nil {
+				vec, err := v.GenVector(egctx, blobs[begin])
+				if err != nil {
+					return err
+				}
+				vecs[begin] = vec
+				return nil
+			}
+			res, err := v.genBatch(egctx, blobs[begin:end])
+			if err != nil {
+				return err
+			}
+			copy(vecs[begin:end], res)
+			return nil
+		}))
+	}
+	if err = eg.Wait(); err != nil {
+		return nil, err
+	}
+	return vecs, nil
+}
+
+func (v *vectorizer) genBatch(ctx context.Context, blobs []*payload.Object_Blob) ([][]float32, error) {
+	data := &templateData{
+		Objects: make([]templateObject, 0, len(blobs)),
+	}
+	for _, blob := range blobs {
+		data.Objects = append(data.Objects, newTemplateObject(blob.GetId(), blob.GetObject()))
+	}
+	body, err := render(v.batchTmpl, data)
+	if err != nil {
+		return nil, err
+	}
+	res, err := v.do(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	vecs, err := v.batchPath.vectors(res)
+	if err != nil {
+		return nil, err
+	}
+	if len(vecs) != len(blobs) {
+		return nil, errors.ErrFilterResultLength(len(vecs), len(blobs))
+	}
+	return vecs, nil
+}
+
+// do sends the request body to the endpoint and returns the decoded response.
+// the request is retried by the backoff when the server is unavailable or the connection failed.
+func (v *vectorizer) do(ctx context.Context, body []byte) (res interface{}, err error) {
+	if v.bo == nil {
+		res, _, err = v.request(ctx, body)
+		return res, err
+	}
+	return v.bo.Do(ctx, func(ctx context.Context) (interface{}, bool, error) {
+		return v.request(ctx, body)
+	})
+}
+
+func (v *vectorizer) request(ctx context.Context, body []byte) (res interface{}, retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, v.method, v.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, false, err
+	}
+	for k, vals := range v.header {
+		req.Header[k] = vals
+	}
+	if len(req.Header.Get("Content-Type")) == 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, isRetryable(ctx, err), err
+	}
+	defer func() {
+		if _, cerr := io.Copy(ioutil.Discard, resp.Body); cerr != nil {
+			log.Warn(cerr)
+		}
+		if cerr := resp.Body.Close(); cerr != nil {
+			log.Warn(cerr)
+		}
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, isRetryableStatus(resp.StatusCode), errors.ErrUnexpectedHTTPStatus(resp.StatusCode, string(msg))
+	}
+
+	if err = json.Decode(resp.Body, &res); err != nil {
+		return nil, false, err
+	}
+	return res, false, nil
+}
+
+// isRetryable reports whether the request error is caused by the connection or the retryable response,
+// the errors caused by the cancellation of the request are not retried.
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	return true
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
@@ -0,0 +1,355 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the requests to the HTTP inference server
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/backoff"
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func TestNew(t *testing.T) {
+	type test struct {
+		name    string
+		opts    []Option
+		wantErr bool
+	}
+	tests := []test{
+		{
+			name: "returns Vectorizer when the endpoint is set",
+			opts: []Option{
+				WithEndpoint("http://localhost:8080"),
+			},
+		},
+		{
+			name:    "returns error when the endpoint is not set",
+			wantErr: true,
+		},
+		{
+			name: "returns error when the request template is invalid",
+			opts: []Option{
+				WithEndpoint("http://localhost:8080"),
+				WithRequestTemplate("{{ .Object "),
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns error when the vector path is invalid",
+			opts: []Option{
+				WithEndpoint("http://localhost:8080"),
+				WithVectorPath("$.data["),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			_, err := New(test.opts...)
+			if (err != nil) != test.wantErr {
+				tt.Errorf("got_error: %v, wantErr: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_vectorizer_GenVector(t *testing.T) {
+	type want struct {
+		want  []float32
+		err   error
+		calls int32
+	}
+	type test struct {
+		name    string
+		opts    []Option
+		handler func(calls int32, w http.ResponseWriter, r *http.Request)
+		blob    *payload.Object_Blob
+		want    want
+	}
+	tests := []test{
+		{
+			name: "returns the vector extracted from the response of the rendered request",
+			opts: []Option{
+				WithHeaders(map[string]string{
+					"Authorization": "Bearer token",
+				}),
+				WithRequestTemplate(`{"inputs": [{{ json .Object }}], "id": {{ json .ID }}}`),
+				WithVectorPath("$.outputs[0]"),
+			},
+			handler: func(_ int32, w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					Inputs []string `json:"inputs"`
+					ID     string   `json:"id"`
+				}
+				if err := json.Decode(r.Body, &req); err != nil ||
+					r.Header.Get("Authorization") != "Bearer token" ||
+					r.Header.Get("Content-Type") != "application/json" ||
+					len(req.Inputs) != 1 || req.Inputs[0] != "hello" || req.ID != "id-1" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_, _ = w.Write([]byte(`{"outputs": [[0.5, 1.5]]}`))
+			},
+			blob: &payload.Object_Blob{
+				Id:     "id-1",
+				Object: []byte("hello"),
+			},
+			want: want{
+				want:  []float32{0.5, 1.5},
+				calls: 1,
+			},
+		},
+		{
+			name: "returns the vector when the object is sent as base64",
+			opts: []Option{
+				WithRequestTemplate(`{"b64": {{ json .ObjectBase64 }}, "same": {{ base64 .Object | json }}}`),
+			},
+			handler: func(_ int32, w http.ResponseWriter, r *http.Request) {
+				var req map[string]string
+				if err := json.Decode(r.Body, &req); err != nil || req["b64"] != "aGVsbG8=" || req["same"] != req["b64"] {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_, _ = w.Write([]byte(`[1, 2]`))
+			},
+			blob: &payload.Object_Blob{
+				Object: []byte("hello"),
+			},
+			want: want{
+				want:  []float32{1, 2},
+				calls: 1,
+			},
+		},
+		{
+			name: "returns error without retry when the server returns the client error",
+			opts: []Option{
+				WithBackoff(true),
+				WithBackoffOpts(
+					backoff.WithInitialDuration("1ms"),
+					backoff.WithRetryCount(3),
+				),
+			},
+			handler: func(_ int32, w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte("bad request"))
+			},
+			blob: &payload.Object_Blob{},
+			want: want{
+				err:   errors.ErrUnexpectedHTTPStatus(http.StatusBadRequest, "bad request"),
+				calls: 1,
+			},
+		},
+		{
+			name: "returns the vector after retry when the server is temporarily unavailable",
+			opts: []Option{
+				WithBackoff(true),
+				WithBackoffOpts(
+					backoff.WithInitialDuration("1ms"),
+					backoff.WithRetryCount(3),
+				),
+			},
+			handler: func(calls int32, w http.ResponseWriter, r *http.Request) {
+				if calls == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`[1, 2]`))
+			},
+			blob: &payload.Object_Blob{},
+			want: want{
+				want:  []float32{1, 2},
+				calls: 2,
+			},
+		},
+		{
+			name: "returns error when the server is unavailable and the backoff is disabled",
+			handler: func(_ int32, w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			blob: &payload.Object_Blob{},
+			want: want{
+				err:   errors.ErrUnexpectedHTTPStatus(http.StatusServiceUnavailable, ""),
+				calls: 1,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			var calls int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				test.handler(atomic.AddInt32(&calls, 1), w, r)
+			}))
+			defer srv.Close()
+
+			v, err := New(append([]Option{
+				WithEndpoint(srv.URL),
+				// use the plain client so that the retry is handled only by the vectorizer.
+				WithHTTPClient(srv.Client()),
+			}, test.opts...)...)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			got, err := v.GenVector(context.Background(), test.blob)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+			if c := atomic.LoadInt32(&calls); c != test.want.calls {
+				tt.Errorf("got calls: %d, want: %d", c, test.want.calls)
+			}
+		})
+	}
+}
+
+func Test_vectorizer_GenVectors(t *testing.T) {
+	blobs := []*payload.Object_Blob{
+		{Id: "1", Object: []byte("1")},
+		{Id: "2", Object: []byte("2")},
+		{Id: "3", Object: []byte("3")},
+	}
+	// echo returns the vectors whose element is the object value, so that the order of the result can be checked.
+	echo := func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var req struct {
+			Inputs []float32 `json:"inputs"`
+			Input  float32   `json:"input"`
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if len(req.Inputs) == 0 {
+			_ = json.Encode(w, map[string][]float32{"vector": {req.Input}})
+			return
+		}
+		vecs := make([][]float32, 0, len(req.Inputs))
+		for _, in := range req.Inputs {
+			vecs = append(vecs, []float32{in})
+		}
+		_ = json.Encode(w, map[string][][]float32{"vectors": vecs})
+	}
+	type want struct {
+		want  [][]float32
+		err   error
+		calls int32
+	}
+	type test struct {
+		name    string
+		opts    []Option
+		handler http.HandlerFunc
+		want    want
+	}
+	tests := []test{
+		{
+			name: "returns the vectors of the batch requests split by the max batch size",
+			opts: []Option{
+				WithBatchRequestTemplate(`{"inputs": [{{ range $i, $o := .Objects }}{{ if $i }},{{ end }}{{ $o.Object }}{{ end }}]}`),
+				WithBatchVectorPath("$.vectors"),
+				WithMaxBatchSize(2),
+			},
+			handler: echo,
+			want: want{
+				want: [][]float32{
+					{1},
+					{2},
+					{3},
+				},
+				calls: 2,
+			},
+		},
+		{
+			name: "returns the vectors of the single requests when the batch request is not configured",
+			opts: []Option{
+				WithRequestTemplate(`{"input": {{ .Object }}}`),
+				WithVectorPath("$.vector"),
+				WithConcurrency(2),
+			},
+			handler: echo,
+			want: want{
+				want: [][]float32{
+					{1},
+					{2},
+					{3},
+				},
+				calls: 3,
+			},
+		},
+		{
+			name: "returns error when the number of the vectors is not equal to the objects",
+			opts: []Option{
+				WithBatchRequestTemplate(`{}`),
+				WithBatchVectorPath("$.vectors"),
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"vectors": [[1]]}`))
+			},
+			want: want{
+				err:   errors.ErrFilterResultLength(1, 3),
+				calls: 1,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			var calls int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				test.handler(w, r)
+			}))
+			defer srv.Close()
+
+			v, err := New(append([]Option{
+				WithEndpoint(srv.URL),
+				WithHTTPClient(srv.Client()),
+			}, test.opts...)...)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			got, err := v.GenVectors(context.Background(), blobs...)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+			if c := atomic.LoadInt32(&calls); c != test.want.calls {
+				tt.Errorf("got calls: %d, want: %d", c, test.want.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/apis/grpc/v1/filter/ingress"
+	iconf "github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/net"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/metric"
+	"github.com/vdaas/vald/internal/net/http/client"
+	"github.com/vdaas/vald/internal/observability"
+	"github.com/vdaas/vald/internal/runner"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/internal/servers/server"
+	"github.com/vdaas/vald/internal/servers/starter"
+	"github.com/vdaas/vald/pkg/filter/ingress/http/config"
+	handler "github.com/vdaas/vald/pkg/filter/ingress/http/handler/grpc"
+	"github.com/vdaas/vald/pkg/filter/ingress/http/handler/rest"
+	"github.com/vdaas/vald/pkg/filter/ingress/http/router"
+	"github.com/vdaas/vald/pkg/filter/ingress/http/service"
+)
+
+type run struct {
+	eg            errgroup.Group
+	cfg           *config.Data
+	der           net.Dialer
+	server        starter.Server
+	observability observability.Observability
+}
+
+func New(cfg *config.Data) (r runner.Runner, err error) {
+	netOpts, err := cfg.Vectorizer.Client.Net.Opts()
+	if err != nil {
+		return nil, err
+	}
+	der, err := net.NewDialer(netOpts...)
+	if err != nil {
+		return nil, err
+	}
+	rt := cfg.Vectorizer.Client.Transport.RoundTripper
+	hc, err := client.New(
+		client.WithDialContext(der.DialContext),
+		client.WithTLSHandshakeTimeout(rt.TLSHandshakeTimeout),
+		client.WithMaxIdleConns(rt.MaxIdleConns),
+		client.WithMaxIdleConnsPerHost(rt.MaxIdleConnsPerHost),
+		client.WithMaxConnsPerHost(rt.MaxConnsPerHost),
+		client.WithIdleConnTimeout(rt.IdleConnTimeout),
+		client.WithResponseHeaderTimeout(rt.ResponseHeaderTimeout),
+		client.WithExpectContinueTimeout(rt.ExpectContinueTimeout),
+		client.WithMaxResponseHeaderBytes(rt.MaxResponseHeaderSize),
+		client.WithWriteBufferSize(rt.WriteBufferSize),
+		client.WithReadBufferSize(rt.ReadBufferSize),
+		client.WithForceAttemptHTTP2(rt.ForceAttemptHTTP2),
+		client.WithBackoffOpts(cfg.Vectorizer.Client.Transport.Backoff.Opts()...),
+	)
+	if err != nil {
+		return nil, err
+	}
+	vectorizer, err := service.New(
+		service.WithHTTPClient(hc),
+		service.WithEndpoint(cfg.Vectorizer.Endpoint),
+		service.WithMethod(cfg.Vectorizer.Method),
+		service.WithHeaders(cfg.Vectorizer.Headers),
+		service.WithRequestTemplate(cfg.Vectorizer.RequestTemplate),
+		service.WithVectorPath(cfg.Vectorizer.VectorPath),
+		service.WithBatchRequestTemplate(cfg.Vectorizer.BatchRequestTemplate),
+		service.WithBatchVectorPath(cfg.Vectorizer.BatchVectorPath),
+		service.WithMaxBatchSize(cfg.Vectorizer.MaxBatchSize),
+		service.WithConcurrency(cfg.Vectorizer.Concurrency),
+		service.WithBackoff(cfg.Vectorizer.BackoffEnabled),
+		service.WithBackoffOpts(cfg.Vectorizer.Backoff.Opts()...),
+	)
+	if err != nil {
+		return nil, err
+	}
+	g := handler.New(handler.WithVectorizer(vectorizer))
+	grpcServerOptions := []server.Option{
+		server.WithGRPCRegistFunc(func(srv *grpc.Server) {
+			ingress.RegisterFilterServer(srv, g)
+		}),
+		server.WithPreStartFunc(func() error {
+			// TODO check unbackupped upstream
+			return nil
+		}),
+		server.WithPreStopFunction(func() error {
+			// TODO backup all index data here
+			return nil
+		}),
+	}
+
+	eg := errgroup.Get()
+	var obs observability.Observability
+	if cfg.Observability.Enabled {
+		obs, err = observability.NewWithConfig(cfg.Observability)
+		if err != nil {
+			return nil, err
+		}
+		grpcServerOptions = append(
+			grpcServerOptions,
+			server.WithGRPCOption(
+				grpc.StatsHandler(metric.NewServerHandler()),
+			),
+		)
+	}
+
+	srv, err := starter.New(
+		starter.WithConfig(cfg.Server),
+		starter.WithREST(func(sc *iconf.Server) []server.Option {
+			return []server.Option{
+				server.WithHTTPHandler(
+					router.New(
+						router.WithTimeout(sc.HTTP.HandlerTimeout),
+						router.WithErrGroup(eg),
+						router.WithHandler(
+							rest.New(
+								rest.WithFilter(g),
+							)))),
+			}
+		}),
+		starter.WithGRPC(func(sc *iconf.Server) []server.Option {
+			return grpcServerOptions
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &run{
+		eg:            eg,
+		cfg:           cfg,
+		der:           der,
+		server:        srv,
+		observability: obs,
+	}, nil
+}
+
+func (r *run) PreStart(ctx context.Context) error {
+	if r.der != nil {
+		r.der.StartDialerCache(ctx)
+	}
+	if r.observability != nil {
+		return r.observability.PreStart(ctx)
+	}
+	return nil
+}
+
+func (r *run) Start(ctx context.Context) (<-chan error, error) {
+	ech := make(chan error, 2)
+	var oech, sech <-chan error
+	r.eg.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+		if r.observability != nil {
+			oech = r.observability.Start(ctx)
+		}
+		sech = r.server.ListenAndServe(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err = <-oech:
+			case err = <-sech:
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case ech <- err:
+				}
+			}
+		}
+	}))
+	return ech, nil
+}
+
+func (r *run) PreStop(ctx context.Context) error {
+	return nil
+}
+
+func (r *run) Stop(ctx context.Context) error {
+	if r.observability != nil {
+		r.observability.Stop(ctx)
+	}
+	return r.server.Shutdown(ctx)
+}
+
+func (r *run) PostStop(ctx context.Context) error {
+	return nil
+}
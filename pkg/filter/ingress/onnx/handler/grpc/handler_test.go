@@ -0,0 +1,139 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package grpc provides grpc server logic
+package grpc
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestMain(m *testing.M) {
+	info.Init("")
+	os.Exit(m.Run())
+}
+
+type onnxMock struct {
+	GetVectorFunc func([]byte) ([]float32, error)
+}
+
+func (m *onnxMock) GetVector(input []byte) ([]float32, error) {
+	return m.GetVectorFunc(input)
+}
+
+func (m *onnxMock) Close() error {
+	return nil
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	m := new(onnxMock)
+	got := New(WithONNX(m))
+	s, ok := got.(*server)
+	if !ok {
+		t.Fatalf("got: %T, want: *server", got)
+	}
+	if s.onnx != m {
+		t.Errorf("got: %#v, want: %#v", s.onnx, m)
+	}
+}
+
+func Test_server_GenVector(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		req *payload.Object_Blob
+	}
+	type want struct {
+		want  *payload.Object_Vector
+		input []byte
+		err   bool
+	}
+	type test struct {
+		name string
+		args args
+		want want
+		vec  []float32
+		err  error
+	}
+	tests := []test{
+		{
+			name: "returns the vector of the object bytes",
+			args: args{
+				req: &payload.Object_Blob{
+					Id:     "1",
+					Object: []byte{1, 2, 3},
+				},
+			},
+			vec: []float32{0.1, 0.2},
+			want: want{
+				want: &payload.Object_Vector{
+					Id:     "1",
+					Vector: []float32{0.1, 0.2},
+				},
+				input: []byte{1, 2, 3},
+			},
+		},
+		{
+			name: "returns error when the object could not vectorize",
+			args: args{
+				req: &payload.Object_Blob{
+					Id:     "1",
+					Object: []byte{1},
+				},
+			},
+			err: errors.ErrONNXInputLength(1, 4),
+			want: want{
+				input: []byte{1},
+				err:   true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			var input []byte
+			s := &server{
+				onnx: &onnxMock{
+					GetVectorFunc: func(in []byte) ([]float32, error) {
+						input = in
+						return test.vec, test.err
+					},
+				},
+			}
+			got, err := s.GenVector(context.Background(), test.args.req)
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v, want error: %v", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+			if !reflect.DeepEqual(input, test.want.input) {
+				tt.Errorf("got input: %v, want: %v", input, test.want.input)
+			}
+		})
+	}
+}
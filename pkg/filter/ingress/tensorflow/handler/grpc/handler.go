@@ -68,3 +68,39 @@ func (s *server) GenVector(ctx context.Context, req *payload.Object_Blob) (vec *
 	}
 	return vec, nil
 }
+
+func (s *server) GenVectors(ctx context.Context, reqs *payload.Object_Blobs) (vecs *payload.Object_Vectors, err error) {
+	ctx, span := trace.StartSpan(ctx, "vald/.GenVectors")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	blobs := reqs.GetBlobs()
+	inputs := make([][]string, 0, len(blobs))
+	for _, blob := range blobs {
+		inputs = append(inputs, []string{string(blob.GetObject())})
+	}
+	f64vecs, err := s.tf.GetVectors(inputs...)
+	if err != nil {
+		if span != nil {
+			span.SetStatus(trace.StatusCodeInvalidArgument(err.Error()))
+		}
+		return nil, status.WrapWithInternal(fmt.Sprintf("GenVectors API %d objects could not vectorize", len(blobs)), err, info.Get())
+	}
+
+	vecs = &payload.Object_Vectors{
+		Vectors: make([]*payload.Object_Vector, 0, len(f64vecs)),
+	}
+	for i, f64vec := range f64vecs {
+		vec := &payload.Object_Vector{
+			Id:     blobs[i].GetId(),
+			Vector: make([]float32, len(f64vec)),
+		}
+		for j, d := range f64vec {
+			vec.Vector[j] = float32(d)
+		}
+		vecs.Vectors = append(vecs.Vectors, vec)
+	}
+	return vecs, nil
+}
@@ -19,6 +19,7 @@ package grpc
 
 import (
 	"context"
+	"os"
 	"reflect"
 	"testing"
 
@@ -26,9 +27,15 @@ import (
 	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/internal/core/converter/tensorflow"
 	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
 	"github.com/vdaas/vald/internal/test/goleak"
 )
 
+func TestMain(m *testing.M) {
+	info.Init("")
+	os.Exit(m.Run())
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -198,3 +205,121 @@ func Test_server_GenVector(t *testing.T) {
 		})
 	}
 }
+
+type tfMock struct {
+	tensorflow.TF
+	GetVectorsFunc func(inputs ...[]string) ([][]float64, error)
+}
+
+func (m *tfMock) GetVectors(inputs ...[]string) ([][]float64, error) {
+	return m.GetVectorsFunc(inputs...)
+}
+
+func Test_server_GenVectors(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx  context.Context
+		reqs *payload.Object_Blobs
+	}
+	type fields struct {
+		tf tensorflow.TF
+	}
+	type want struct {
+		wantVecs *payload.Object_Vectors
+		err      bool
+	}
+	type test struct {
+		name   string
+		args   args
+		fields fields
+		want   want
+	}
+	tests := []test{
+		{
+			name: "returns vectors in the order of the objects",
+			args: args{
+				ctx: context.Background(),
+				reqs: &payload.Object_Blobs{
+					Blobs: []*payload.Object_Blob{
+						{
+							Id:     "a",
+							Object: []byte("1"),
+						},
+						{
+							Id:     "b",
+							Object: []byte("2"),
+						},
+					},
+				},
+			},
+			fields: fields{
+				tf: &tfMock{
+					GetVectorsFunc: func(inputs ...[]string) ([][]float64, error) {
+						vecs := make([][]float64, 0, len(inputs))
+						for _, in := range inputs {
+							vecs = append(vecs, []float64{float64(in[0][0] - '0'), 0.5})
+						}
+						return vecs, nil
+					},
+				},
+			},
+			want: want{
+				wantVecs: &payload.Object_Vectors{
+					Vectors: []*payload.Object_Vector{
+						{
+							Id:     "a",
+							Vector: []float32{1, 0.5},
+						},
+						{
+							Id:     "b",
+							Vector: []float32{2, 0.5},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "returns error when the objects could not vectorize",
+			args: args{
+				ctx: context.Background(),
+				reqs: &payload.Object_Blobs{
+					Blobs: []*payload.Object_Blob{
+						{
+							Id:     "a",
+							Object: []byte("1"),
+						},
+					},
+				},
+			},
+			fields: fields{
+				tf: &tfMock{
+					GetVectorsFunc: func(inputs ...[]string) ([][]float64, error) {
+						return nil, errors.ErrInputLength(1, 2)
+					},
+				},
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := &server{
+				tf: test.fields.tf,
+			}
+
+			gotVecs, err := s.GenVectors(test.args.ctx, test.args.reqs)
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v, want error: %v", err, test.want.err)
+			}
+			if !reflect.DeepEqual(gotVecs, test.want.wantVecs) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", gotVecs, test.want.wantVecs)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 type Handler interface {
 	GenVector(w http.ResponseWriter, r *http.Request) (int, error)
+	GenVectors(w http.ResponseWriter, r *http.Request) (int, error)
 }
 
 type handler struct {
@@ -48,3 +49,10 @@ func (h *handler) GenVector(w http.ResponseWriter, r *http.Request) (int, error)
 		return h.ingress.GenVector(r.Context(), req)
 	})
 }
+
+func (h *handler) GenVectors(w http.ResponseWriter, r *http.Request) (int, error) {
+	var req *payload.Object_Blobs
+	return json.Handler(w, r, &req, func() (interface{}, error) {
+		return h.ingress.GenVectors(r.Context(), req)
+	})
+}
@@ -55,5 +55,12 @@ func New(opts ...Option) http.Handler {
 			},
 			"/gen/vector",
 			h.GenVector,
+		}, {
+			"GenVectors",
+			[]string{
+				http.MethodPost,
+			},
+			"/gen/vectors",
+			h.GenVectors,
 		}}...))
 }
@@ -53,6 +53,8 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		tensorflow.WithTags(cfg.Tensorflow.Tags...),
 		tensorflow.WithWarmupInputs(cfg.Tensorflow.WarmupInputs...),
 		tensorflow.WithNdim(cfg.Tensorflow.ResultNestedDimension),
+		tensorflow.WithMaxBatchSize(cfg.Tensorflow.MaxBatchSize),
+		tensorflow.WithBatchWindow(cfg.Tensorflow.BatchWindow),
 	)
 	if err != nil {
 		return nil, err
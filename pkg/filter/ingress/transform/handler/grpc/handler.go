@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package grpc provides grpc server logic
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vdaas/vald/apis/grpc/v1/filter/ingress"
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/info"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/pkg/filter/ingress/transform/service"
+)
+
+type Server ingress.FilterServer
+
+type server struct {
+	ingress.UnimplementedFilterServer
+	transformer service.Transformer
+}
+
+func New(opts ...Option) Server {
+	s := new(server)
+
+	for _, opt := range append(defaultOptions, opts...) {
+		opt(s)
+	}
+	return s
+}
+
+func (s *server) FilterVector(ctx context.Context, req *payload.Object_Vector) (*payload.Object_Vector, error) {
+	_, span := trace.StartSpan(ctx, "vald/filter-ingress-transform/FilterVector")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	vector, err := s.transformer.Transform(req.GetVector())
+	if err != nil {
+		if span != nil {
+			span.SetStatus(trace.StatusCodeInvalidArgument(err.Error()))
+		}
+		return nil, status.WrapWithInvalidArgument(fmt.Sprintf("FilterVector API id %s's vector could not transform", req.GetId()), err, info.Get())
+	}
+	return &payload.Object_Vector{
+		Id:     req.GetId(),
+		Vector: vector,
+	}, nil
+}
@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package grpc provides grpc server logic
+package grpc
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
+	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/filter/ingress/transform/service"
+)
+
+func TestMain(m *testing.M) {
+	info.Init("")
+	os.Exit(m.Run())
+}
+
+type transformerMock struct {
+	TransformFunc func([]float32) ([]float32, error)
+}
+
+func (m *transformerMock) Transform(vec []float32) ([]float32, error) {
+	return m.TransformFunc(vec)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	tr := new(transformerMock)
+	got := New(WithTransformer(tr))
+	s, ok := got.(*server)
+	if !ok {
+		t.Fatalf("got: %T, want: *server", got)
+	}
+	if s.transformer != tr {
+		t.Errorf("got: %#v, want: %#v", s.transformer, tr)
+	}
+}
+
+func Test_server_FilterVector(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		req *payload.Object_Vector
+	}
+	type want struct {
+		want *payload.Object_Vector
+		err  bool
+	}
+	type test struct {
+		name        string
+		transformer service.Transformer
+		args        args
+		want        want
+	}
+	tests := []test{
+		{
+			name: "returns the normalized vector",
+			transformer: &transformerMock{
+				TransformFunc: func(vec []float32) ([]float32, error) {
+					return []float32{0.6, 0.8}, nil
+				},
+			},
+			args: args{
+				req: &payload.Object_Vector{
+					Id:     "1",
+					Vector: []float32{3, 4},
+				},
+			},
+			want: want{
+				want: &payload.Object_Vector{
+					Id:     "1",
+					Vector: []float32{0.6, 0.8},
+				},
+			},
+		},
+		{
+			name: "returns error when the vector could not transform",
+			transformer: &transformerMock{
+				TransformFunc: func(vec []float32) ([]float32, error) {
+					return nil, errors.ErrTransformDimension("projection", len(vec), 3)
+				},
+			},
+			args: args{
+				req: &payload.Object_Vector{
+					Id:     "1",
+					Vector: []float32{3, 4},
+				},
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := &server{
+				transformer: test.transformer,
+			}
+			got, err := s.FilterVector(context.Background(), test.args.req)
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v, want error: %v", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the vector transform steps
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/fs"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+)
+
+// matrix is a row-major float32 matrix. a vector is a matrix of a single row.
+type matrix struct {
+	rows int
+	cols int
+	data []float32
+}
+
+var (
+	npyMagic   = []byte("\x93NUMPY")
+	npyDescr   = regexp.MustCompile(`'descr':\s*'([^']*)'`)
+	npyFortran = regexp.MustCompile(`'fortran_order':\s*(True|False)`)
+	npyShape   = regexp.MustCompile(`'shape':\s*\(([^)]*)\)`)
+)
+
+// loadMatrix reads the .npy file written by numpy.save, or the text file written by numpy.savetxt.
+func loadMatrix(path string) (m *matrix, err error) {
+	f, err := file.Open(path, os.O_RDONLY, fs.ModePerm)
+	if err != nil {
+		return nil, errors.ErrInvalidMatrixFile(path, err)
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil {
+			err = errors.Wrap(err, cerr.Error())
+		}
+	}()
+	if strings.HasSuffix(path, ".npy") {
+		m, err = readNpy(bufio.NewReader(f))
+	} else {
+		m, err = readText(f)
+	}
+	if err != nil {
+		return nil, errors.ErrInvalidMatrixFile(path, err)
+	}
+	return m, nil
+}
+
+func readNpy(r io.Reader) (*matrix, error) {
+	pre := make([]byte, len(npyMagic)+2)
+	if _, err := io.ReadFull(r, pre); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(pre[:len(npyMagic)], npyMagic) {
+		return nil, errors.ErrInvalidNpyHeader(string(pre))
+	}
+	var hlen int
+	if pre[len(npyMagic)] == 1 {
+		var l uint16
+		if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+			return nil, err
+		}
+		hlen = int(l)
+	} else {
+		var l uint32
+		if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+			return nil, err
+		}
+		hlen = int(l)
+	}
+	hbuf := make([]byte, hlen)
+	if _, err := io.ReadFull(r, hbuf); err != nil {
+		return nil, err
+	}
+	header := string(hbuf)
+
+	descr := npyDescr.FindStringSubmatch(header)
+	fortran := npyFortran.FindStringSubmatch(header)
+	shape := npyShape.FindStringSubmatch(header)
+	if descr == nil || fortran == nil || shape == nil {
+		return nil, errors.ErrInvalidNpyHeader(header)
+	}
+	dims := make([]int, 0, 2)
+	for _, s := range strings.Split(shape[1], ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		d, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, errors.ErrInvalidNpyHeader(header)
+		}
+		dims = append(dims, d)
+	}
+	m := new(matrix)
+	switch len(dims) {
+	case 1:
+		m.rows, m.cols = 1, dims[0]
+	case 2:
+		m.rows, m.cols = dims[0], dims[1]
+	default:
+		return nil, errors.ErrInvalidNpyHeader(header)
+	}
+
+	n := m.rows * m.cols
+	m.data = make([]float32, n)
+	switch descr[1] {
+	case "<f4":
+		if err := binary.Read(r, binary.LittleEndian, m.data); err != nil {
+			return nil, err
+		}
+	case "<f8":
+		buf := make([]float64, n)
+		if err := binary.Read(r, binary.LittleEndian, buf); err != nil {
+			return nil, err
+		}
+		for i, v := range buf {
+			m.data[i] = float32(v)
+		}
+	default:
+		return nil, errors.ErrInvalidNpyHeader(header)
+	}
+
+	if fortran[1] == "True" && m.rows > 1 {
+		data := make([]float32, n)
+		for i := 0; i < m.rows; i++ {
+			for j := 0; j < m.cols; j++ {
+				data[i*m.cols+j] = m.data[j*m.rows+i]
+			}
+		}
+		m.data = data
+	}
+	return m, nil
+}
+
+func readText(r io.Reader) (*matrix, error) {
+	m := new(matrix)
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.FieldsFunc(line, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+		if m.rows == 0 {
+			m.cols = len(fields)
+		} else if len(fields) != m.cols {
+			return nil, errors.ErrMatrixRowLength(m.rows, len(fields), m.cols)
+		}
+		for _, field := range fields {
+			v, err := strconv.ParseFloat(field, 32)
+			if err != nil {
+				return nil, err
+			}
+			m.data = append(m.data, float32(v))
+		}
+		m.rows++
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,166 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the vector transform steps
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func npy(t *testing.T, descr string, fortran bool, shape string, data interface{}) []byte {
+	t.Helper()
+	order := "False"
+	if fortran {
+		order = "True"
+	}
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': %s, 'shape': %s, }\n", descr, order, shape)
+	buf := new(bytes.Buffer)
+	buf.Write(npyMagic)
+	buf.Write([]byte{1, 0})
+	if err := binary.Write(buf, binary.LittleEndian, uint16(len(header))); err != nil {
+		t.Fatal(err)
+	}
+	buf.WriteString(header)
+	if err := binary.Write(buf, binary.LittleEndian, data); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func Test_loadMatrix(t *testing.T) {
+	type want struct {
+		want *matrix
+		err  bool
+	}
+	type test struct {
+		name string
+		file string
+		data func(*testing.T) []byte
+		want want
+	}
+	tests := []test{
+		{
+			name: "returns matrix when the file is float32 npy",
+			file: "m.npy",
+			data: func(t *testing.T) []byte {
+				return npy(t, "<f4", false, "(2, 3)", []float32{1, 2, 3, 4, 5, 6})
+			},
+			want: want{
+				want: &matrix{
+					rows: 2,
+					cols: 3,
+					data: []float32{1, 2, 3, 4, 5, 6},
+				},
+			},
+		},
+		{
+			name: "returns matrix when the file is float64 fortran order npy",
+			file: "m.npy",
+			data: func(t *testing.T) []byte {
+				return npy(t, "<f8", true, "(2, 3)", []float64{1, 4, 2, 5, 3, 6})
+			},
+			want: want{
+				want: &matrix{
+					rows: 2,
+					cols: 3,
+					data: []float32{1, 2, 3, 4, 5, 6},
+				},
+			},
+		},
+		{
+			name: "returns single row matrix when the file is 1-D npy",
+			file: "mean.npy",
+			data: func(t *testing.T) []byte {
+				return npy(t, "<f4", false, "(3,)", []float32{1, 2, 3})
+			},
+			want: want{
+				want: &matrix{
+					rows: 1,
+					cols: 3,
+					data: []float32{1, 2, 3},
+				},
+			},
+		},
+		{
+			name: "returns error when the npy dtype is not float",
+			file: "m.npy",
+			data: func(t *testing.T) []byte {
+				return npy(t, "<i8", false, "(1,)", []int64{1})
+			},
+			want: want{
+				err: true,
+			},
+		},
+		{
+			name: "returns matrix when the file is text",
+			file: "m.txt",
+			data: func(*testing.T) []byte {
+				return []byte("# pca components\n1.0 2.0 3.0\n4,5,6\n\n")
+			},
+			want: want{
+				want: &matrix{
+					rows: 2,
+					cols: 3,
+					data: []float32{1, 2, 3, 4, 5, 6},
+				},
+			},
+		},
+		{
+			name: "returns error when the text rows have different length",
+			file: "m.txt",
+			data: func(*testing.T) []byte {
+				return []byte("1 2 3\n4 5\n")
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			path := filepath.Join(tt.TempDir(), test.file)
+			if err := os.WriteFile(path, test.data(tt), 0o600); err != nil {
+				tt.Fatal(err)
+			}
+			got, err := loadMatrix(path)
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v, want error: %v", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+func Test_loadMatrix_notFound(t *testing.T) {
+	_, err := loadMatrix(filepath.Join(t.TempDir(), "not_found.npy"))
+	if err == nil {
+		t.Error("got nil error")
+	}
+}
@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the vector transform steps
+package service
+
+import (
+	"github.com/vdaas/vald/internal/config"
+)
+
+// Option represents the functional option for transformer.
+type Option func(t *transformer) error
+
+var defaultOptions = []Option{}
+
+// WithSteps returns the option to set the transform steps. the steps are applied in the given order.
+func WithSteps(steps ...*config.TransformStep) Option {
+	return func(t *transformer) error {
+		for _, cfg := range steps {
+			if cfg == nil {
+				continue
+			}
+			s, err := newStep(cfg)
+			if err != nil {
+				return err
+			}
+			t.steps = append(t.steps, s)
+		}
+		return nil
+	}
+}
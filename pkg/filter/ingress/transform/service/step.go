@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the vector transform steps
+package service
+
+import (
+	"math"
+
+	"github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+const (
+	stepL2Normalization = "l2_normalization"
+	stepMeanCentering   = "mean_centering"
+	stepProjection      = "projection"
+	stepInt8Quantize    = "int8_quantization"
+)
+
+type step struct {
+	name  string
+	apply func(vec []float32) ([]float32, error)
+}
+
+func newStep(cfg *config.TransformStep) (*step, error) {
+	switch cfg.Type {
+	case stepL2Normalization:
+		return &step{
+			name:  cfg.Type,
+			apply: l2Normalize,
+		}, nil
+	case stepMeanCentering:
+		if cfg.Path == "" {
+			return &step{
+				name:  cfg.Type,
+				apply: centerBySelf,
+			}, nil
+		}
+		m, err := loadMatrix(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+		return &step{
+			name:  cfg.Type,
+			apply: centerBy(m.data),
+		}, nil
+	case stepProjection:
+		m, err := loadMatrix(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+		return &step{
+			name:  cfg.Type,
+			apply: project(m),
+		}, nil
+	case stepInt8Quantize:
+		min, max := cfg.Min, cfg.Max
+		if min == 0 && max == 0 {
+			min, max = -1, 1
+		}
+		if max <= min {
+			return nil, errors.ErrInvalidQuantizationRange(min, max)
+		}
+		return &step{
+			name:  cfg.Type,
+			apply: quantizeInt8(min, max),
+		}, nil
+	}
+	return nil, errors.ErrUnknownTransformStep(cfg.Type)
+}
+
+// l2Normalize scales the vector to unit length. a zero vector is returned as it is.
+func l2Normalize(vec []float32) ([]float32, error) {
+	var sum float64
+	for _, v := range vec {
+		sum += float64(v) * float64(v)
+	}
+	res := make([]float32, len(vec))
+	if sum == 0 {
+		copy(res, vec)
+		return res, nil
+	}
+	norm := math.Sqrt(sum)
+	for i, v := range vec {
+		res[i] = float32(float64(v) / norm)
+	}
+	return res, nil
+}
+
+// centerBySelf subtracts the mean of its own elements from the vector.
+func centerBySelf(vec []float32) ([]float32, error) {
+	if len(vec) == 0 {
+		return vec, nil
+	}
+	var sum float64
+	for _, v := range vec {
+		sum += float64(v)
+	}
+	mean := sum / float64(len(vec))
+	res := make([]float32, len(vec))
+	for i, v := range vec {
+		res[i] = float32(float64(v) - mean)
+	}
+	return res, nil
+}
+
+// centerBy subtracts the mean vector, e.g. the mean of the training data of PCA.
+func centerBy(mean []float32) func([]float32) ([]float32, error) {
+	return func(vec []float32) ([]float32, error) {
+		if len(vec) != len(mean) {
+			return nil, errors.ErrTransformDimension(stepMeanCentering, len(vec), len(mean))
+		}
+		res := make([]float32, len(vec))
+		for i, v := range vec {
+			res[i] = v - mean[i]
+		}
+		return res, nil
+	}
+}
+
+// project multiplies the vector by the matrix whose shape is (output dimension, input dimension).
+func project(m *matrix) func([]float32) ([]float32, error) {
+	return func(vec []float32) ([]float32, error) {
+		if len(vec) != m.cols {
+			return nil, errors.ErrTransformDimension(stepProjection, len(vec), m.cols)
+		}
+		res := make([]float32, m.rows)
+		for i := range res {
+			row := m.data[i*m.cols : (i+1)*m.cols]
+			var sum float64
+			for j, v := range vec {
+				sum += float64(row[j]) * float64(v)
+			}
+			res[i] = float32(sum)
+		}
+		return res, nil
+	}
+}
+
+// quantizeInt8 maps [min, max] to the int8 range [-128, 127]. values out of range are clamped.
+func quantizeInt8(min, max float64) func([]float32) ([]float32, error) {
+	scale := 255 / (max - min)
+	return func(vec []float32) ([]float32, error) {
+		res := make([]float32, len(vec))
+		for i, v := range vec {
+			q := math.Round((float64(v)-min)*scale) - 128
+			switch {
+			case q < math.MinInt8:
+				q = math.MinInt8
+			case q > math.MaxInt8:
+				q = math.MaxInt8
+			}
+			res[i] = float32(q)
+		}
+		return res, nil
+	}
+}
@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the vector transform steps
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func Test_newStep(t *testing.T) {
+	dir := t.TempDir()
+	mean := filepath.Join(dir, "mean.txt")
+	if err := os.WriteFile(mean, []byte("1 1 1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	proj := filepath.Join(dir, "proj.txt")
+	if err := os.WriteFile(proj, []byte("1 0 0\n0 1 1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	type args struct {
+		cfg *config.TransformStep
+		vec []float32
+	}
+	type want struct {
+		want []float32
+		err  error
+	}
+	type test struct {
+		name string
+		args args
+		want want
+	}
+	tests := []test{
+		{
+			name: "l2_normalization scales the vector to unit length",
+			args: args{
+				cfg: &config.TransformStep{Type: "l2_normalization"},
+				vec: []float32{3, 4},
+			},
+			want: want{
+				want: []float32{0.6, 0.8},
+			},
+		},
+		{
+			name: "l2_normalization returns zero vector as it is",
+			args: args{
+				cfg: &config.TransformStep{Type: "l2_normalization"},
+				vec: []float32{0, 0},
+			},
+			want: want{
+				want: []float32{0, 0},
+			},
+		},
+		{
+			name: "mean_centering subtracts the mean of its own elements when path is empty",
+			args: args{
+				cfg: &config.TransformStep{Type: "mean_centering"},
+				vec: []float32{1, 2, 3},
+			},
+			want: want{
+				want: []float32{-1, 0, 1},
+			},
+		},
+		{
+			name: "mean_centering subtracts the mean vector loaded from the file",
+			args: args{
+				cfg: &config.TransformStep{Type: "mean_centering", Path: mean},
+				vec: []float32{1, 2, 3},
+			},
+			want: want{
+				want: []float32{0, 1, 2},
+			},
+		},
+		{
+			name: "mean_centering returns error when the dimension does not match",
+			args: args{
+				cfg: &config.TransformStep{Type: "mean_centering", Path: mean},
+				vec: []float32{1, 2},
+			},
+			want: want{
+				err: errors.ErrTransformDimension("mean_centering", 2, 3),
+			},
+		},
+		{
+			name: "projection multiplies the matrix",
+			args: args{
+				cfg: &config.TransformStep{Type: "projection", Path: proj},
+				vec: []float32{1, 2, 3},
+			},
+			want: want{
+				want: []float32{1, 5},
+			},
+		},
+		{
+			name: "projection returns error when the dimension does not match",
+			args: args{
+				cfg: &config.TransformStep{Type: "projection", Path: proj},
+				vec: []float32{1, 2},
+			},
+			want: want{
+				err: errors.ErrTransformDimension("projection", 2, 3),
+			},
+		},
+		{
+			name: "int8_quantization maps the default range to int8 and clamps the outliers",
+			args: args{
+				cfg: &config.TransformStep{Type: "int8_quantization"},
+				vec: []float32{-1, 0, 1, 2},
+			},
+			want: want{
+				want: []float32{-128, 0, 127, 127},
+			},
+		},
+		{
+			name: "int8_quantization maps the configured range to int8",
+			args: args{
+				cfg: &config.TransformStep{Type: "int8_quantization", Min: 0, Max: 255},
+				vec: []float32{0, 128, 255},
+			},
+			want: want{
+				want: []float32{-128, 0, 127},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s, err := newStep(test.args.cfg)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			got, err := s.apply(test.args.vec)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+func Test_newStep_error(t *testing.T) {
+	type test struct {
+		name string
+		cfg  *config.TransformStep
+		want error
+	}
+	tests := []test{
+		{
+			name: "returns error when the step type is unknown",
+			cfg:  &config.TransformStep{Type: "pca"},
+			want: errors.ErrUnknownTransformStep("pca"),
+		},
+		{
+			name: "returns error when the quantization range is empty",
+			cfg:  &config.TransformStep{Type: "int8_quantization", Min: 1, Max: -1},
+			want: errors.ErrInvalidQuantizationRange(1, -1),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			_, err := newStep(test.cfg)
+			if !errors.Is(err, test.want) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want)
+			}
+		})
+	}
+}
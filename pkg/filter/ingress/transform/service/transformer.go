@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the vector transform steps
+package service
+
+import (
+	"reflect"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// Transformer represents the interface to transform a vector.
+type Transformer interface {
+	Transform(vec []float32) ([]float32, error)
+}
+
+type transformer struct {
+	steps []*step
+}
+
+// New returns the Transformer which applies the configured steps in order.
+func New(opts ...Option) (Transformer, error) {
+	t := new(transformer)
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(t); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	return t, nil
+}
+
+// Transform applies all steps to the vector and returns the result.
+// The input vector is not modified.
+func (t *transformer) Transform(vec []float32) (res []float32, err error) {
+	res = vec
+	for _, s := range t.steps {
+		res, err = s.apply(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the vector transform steps
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func Test_transformer_Transform(t *testing.T) {
+	type args struct {
+		vec []float32
+	}
+	type want struct {
+		want []float32
+		err  error
+	}
+	type test struct {
+		name  string
+		steps []*config.TransformStep
+		args  args
+		want  want
+	}
+	tests := []test{
+		{
+			name: "applies the steps in order",
+			steps: []*config.TransformStep{
+				{Type: "mean_centering"},
+				{Type: "l2_normalization"},
+				{Type: "int8_quantization"},
+			},
+			args: args{
+				vec: []float32{1, 3},
+			},
+			want: want{
+				want: []float32{-91, 90},
+			},
+		},
+		{
+			name: "returns the vector as it is when there is no step",
+			args: args{
+				vec: []float32{1, 3},
+			},
+			want: want{
+				want: []float32{1, 3},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			tr, err := New(WithSteps(test.steps...))
+			if err != nil {
+				tt.Fatal(err)
+			}
+			got, err := tr.Transform(test.args.vec)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(WithSteps(&config.TransformStep{Type: "pca"}))
+	if !errors.Is(err, errors.ErrUnknownTransformStep("pca")) {
+		t.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, errors.ErrUnknownTransformStep("pca"))
+	}
+}
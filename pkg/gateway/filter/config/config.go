@@ -25,6 +25,9 @@ import (
 type (
 	GlobalConfig = config.GlobalConfig
 	Server       = config.Server
+	FilterStage  = config.FilterStage
+	ObjectStore  = config.ObjectStore
+	FilterPlugin = config.FilterPlugin
 )
 
 // Config represent a application setting data content (config.yaml).
@@ -46,6 +49,9 @@ type Data struct {
 
 	// EgressFilters represent filter configuration for egress payload
 	EgressFilters *config.EgressFilter `json:"egress_filter" yaml:"egress_filter"`
+
+	// ObjectStore represent blob store configuration for raw objects
+	ObjectStore *config.ObjectStore `json:"object_store" yaml:"object_store"`
 }
 
 func NewConfig(path string) (cfg *Data, err error) {
@@ -93,6 +99,12 @@ func NewConfig(path string) (cfg *Data, err error) {
 		cfg.EgressFilters = new(config.EgressFilter).Bind()
 	}
 
+	if cfg.ObjectStore != nil {
+		cfg.ObjectStore = cfg.ObjectStore.Bind()
+	} else {
+		cfg.ObjectStore = new(config.ObjectStore).Bind()
+	}
+
 	return cfg, nil
 }
 
@@ -20,8 +20,10 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 
+	ingressgrpc "github.com/vdaas/vald/apis/grpc/v1/filter/ingress"
 	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/apis/grpc/v1/vald"
 	"github.com/vdaas/vald/internal/client/v1/client/filter/egress"
@@ -36,6 +38,8 @@ import (
 	"github.com/vdaas/vald/internal/net/grpc/codes"
 	"github.com/vdaas/vald/internal/net/grpc/status"
 	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/pkg/gateway/filter/service"
 )
 
 type server struct {
@@ -54,6 +58,19 @@ type server struct {
 	InsertFilters     []string
 	UpdateFilters     []string
 	UpsertFilters     []string
+
+	SearchResponseFilters []string
+	overFetchRatio        float64
+
+	searchPipeline   service.Pipeline
+	insertPipeline   service.Pipeline
+	updatePipeline   service.Pipeline
+	upsertPipeline   service.Pipeline
+	distancePipeline service.Pipeline
+	objectPipeline   service.Pipeline
+
+	objectStore service.ObjectStore
+
 	vald.UnimplementedValdServerWithFilter
 }
 
@@ -65,9 +82,139 @@ func New(opts ...Option) vald.ServerWithFilter {
 	for _, opt := range append(defaultOptions, opts...) {
 		opt(s)
 	}
+
+	// the filter targets are used as the pipeline stages when the pipeline is not configured.
+	if s.searchPipeline == nil {
+		s.searchPipeline = newPipeline("search", s.SearchFilters...)
+	}
+	if s.insertPipeline == nil {
+		s.insertPipeline = newPipeline("insert", s.InsertFilters...)
+	}
+	if s.updatePipeline == nil {
+		s.updatePipeline = newPipeline("update", s.UpdateFilters...)
+	}
+	if s.upsertPipeline == nil {
+		s.upsertPipeline = newPipeline("upsert", s.UpsertFilters...)
+	}
+	if s.distancePipeline == nil {
+		s.distancePipeline = newPipeline("distance", s.DistanceFilters...)
+	}
+	if s.objectPipeline == nil {
+		s.objectPipeline = newPipeline("object", s.ObjectFilters...)
+	}
 	return s
 }
 
+func newPipeline(name string, targets ...string) service.Pipeline {
+	p, err := service.NewPipeline(
+		service.WithPipelineName(name),
+		service.WithTargets(targets...),
+	)
+	if err != nil {
+		log.Warn(err)
+	}
+	return p
+}
+
+func filterTargets(targets []*payload.Filter_Target) []string {
+	if len(targets) == 0 {
+		return nil
+	}
+	addrs := make([]string, 0, len(targets))
+	for _, target := range targets {
+		addrs = append(addrs, fmt.Sprintf("%s:%d", target.GetHost(), target.GetPort()))
+	}
+	return addrs
+}
+
+// ingressFilterVector runs the ingress filter pipeline stages followed by the request targets on the vector.
+func (s *server) ingressFilterVector(ctx context.Context, p service.Pipeline, targets []string, vec *payload.Object_Vector) (*payload.Object_Vector, error) {
+	err := p.Run(ctx, func(ctx context.Context, st *service.Stage) error {
+		c, err := s.ingress.Target(ctx, st.Target)
+		if err != nil {
+			return err
+		}
+		return st.Do(ctx, func(ctx context.Context) error {
+			res, err := c.FilterVector(ctx, vec)
+			if err != nil {
+				return err
+			}
+			vec = res
+			return nil
+		})
+	}, targets...)
+	if err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
+// egressFilterVector runs the egress filter pipeline stages followed by the request targets on the vector.
+func (s *server) egressFilterVector(ctx context.Context, p service.Pipeline, targets []string, vec *payload.Object_Vector) (*payload.Object_Vector, error) {
+	err := p.Run(ctx, func(ctx context.Context, st *service.Stage) error {
+		c, err := s.egress.Target(ctx, st.Target)
+		if err != nil {
+			return err
+		}
+		return st.Do(ctx, func(ctx context.Context) error {
+			res, err := c.FilterVector(ctx, vec)
+			if err != nil {
+				return err
+			}
+			vec = res
+			return nil
+		})
+	}, targets...)
+	if err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
+// egressFilterDistance runs the egress filter pipeline stages followed by the request targets on the search results.
+// Each stage filters the results concurrently within the concurrency limit of the stage.
+// The results for which a filter returns NotFound are dropped.
+func (s *server) egressFilterDistance(ctx context.Context, p service.Pipeline, targets []string, results []*payload.Object_Distance) ([]*payload.Object_Distance, error) {
+	err := p.Run(ctx, func(ctx context.Context, st *service.Stage) error {
+		c, err := s.egress.Target(ctx, st.Target)
+		if err != nil {
+			return err
+		}
+		filtered := make([]*payload.Object_Distance, len(results))
+		eg, ectx := errgroup.New(ctx)
+		for i, dist := range results {
+			idx, d := i, dist
+			eg.Go(safety.RecoverFunc(func() error {
+				return st.Do(ectx, func(ctx context.Context) error {
+					res, err := c.FilterDistance(ctx, d)
+					if err != nil {
+						if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+							return nil
+						}
+						return errors.Wrapf(err, "failure on id %s", d.GetId())
+					}
+					filtered[idx] = res
+					return nil
+				})
+			}))
+		}
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+		results = filtered[:0]
+		for _, res := range filtered {
+			if res != nil {
+				results = append(results, res)
+			}
+		}
+		return nil
+	}, targets...)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 func (s *server) SearchObject(ctx context.Context, req *payload.Search_ObjectRequest) (*payload.Search_Response, error) {
 	ctx, span := trace.StartSpan(ctx, apiName+".SearchObject")
 	defer func() {
@@ -210,13 +357,21 @@ func (s *server) InsertObject(ctx context.Context, req *payload.Insert_ObjectReq
 	if err != nil {
 		return nil, status.WrapWithInternal("InsertObject API failed to extract vector from filter", err, req, info.Get())
 	}
-	return s.Insert(ctx, &payload.Insert_Request{
+	loc, err := s.Insert(ctx, &payload.Insert_Request{
 		Vector: &payload.Object_Vector{
 			Vector: vec.GetVector(),
 			Id:     req.GetObject().GetId(),
 		},
 		Config: req.GetConfig(),
 	})
+	if err != nil {
+		return nil, err
+	}
+	err = s.storeObject(ctx, "InsertObject", req.GetObject())
+	if err != nil {
+		return nil, err
+	}
+	return loc, nil
 }
 
 func (s *server) StreamInsertObject(stream vald.Filter_StreamInsertObjectServer) error {
@@ -259,6 +414,14 @@ func (s *server) MultiInsertObject(ctx context.Context, reqs *payload.Insert_Mul
 		}
 	}()
 
+	objs := make([]*payload.Object_Blob, 0, len(reqs.GetRequests()))
+	vrs := make([]*payload.Filter_Target, 0, len(reqs.GetRequests()))
+	for _, req := range reqs.GetRequests() {
+		objs = append(objs, req.GetObject())
+		vrs = append(vrs, req.GetVectorizer())
+	}
+	vecs, verrs := s.genVectors(ctx, "MultiInsertObject", objs, vrs)
+
 	locs = &payload.Object_Locations{
 		Locations: make([]*payload.Object_Location, len(reqs.GetRequests())),
 	}
@@ -269,7 +432,20 @@ func (s *server) MultiInsertObject(ctx context.Context, reqs *payload.Insert_Mul
 		wg.Add(1)
 		s.eg.Go(func() error {
 			defer wg.Done()
-			loc, err := s.InsertObject(ctx, query)
+			var loc *payload.Object_Location
+			err := verrs[idx]
+			if err == nil {
+				loc, err = s.Insert(ctx, &payload.Insert_Request{
+					Vector: &payload.Object_Vector{
+						Vector: vecs[idx].GetVector(),
+						Id:     query.GetObject().GetId(),
+					},
+					Config: query.GetConfig(),
+				})
+			}
+			if err == nil {
+				err = s.storeObject(ctx, "MultiInsertObject", query.GetObject())
+			}
 			if err != nil {
 				if span != nil {
 					span.SetStatus(trace.StatusCodeNotFound(err.Error()))
@@ -296,6 +472,91 @@ func (s *server) MultiInsertObject(ctx context.Context, reqs *payload.Insert_Mul
 	return locs, errs
 }
 
+// storeObject stores the raw object to the object store after its vector is written,
+// so that a failed write does not overwrite the object stored by the previous request.
+func (s *server) storeObject(ctx context.Context, api string, obj *payload.Object_Blob) error {
+	if s.objectStore == nil {
+		return nil
+	}
+	err := s.objectStore.Put(ctx, obj)
+	if err != nil {
+		return status.WrapWithInternal(api+" API failed to store the object", err, obj.GetId(), info.Get())
+	}
+	return nil
+}
+
+// genVectors vectorizes the objects with a single GenVectors call per vectorizer target.
+// It falls back to GenVector per object when the target filter does not implement GenVectors.
+// The returned vectors and errors have the same index as objs.
+func (s *server) genVectors(ctx context.Context, api string, objs []*payload.Object_Blob, vrs []*payload.Filter_Target) ([]*payload.Object_Vector, []error) {
+	vecs := make([]*payload.Object_Vector, len(objs))
+	errs := make([]error, len(objs))
+	targets := make(map[string][]int)
+	for i, vr := range vrs {
+		if vr == nil || vr.GetPort() == 0 {
+			errs[i] = status.WrapWithInvalidArgument(api+" API vectorizer configuration is invalid", errors.ErrFilterNotFound, info.Get())
+			continue
+		}
+		host := vr.GetHost()
+		if host == "" {
+			host = "localhost"
+		}
+		target := fmt.Sprintf("%s:%d", host, vr.GetPort())
+		targets[target] = append(targets[target], i)
+	}
+
+	eg, ectx := errgroup.New(ctx)
+	for target, idxs := range targets {
+		target, idxs := target, idxs
+		eg.Go(safety.RecoverFunc(func() error {
+			setErr := func(err error) {
+				for _, i := range idxs {
+					errs[i] = err
+				}
+			}
+			c, err := s.ingress.Target(ectx, target)
+			if err != nil {
+				setErr(status.WrapWithUnavailable(api+" API target filter API unavailable", err, info.Get()))
+				return nil
+			}
+			blobs := make([]*payload.Object_Blob, 0, len(idxs))
+			for _, i := range idxs {
+				blobs = append(blobs, objs[i])
+			}
+			res, err := c.GenVectors(ectx, &payload.Object_Blobs{
+				Blobs: blobs,
+			})
+			if err != nil {
+				st, ok := status.FromError(err)
+				if !ok || st.Code() != codes.Unimplemented {
+					setErr(status.WrapWithInternal(api+" API failed to extract vector from filter", err, info.Get()))
+					return nil
+				}
+				for _, i := range idxs {
+					vec, err := c.GenVector(ectx, objs[i])
+					if err != nil {
+						errs[i] = status.WrapWithInternal(api+" API failed to extract vector from filter", err, info.Get())
+						continue
+					}
+					vecs[i] = vec
+				}
+				return nil
+			}
+			if len(res.GetVectors()) != len(idxs) {
+				setErr(status.WrapWithInternal(api+" API failed to extract vector from filter",
+					errors.ErrFilterResultLength(len(res.GetVectors()), len(idxs)), info.Get()))
+				return nil
+			}
+			for j, i := range idxs {
+				vecs[i] = res.GetVectors()[j]
+			}
+			return nil
+		}))
+	}
+	_ = eg.Wait()
+	return vecs, errs
+}
+
 func (s *server) UpdateObject(ctx context.Context, req *payload.Update_ObjectRequest) (*payload.Object_Location, error) {
 	ctx, span := trace.StartSpan(ctx, apiName+".UpdateObject")
 	defer func() {
@@ -326,13 +587,21 @@ func (s *server) UpdateObject(ctx context.Context, req *payload.Update_ObjectReq
 	if err != nil {
 		return nil, status.WrapWithInternal("UpdateObject API failed to extract vector from filter", err, req, info.Get())
 	}
-	return s.Update(ctx, &payload.Update_Request{
+	loc, err := s.Update(ctx, &payload.Update_Request{
 		Vector: &payload.Object_Vector{
 			Vector: vec.GetVector(),
 			Id:     req.GetObject().GetId(),
 		},
 		Config: req.GetConfig(),
 	})
+	if err != nil {
+		return nil, err
+	}
+	err = s.storeObject(ctx, "UpdateObject", req.GetObject())
+	if err != nil {
+		return nil, err
+	}
+	return loc, nil
 }
 
 func (s *server) StreamUpdateObject(stream vald.Filter_StreamUpdateObjectServer) error {
@@ -440,13 +709,21 @@ func (s *server) UpsertObject(ctx context.Context, req *payload.Upsert_ObjectReq
 	if err != nil {
 		return nil, status.WrapWithInternal("UpsertObject API failed to extract vector from filter", err, req, info.Get())
 	}
-	return s.Upsert(ctx, &payload.Upsert_Request{
+	loc, err := s.Upsert(ctx, &payload.Upsert_Request{
 		Vector: &payload.Object_Vector{
 			Vector: vec.GetVector(),
 			Id:     req.GetObject().GetId(),
 		},
 		Config: req.GetConfig(),
 	})
+	if err != nil {
+		return nil, err
+	}
+	err = s.storeObject(ctx, "UpsertObject", req.GetObject())
+	if err != nil {
+		return nil, err
+	}
+	return loc, nil
 }
 
 func (s *server) StreamUpsertObject(stream vald.Filter_StreamUpsertObjectServer) error {
@@ -525,6 +802,121 @@ func (s *server) MultiUpsertObject(ctx context.Context, reqs *payload.Upsert_Mul
 	return locs, errs
 }
 
+// Revectorize re-vectorizes all the stored objects with the requested vectorizer and upserts the vectors.
+// The result of each object is sent to the stream and a failed object does not abort the others.
+func (s *server) Revectorize(req *payload.Filter_RevectorizeRequest, stream vald.Filter_RevectorizeServer) error {
+	ctx, span := trace.StartSpan(stream.Context(), apiName+".Revectorize")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	if s.objectStore == nil {
+		return status.WrapWithFailedPrecondition("Revectorize API object store is not enabled", errors.ErrObjectStoreNotEnabled, req, info.Get())
+	}
+	vr := req.GetVectorizer()
+	if vr == nil || vr.GetPort() == 0 {
+		return status.WrapWithInvalidArgument("Revectorize API vectorizer configuration is invalid", errors.ErrFilterNotFound, info.Get())
+	}
+	if vr.GetHost() == "" {
+		vr.Host = "localhost"
+	}
+	c, err := s.ingress.Target(ctx, fmt.Sprintf("%s:%d", vr.GetHost(), vr.GetPort()))
+	if err != nil {
+		return status.WrapWithUnavailable("Revectorize API target filter API unavailable", err, req, info.Get())
+	}
+
+	concurrency := s.streamConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	// the objects are processed in batches of the concurrency, so that the stored objects are not read ahead of the processing.
+	var (
+		mu    sync.Mutex
+		serr  error
+		batch = make([]*payload.Object_Blob, 0, concurrency)
+	)
+	flush := func() error {
+		eg, ectx := errgroup.New(ctx)
+		eg.Limitation(concurrency)
+		for _, obj := range batch {
+			obj := obj
+			eg.Go(safety.RecoverFunc(func() error {
+				var res *payload.Object_StreamLocation
+				loc, err := s.revectorize(ectx, c, obj, req.GetConfig())
+				if err != nil {
+					st, ok := status.FromError(err)
+					if !ok {
+						st = status.New(codes.Internal, errors.Wrap(err, "failed to parse grpc status from error").Error())
+					}
+					res = &payload.Object_StreamLocation{
+						Payload: &payload.Object_StreamLocation_Status{
+							Status: st.Proto(),
+						},
+					}
+				} else {
+					res = &payload.Object_StreamLocation{
+						Payload: &payload.Object_StreamLocation_Location{
+							Location: loc,
+						},
+					}
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				return stream.Send(res)
+			}))
+		}
+		batch = batch[:0]
+		serr = eg.Wait()
+		return serr
+	}
+	rerr := s.objectStore.Range(ctx, func(ctx context.Context, obj *payload.Object_Blob) error {
+		batch = append(batch, obj)
+		if len(batch) < concurrency {
+			return nil
+		}
+		return flush()
+	})
+	if rerr == nil && len(batch) != 0 {
+		rerr = flush()
+	}
+	if serr != nil {
+		if span != nil {
+			span.SetStatus(trace.StatusCodeUnavailable(serr.Error()))
+		}
+		return status.WrapWithUnavailable("Revectorize API failed to send the result", serr, info.Get())
+	}
+	if rerr != nil {
+		if span != nil {
+			span.SetStatus(trace.StatusCodeInternal(rerr.Error()))
+		}
+		return status.WrapWithInternal("Revectorize API failed to read the stored objects", rerr, info.Get())
+	}
+	return nil
+}
+
+// revectorize vectorizes the stored object and upserts the vector without storing the object again.
+func (s *server) revectorize(ctx context.Context, c ingressgrpc.FilterClient, obj *payload.Object_Blob, cfg *payload.Upsert_Config) (*payload.Object_Location, error) {
+	vec, err := c.GenVector(ctx, obj)
+	if err != nil {
+		return nil, status.WrapWithInternal("Revectorize API failed to extract vector from filter", err, obj.GetId(), info.Get())
+	}
+	return s.Upsert(ctx, &payload.Upsert_Request{
+		Vector: &payload.Object_Vector{
+			Vector: vec.GetVector(),
+			Id:     obj.GetId(),
+		},
+		// the config is copied for each object because Upsert modifies it,
+		// and the exist check is skipped because the stored objects are expected to be indexed already.
+		Config: &payload.Upsert_Config{
+			SkipStrictExistCheck: true,
+			Filters:              cfg.GetFilters(),
+			Timestamp:            cfg.GetTimestamp(),
+			Consistency:          cfg.GetConsistency(),
+		},
+	})
+}
+
 func (s *server) Exists(ctx context.Context, meta *payload.Object_ID) (*payload.Object_ID, error) {
 	ctx, span := trace.StartSpan(ctx, apiName+".Exists")
 	defer func() {
@@ -542,49 +934,39 @@ func (s *server) Search(ctx context.Context, req *payload.Search_Request) (res *
 			span.End()
 		}
 	}()
-	targets := req.GetConfig().GetIngressFilters().GetTargets()
-	if targets != nil || s.SearchFilters != nil {
-		addrs := make([]string, 0, len(targets)+len(s.SearchFilters))
-		addrs = append(addrs, s.SearchFilters...)
-		for _, target := range targets {
-			addrs = append(addrs, fmt.Sprintf("%s:%d", target.GetHost(), target.GetPort()))
-		}
-		c, err := s.ingress.Target(ctx, addrs...)
-		if err != nil {
-			return nil, status.WrapWithUnavailable(fmt.Sprintf("Search API ingress filter targets %v not found", addrs), err, info.Get())
-		}
-		vec, err := c.FilterVector(ctx, &payload.Object_Vector{
+	targets := filterTargets(req.GetConfig().GetIngressFilters().GetTargets())
+	if len(targets) != 0 || s.searchPipeline.Len() != 0 {
+		vec, err := s.ingressFilterVector(ctx, s.searchPipeline, targets, &payload.Object_Vector{
 			Vector: req.GetVector(),
 		})
 		if err != nil {
-			return nil, status.WrapWithInternal(fmt.Sprintf("Search API ingress filter request to %v failure on vec %v", addrs, req.GetVector()), err, info.Get())
+			return nil, status.WrapWithInternal(fmt.Sprintf("Search API ingress filter pipeline %s failure on vec %v", s.searchPipeline.Name(), req.GetVector()), err, info.Get())
 		}
 		req.Vector = vec.GetVector()
 	}
+	num := req.GetConfig().GetNum()
+	if n := s.overFetch(num); n != num {
+		req.GetConfig().Num = n
+	}
 	res, err = s.gateway.Search(ctx, req, s.copts...)
+	if req.GetConfig() != nil {
+		req.GetConfig().Num = num
+	}
 	if err != nil {
 		return nil, err
 	}
-	targets = req.GetConfig().GetEgressFilters().GetTargets()
-	if targets != nil || s.DistanceFilters != nil {
-		addrs := make([]string, 0, len(targets)+len(s.DistanceFilters))
-		addrs = append(addrs, s.DistanceFilters...)
-		for _, target := range targets {
-			addrs = append(addrs, fmt.Sprintf("%s:%d", target.GetHost(), target.GetPort()))
-		}
-		c, err := s.egress.Target(ctx, addrs...)
+	targets = filterTargets(req.GetConfig().GetEgressFilters().GetTargets())
+	if len(targets) != 0 || s.distancePipeline.Len() != 0 {
+		res.Results, err = s.egressFilterDistance(ctx, s.distancePipeline, targets, res.GetResults())
 		if err != nil {
-			return nil, status.WrapWithUnavailable(fmt.Sprintf("Search API egress filter targets %v not found", addrs), err, info.Get())
-		}
-		for i, dist := range res.GetResults() {
-			d, err := c.FilterDistance(ctx, dist)
-			if err != nil {
-				return nil, status.WrapWithInternal(fmt.Sprintf("Search API egress filter request to %v failure on id %s", addrs, dist.GetId()), err, info.Get())
-			}
-			res.Results[i] = d
+			return nil, status.WrapWithInternal(fmt.Sprintf("Search API egress filter pipeline %s failure", s.distancePipeline.Name()), err, info.Get())
 		}
 	}
-	return res, nil
+	return s.filterSearchResponse(ctx, "Search", &payload.Filter_SearchResponseRequest{
+		Vector:   req.GetVector(),
+		Config:   req.GetConfig(),
+		Response: res,
+	})
 }
 
 func (s *server) SearchByID(ctx context.Context, req *payload.Search_IDRequest) (res *payload.Search_Response, err error) {
@@ -594,29 +976,57 @@ func (s *server) SearchByID(ctx context.Context, req *payload.Search_IDRequest)
 			span.End()
 		}
 	}()
+	num := req.GetConfig().GetNum()
+	if n := s.overFetch(num); n != num {
+		req.GetConfig().Num = n
+	}
 	res, err = s.gateway.SearchByID(ctx, req, s.copts...)
+	if req.GetConfig() != nil {
+		req.GetConfig().Num = num
+	}
 	if err != nil {
 		return nil, err
 	}
-	targets := req.GetConfig().GetEgressFilters().GetTargets()
-	if targets != nil || s.DistanceFilters != nil {
-		addrs := make([]string, 0, len(targets)+len(s.DistanceFilters))
-		addrs = append(addrs, s.DistanceFilters...)
-		for _, target := range targets {
-			addrs = append(addrs, fmt.Sprintf("%s:%d", target.GetHost(), target.GetPort()))
+	targets := filterTargets(req.GetConfig().GetEgressFilters().GetTargets())
+	if len(targets) != 0 || s.distancePipeline.Len() != 0 {
+		res.Results, err = s.egressFilterDistance(ctx, s.distancePipeline, targets, res.GetResults())
+		if err != nil {
+			return nil, status.WrapWithInternal(fmt.Sprintf("SearchByID API egress filter pipeline %s failure", s.distancePipeline.Name()), err, info.Get())
 		}
-		c, err := s.egress.Target(ctx, addrs...)
+	}
+	return s.filterSearchResponse(ctx, "SearchByID", &payload.Filter_SearchResponseRequest{
+		Id:       req.GetId(),
+		Config:   req.GetConfig(),
+		Response: res,
+	})
+}
+
+// overFetch returns the number of results to request from the LB gateway,
+// so that enough results remain after the search response filters drop some of them.
+func (s *server) overFetch(num uint32) uint32 {
+	if len(s.SearchResponseFilters) == 0 || num == 0 || s.overFetchRatio <= 1 {
+		return num
+	}
+	return uint32(math.Ceil(float64(num) * s.overFetchRatio))
+}
+
+// filterSearchResponse passes the whole search response to the search response filters,
+// which may drop, re-score and re-order the results, and trims it to the requested number of results.
+func (s *server) filterSearchResponse(ctx context.Context, api string, req *payload.Filter_SearchResponseRequest) (res *payload.Search_Response, err error) {
+	res = req.GetResponse()
+	if len(s.SearchResponseFilters) != 0 {
+		c, err := s.egress.Target(ctx, s.SearchResponseFilters...)
 		if err != nil {
-			return nil, status.WrapWithUnavailable(fmt.Sprintf("SearchByID API egress filter targets %v not found", addrs), err, info.Get())
+			return nil, status.WrapWithUnavailable(fmt.Sprintf("%s API search response filter targets %v not found", api, s.SearchResponseFilters), err, info.Get())
 		}
-		for i, dist := range res.GetResults() {
-			d, err := c.FilterDistance(ctx, dist)
-			if err != nil {
-				return nil, status.WrapWithInternal(fmt.Sprintf("SearchByID API egress filter request to %v failure on id %s", addrs, dist.GetId()), err, info.Get())
-			}
-			res.Results[i] = d
+		res, err = c.FilterSearchResponse(ctx, req)
+		if err != nil {
+			return nil, status.WrapWithInternal(fmt.Sprintf("%s API search response filter request to %v failure", api, s.SearchResponseFilters), err, info.Get())
 		}
 	}
+	if num := int(req.GetConfig().GetNum()); num > 0 && len(res.GetResults()) > num {
+		res.Results = res.GetResults()[:num]
+	}
 	return res, nil
 }
 
@@ -830,23 +1240,14 @@ func (s *server) Insert(ctx context.Context, req *payload.Insert_Request) (loc *
 			req.Config = &payload.Insert_Config{SkipStrictExistCheck: true}
 		}
 	}
-	targets := req.GetConfig().GetFilters().GetTargets()
-	if len(targets) == 0 && len(s.InsertFilters) == 0 {
+	targets := filterTargets(req.GetConfig().GetFilters().GetTargets())
+	if len(targets) == 0 && s.insertPipeline.Len() == 0 {
 		return s.gateway.Insert(ctx, req)
 	}
-	addrs := make([]string, 0, len(targets)+len(s.InsertFilters))
-	addrs = append(addrs, s.InsertFilters...)
-	for _, target := range targets {
-		addrs = append(addrs, fmt.Sprintf("%s:%d", target.GetHost(), target.GetPort()))
-	}
-	c, err := s.ingress.Target(ctx, addrs...)
-	if err != nil {
-		return nil, status.WrapWithUnavailable(fmt.Sprintf("Insert API ingress filter targets %v not found", addrs), err, info.Get())
-	}
-	vec, err = c.FilterVector(ctx, req.GetVector())
+	vec, err = s.ingressFilterVector(ctx, s.insertPipeline, targets, req.GetVector())
 	if err != nil {
 		return nil, status.WrapWithInternal(
-			fmt.Sprintf("Insert API ingress filter request to %v failure on id: %s\tvec: %v", addrs, req.GetVector().GetId(), req.GetVector().GetVector()),
+			fmt.Sprintf("Insert API ingress filter pipeline %s failure on id: %s\tvec: %v", s.insertPipeline.Name(), req.GetVector().GetId(), req.GetVector().GetVector()),
 			err,
 			info.Get(),
 		)
@@ -994,23 +1395,14 @@ func (s *server) Update(ctx context.Context, req *payload.Update_Request) (loc *
 			req.Config = &payload.Update_Config{SkipStrictExistCheck: true}
 		}
 	}
-	targets := req.GetConfig().GetFilters().GetTargets()
-	if len(targets) == 0 && len(s.UpdateFilters) == 0 {
+	targets := filterTargets(req.GetConfig().GetFilters().GetTargets())
+	if len(targets) == 0 && s.updatePipeline.Len() == 0 {
 		return s.gateway.Update(ctx, req)
 	}
-	addrs := make([]string, 0, len(targets)+len(s.UpdateFilters))
-	addrs = append(addrs, s.UpdateFilters...)
-	for _, target := range targets {
-		addrs = append(addrs, fmt.Sprintf("%s:%d", target.GetHost(), target.GetPort()))
-	}
-	c, err := s.ingress.Target(ctx, addrs...)
-	if err != nil {
-		return nil, status.WrapWithUnavailable(fmt.Sprintf("Update API ingress filter targets %v not found", addrs), err, info.Get())
-	}
-	vec, err = c.FilterVector(ctx, req.GetVector())
+	vec, err = s.ingressFilterVector(ctx, s.updatePipeline, targets, req.GetVector())
 	if err != nil {
 		return nil, status.WrapWithInternal(
-			fmt.Sprintf("Update API ingress filter request to %v failure on id: %s\tvec: %v", addrs, req.GetVector().GetId(), req.GetVector().GetVector()),
+			fmt.Sprintf("Update API ingress filter pipeline %s failure on id: %s\tvec: %v", s.updatePipeline.Name(), req.GetVector().GetId(), req.GetVector().GetVector()),
 			err,
 			info.Get(),
 		)
@@ -1158,23 +1550,14 @@ func (s *server) Upsert(ctx context.Context, req *payload.Upsert_Request) (loc *
 			req.Config = &payload.Upsert_Config{SkipStrictExistCheck: true}
 		}
 	}
-	targets := req.GetConfig().GetFilters().GetTargets()
-	if len(targets) == 0 && len(s.UpsertFilters) == 0 {
+	targets := filterTargets(req.GetConfig().GetFilters().GetTargets())
+	if len(targets) == 0 && s.upsertPipeline.Len() == 0 {
 		return s.gateway.Upsert(ctx, req)
 	}
-	addrs := make([]string, 0, len(targets))
-	addrs = append(addrs, s.UpsertFilters...)
-	for _, target := range targets {
-		addrs = append(addrs, fmt.Sprintf("%s:%d", target.GetHost(), target.GetPort()))
-	}
-	c, err := s.ingress.Target(ctx, addrs...)
-	if err != nil {
-		return nil, status.WrapWithUnavailable(fmt.Sprintf("Upsert API ingress filter targets %v not found", addrs), err, info.Get())
-	}
-	vec, err = c.FilterVector(ctx, req.GetVector())
+	vec, err = s.ingressFilterVector(ctx, s.upsertPipeline, targets, req.GetVector())
 	if err != nil {
 		return nil, status.WrapWithInternal(
-			fmt.Sprintf("Upsert API ingress filter request to %v failure on id: %s\tvec: %v", addrs, req.GetVector().GetId(), req.GetVector().GetVector()),
+			fmt.Sprintf("Upsert API ingress filter pipeline %s failure on id: %s\tvec: %v", s.upsertPipeline.Name(), req.GetVector().GetId(), req.GetVector().GetVector()),
 			err,
 			info.Get(),
 		)
@@ -1296,7 +1679,18 @@ func (s *server) Remove(ctx context.Context, req *payload.Remove_Request) (loc *
 			span.End()
 		}
 	}()
-	return s.gateway.Remove(ctx, req, s.copts...)
+	loc, err = s.gateway.Remove(ctx, req, s.copts...)
+	if err != nil {
+		return nil, err
+	}
+	// the stored object is deleted not to be re-vectorized after the removal.
+	if s.objectStore != nil {
+		err = s.objectStore.Delete(ctx, req.GetId().GetId())
+		if err != nil {
+			return nil, status.WrapWithInternal("Remove API failed to delete the stored object", err, req, info.Get())
+		}
+	}
+	return loc, nil
 }
 
 func (s *server) StreamRemove(stream vald.Remove_StreamRemoveServer) (err error) {
@@ -1407,20 +1801,11 @@ func (s *server) GetObject(ctx context.Context, req *payload.Object_VectorReques
 		}
 		return nil, status.WrapWithNotFound(fmt.Sprintf("GetObject API uuid %s Object not found", req.GetId().GetId()), err, info.Get())
 	}
-	targets := req.GetFilters().GetTargets()
-	if targets != nil || s.ObjectFilters != nil {
-		addrs := make([]string, 0, len(targets)+len(s.ObjectFilters))
-		addrs = append(addrs, s.ObjectFilters...)
-		for _, target := range targets {
-			addrs = append(addrs, fmt.Sprintf("%s:%d", target.GetHost(), target.GetPort()))
-		}
-		c, err := s.egress.Target(ctx, addrs...)
-		if err != nil {
-			return nil, status.WrapWithUnavailable(fmt.Sprintf("GetObject API egress filter targets %v not found on id %s", addrs, req.GetId().GetId()), err, info.Get())
-		}
-		vec, err = c.FilterVector(ctx, vec)
+	targets := filterTargets(req.GetFilters().GetTargets())
+	if len(targets) != 0 || s.objectPipeline.Len() != 0 {
+		vec, err = s.egressFilterVector(ctx, s.objectPipeline, targets, vec)
 		if err != nil {
-			return nil, status.WrapWithInternal(fmt.Sprintf("GetObject API egress filter request to %v failure on id %s", addrs, req.GetId().GetId()), err, info.Get())
+			return nil, status.WrapWithInternal(fmt.Sprintf("GetObject API egress filter pipeline %s failure on id %s", s.objectPipeline.Name(), req.GetId().GetId()), err, info.Get())
 		}
 	}
 	return vec, nil
@@ -1475,3 +1860,59 @@ func (s *server) StreamGetObject(stream vald.Object_StreamGetObjectServer) (err
 	}
 	return nil
 }
+
+func (s *server) MultiGetObject(ctx context.Context, reqs *payload.Object_MultiVectorRequest) (vecs *payload.Object_Vectors, errs error) {
+	ctx, span := trace.StartSpan(ctx, apiName+".MultiGetObject")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	vecs = &payload.Object_Vectors{
+		Vectors: make([]*payload.Object_Vector, len(reqs.GetRequests())),
+	}
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		code  codes.Code
+		fails int
+	)
+	for i, req := range reqs.Requests {
+		idx, query := i, req
+		wg.Add(1)
+		s.eg.Go(func() error {
+			defer wg.Done()
+			// GetObject applies the same egress filter pipeline as the single request.
+			r, err := s.GetObject(ctx, query)
+			if err != nil {
+				st, msg, err := status.ParseError(err, codes.Internal,
+					fmt.Sprintf("MultiGetObject API uuid %s Object could not be fetched", query.GetId().GetId()))
+				if span != nil {
+					span.SetStatus(trace.FromGRPCStatus(st.Code(), msg))
+				}
+				mu.Lock()
+				switch {
+				case errs == nil:
+					errs = err
+					code = st.Code()
+				default:
+					errs = errors.Wrap(errs, err.Error())
+					if code != st.Code() {
+						code = codes.Internal
+					}
+				}
+				fails++
+				mu.Unlock()
+				return nil
+			}
+			vecs.Vectors[idx] = r
+			return nil
+		})
+	}
+	wg.Wait()
+	if fails > 1 {
+		// keep the status code of the children when they agree, otherwise report Internal.
+		errs = status.Error(code, errs.Error())
+	}
+	return vecs, errs
+}
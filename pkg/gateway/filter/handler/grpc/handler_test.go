@@ -19,20 +19,35 @@ package grpc
 
 import (
 	"context"
+	"os"
 	"reflect"
+	"sort"
+	"sync"
 	"testing"
 
+	egressgrpc "github.com/vdaas/vald/apis/grpc/v1/filter/egress"
+	ingressgrpc "github.com/vdaas/vald/apis/grpc/v1/filter/ingress"
 	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/apis/grpc/v1/vald"
 	"github.com/vdaas/vald/internal/client/v1/client/filter/egress"
 	"github.com/vdaas/vald/internal/client/v1/client/filter/ingress"
 	client "github.com/vdaas/vald/internal/client/v1/client/vald"
+	"github.com/vdaas/vald/internal/config"
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
 	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
 	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/gateway/filter/service"
 )
 
+func TestMain(m *testing.M) {
+	info.Init("")
+	os.Exit(m.Run())
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -4945,3 +4960,964 @@ func Test_server_StreamGetObject(t *testing.T) {
 		})
 	}
 }
+
+func Test_server_overFetch(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		num uint32
+	}
+	type fields struct {
+		SearchResponseFilters []string
+		overFetchRatio        float64
+	}
+	type want struct {
+		want uint32
+	}
+	type test struct {
+		name   string
+		args   args
+		fields fields
+		want   want
+	}
+	tests := []test{
+		{
+			name: "returns num when search response filters are not set",
+			args: args{
+				num: 10,
+			},
+			fields: fields{
+				overFetchRatio: 2,
+			},
+			want: want{
+				want: 10,
+			},
+		},
+		{
+			name: "returns num multiplied by the ratio when search response filters are set",
+			args: args{
+				num: 10,
+			},
+			fields: fields{
+				SearchResponseFilters: []string{"filter"},
+				overFetchRatio:        2,
+			},
+			want: want{
+				want: 20,
+			},
+		},
+		{
+			name: "returns the ceiling of num multiplied by the ratio",
+			args: args{
+				num: 3,
+			},
+			fields: fields{
+				SearchResponseFilters: []string{"filter"},
+				overFetchRatio:        1.5,
+			},
+			want: want{
+				want: 5,
+			},
+		},
+		{
+			name: "returns num when the ratio is less than 1",
+			args: args{
+				num: 10,
+			},
+			fields: fields{
+				SearchResponseFilters: []string{"filter"},
+				overFetchRatio:        0.5,
+			},
+			want: want{
+				want: 10,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			s := &server{
+				SearchResponseFilters: test.fields.SearchResponseFilters,
+				overFetchRatio:        test.fields.overFetchRatio,
+			}
+			if got := s.overFetch(test.args.num); got != test.want.want {
+				tt.Errorf("got: %d, want: %d", got, test.want.want)
+			}
+		})
+	}
+}
+
+func Test_server_filterSearchResponse(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		req *payload.Filter_SearchResponseRequest
+	}
+	type want struct {
+		want *payload.Search_Response
+		err  error
+	}
+	type test struct {
+		name string
+		args args
+		want want
+	}
+	results := []*payload.Object_Distance{
+		{Id: "a", Distance: 0.1},
+		{Id: "b", Distance: 0.2},
+		{Id: "c", Distance: 0.3},
+	}
+	tests := []test{
+		{
+			name: "returns the response trimmed to the requested number of results",
+			args: args{
+				req: &payload.Filter_SearchResponseRequest{
+					Config: &payload.Search_Config{
+						Num: 2,
+					},
+					Response: &payload.Search_Response{
+						RequestId: "req",
+						Results:   results,
+					},
+				},
+			},
+			want: want{
+				want: &payload.Search_Response{
+					RequestId: "req",
+					Results:   results[:2],
+				},
+			},
+		},
+		{
+			name: "returns the response as is when it has fewer results than requested",
+			args: args{
+				req: &payload.Filter_SearchResponseRequest{
+					Config: &payload.Search_Config{
+						Num: 5,
+					},
+					Response: &payload.Search_Response{
+						RequestId: "req",
+						Results:   results,
+					},
+				},
+			},
+			want: want{
+				want: &payload.Search_Response{
+					RequestId: "req",
+					Results:   results,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := new(server)
+			got, err := s.filterSearchResponse(context.Background(), "Search", test.args.req)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+type egressClientMock struct {
+	egress.Client
+	filters map[string]egressgrpc.FilterClient
+}
+
+func (m *egressClientMock) Target(ctx context.Context, targets ...string) (egressgrpc.FilterClient, error) {
+	if c, ok := m.filters[targets[0]]; ok {
+		return c, nil
+	}
+	return nil, errors.ErrTargetFilterNotFound(targets[0])
+}
+
+type egressFilterMock struct {
+	egressgrpc.FilterClient
+	filterDistanceFunc func(*payload.Object_Distance) (*payload.Object_Distance, error)
+	filterVectorFunc   func(*payload.Object_Vector) (*payload.Object_Vector, error)
+}
+
+func (m *egressFilterMock) FilterDistance(ctx context.Context, in *payload.Object_Distance, opts ...grpc.CallOption) (*payload.Object_Distance, error) {
+	return m.filterDistanceFunc(in)
+}
+
+func (m *egressFilterMock) FilterVector(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (*payload.Object_Vector, error) {
+	return m.filterVectorFunc(in)
+}
+
+func Test_server_egressFilterDistance(t *testing.T) {
+	t.Parallel()
+	errFilter := errors.New("filter error")
+	addOne := &egressFilterMock{
+		filterDistanceFunc: func(d *payload.Object_Distance) (*payload.Object_Distance, error) {
+			return &payload.Object_Distance{Id: d.GetId(), Distance: d.GetDistance() + 1}, nil
+		},
+	}
+	double := &egressFilterMock{
+		filterDistanceFunc: func(d *payload.Object_Distance) (*payload.Object_Distance, error) {
+			return &payload.Object_Distance{Id: d.GetId(), Distance: d.GetDistance() * 2}, nil
+		},
+	}
+	failure := &egressFilterMock{
+		filterDistanceFunc: func(d *payload.Object_Distance) (*payload.Object_Distance, error) {
+			return nil, errFilter
+		},
+	}
+	dropOdd := &egressFilterMock{
+		filterDistanceFunc: func(d *payload.Object_Distance) (*payload.Object_Distance, error) {
+			if int(d.GetDistance())%2 == 1 {
+				return nil, status.WrapWithNotFound("not found", errors.ErrObjectIDNotFound(d.GetId()))
+			}
+			return d, nil
+		},
+	}
+	filters := map[string]egressgrpc.FilterClient{
+		"add:8081":      addOne,
+		"double:8081":   double,
+		"failure:8081":  failure,
+		"drop-odd:8081": dropOdd,
+	}
+	type args struct {
+		targets []string
+		results []*payload.Object_Distance
+	}
+	type want struct {
+		want []*payload.Object_Distance
+		err  error
+	}
+	type test struct {
+		name   string
+		stages []*config.FilterStage
+		args   args
+		want   want
+	}
+	tests := []test{
+		{
+			name: "return the results filtered by the stages in order followed by the request targets",
+			stages: []*config.FilterStage{
+				{Target: "add:8081"},
+				{Target: "double:8081"},
+			},
+			args: args{
+				targets: []string{"add:8081"},
+				results: []*payload.Object_Distance{
+					{Id: "a", Distance: 1},
+					{Id: "b", Distance: 2},
+				},
+			},
+			want: want{
+				want: []*payload.Object_Distance{
+					{Id: "a", Distance: 5},
+					{Id: "b", Distance: 7},
+				},
+			},
+		},
+		{
+			name: "return the results filtered by the other stages when the failed stage is skippable",
+			stages: []*config.FilterStage{
+				{Target: "failure:8081", OnFailure: "skip"},
+				{Target: "double:8081", Concurrency: 1},
+			},
+			args: args{
+				results: []*payload.Object_Distance{
+					{Id: "a", Distance: 1},
+					{Id: "b", Distance: 2},
+				},
+			},
+			want: want{
+				want: []*payload.Object_Distance{
+					{Id: "a", Distance: 2},
+					{Id: "b", Distance: 4},
+				},
+			},
+		},
+		{
+			name: "drop the results for which a stage returns NotFound",
+			stages: []*config.FilterStage{
+				{Target: "drop-odd:8081"},
+				{Target: "double:8081"},
+			},
+			args: args{
+				results: []*payload.Object_Distance{
+					{Id: "a", Distance: 1},
+					{Id: "b", Distance: 2},
+					{Id: "c", Distance: 3},
+					{Id: "d", Distance: 4},
+				},
+			},
+			want: want{
+				want: []*payload.Object_Distance{
+					{Id: "b", Distance: 4},
+					{Id: "d", Distance: 8},
+				},
+			},
+		},
+		{
+			name: "return an error when the failed stage is not skippable",
+			stages: []*config.FilterStage{
+				{Target: "failure:8081"},
+				{Target: "double:8081"},
+			},
+			args: args{
+				results: []*payload.Object_Distance{
+					{Id: "a", Distance: 1},
+				},
+			},
+			want: want{
+				err: errFilter,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			p, err := service.NewPipeline(
+				service.WithPipelineName("distance"),
+				service.WithStages(test.stages...),
+			)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			s := &server{
+				egress: &egressClientMock{
+					filters: filters,
+				},
+			}
+			got, err := s.egressFilterDistance(context.Background(), p, test.args.targets, test.args.results)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+type ingressClientMock struct {
+	ingress.Client
+	filters map[string]ingressgrpc.FilterClient
+}
+
+func (m *ingressClientMock) Target(ctx context.Context, targets ...string) (ingressgrpc.FilterClient, error) {
+	if c, ok := m.filters[targets[0]]; ok {
+		return c, nil
+	}
+	return nil, errors.ErrTargetFilterNotFound(targets[0])
+}
+
+type ingressFilterMock struct {
+	ingressgrpc.FilterClient
+	genVectorFunc  func(*payload.Object_Blob) (*payload.Object_Vector, error)
+	genVectorsFunc func(*payload.Object_Blobs) (*payload.Object_Vectors, error)
+}
+
+func (m *ingressFilterMock) GenVector(ctx context.Context, in *payload.Object_Blob, opts ...grpc.CallOption) (*payload.Object_Vector, error) {
+	return m.genVectorFunc(in)
+}
+
+func (m *ingressFilterMock) GenVectors(ctx context.Context, in *payload.Object_Blobs, opts ...grpc.CallOption) (*payload.Object_Vectors, error) {
+	return m.genVectorsFunc(in)
+}
+
+func Test_server_genVectors(t *testing.T) {
+	t.Parallel()
+	vectorize := func(in *payload.Object_Blob) *payload.Object_Vector {
+		return &payload.Object_Vector{
+			Id:     in.GetId(),
+			Vector: []float32{float32(len(in.GetObject())), 1},
+		}
+	}
+	var batched int
+	batch := &ingressFilterMock{
+		genVectorsFunc: func(in *payload.Object_Blobs) (*payload.Object_Vectors, error) {
+			batched++
+			vecs := make([]*payload.Object_Vector, 0, len(in.GetBlobs()))
+			for _, blob := range in.GetBlobs() {
+				vecs = append(vecs, vectorize(blob))
+			}
+			return &payload.Object_Vectors{
+				Vectors: vecs,
+			}, nil
+		},
+	}
+	legacy := &ingressFilterMock{
+		genVectorsFunc: func(in *payload.Object_Blobs) (*payload.Object_Vectors, error) {
+			return nil, status.WrapWithUnimplemented("GenVectors is not implemented", errors.ErrFilterNotFound)
+		},
+		genVectorFunc: func(in *payload.Object_Blob) (*payload.Object_Vector, error) {
+			return vectorize(in), nil
+		},
+	}
+	short := &ingressFilterMock{
+		genVectorsFunc: func(in *payload.Object_Blobs) (*payload.Object_Vectors, error) {
+			return new(payload.Object_Vectors), nil
+		},
+	}
+	filters := map[string]ingressgrpc.FilterClient{
+		"batch:8081":  batch,
+		"legacy:8081": legacy,
+		"short:8081":  short,
+	}
+	objs := []*payload.Object_Blob{
+		{
+			Id:     "a",
+			Object: []byte("a"),
+		},
+		{
+			Id:     "bb",
+			Object: []byte("bb"),
+		},
+		{
+			Id:     "ccc",
+			Object: []byte("ccc"),
+		},
+	}
+	type args struct {
+		vrs []*payload.Filter_Target
+	}
+	type want struct {
+		want    []*payload.Object_Vector
+		errs    []bool
+		batched int
+	}
+	type test struct {
+		name string
+		args args
+		want want
+	}
+	tests := []test{
+		{
+			name: "vectorizes objects of the same target with a single GenVectors call",
+			args: args{
+				vrs: []*payload.Filter_Target{
+					{Host: "batch", Port: 8081},
+					{Host: "batch", Port: 8081},
+					{Host: "batch", Port: 8081},
+				},
+			},
+			want: want{
+				want:    []*payload.Object_Vector{vectorize(objs[0]), vectorize(objs[1]), vectorize(objs[2])},
+				errs:    []bool{false, false, false},
+				batched: 1,
+			},
+		},
+		{
+			name: "falls back to GenVector when GenVectors is unimplemented",
+			args: args{
+				vrs: []*payload.Filter_Target{
+					{Host: "legacy", Port: 8081},
+					{Host: "batch", Port: 8081},
+					{Host: "legacy", Port: 8081},
+				},
+			},
+			want: want{
+				want:    []*payload.Object_Vector{vectorize(objs[0]), vectorize(objs[1]), vectorize(objs[2])},
+				errs:    []bool{false, false, false},
+				batched: 1,
+			},
+		},
+		{
+			name: "returns errors for invalid vectorizer and mismatched results",
+			args: args{
+				vrs: []*payload.Filter_Target{
+					nil,
+					{Host: "short", Port: 8081},
+					{Host: "unknown", Port: 8081},
+				},
+			},
+			want: want{
+				want: make([]*payload.Object_Vector, 3),
+				errs: []bool{true, true, true},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			batched = 0
+			s := &server{
+				ingress: &ingressClientMock{
+					filters: filters,
+				},
+			}
+			got, errs := s.genVectors(context.Background(), "MultiInsertObject", objs, test.args.vrs)
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+			for i, err := range errs {
+				if (err != nil) != test.want.errs[i] {
+					tt.Errorf("index %d got_error: %v, want error: %v", i, err, test.want.errs[i])
+				}
+			}
+			if batched != test.want.batched {
+				tt.Errorf("got GenVectors calls: %d, want: %d", batched, test.want.batched)
+			}
+		})
+	}
+}
+
+type gatewayClientMock struct {
+	client.Client
+	getObjectFunc func(*payload.Object_VectorRequest) (*payload.Object_Vector, error)
+}
+
+func (m *gatewayClientMock) GetObject(ctx context.Context, in *payload.Object_VectorRequest, opts ...grpc.CallOption) (*payload.Object_Vector, error) {
+	return m.getObjectFunc(in)
+}
+
+func Test_server_MultiGetObject(t *testing.T) {
+	t.Parallel()
+	errFilter := errors.New("filter error")
+	gateway := &gatewayClientMock{
+		getObjectFunc: func(req *payload.Object_VectorRequest) (*payload.Object_Vector, error) {
+			switch id := req.GetId().GetId(); id {
+			case "a":
+				return &payload.Object_Vector{Id: id, Vector: []float32{1, 2}}, nil
+			case "b":
+				return &payload.Object_Vector{Id: id, Vector: []float32{3, 4}}, nil
+			}
+			return nil, errors.ErrObjectNotFound(nil, req.GetId().GetId())
+		},
+	}
+	filters := map[string]egressgrpc.FilterClient{
+		"double:8081": &egressFilterMock{
+			filterVectorFunc: func(v *payload.Object_Vector) (*payload.Object_Vector, error) {
+				vec := make([]float32, 0, len(v.GetVector()))
+				for _, f := range v.GetVector() {
+					vec = append(vec, f*2)
+				}
+				return &payload.Object_Vector{Id: v.GetId(), Vector: vec}, nil
+			},
+		},
+		"failure:8081": &egressFilterMock{
+			filterVectorFunc: func(v *payload.Object_Vector) (*payload.Object_Vector, error) {
+				return nil, errFilter
+			},
+		},
+	}
+	request := func(id string, targets ...*payload.Filter_Target) *payload.Object_VectorRequest {
+		req := &payload.Object_VectorRequest{
+			Id: &payload.Object_ID{Id: id},
+		}
+		if len(targets) != 0 {
+			req.Filters = &payload.Filter_Config{Targets: targets}
+		}
+		return req
+	}
+	type want struct {
+		want *payload.Object_Vectors
+		code codes.Code
+		err  bool
+	}
+	type test struct {
+		name   string
+		stages []*config.FilterStage
+		reqs   *payload.Object_MultiVectorRequest
+		want   want
+	}
+	tests := []test{
+		{
+			name: "return the vectors filtered by the object pipeline followed by the request targets",
+			stages: []*config.FilterStage{
+				{Target: "double:8081"},
+			},
+			reqs: &payload.Object_MultiVectorRequest{
+				Requests: []*payload.Object_VectorRequest{
+					request("a"),
+					request("b", &payload.Filter_Target{Host: "double", Port: 8081}),
+				},
+			},
+			want: want{
+				want: &payload.Object_Vectors{
+					Vectors: []*payload.Object_Vector{
+						{Id: "a", Vector: []float32{2, 4}},
+						{Id: "b", Vector: []float32{12, 16}},
+					},
+				},
+			},
+		},
+		{
+			name: "return the found vectors and an error when some objects are not found",
+			reqs: &payload.Object_MultiVectorRequest{
+				Requests: []*payload.Object_VectorRequest{
+					request("a"),
+					request("c"),
+				},
+			},
+			want: want{
+				want: &payload.Object_Vectors{
+					Vectors: []*payload.Object_Vector{
+						{Id: "a", Vector: []float32{1, 2}},
+						nil,
+					},
+				},
+				code: codes.NotFound,
+				err:  true,
+			},
+		},
+		{
+			name: "return NotFound error when every object is not found",
+			reqs: &payload.Object_MultiVectorRequest{
+				Requests: []*payload.Object_VectorRequest{
+					request("c"),
+					request("d"),
+				},
+			},
+			want: want{
+				want: &payload.Object_Vectors{
+					Vectors: []*payload.Object_Vector{
+						nil,
+						nil,
+					},
+				},
+				code: codes.NotFound,
+				err:  true,
+			},
+		},
+		{
+			name: "return an error when the egress filter fails",
+			stages: []*config.FilterStage{
+				{Target: "failure:8081"},
+			},
+			reqs: &payload.Object_MultiVectorRequest{
+				Requests: []*payload.Object_VectorRequest{
+					request("a"),
+				},
+			},
+			want: want{
+				want: &payload.Object_Vectors{
+					Vectors: []*payload.Object_Vector{
+						nil,
+					},
+				},
+				code: codes.Internal,
+				err:  true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			p, err := service.NewPipeline(
+				service.WithPipelineName("object"),
+				service.WithStages(test.stages...),
+			)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			eg, _ := errgroup.New(context.Background())
+			s := &server{
+				eg:      eg,
+				gateway: gateway,
+				egress: &egressClientMock{
+					filters: filters,
+				},
+				objectPipeline: p,
+			}
+			got, err := s.MultiGetObject(context.Background(), test.reqs)
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v, want error: %v", err, test.want.err)
+			}
+			if st, ok := status.FromError(err); err != nil && (!ok || st.Code() != test.want.code) {
+				tt.Errorf("got_error: %v, want code: %s", err, test.want.code)
+			}
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+		})
+	}
+}
+
+type objectStoreMock struct {
+	service.ObjectStore
+	mu   sync.Mutex
+	objs map[string][]byte
+}
+
+func (m *objectStoreMock) Put(ctx context.Context, obj *payload.Object_Blob) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objs[obj.GetId()] = obj.GetObject()
+	return nil
+}
+
+func (m *objectStoreMock) Delete(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objs, id)
+	return nil
+}
+
+func (m *objectStoreMock) Range(ctx context.Context, f func(ctx context.Context, obj *payload.Object_Blob) error) error {
+	m.mu.Lock()
+	ids := make([]string, 0, len(m.objs))
+	for id := range m.objs {
+		ids = append(ids, id)
+	}
+	m.mu.Unlock()
+	sort.Strings(ids)
+	for _, id := range ids {
+		m.mu.Lock()
+		obj := m.objs[id]
+		m.mu.Unlock()
+		if err := f(ctx, &payload.Object_Blob{Id: id, Object: obj}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type valdWriterClientMock struct {
+	client.Client
+	upsertFunc func(*payload.Upsert_Request) (*payload.Object_Location, error)
+	removeFunc func(*payload.Remove_Request) (*payload.Object_Location, error)
+}
+
+func (m *valdWriterClientMock) Upsert(ctx context.Context, in *payload.Upsert_Request, opts ...grpc.CallOption) (*payload.Object_Location, error) {
+	return m.upsertFunc(in)
+}
+
+func (m *valdWriterClientMock) Remove(ctx context.Context, in *payload.Remove_Request, opts ...grpc.CallOption) (*payload.Object_Location, error) {
+	return m.removeFunc(in)
+}
+
+type revectorizeServerMock struct {
+	vald.Filter_RevectorizeServer
+	ctx  context.Context
+	mu   sync.Mutex
+	sent []*payload.Object_StreamLocation
+}
+
+func (m *revectorizeServerMock) Context() context.Context {
+	return m.ctx
+}
+
+func (m *revectorizeServerMock) Send(res *payload.Object_StreamLocation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, res)
+	return nil
+}
+
+func newObjectStoreTestServer(store service.ObjectStore) *server {
+	eg, _ := errgroup.New(context.Background())
+	return &server{
+		eg: eg,
+		gateway: &valdWriterClientMock{
+			upsertFunc: func(req *payload.Upsert_Request) (*payload.Object_Location, error) {
+				if len(req.GetVector().GetVector()) == 0 || req.GetVector().GetVector()[0] < 0 {
+					return nil, errors.New("upsert error")
+				}
+				return &payload.Object_Location{Uuid: req.GetVector().GetId()}, nil
+			},
+			removeFunc: func(req *payload.Remove_Request) (*payload.Object_Location, error) {
+				if req.GetId().GetId() == "missing" {
+					return nil, errors.ErrObjectNotFound(nil, req.GetId().GetId())
+				}
+				return &payload.Object_Location{Uuid: req.GetId().GetId()}, nil
+			},
+		},
+		ingress: &ingressClientMock{
+			filters: map[string]ingressgrpc.FilterClient{
+				"vectorizer:8081": &ingressFilterMock{
+					genVectorFunc: func(obj *payload.Object_Blob) (*payload.Object_Vector, error) {
+						if string(obj.GetObject()) == "broken" {
+							return nil, errors.New("vectorize error")
+						}
+						return &payload.Object_Vector{Vector: []float32{float32(len(obj.GetObject())), 1}}, nil
+					},
+				},
+			},
+		},
+		upsertPipeline:    newPipeline("upsert"),
+		streamConcurrency: 2,
+		objectStore:       store,
+	}
+}
+
+func Test_server_objectStore(t *testing.T) {
+	t.Parallel()
+	vectorizer := &payload.Filter_Target{Host: "vectorizer", Port: 8081}
+	store := &objectStoreMock{
+		objs: map[string][]byte{
+			"b": []byte("stored"),
+		},
+	}
+	s := newObjectStoreTestServer(store)
+	ctx := context.Background()
+
+	_, err := s.UpsertObject(ctx, &payload.Upsert_ObjectRequest{
+		Object: &payload.Object_Blob{Id: "a", Object: []byte("new")},
+		Config: &payload.Upsert_Config{
+			SkipStrictExistCheck: true,
+		},
+		Vectorizer: vectorizer,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.UpsertObject(ctx, &payload.Upsert_ObjectRequest{
+		Object: &payload.Object_Blob{Id: "b", Object: []byte("broken")},
+		Config: &payload.Upsert_Config{
+			SkipStrictExistCheck: true,
+		},
+		Vectorizer: vectorizer,
+	})
+	if err == nil {
+		t.Error("the failed upsert returned no error")
+	}
+	_, err = s.Remove(ctx, &payload.Remove_Request{
+		Id: &payload.Object_ID{Id: "missing"},
+	})
+	if err == nil {
+		t.Error("the failed remove returned no error")
+	}
+
+	want := map[string][]byte{
+		"a": []byte("new"),
+		"b": []byte("stored"),
+	}
+	if !reflect.DeepEqual(store.objs, want) {
+		t.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", store.objs, want)
+	}
+
+	_, err = s.Remove(ctx, &payload.Remove_Request{
+		Id: &payload.Object_ID{Id: "b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.objs["b"]; ok {
+		t.Error("the removed object is not deleted from the object store")
+	}
+}
+
+func Test_server_Revectorize(t *testing.T) {
+	t.Parallel()
+	type want struct {
+		uuids    []string
+		failures int
+		err      bool
+	}
+	type test struct {
+		name  string
+		store service.ObjectStore
+		req   *payload.Filter_RevectorizeRequest
+		want  want
+	}
+	tests := []test{
+		{
+			name: "return an error when the object store is not enabled",
+			req: &payload.Filter_RevectorizeRequest{
+				Vectorizer: &payload.Filter_Target{Host: "vectorizer", Port: 8081},
+			},
+			want: want{
+				err: true,
+			},
+		},
+		{
+			name: "return an error when the vectorizer is invalid",
+			store: &objectStoreMock{
+				objs: map[string][]byte{},
+			},
+			req: &payload.Filter_RevectorizeRequest{},
+			want: want{
+				err: true,
+			},
+		},
+		{
+			name: "return an error when the vectorizer is unavailable",
+			store: &objectStoreMock{
+				objs: map[string][]byte{},
+			},
+			req: &payload.Filter_RevectorizeRequest{
+				Vectorizer: &payload.Filter_Target{Host: "unknown", Port: 8081},
+			},
+			want: want{
+				err: true,
+			},
+		},
+		{
+			name: "send the location of each upserted object and the status of each failed object",
+			store: &objectStoreMock{
+				objs: map[string][]byte{
+					"a": []byte("a"),
+					"b": []byte("bb"),
+					"c": []byte("broken"),
+					"d": []byte("dddd"),
+				},
+			},
+			req: &payload.Filter_RevectorizeRequest{
+				Vectorizer: &payload.Filter_Target{Host: "vectorizer", Port: 8081},
+				Config: &payload.Upsert_Config{
+					Timestamp: 1,
+				},
+			},
+			want: want{
+				uuids:    []string{"a", "b", "d"},
+				failures: 1,
+			},
+		},
+		{
+			name: "send the results of the objects of the last batch which is smaller than the concurrency",
+			store: &objectStoreMock{
+				objs: map[string][]byte{
+					"a": []byte("a"),
+					"b": []byte("bb"),
+					"c": []byte("ccc"),
+				},
+			},
+			req: &payload.Filter_RevectorizeRequest{
+				Vectorizer: &payload.Filter_Target{Host: "vectorizer", Port: 8081},
+			},
+			want: want{
+				uuids: []string{"a", "b", "c"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			s := newObjectStoreTestServer(test.store)
+			stream := &revectorizeServerMock{
+				ctx: context.Background(),
+			}
+			err := s.Revectorize(test.req, stream)
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v, want error: %v", err, test.want.err)
+			}
+			var uuids []string
+			var failures int
+			for _, res := range stream.sent {
+				if loc := res.GetLocation(); loc != nil {
+					uuids = append(uuids, loc.GetUuid())
+					continue
+				}
+				failures++
+			}
+			sort.Strings(uuids)
+			if !reflect.DeepEqual(uuids, test.want.uuids) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", uuids, test.want.uuids)
+			}
+			if failures != test.want.failures {
+				tt.Errorf("got failures: %d, want: %d", failures, test.want.failures)
+			}
+		})
+	}
+}
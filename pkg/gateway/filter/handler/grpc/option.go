@@ -24,6 +24,7 @@ import (
 	"github.com/vdaas/vald/internal/client/v1/client/filter/ingress"
 	"github.com/vdaas/vald/internal/client/v1/client/vald"
 	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/pkg/gateway/filter/service"
 )
 
 type Option func(*server)
@@ -31,6 +32,7 @@ type Option func(*server)
 var defaultOptions = []Option{
 	WithErrGroup(errgroup.Get()),
 	WithStreamConcurrency(runtime.GOMAXPROCS(-1) * 10),
+	WithSearchOverFetchRatio(2),
 }
 
 func WithIngressFilterClient(c ingress.Client) Option {
@@ -57,6 +59,14 @@ func WithValdClient(g vald.Client) Option {
 	}
 }
 
+func WithObjectStore(o service.ObjectStore) Option {
+	return func(s *server) {
+		if o != nil {
+			s.objectStore = o
+		}
+	}
+}
+
 func WithErrGroup(eg errgroup.Group) Option {
 	return func(s *server) {
 		if eg != nil {
@@ -159,3 +169,80 @@ func WithUpsertFilterTargets(addrs ...string) Option {
 		}
 	}
 }
+
+func WithSearchResponseFilterTargets(addrs ...string) Option {
+	return func(s *server) {
+		if len(addrs) == 0 {
+			return
+		}
+		if len(s.SearchResponseFilters) == 0 {
+			s.SearchResponseFilters = addrs
+		} else {
+			s.SearchResponseFilters = append(s.SearchResponseFilters, addrs...)
+		}
+	}
+}
+
+// WithSearchOverFetchRatio returns the option to set how many times the requested number of results
+// are fetched from the LB gateway when the search response filters are enabled.
+func WithSearchOverFetchRatio(ratio float64) Option {
+	return func(s *server) {
+		if ratio >= 1 {
+			s.overFetchRatio = ratio
+		}
+	}
+}
+
+// WithSearchFilterPipeline returns the option to set the search filter pipeline, which takes precedence over the search filter targets.
+func WithSearchFilterPipeline(p service.Pipeline) Option {
+	return func(s *server) {
+		if p != nil {
+			s.searchPipeline = p
+		}
+	}
+}
+
+// WithInsertFilterPipeline returns the option to set the insert filter pipeline, which takes precedence over the insert filter targets.
+func WithInsertFilterPipeline(p service.Pipeline) Option {
+	return func(s *server) {
+		if p != nil {
+			s.insertPipeline = p
+		}
+	}
+}
+
+// WithUpdateFilterPipeline returns the option to set the update filter pipeline, which takes precedence over the update filter targets.
+func WithUpdateFilterPipeline(p service.Pipeline) Option {
+	return func(s *server) {
+		if p != nil {
+			s.updatePipeline = p
+		}
+	}
+}
+
+// WithUpsertFilterPipeline returns the option to set the upsert filter pipeline, which takes precedence over the upsert filter targets.
+func WithUpsertFilterPipeline(p service.Pipeline) Option {
+	return func(s *server) {
+		if p != nil {
+			s.upsertPipeline = p
+		}
+	}
+}
+
+// WithDistanceFilterPipeline returns the option to set the distance filter pipeline, which takes precedence over the distance filter targets.
+func WithDistanceFilterPipeline(p service.Pipeline) Option {
+	return func(s *server) {
+		if p != nil {
+			s.distancePipeline = p
+		}
+	}
+}
+
+// WithObjectFilterPipeline returns the option to set the object filter pipeline, which takes precedence over the object filter targets.
+func WithObjectFilterPipeline(p service.Pipeline) Option {
+	return func(s *server) {
+		if p != nil {
+			s.objectPipeline = p
+		}
+	}
+}
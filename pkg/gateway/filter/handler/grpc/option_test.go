@@ -18,6 +18,7 @@
 package grpc
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/vdaas/vald/internal/client/v1/client/filter/egress"
@@ -25,6 +26,7 @@ import (
 	"github.com/vdaas/vald/internal/client/v1/client/vald"
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/gateway/filter/service"
 )
 
 func TestWithIngressFilterClient(t *testing.T) {
@@ -1430,3 +1432,225 @@ func TestWithUpsertFilterTargets(t *testing.T) {
 		})
 	}
 }
+
+func TestWithSearchResponseFilterTargets(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		addrs []string
+	}
+	type want struct {
+		obj *server
+	}
+	type test struct {
+		name  string
+		args  args
+		field []string
+		want  want
+	}
+	tests := []test{
+		{
+			name: "set filter targets when targets are not set",
+			args: args{
+				addrs: []string{"a", "b"},
+			},
+			want: want{
+				obj: &server{
+					SearchResponseFilters: []string{"a", "b"},
+				},
+			},
+		},
+		{
+			name: "append filter targets when targets are already set",
+			args: args{
+				addrs: []string{"b"},
+			},
+			field: []string{"a"},
+			want: want{
+				obj: &server{
+					SearchResponseFilters: []string{"a", "b"},
+				},
+			},
+		},
+		{
+			name: "do nothing when addrs is empty",
+			want: want{
+				obj: new(server),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			obj := &server{
+				SearchResponseFilters: test.field,
+			}
+			WithSearchResponseFilterTargets(test.args.addrs...)(obj)
+			if !reflect.DeepEqual(obj, test.want.obj) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", obj, test.want.obj)
+			}
+		})
+	}
+}
+
+func TestWithSearchOverFetchRatio(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ratio float64
+	}
+	type want struct {
+		obj *server
+	}
+	type test struct {
+		name string
+		args args
+		want want
+	}
+	tests := []test{
+		{
+			name: "set ratio when ratio is 1",
+			args: args{
+				ratio: 1,
+			},
+			want: want{
+				obj: &server{
+					overFetchRatio: 1,
+				},
+			},
+		},
+		{
+			name: "set ratio when ratio is larger than 1",
+			args: args{
+				ratio: 2.5,
+			},
+			want: want{
+				obj: &server{
+					overFetchRatio: 2.5,
+				},
+			},
+		},
+		{
+			name: "do nothing when ratio is less than 1",
+			args: args{
+				ratio: 0.5,
+			},
+			want: want{
+				obj: new(server),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			obj := new(server)
+			WithSearchOverFetchRatio(test.args.ratio)(obj)
+			if !reflect.DeepEqual(obj, test.want.obj) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", obj, test.want.obj)
+			}
+		})
+	}
+}
+
+func TestWithSearchFilterPipeline(t *testing.T) {
+	t.Parallel()
+	p, err := service.NewPipeline(service.WithPipelineName("search"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	type args struct {
+		p service.Pipeline
+	}
+	type want struct {
+		obj *server
+	}
+	type test struct {
+		name string
+		args args
+		want want
+	}
+	tests := []test{
+		{
+			name: "set pipeline when pipeline is not nil",
+			args: args{
+				p: p,
+			},
+			want: want{
+				obj: &server{
+					searchPipeline: p,
+				},
+			},
+		},
+		{
+			name: "do nothing when pipeline is nil",
+			want: want{
+				obj: new(server),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			obj := new(server)
+			WithSearchFilterPipeline(test.args.p)(obj)
+			if !reflect.DeepEqual(obj, test.want.obj) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", obj, test.want.obj)
+			}
+		})
+	}
+}
+
+func TestWithObjectStore(t *testing.T) {
+	t.Parallel()
+	store := new(objectStoreMock)
+	type args struct {
+		o service.ObjectStore
+	}
+	type want struct {
+		obj *server
+	}
+	type test struct {
+		name string
+		args args
+		want want
+	}
+	tests := []test{
+		{
+			name: "set object store when object store is not nil",
+			args: args{
+				o: store,
+			},
+			want: want{
+				obj: &server{
+					objectStore: store,
+				},
+			},
+		},
+		{
+			name: "do nothing when object store is nil",
+			want: want{
+				obj: new(server),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			obj := new(server)
+			WithObjectStore(test.args.o)(obj)
+			if !reflect.DeepEqual(obj, test.want.obj) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", obj, test.want.obj)
+			}
+		})
+	}
+}
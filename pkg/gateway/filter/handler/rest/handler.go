@@ -42,6 +42,7 @@ type Handler interface {
 	Remove(w http.ResponseWriter, r *http.Request) (int, error)
 	MultiRemove(w http.ResponseWriter, r *http.Request) (int, error)
 	GetObject(w http.ResponseWriter, r *http.Request) (int, error)
+	MultiGetObject(w http.ResponseWriter, r *http.Request) (int, error)
 	SearchObject(w http.ResponseWriter, r *http.Request) (int, error)
 	InsertObject(w http.ResponseWriter, r *http.Request) (int, error)
 	UpdateObject(w http.ResponseWriter, r *http.Request) (int, error)
@@ -163,6 +164,13 @@ func (h *handler) GetObject(w http.ResponseWriter, r *http.Request) (code int, e
 	})
 }
 
+func (h *handler) MultiGetObject(w http.ResponseWriter, r *http.Request) (code int, err error) {
+	var req *payload.Object_MultiVectorRequest
+	return json.Handler(w, r, &req, func() (interface{}, error) {
+		return h.vald.MultiGetObject(r.Context(), req)
+	})
+}
+
 func (h *handler) Exists(w http.ResponseWriter, r *http.Request) (code int, err error) {
 	var req *payload.Object_ID
 	return json.Handler(w, r, &req, func() (interface{}, error) {
@@ -163,5 +163,13 @@ func New(opts ...Option) http.Handler {
 				"/object/{id}",
 				h.GetObject,
 			},
+			{
+				"Multiple GetObject",
+				[]string{
+					http.MethodPost,
+				},
+				"/object/multiple",
+				h.MultiGetObject,
+			},
 		}...))
 }
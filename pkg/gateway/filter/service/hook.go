@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// StageInfo represents the execution result of a filter pipeline stage.
+type StageInfo struct {
+	Pipeline  string
+	Stage     string
+	Target    string
+	StartTime time.Time
+	EndTime   time.Time
+	Err       error
+	Skipped   bool
+}
+
+// Hook represents the hook interface called after each filter pipeline stage.
+type Hook interface {
+	AfterStage(ctx context.Context, info *StageInfo) error
+}
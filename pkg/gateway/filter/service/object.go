@@ -0,0 +1,228 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage"
+	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage/urlopener"
+	"github.com/vdaas/vald/internal/db/storage/blob/local"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3/session"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// ObjectStore represents the interface to store the raw objects to the blob storage.
+type ObjectStore interface {
+	Start(ctx context.Context) (<-chan error, error)
+	Stop(ctx context.Context) error
+	// Put stores the object. The stored object of the same ID is overwritten.
+	Put(ctx context.Context, obj *payload.Object_Blob) error
+	// Delete removes the stored object of the ID. It does not return an error when the object does not exist.
+	Delete(ctx context.Context, id string) error
+	// Range calls f for each stored object in the ID order until f returns an error.
+	Range(ctx context.Context, f func(ctx context.Context, obj *payload.Object_Blob) error) error
+}
+
+type objectStore struct {
+	eg          errgroup.Group
+	storageType string
+	bucketName  string
+	prefix      string
+
+	s3Opts        []s3.Option
+	s3SessionOpts []session.Option
+
+	cloudStorageOpts          []cloudstorage.Option
+	cloudStorageURLOpenerOpts []urlopener.Option
+
+	localOpts []local.Option
+
+	bucket blob.Bucket
+}
+
+// NewObjectStore returns the ObjectStore implementation.
+func NewObjectStore(opts ...ObjectStoreOption) (ObjectStore, error) {
+	o := new(objectStore)
+	for _, opt := range append(defaultObjectStoreOpts, opts...) {
+		if err := opt(o); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	return o, nil
+}
+
+func (o *objectStore) initBucket(ctx context.Context) (err error) {
+	switch config.AtoBST(o.storageType) {
+	case config.S3:
+		s, err := session.New(o.s3SessionOpts...).Session()
+		if err != nil {
+			return err
+		}
+
+		o.bucket, err = s3.New(
+			append(
+				o.s3Opts,
+				s3.WithErrGroup(o.eg),
+				s3.WithSession(s),
+				s3.WithBucket(o.bucketName),
+			)...,
+		)
+		if err != nil {
+			return err
+		}
+	case config.CloudStorage:
+		uoi, err := urlopener.New(o.cloudStorageURLOpenerOpts...)
+		if err != nil {
+			return err
+		}
+
+		uo, err := uoi.URLOpener(ctx)
+		if err != nil {
+			return err
+		}
+
+		o.bucket, err = cloudstorage.New(
+			append(
+				o.cloudStorageOpts,
+				cloudstorage.WithURLOpener(uo),
+			)...,
+		)
+		if err != nil {
+			return err
+		}
+	case config.Local:
+		o.bucket, err = local.New(
+			append(
+				o.localOpts,
+				local.WithBucket(o.bucketName),
+			)...,
+		)
+		if err != nil {
+			return err
+		}
+	default:
+		return errors.ErrInvalidStorageType
+	}
+
+	return nil
+}
+
+func (o *objectStore) Start(ctx context.Context) (<-chan error, error) {
+	ech := make(chan error, 1)
+
+	err := o.initBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.bucket.Open(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ech, nil
+}
+
+func (o *objectStore) Stop(ctx context.Context) error {
+	if o.bucket != nil {
+		return o.bucket.Close()
+	}
+	return nil
+}
+
+func (o *objectStore) Put(ctx context.Context, obj *payload.Object_Blob) (err error) {
+	w, err := o.bucket.Writer(ctx, o.key(obj.GetId()))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := w.Close(); cerr != nil {
+			err = errors.Wrap(err, cerr.Error())
+		}
+	}()
+
+	_, err = io.Copy(w, bytes.NewReader(obj.GetObject()))
+	return err
+}
+
+func (o *objectStore) Delete(ctx context.Context, id string) error {
+	return o.bucket.Delete(ctx, o.key(id))
+}
+
+func (o *objectStore) Range(ctx context.Context, f func(ctx context.Context, obj *payload.Object_Blob) error) (err error) {
+	// the objects are read and passed to f while listing, so that the keys of the bucket are not held in memory.
+	lerr := o.bucket.List(ctx, o.prefix, func(key string) bool {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			return false
+		default:
+		}
+
+		var id string
+		id, err = url.PathUnescape(strings.TrimPrefix(key, o.prefix))
+		if err != nil {
+			return false
+		}
+
+		var obj []byte
+		obj, err = o.read(ctx, key)
+		if err != nil {
+			return false
+		}
+
+		err = f(ctx, &payload.Object_Blob{
+			Id:     id,
+			Object: obj,
+		})
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	return lerr
+}
+
+func (o *objectStore) read(ctx context.Context, key string) (obj []byte, err error) {
+	r, err := o.bucket.Reader(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := r.Close(); cerr != nil {
+			err = errors.Wrap(err, cerr.Error())
+		}
+	}()
+
+	return io.ReadAll(r)
+}
+
+func (o *objectStore) key(id string) string {
+	return o.prefix + url.PathEscape(id)
+}
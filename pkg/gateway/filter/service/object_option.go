@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage"
+	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage/urlopener"
+	"github.com/vdaas/vald/internal/db/storage/blob/local"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3/session"
+	"github.com/vdaas/vald/internal/errgroup"
+)
+
+type ObjectStoreOption func(o *objectStore) error
+
+var defaultObjectStoreOpts = []ObjectStoreOption{
+	WithObjectStoreErrGroup(errgroup.Get()),
+}
+
+// WithObjectStoreErrGroup returns the option to set the errgroup for the blob storage.
+func WithObjectStoreErrGroup(eg errgroup.Group) ObjectStoreOption {
+	return func(o *objectStore) error {
+		if eg != nil {
+			o.eg = eg
+		}
+		return nil
+	}
+}
+
+// WithObjectStoreType returns the option to set the blob storage type, s3 or cloud_storage.
+func WithObjectStoreType(bst string) ObjectStoreOption {
+	return func(o *objectStore) error {
+		o.storageType = bst
+		return nil
+	}
+}
+
+// WithObjectStoreBucketName returns the option to set the bucket name.
+func WithObjectStoreBucketName(bn string) ObjectStoreOption {
+	return func(o *objectStore) error {
+		o.bucketName = bn
+		return nil
+	}
+}
+
+// WithObjectStorePrefix returns the option to set the key prefix of the stored objects.
+func WithObjectStorePrefix(prefix string) ObjectStoreOption {
+	return func(o *objectStore) error {
+		o.prefix = prefix
+		return nil
+	}
+}
+
+// WithObjectStoreS3Opts returns the option to append the s3 options.
+func WithObjectStoreS3Opts(opts ...s3.Option) ObjectStoreOption {
+	return func(o *objectStore) error {
+		o.s3Opts = append(o.s3Opts, opts...)
+		return nil
+	}
+}
+
+// WithObjectStoreS3SessionOpts returns the option to append the s3 session options.
+func WithObjectStoreS3SessionOpts(opts ...session.Option) ObjectStoreOption {
+	return func(o *objectStore) error {
+		o.s3SessionOpts = append(o.s3SessionOpts, opts...)
+		return nil
+	}
+}
+
+// WithObjectStoreCloudStorageOpts returns the option to append the cloud storage options.
+func WithObjectStoreCloudStorageOpts(opts ...cloudstorage.Option) ObjectStoreOption {
+	return func(o *objectStore) error {
+		o.cloudStorageOpts = append(o.cloudStorageOpts, opts...)
+		return nil
+	}
+}
+
+// WithObjectStoreLocalOpts returns the option to append the local filesystem storage options.
+func WithObjectStoreLocalOpts(opts ...local.Option) ObjectStoreOption {
+	return func(o *objectStore) error {
+		o.localOpts = append(o.localOpts, opts...)
+		return nil
+	}
+}
+
+// WithObjectStoreCloudStorageURLOpenerOpts returns the option to append the cloud storage URL opener options.
+func WithObjectStoreCloudStorageURLOpenerOpts(opts ...urlopener.Option) ObjectStoreOption {
+	return func(o *objectStore) error {
+		o.cloudStorageURLOpenerOpts = append(o.cloudStorageURLOpenerOpts, opts...)
+		return nil
+	}
+}
@@ -0,0 +1,311 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/db/storage/blob/local"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+type bucketMock struct {
+	mu     sync.Mutex
+	objs   map[string][]byte
+	listed int
+}
+
+type bucketWriterMock struct {
+	bytes.Buffer
+	close func([]byte)
+}
+
+func (w *bucketWriterMock) Close() error {
+	w.close(w.Bytes())
+	return nil
+}
+
+func (b *bucketMock) Open(ctx context.Context) error {
+	return nil
+}
+
+func (b *bucketMock) Close() error {
+	return nil
+}
+
+func (b *bucketMock) Reader(ctx context.Context, key string) (io.ReadCloser, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	obj, ok := b.objs[key]
+	if !ok {
+		return nil, errors.Errorf("blob not found: %s", key)
+	}
+	return io.NopCloser(bytes.NewReader(obj)), nil
+}
+
+func (b *bucketMock) Writer(ctx context.Context, key string) (io.WriteCloser, error) {
+	return &bucketWriterMock{
+		close: func(obj []byte) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			b.objs[key] = obj
+		},
+	}, nil
+}
+
+func (b *bucketMock) Delete(ctx context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.objs, key)
+	return nil
+}
+
+func (b *bucketMock) List(ctx context.Context, prefix string, f func(key string) bool) error {
+	b.mu.Lock()
+	keys := make([]string, 0, len(b.objs))
+	for key := range b.objs {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	b.mu.Unlock()
+	sort.Strings(keys)
+	for _, key := range keys {
+		b.listed++
+		if !f(key) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (b *bucketMock) Stat(ctx context.Context, key string) (*blob.Attributes, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	obj, ok := b.objs[key]
+	if !ok {
+		return nil, errors.NewErrBlobNoSuchKey(errors.New("not found"), key)
+	}
+	return &blob.Attributes{
+		Key:  key,
+		Size: int64(len(obj)),
+	}, nil
+}
+
+func TestNewObjectStore(t *testing.T) {
+	t.Parallel()
+	o, err := NewObjectStore(
+		WithObjectStoreType("s3"),
+		WithObjectStoreBucketName("vald"),
+		WithObjectStorePrefix("objects/"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := o.(*objectStore)
+	if got.storageType != "s3" || got.bucketName != "vald" || got.prefix != "objects/" {
+		t.Errorf("got: \"%#v\"", got)
+	}
+	if got.eg == nil {
+		t.Error("the default errgroup is not set")
+	}
+}
+
+func Test_objectStore_Start(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	o, err := NewObjectStore(
+		WithObjectStoreType("unknown"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = o.Start(context.Background())
+	if !errors.Is(err, errors.ErrInvalidStorageType) {
+		t.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, errors.ErrInvalidStorageType)
+	}
+}
+
+func Test_objectStore_Local(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	o, err := NewObjectStore(
+		WithObjectStoreType("local"),
+		WithObjectStoreBucketName("vald"),
+		WithObjectStoreLocalOpts(
+			local.WithDir(t.TempDir()),
+		),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err = o.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer o.Stop(ctx)
+
+	want := []*payload.Object_Blob{
+		{Id: "a", Object: []byte("a")},
+		{Id: "b/c d", Object: []byte("bcd")},
+	}
+	for _, obj := range want {
+		if err = o.Put(ctx, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []*payload.Object_Blob
+	err = o.Range(ctx, func(ctx context.Context, obj *payload.Object_Blob) error {
+		got = append(got, obj)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, want)
+	}
+}
+
+func Test_objectStore_ConcurrentPut(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	bucket := &bucketMock{
+		objs: make(map[string][]byte),
+	}
+	o := &objectStore{
+		prefix: "objects/",
+		bucket: bucket,
+	}
+	ctx := context.Background()
+
+	ids := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	var wg sync.WaitGroup
+	ech := make(chan error, len(ids))
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			ech <- o.Put(ctx, &payload.Object_Blob{
+				Id:     id,
+				Object: []byte(strings.Repeat(id, 1024)),
+			})
+		}(id)
+	}
+	wg.Wait()
+	close(ech)
+	for err := range ech {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []string
+	err := o.Range(ctx, func(ctx context.Context, obj *payload.Object_Blob) error {
+		if want := strings.Repeat(obj.GetId(), 1024); string(obj.GetObject()) != want {
+			t.Errorf("the object of %s is mixed up", obj.GetId())
+		}
+		got = append(got, obj.GetId())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, ids) {
+		t.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, ids)
+	}
+}
+
+func Test_objectStore(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	bucket := &bucketMock{
+		objs: map[string][]byte{
+			"others/a": []byte("other"),
+		},
+	}
+	o := &objectStore{
+		prefix: "objects/",
+		bucket: bucket,
+	}
+	ctx := context.Background()
+
+	for _, obj := range []*payload.Object_Blob{
+		{Id: "a", Object: []byte("a")},
+		{Id: "b/c d", Object: []byte("bcd")},
+		{Id: "e", Object: []byte("e")},
+	} {
+		if err := o.Put(ctx, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := bucket.objs["objects/b%2Fc%20d"]; !ok {
+		t.Errorf("the object id is not escaped: %v", bucket.objs)
+	}
+	if err := o.Delete(ctx, "e"); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Delete(ctx, "missing"); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		got    []*payload.Object_Blob
+		listed []int
+	)
+	err := o.Range(ctx, func(ctx context.Context, obj *payload.Object_Blob) error {
+		got = append(got, obj)
+		listed = append(listed, bucket.listed)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*payload.Object_Blob{
+		{Id: "a", Object: []byte("a")},
+		{Id: "b/c d", Object: []byte("bcd")},
+	}
+	// each object is passed while listing, before the next key is listed.
+	if !reflect.DeepEqual(listed, []int{1, 2}) {
+		t.Errorf("got listed keys: %v,\n\t\t\t\twant: %v", listed, []int{1, 2})
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, want)
+	}
+
+	errRange := errors.New("range error")
+	var n int
+	err = o.Range(ctx, func(ctx context.Context, obj *payload.Object_Blob) error {
+		n++
+		return errRange
+	})
+	if !errors.Is(err, errRange) || n != 1 {
+		t.Errorf("got_error: \"%#v\", called: %d,\n\t\t\t\twant: \"%#v\", called: 1", err, n, errRange)
+	}
+}
@@ -0,0 +1,205 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/observability/trace"
+)
+
+// FailurePolicy represents how the pipeline handles the failure of a stage.
+type FailurePolicy uint8
+
+const (
+	// FailurePolicyFail aborts the pipeline when the stage fails.
+	FailurePolicyFail FailurePolicy = iota
+	// FailurePolicySkip ignores the failure of the stage and continues the pipeline.
+	FailurePolicySkip
+)
+
+const pipelineSpanName = "vald/gateway-filter/service/Pipeline"
+
+// FailurePolicyFrom returns the FailurePolicy from the given string. FailurePolicyFail is returned for unknown values.
+func FailurePolicyFrom(p string) FailurePolicy {
+	switch strings.ToLower(p) {
+	case "skip":
+		return FailurePolicySkip
+	}
+	return FailurePolicyFail
+}
+
+func (f FailurePolicy) String() string {
+	switch f {
+	case FailurePolicySkip:
+		return "skip"
+	}
+	return "fail"
+}
+
+// Stage represents a stage of the filter pipeline.
+type Stage struct {
+	Name          string
+	Target        string
+	Timeout       time.Duration
+	FailurePolicy FailurePolicy
+	Concurrency   int
+
+	sem chan struct{}
+}
+
+// StageFunc represents the function to execute a stage of the filter pipeline.
+// It must not change the filtered data when it returns an error, so that the stage can be skipped.
+type StageFunc func(ctx context.Context, stage *Stage) error
+
+// Pipeline represents the interface of the ordered filter pipeline.
+type Pipeline interface {
+	Name() string
+	Len() int
+	Run(ctx context.Context, f StageFunc, targets ...string) error
+}
+
+type pipeline struct {
+	name   string
+	stages []*Stage
+	hooks  []Hook
+}
+
+// NewPipeline returns the Pipeline implementation.
+func NewPipeline(opts ...PipelineOption) (Pipeline, error) {
+	p := new(pipeline)
+	for _, opt := range append(defaultPipelineOpts, opts...) {
+		if err := opt(p); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	return p, nil
+}
+
+func newStage(name, target string, timeout time.Duration, policy FailurePolicy, concurrency int) *Stage {
+	if len(name) == 0 {
+		name = target
+	}
+	st := &Stage{
+		Name:          name,
+		Target:        target,
+		Timeout:       timeout,
+		FailurePolicy: policy,
+		Concurrency:   concurrency,
+	}
+	if concurrency > 0 {
+		st.sem = make(chan struct{}, concurrency)
+	}
+	return st
+}
+
+// Do calls f after acquiring one of the concurrency slots of the stage.
+func (s *Stage) Do(ctx context.Context, f func(ctx context.Context) error) error {
+	if s.sem != nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case s.sem <- struct{}{}:
+		}
+		defer func() {
+			<-s.sem
+		}()
+	}
+	return f(ctx)
+}
+
+func (p *pipeline) Name() string {
+	return p.name
+}
+
+func (p *pipeline) Len() int {
+	return len(p.stages)
+}
+
+// Run executes f for each stage in order. The targets are executed after the configured stages
+// as the stages which abort the pipeline on failure.
+func (p *pipeline) Run(ctx context.Context, f StageFunc, targets ...string) error {
+	stages := p.stages
+	if len(targets) != 0 {
+		stages = make([]*Stage, 0, len(p.stages)+len(targets))
+		stages = append(stages, p.stages...)
+		for _, target := range targets {
+			stages = append(stages, newStage(target, target, 0, FailurePolicyFail, 0))
+		}
+	}
+	for _, st := range stages {
+		if err := p.runStage(ctx, st, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pipeline) runStage(ctx context.Context, st *Stage, f StageFunc) (err error) {
+	sctx, span := trace.StartSpan(ctx, pipelineSpanName+"/"+p.name+"/"+st.Name)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	if span != nil {
+		span.AddAttributes(
+			trace.StringAttribute("target", st.Target),
+			trace.StringAttribute("on_failure", st.FailurePolicy.String()),
+		)
+	}
+	if st.Timeout > 0 {
+		var cancel context.CancelFunc
+		sctx, cancel = context.WithTimeout(sctx, st.Timeout)
+		defer cancel()
+	}
+
+	info := &StageInfo{
+		Pipeline:  p.name,
+		Stage:     st.Name,
+		Target:    st.Target,
+		StartTime: time.Now(),
+	}
+	err = f(sctx, st)
+	info.EndTime = time.Now()
+	info.Err = err
+	info.Skipped = err != nil && st.FailurePolicy == FailurePolicySkip && ctx.Err() == nil
+	for _, hook := range p.hooks {
+		if herr := hook.AfterStage(ctx, info); herr != nil {
+			log.Warn(herr)
+		}
+	}
+	if err == nil {
+		return nil
+	}
+
+	err = errors.ErrFilterStageFailed(p.name, st.Name, err)
+	if span != nil {
+		span.SetStatus(trace.StatusCodeInternal(err.Error()))
+	}
+	if info.Skipped {
+		log.Warn(err)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"time"
+
+	"github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+type PipelineOption func(p *pipeline) error
+
+var defaultPipelineOpts = []PipelineOption{}
+
+// WithPipelineName returns the option to set the pipeline name used for trace spans and metrics.
+func WithPipelineName(name string) PipelineOption {
+	return func(p *pipeline) error {
+		if len(name) != 0 {
+			p.name = name
+		}
+		return nil
+	}
+}
+
+// WithStages returns the option to append the configured stages to the pipeline.
+func WithStages(stages ...*config.FilterStage) PipelineOption {
+	return func(p *pipeline) error {
+		for _, stage := range stages {
+			if stage == nil {
+				continue
+			}
+			if len(stage.Target) == 0 {
+				return errors.ErrTargetNotFound
+			}
+			var timeout time.Duration
+			if len(stage.Timeout) != 0 {
+				dur, err := timeutil.Parse(stage.Timeout)
+				if err != nil {
+					return err
+				}
+				timeout = dur
+			}
+			p.stages = append(p.stages, newStage(
+				stage.Name,
+				stage.Target,
+				timeout,
+				FailurePolicyFrom(stage.OnFailure),
+				stage.Concurrency,
+			))
+		}
+		return nil
+	}
+}
+
+// WithTargets returns the option to append the stages which abort the pipeline on failure for each target.
+func WithTargets(targets ...string) PipelineOption {
+	return func(p *pipeline) error {
+		for _, target := range targets {
+			if len(target) != 0 {
+				p.stages = append(p.stages, newStage(target, target, 0, FailurePolicyFail, 0))
+			}
+		}
+		return nil
+	}
+}
+
+// WithHooks returns the option to append the hooks called after each stage.
+func WithHooks(hooks ...Hook) PipelineOption {
+	return func(p *pipeline) error {
+		for _, hook := range hooks {
+			if hook != nil {
+				p.hooks = append(p.hooks, hook)
+			}
+		}
+		return nil
+	}
+}
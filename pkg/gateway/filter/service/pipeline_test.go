@@ -0,0 +1,330 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+type hookMock struct {
+	mu    sync.Mutex
+	infos []*StageInfo
+}
+
+func (h *hookMock) AfterStage(ctx context.Context, info *StageInfo) error {
+	h.mu.Lock()
+	h.infos = append(h.infos, info)
+	h.mu.Unlock()
+	return nil
+}
+
+func TestFailurePolicyFrom(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		p    string
+		want FailurePolicy
+	}{
+		{
+			name: "return FailurePolicySkip when p is skip",
+			p:    "skip",
+			want: FailurePolicySkip,
+		},
+		{
+			name: "return FailurePolicySkip when p is SKIP",
+			p:    "SKIP",
+			want: FailurePolicySkip,
+		},
+		{
+			name: "return FailurePolicyFail when p is fail",
+			p:    "fail",
+			want: FailurePolicyFail,
+		},
+		{
+			name: "return FailurePolicyFail when p is empty",
+			want: FailurePolicyFail,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			if got := FailurePolicyFrom(test.p); got != test.want {
+				tt.Errorf("got: %v,\n\t\t\t\twant: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNewPipeline(t *testing.T) {
+	t.Parallel()
+	type want struct {
+		stages []*Stage
+		err    error
+	}
+	type test struct {
+		name string
+		opts []PipelineOption
+		want want
+	}
+	tests := []test{
+		{
+			name: "return the pipeline with the configured stages followed by the targets",
+			opts: []PipelineOption{
+				WithPipelineName("search"),
+				WithStages(
+					&config.FilterStage{
+						Name:      "normalizer",
+						Target:    "normalizer:8081",
+						Timeout:   "100ms",
+						OnFailure: "skip",
+					},
+					&config.FilterStage{
+						Target: "pca:8081",
+					},
+				),
+				WithTargets("legacy:8081"),
+			},
+			want: want{
+				stages: []*Stage{
+					{
+						Name:          "normalizer",
+						Target:        "normalizer:8081",
+						Timeout:       100 * time.Millisecond,
+						FailurePolicy: FailurePolicySkip,
+					},
+					{
+						Name:   "pca:8081",
+						Target: "pca:8081",
+					},
+					{
+						Name:   "legacy:8081",
+						Target: "legacy:8081",
+					},
+				},
+			},
+		},
+		{
+			name: "return an error when the stage target is empty",
+			opts: []PipelineOption{
+				WithStages(&config.FilterStage{
+					Name: "normalizer",
+				}),
+			},
+			want: want{
+				err: errors.ErrTargetNotFound,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			got, err := NewPipeline(test.opts...)
+			if !errors.Is(err, test.want.err) {
+				tt.Fatalf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if err != nil {
+				return
+			}
+			if stages := got.(*pipeline).stages; !reflect.DeepEqual(stages, test.want.stages) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", stages, test.want.stages)
+			}
+		})
+	}
+}
+
+func Test_pipeline_Run(t *testing.T) {
+	t.Parallel()
+	errStage := errors.New("stage error")
+	type args struct {
+		targets []string
+	}
+	type want struct {
+		called []string
+		hooked []string
+		err    error
+	}
+	type test struct {
+		name   string
+		stages []*config.FilterStage
+		args   args
+		f      func(ctx context.Context, st *Stage) error
+		want   want
+	}
+	tests := []test{
+		{
+			name: "run the stages in order followed by the request targets",
+			stages: []*config.FilterStage{
+				{Name: "a", Target: "a:8081"},
+				{Name: "b", Target: "b:8081"},
+			},
+			args: args{
+				targets: []string{"c:8081"},
+			},
+			want: want{
+				called: []string{"a", "b", "c:8081"},
+				hooked: []string{"a", "b", "c:8081"},
+			},
+		},
+		{
+			name: "continue the pipeline when the failed stage is skippable",
+			stages: []*config.FilterStage{
+				{Name: "a", Target: "a:8081", OnFailure: "skip"},
+				{Name: "b", Target: "b:8081"},
+			},
+			f: func(ctx context.Context, st *Stage) error {
+				if st.Name == "a" {
+					return errStage
+				}
+				return nil
+			},
+			want: want{
+				called: []string{"a", "b"},
+				hooked: []string{"a", "b"},
+			},
+		},
+		{
+			name: "abort the pipeline when the failed stage is not skippable",
+			stages: []*config.FilterStage{
+				{Name: "a", Target: "a:8081", OnFailure: "fail"},
+				{Name: "b", Target: "b:8081"},
+			},
+			f: func(ctx context.Context, st *Stage) error {
+				if st.Name == "a" {
+					return errStage
+				}
+				return nil
+			},
+			want: want{
+				called: []string{"a"},
+				hooked: []string{"a"},
+				err:    errStage,
+			},
+		},
+		{
+			name: "abort the pipeline when the stage exceeds its timeout",
+			stages: []*config.FilterStage{
+				{Name: "a", Target: "a:8081", Timeout: "1ms"},
+				{Name: "b", Target: "b:8081"},
+			},
+			f: func(ctx context.Context, st *Stage) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			want: want{
+				called: []string{"a"},
+				hooked: []string{"a"},
+				err:    context.DeadlineExceeded,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			hook := new(hookMock)
+			p, err := NewPipeline(
+				WithPipelineName("test"),
+				WithStages(test.stages...),
+				WithHooks(hook),
+			)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			var called []string
+			err = p.Run(context.Background(), func(ctx context.Context, st *Stage) error {
+				called = append(called, st.Name)
+				if test.f != nil {
+					return test.f(ctx, st)
+				}
+				return nil
+			}, test.args.targets...)
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+			if !reflect.DeepEqual(called, test.want.called) {
+				tt.Errorf("got_called: %v,\n\t\t\t\twant: %v", called, test.want.called)
+			}
+			hooked := make([]string, 0, len(hook.infos))
+			for _, info := range hook.infos {
+				hooked = append(hooked, info.Stage)
+			}
+			if !reflect.DeepEqual(hooked, test.want.hooked) {
+				tt.Errorf("got_hooked: %v,\n\t\t\t\twant: %v", hooked, test.want.hooked)
+			}
+		})
+	}
+}
+
+func TestStage_Do(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	st := newStage("a", "a:8081", 0, FailurePolicyFail, 2)
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		inflight int
+		max      int
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = st.Do(context.Background(), func(ctx context.Context) error {
+				mu.Lock()
+				inflight++
+				if inflight > max {
+					max = inflight
+				}
+				mu.Unlock()
+				time.Sleep(time.Millisecond)
+				mu.Lock()
+				inflight--
+				mu.Unlock()
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+	if max > 2 {
+		t.Errorf("got max concurrency: %d,\n\t\t\t\twant: <= %d", max, 2)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	st.sem <- struct{}{}
+	st.sem <- struct{}{}
+	if err := st.Do(ctx, func(context.Context) error { return nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, context.Canceled)
+	}
+}
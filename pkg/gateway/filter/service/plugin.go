@@ -0,0 +1,443 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/vdaas/vald/apis/grpc/v1/filter/egress"
+	"github.com/vdaas/vald/apis/grpc/v1/filter/ingress"
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file/watch"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+)
+
+const pluginSpanName = "vald/gateway-filter/service/Plugin"
+
+// The exports of the WASM filter plugin module.
+// The vectors are passed as the little-endian float32 arrays in the module memory.
+// The instances are reused by the calls, so the plugin owns the allocated memory and should reuse it,
+// and the instance is discarded when the call fails, e.g., by exceeding the memory limit or the timeout.
+const (
+	// pluginMemory is the exported memory of the module.
+	pluginMemory = "memory"
+	// pluginAlloc is the exported function to allocate the memory of the size: (size i32) -> (ptr i32).
+	pluginAlloc = "alloc"
+	// pluginFilterVector is the exported function to filter the vector: (ptr i32, len i32) -> (ptr<<32|len i64).
+	pluginFilterVector = "filter_vector"
+	// pluginFilterDistance is the exported function to filter the distance of the ID: (ptr i32, len i32, distance f32) -> (distance f32).
+	pluginFilterDistance = "filter_distance"
+)
+
+// pluginMemoryPageSize is the size of the WASM memory page.
+const pluginMemoryPageSize = 1 << 16
+
+// pluginSignature represents the parameter and result types of the exported function.
+type pluginSignature struct {
+	params  []api.ValueType
+	results []api.ValueType
+}
+
+var (
+	pluginAllocSignature = pluginSignature{
+		params:  []api.ValueType{api.ValueTypeI32},
+		results: []api.ValueType{api.ValueTypeI32},
+	}
+	pluginFilterVectorSignature = pluginSignature{
+		params:  []api.ValueType{api.ValueTypeI32, api.ValueTypeI32},
+		results: []api.ValueType{api.ValueTypeI64},
+	}
+	pluginFilterDistanceSignature = pluginSignature{
+		params:  []api.ValueType{api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeF32},
+		results: []api.ValueType{api.ValueTypeF32},
+	}
+)
+
+func (s pluginSignature) match(def api.FunctionDefinition) bool {
+	return reflect.DeepEqual(s.params, def.ParamTypes()) && reflect.DeepEqual(s.results, def.ResultTypes())
+}
+
+// Plugin represents the filter which is loaded in-process instead of calling the filter server.
+// It implements the ingress and egress filter contracts, and the unsupported RPCs return the Unimplemented error.
+type Plugin interface {
+	ingress.FilterClient
+	egress.FilterClient
+	// Name returns the plugin name which is used as the filter target.
+	Name() string
+	// Start starts watching the plugin file to reload it when it is changed.
+	Start(ctx context.Context) (<-chan error, error)
+	Stop(ctx context.Context) error
+	// Reload loads the plugin file again. The current module is kept when the new module is invalid.
+	Reload(ctx context.Context) error
+}
+
+type wasmPlugin struct {
+	eg          errgroup.Group
+	name        string
+	path        string
+	timeout     time.Duration
+	memoryLimit uint64
+
+	runtime wazero.Runtime
+	w       watch.Watcher
+
+	mu     sync.RWMutex
+	bin    []byte
+	module *pluginModule
+}
+
+// pluginModule is the compiled module and the pool of its instances.
+// The pool is replaced with the module when the plugin is reloaded.
+type pluginModule struct {
+	wazero.CompiledModule
+	mu     sync.Mutex
+	closed bool
+	pool   chan api.Module
+}
+
+// NewPlugin returns the Plugin implementation and loads the plugin file.
+func NewPlugin(opts ...PluginOption) (Plugin, error) {
+	p := new(wasmPlugin)
+	for _, opt := range append(defaultPluginOpts, opts...) {
+		if err := opt(p); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	if len(p.name) == 0 || len(p.path) == 0 {
+		return nil, errors.ErrInvalidFilterPlugin(p.name, p.path)
+	}
+
+	// the running calls are closed when their context is done, so that the timeout stops the endless loop of the plugin.
+	cfg := wazero.NewRuntimeConfig().WithCloseOnContextDone(true)
+	if p.memoryLimit > 0 {
+		pages := p.memoryLimit / pluginMemoryPageSize
+		if pages > math.MaxUint16+1 {
+			pages = math.MaxUint16 + 1
+		}
+		cfg = cfg.WithMemoryLimitPages(uint32(pages))
+	}
+	ctx := context.Background()
+	p.runtime = wazero.NewRuntimeWithConfig(ctx, cfg)
+	if err := p.Reload(ctx); err != nil {
+		_ = p.runtime.Close(ctx)
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *wasmPlugin) Name() string {
+	return p.name
+}
+
+func (p *wasmPlugin) Start(ctx context.Context) (<-chan error, error) {
+	var err error
+	p.w, err = watch.New(
+		watch.WithErrGroup(p.eg),
+		watch.WithDirs(filepath.Dir(p.path)),
+		watch.WithOnChange(func(ctx context.Context, name string) error {
+			if filepath.Clean(name) != filepath.Clean(p.path) {
+				return nil
+			}
+			return p.Reload(ctx)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return p.w.Start(ctx)
+}
+
+func (p *wasmPlugin) Stop(ctx context.Context) (err error) {
+	if p.w != nil {
+		err = p.w.Stop(ctx)
+	}
+	// closing the runtime closes the compiled modules and their instances.
+	if cerr := p.runtime.Close(ctx); cerr != nil {
+		err = errors.Wrap(err, cerr.Error())
+	}
+	return err
+}
+
+func (p *wasmPlugin) Reload(ctx context.Context) error {
+	bin, err := os.ReadFile(p.path)
+	if err != nil {
+		if p.loaded() && errors.Is(err, os.ErrNotExist) {
+			// the file is being replaced, and the next event reloads it.
+			return nil
+		}
+		return errors.ErrFilterPluginLoadFailed(p.name, err)
+	}
+
+	p.mu.RLock()
+	unchanged := bytes.Equal(p.bin, bin)
+	p.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	cm, err := p.runtime.CompileModule(ctx, bin)
+	if err != nil {
+		return errors.ErrFilterPluginLoadFailed(p.name, err)
+	}
+	if err = validatePluginModule(cm); err != nil {
+		_ = cm.Close(ctx)
+		return errors.ErrFilterPluginLoadFailed(p.name, err)
+	}
+
+	p.mu.Lock()
+	prev := p.module
+	p.bin, p.module = bin, &pluginModule{
+		CompiledModule: cm,
+		pool:           make(chan api.Module, runtime.GOMAXPROCS(0)),
+	}
+	p.mu.Unlock()
+	if prev != nil {
+		// the running calls of the previous module are not affected, and their instances are closed when they are released.
+		prev.close(ctx)
+	}
+	log.Infof("filter plugin %s is loaded from %s", p.name, p.path)
+	return nil
+}
+
+func (p *wasmPlugin) loaded() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.module != nil
+}
+
+func validatePluginModule(m wazero.CompiledModule) error {
+	// the module can not import anything because the plugin does not provide any host module.
+	if defs := m.ImportedFunctions(); len(defs) != 0 {
+		module, name, _ := defs[0].Import()
+		return errors.ErrWASMImportNotSupported(module, name)
+	}
+	if defs := m.ImportedMemories(); len(defs) != 0 {
+		module, name, _ := defs[0].Import()
+		return errors.ErrWASMImportNotSupported(module, name)
+	}
+	if _, ok := m.ExportedMemories()[pluginMemory]; !ok {
+		return errors.ErrWASMExportNotFound(pluginMemory)
+	}
+	funcs := m.ExportedFunctions()
+	if def, ok := funcs[pluginAlloc]; !ok || !pluginAllocSignature.match(def) {
+		return errors.ErrWASMExportNotFound(pluginAlloc)
+	}
+	vdef, vok := funcs[pluginFilterVector]
+	if vok && !pluginFilterVectorSignature.match(vdef) {
+		return errors.ErrWASMExportNotFound(pluginFilterVector)
+	}
+	ddef, dok := funcs[pluginFilterDistance]
+	if dok && !pluginFilterDistanceSignature.match(ddef) {
+		return errors.ErrWASMExportNotFound(pluginFilterDistance)
+	}
+	if !vok && !dok {
+		return errors.ErrWASMExportNotFound(pluginFilterVector + " or " + pluginFilterDistance)
+	}
+	return nil
+}
+
+// put puts the instance back to the pool, or closes it when the pool is full or the module is replaced.
+func (m *pluginModule) put(ctx context.Context, in api.Module) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.closed {
+		select {
+		case m.pool <- in:
+			return
+		default:
+		}
+	}
+	_ = in.Close(ctx)
+}
+
+// close closes the pooled instances and the compiled module, and the running calls of the instances are not affected.
+func (m *pluginModule) close(ctx context.Context) {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+	for {
+		select {
+		case in := <-m.pool:
+			_ = in.Close(ctx)
+		default:
+			_ = m.CompiledModule.Close(ctx)
+			return
+		}
+	}
+}
+
+// instantiate returns an instance of the current module from the pool, or creates a new one when the pool is empty.
+// The returned function puts the instance back to the pool when the call succeeds,
+// and closes it when the call fails because the instance may be broken, e.g., by the trap or the timeout.
+func (p *wasmPlugin) instantiate(ctx context.Context, export string) (api.Module, func(error), error) {
+	p.mu.RLock()
+	m := p.module
+	p.mu.RUnlock()
+	if _, ok := m.ExportedFunctions()[export]; !ok {
+		return nil, nil, status.WrapWithUnimplemented("filter plugin "+p.name+" does not export "+export, errors.ErrWASMExportNotFound(export))
+	}
+	var in api.Module
+	select {
+	case in = <-m.pool:
+	default:
+		var err error
+		// the instances have no name to be instantiated many times in the runtime, and no start function other than the start section of the module.
+		in, err = p.runtime.InstantiateModule(ctx, m.CompiledModule, wazero.NewModuleConfig().WithName("").WithStartFunctions())
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return in, func(err error) {
+		if err != nil {
+			_ = in.Close(ctx)
+			return
+		}
+		m.put(ctx, in)
+	}, nil
+}
+
+func (p *wasmPlugin) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if p.timeout > 0 {
+		return context.WithTimeout(ctx, p.timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// write allocates the memory by the alloc export and writes the data to it.
+func (p *wasmPlugin) write(ctx context.Context, in api.Module, data []byte) (uint32, error) {
+	res, err := in.ExportedFunction(pluginAlloc).Call(ctx, api.EncodeI32(int32(len(data))))
+	if err != nil {
+		return 0, err
+	}
+	ptr := uint32(api.DecodeI32(res[0]))
+	if !in.ExportedMemory(pluginMemory).Write(ptr, data) {
+		return 0, errors.ErrWASMTrap("out of bounds memory access")
+	}
+	return ptr, nil
+}
+
+func (p *wasmPlugin) FilterVector(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (res *payload.Object_Vector, err error) {
+	ctx, span := trace.StartSpan(ctx, pluginSpanName+"/"+p.name+"/FilterVector")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	ctx, cancel := p.context(ctx)
+	defer cancel()
+
+	inst, release, err := p.instantiate(ctx, pluginFilterVector)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		release(err)
+	}()
+	vec := in.GetVector()
+	buf := make([]byte, 4*len(vec))
+	for i, v := range vec {
+		binary.LittleEndian.PutUint32(buf[4*i:], math.Float32bits(v))
+	}
+	ptr, err := p.write(ctx, inst, buf)
+	if err != nil {
+		return nil, err
+	}
+	r, err := inst.ExportedFunction(pluginFilterVector).Call(ctx, api.EncodeI32(int32(ptr)), api.EncodeI32(int32(len(vec))))
+	if err != nil {
+		return nil, err
+	}
+	packed := r[0]
+	rptr, rlen := uint32(packed>>32), uint32(packed)
+	if uint64(rlen)*4 > math.MaxUint32 {
+		return nil, errors.ErrWASMTrap("out of bounds memory access")
+	}
+	buf, ok := inst.ExportedMemory(pluginMemory).Read(rptr, rlen*4)
+	if !ok {
+		return nil, errors.ErrWASMTrap("out of bounds memory access")
+	}
+	vec = make([]float32, rlen)
+	for i := range vec {
+		vec[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4*i:]))
+	}
+	return &payload.Object_Vector{
+		Id:     in.GetId(),
+		Vector: vec,
+	}, nil
+}
+
+func (p *wasmPlugin) FilterDistance(ctx context.Context, in *payload.Object_Distance, opts ...grpc.CallOption) (res *payload.Object_Distance, err error) {
+	ctx, span := trace.StartSpan(ctx, pluginSpanName+"/"+p.name+"/FilterDistance")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	ctx, cancel := p.context(ctx)
+	defer cancel()
+
+	inst, release, err := p.instantiate(ctx, pluginFilterDistance)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		release(err)
+	}()
+	ptr, err := p.write(ctx, inst, []byte(in.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	r, err := inst.ExportedFunction(pluginFilterDistance).Call(ctx,
+		api.EncodeI32(int32(ptr)),
+		api.EncodeI32(int32(len(in.GetId()))),
+		api.EncodeF32(in.GetDistance()))
+	if err != nil {
+		return nil, err
+	}
+	return &payload.Object_Distance{
+		Id:       in.GetId(),
+		Distance: api.DecodeF32(r[0]),
+	}, nil
+}
+
+func (p *wasmPlugin) GenVector(ctx context.Context, in *payload.Object_Blob, opts ...grpc.CallOption) (*payload.Object_Vector, error) {
+	return nil, status.WrapWithUnimplemented("filter plugin "+p.name+" does not support GenVector", errors.ErrFilterPluginRPCNotSupported(p.name, "GenVector"))
+}
+
+func (p *wasmPlugin) GenVectors(ctx context.Context, in *payload.Object_Blobs, opts ...grpc.CallOption) (*payload.Object_Vectors, error) {
+	return nil, status.WrapWithUnimplemented("filter plugin "+p.name+" does not support GenVectors", errors.ErrFilterPluginRPCNotSupported(p.name, "GenVectors"))
+}
+
+func (p *wasmPlugin) FilterSearchResponse(ctx context.Context, in *payload.Filter_SearchResponseRequest, opts ...grpc.CallOption) (*payload.Search_Response, error) {
+	return nil, status.WrapWithUnimplemented("filter plugin "+p.name+" does not support FilterSearchResponse", errors.ErrFilterPluginRPCNotSupported(p.name, "FilterSearchResponse"))
+}
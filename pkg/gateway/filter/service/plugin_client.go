@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+
+	egressgrpc "github.com/vdaas/vald/apis/grpc/v1/filter/egress"
+	ingressgrpc "github.com/vdaas/vald/apis/grpc/v1/filter/ingress"
+	"github.com/vdaas/vald/internal/client/v1/client/filter/egress"
+	"github.com/vdaas/vald/internal/client/v1/client/filter/ingress"
+)
+
+type ingressPluginClient struct {
+	ingress.Client
+	plugins map[string]Plugin
+}
+
+type egressPluginClient struct {
+	egress.Client
+	plugins map[string]Plugin
+}
+
+func pluginMap(plugins []Plugin) map[string]Plugin {
+	m := make(map[string]Plugin, len(plugins))
+	for _, p := range plugins {
+		if p != nil {
+			m[p.Name()] = p
+		}
+	}
+	return m
+}
+
+// NewIngressPluginClient returns the ingress filter client which calls the plugin when the target is the plugin name,
+// and the other targets are delegated to the client.
+func NewIngressPluginClient(c ingress.Client, plugins ...Plugin) ingress.Client {
+	if len(plugins) == 0 {
+		return c
+	}
+	return &ingressPluginClient{
+		Client:  c,
+		plugins: pluginMap(plugins),
+	}
+}
+
+func (c *ingressPluginClient) Target(ctx context.Context, targets ...string) (ingressgrpc.FilterClient, error) {
+	if len(targets) == 1 {
+		if p, ok := c.plugins[targets[0]]; ok {
+			return p, nil
+		}
+	}
+	return c.Client.Target(ctx, targets...)
+}
+
+// NewEgressPluginClient returns the egress filter client which calls the plugin when the target is the plugin name,
+// and the other targets are delegated to the client.
+func NewEgressPluginClient(c egress.Client, plugins ...Plugin) egress.Client {
+	if len(plugins) == 0 {
+		return c
+	}
+	return &egressPluginClient{
+		Client:  c,
+		plugins: pluginMap(plugins),
+	}
+}
+
+func (c *egressPluginClient) Target(ctx context.Context, targets ...string) (egressgrpc.FilterClient, error) {
+	if len(targets) == 1 {
+		if p, ok := c.plugins[targets[0]]; ok {
+			return p, nil
+		}
+	}
+	return c.Client.Target(ctx, targets...)
+}
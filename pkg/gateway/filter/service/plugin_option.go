@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/timeutil"
+	"github.com/vdaas/vald/internal/unit"
+)
+
+type PluginOption func(p *wasmPlugin) error
+
+var defaultPluginOpts = []PluginOption{
+	WithPluginErrGroup(errgroup.Get()),
+}
+
+// WithPluginErrGroup returns the option to set the errgroup for the plugin file watcher.
+func WithPluginErrGroup(eg errgroup.Group) PluginOption {
+	return func(p *wasmPlugin) error {
+		if eg != nil {
+			p.eg = eg
+		}
+		return nil
+	}
+}
+
+// WithPluginName returns the option to set the plugin name used as the filter target.
+func WithPluginName(name string) PluginOption {
+	return func(p *wasmPlugin) error {
+		p.name = name
+		return nil
+	}
+}
+
+// WithPluginType returns the option to check the plugin type. Only wasm is supported.
+func WithPluginType(typ string) PluginOption {
+	return func(p *wasmPlugin) error {
+		if len(typ) != 0 && config.AtoFPT(typ) != config.WASMPlugin {
+			return errors.ErrFilterPluginTypeNotSupported(typ)
+		}
+		return nil
+	}
+}
+
+// WithPluginPath returns the option to set the path of the plugin file.
+func WithPluginPath(path string) PluginOption {
+	return func(p *wasmPlugin) error {
+		p.path = path
+		return nil
+	}
+}
+
+// WithPluginTimeout returns the option to set the timeout of each call of the plugin.
+func WithPluginTimeout(dur string) PluginOption {
+	return func(p *wasmPlugin) error {
+		if len(dur) == 0 {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return err
+		}
+		p.timeout = d
+		return nil
+	}
+}
+
+// WithPluginMemoryLimit returns the option to set the maximum memory size of the plugin instance, e.g. 16MB.
+func WithPluginMemoryLimit(size string) PluginOption {
+	return func(p *wasmPlugin) error {
+		b, err := unit.ParseBytes(size)
+		if err != nil {
+			return err
+		}
+		p.memoryLimit = b
+		return nil
+	}
+}
@@ -0,0 +1,441 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	egressgrpc "github.com/vdaas/vald/apis/grpc/v1/filter/egress"
+	ingressgrpc "github.com/vdaas/vald/apis/grpc/v1/filter/ingress"
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/client/v1/client/filter/egress"
+	"github.com/vdaas/vald/internal/client/v1/client/filter/ingress"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+)
+
+func wasmVec(items ...[]byte) []byte {
+	b := []byte{byte(len(items))}
+	for _, item := range items {
+		b = append(b, item...)
+	}
+	return b
+}
+
+func wasmSection(id byte, payload []byte) []byte {
+	return append([]byte{id, byte(len(payload))}, payload...)
+}
+
+func wasmName(s string) []byte {
+	return append([]byte{byte(len(s))}, s...)
+}
+
+func wasmCode(locals []byte, body ...byte) []byte {
+	code := append(locals, body...)
+	return append([]byte{byte(len(code))}, code...)
+}
+
+// wasmFilterModule builds the plugin module which has the alloc, filter_vector and filter_distance exports.
+// alloc never frees the memory, filter_vector doubles the vector, or loops forever when spin is true,
+// and filter_distance adds the ID length to the distance.
+func wasmFilterModule(distanceOnly, spin bool) []byte {
+	f32x2 := math.Float32bits(2)
+	vectorBody := []byte{
+		0x02, 0x40, 0x03, 0x40,
+		// i >= len
+		0x20, 0x02, 0x20, 0x01, 0x4f, 0x0d, 0x01,
+		// ptr + i * 4
+		0x20, 0x00, 0x20, 0x02, 0x41, 0x02, 0x74, 0x6a,
+		0x20, 0x00, 0x20, 0x02, 0x41, 0x02, 0x74, 0x6a,
+		0x2a, 0x02, 0x00,
+		0x43, byte(f32x2), byte(f32x2 >> 8), byte(f32x2 >> 16), byte(f32x2 >> 24),
+		0x94,
+		0x38, 0x02, 0x00,
+		// i++
+		0x20, 0x02, 0x41, 0x01, 0x6a, 0x21, 0x02,
+		0x0c, 0x00,
+		0x0b, 0x0b,
+		// ptr << 32 | len
+		0x20, 0x00, 0xad, 0x42, 0x20, 0x86, 0x20, 0x01, 0xad, 0x84,
+		0x0b,
+	}
+	if spin {
+		vectorBody = []byte{0x03, 0x40, 0x0c, 0x00, 0x0b, 0x42, 0x00, 0x0b}
+	}
+	types := wasmVec(
+		// (i32) -> i32
+		[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},
+		// (i32, i32) -> i64
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e},
+		// (i32, i32, f32) -> f32
+		[]byte{0x60, 0x03, 0x7f, 0x7f, 0x7d, 0x01, 0x7d},
+	)
+	funcs := wasmVec([]byte{0x00}, []byte{0x01}, []byte{0x02})
+	exports := [][]byte{
+		append(wasmName("memory"), 0x02, 0x00),
+		append(wasmName("alloc"), 0x00, 0x00),
+		append(wasmName("filter_distance"), 0x00, 0x02),
+	}
+	if !distanceOnly {
+		exports = append(exports, append(wasmName("filter_vector"), 0x00, 0x01))
+	}
+	codes := wasmVec(
+		wasmCode([]byte{0x00}, 0x23, 0x00, 0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00, 0x0b),
+		wasmCode([]byte{0x01, 0x01, 0x7f}, vectorBody...),
+		wasmCode([]byte{0x00}, 0x20, 0x02, 0x20, 0x01, 0xb3, 0x92, 0x0b),
+	)
+	bin := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	bin = append(bin, wasmSection(1, types)...)
+	bin = append(bin, wasmSection(3, funcs)...)
+	bin = append(bin, wasmSection(5, wasmVec([]byte{0x00, 0x01}))...)
+	// the heap pointer of alloc starts from 1024.
+	bin = append(bin, wasmSection(6, wasmVec([]byte{0x7f, 0x01, 0x41, 0x80, 0x08, 0x0b}))...)
+	bin = append(bin, wasmSection(7, wasmVec(exports...))...)
+	bin = append(bin, wasmSection(10, codes)...)
+	return bin
+}
+
+func writePluginFile(t *testing.T, path string, bin []byte) {
+	t.Helper()
+	// the file is replaced by the rename to avoid reading the partially written file.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, bin, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewPlugin(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "plugin.wasm")
+	writePluginFile(t, path, wasmFilterModule(false, false))
+	invalid := filepath.Join(dir, "invalid.wasm")
+	writePluginFile(t, invalid, []byte("vald"))
+
+	type want struct {
+		err error
+	}
+	type test struct {
+		name string
+		opts []PluginOption
+		want want
+	}
+	tests := []test{
+		{
+			name: "returns the plugin when the module is valid",
+			opts: []PluginOption{
+				WithPluginName("double"),
+				WithPluginType("wasm"),
+				WithPluginPath(path),
+				WithPluginTimeout("1s"),
+				WithPluginMemoryLimit("1MB"),
+			},
+		},
+		{
+			name: "returns error when the type is not supported",
+			opts: []PluginOption{
+				WithPluginName("double"),
+				WithPluginType("go"),
+				WithPluginPath(path),
+			},
+			want: want{
+				err: errors.ErrFilterPluginTypeNotSupported("go"),
+			},
+		},
+		{
+			name: "returns error when the path is empty",
+			opts: []PluginOption{
+				WithPluginName("double"),
+			},
+			want: want{
+				err: errors.ErrInvalidFilterPlugin("double", ""),
+			},
+		},
+		{
+			name: "returns error when the module memory exceeds the limit",
+			opts: []PluginOption{
+				WithPluginName("double"),
+				WithPluginPath(path),
+				WithPluginMemoryLimit("1KB"),
+			},
+			want: want{
+				err: errors.ErrFilterPluginLoadFailed("double", errors.New("section memory: min 1 pages (64 Ki) over limit of 0 pages (0 Ki)")),
+			},
+		},
+		{
+			name: "returns error when the module is invalid",
+			opts: []PluginOption{
+				WithPluginName("invalid"),
+				WithPluginPath(invalid),
+			},
+			want: want{
+				err: errors.ErrFilterPluginLoadFailed("invalid", errors.New("invalid magic number")),
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			_, err := NewPlugin(test.opts...)
+			if test.want.err == nil {
+				if err != nil {
+					tt.Errorf("got_error: \"%#v\"", err)
+				}
+				return
+			}
+			if !errors.Is(err, test.want.err) {
+				tt.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, test.want.err)
+			}
+		})
+	}
+}
+
+func Test_wasmPlugin_Filter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugin.wasm")
+	writePluginFile(t, path, wasmFilterModule(false, false))
+	p, err := NewPlugin(
+		WithPluginName("double"),
+		WithPluginPath(path),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	vec, err := p.FilterVector(ctx, &payload.Object_Vector{
+		Id:     "vald",
+		Vector: []float32{1, -2, 0.5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []float32{2, -4, 1}; vec.GetId() != "vald" || !reflect.DeepEqual(vec.GetVector(), want) {
+		t.Errorf("got: %v, want: %v", vec, want)
+	}
+
+	// the pooled instances are used by the concurrent calls.
+	large := make([]float32, 10)
+	for i := range large {
+		large[i] = float32(i)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				vec, err := p.FilterVector(ctx, &payload.Object_Vector{
+					Id:     "vald",
+					Vector: large,
+				})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if got := vec.GetVector(); len(got) != len(large) || got[len(got)-1] != 2*large[len(large)-1] {
+					t.Errorf("got: %v", got[len(got)-1])
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	dist, err := p.FilterDistance(ctx, &payload.Object_Distance{
+		Id:       "vald",
+		Distance: 0.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dist.GetId() != "vald" || dist.GetDistance() != 4.5 {
+		t.Errorf("got: %v, want distance: 4.5", dist)
+	}
+
+	if _, err := p.GenVector(ctx, &payload.Object_Blob{}); !isUnimplemented(err) {
+		t.Errorf("got_error: \"%#v\", want the unimplemented error", err)
+	}
+	if _, err := p.FilterSearchResponse(ctx, &payload.Filter_SearchResponseRequest{}); !isUnimplemented(err) {
+		t.Errorf("got_error: \"%#v\", want the unimplemented error", err)
+	}
+}
+
+func isUnimplemented(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.Unimplemented
+}
+
+func Test_wasmPlugin_Limits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugin.wasm")
+	writePluginFile(t, path, wasmFilterModule(false, true))
+	ctx := context.Background()
+	vec := &payload.Object_Vector{
+		Vector: []float32{1},
+	}
+
+	p, err := NewPlugin(
+		WithPluginName("spin"),
+		WithPluginPath(path),
+		WithPluginTimeout("10ms"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.FilterVector(ctx, vec); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, context.DeadlineExceeded)
+	}
+}
+
+func Test_wasmPlugin_Release(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugin.wasm")
+	writePluginFile(t, path, wasmFilterModule(false, false))
+	p, err := NewPlugin(
+		WithPluginName("double"),
+		WithPluginPath(path),
+		WithPluginMemoryLimit("64KB"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	vec := &payload.Object_Vector{
+		Vector: make([]float32, 1000),
+	}
+
+	// alloc uses up the page of the instance after the 16 calls, and the failed instance is replaced by the new one.
+	for i := 0; i < 40; i++ {
+		_, err := p.FilterVector(ctx, vec)
+		if fail := i%17 == 16; fail != (err != nil) {
+			t.Errorf("call %d: got_error: \"%#v\", want failure: %v", i, err, fail)
+		}
+	}
+}
+
+func Test_wasmPlugin_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugin.wasm")
+	writePluginFile(t, path, wasmFilterModule(false, false))
+	p, err := NewPlugin(
+		WithPluginName("double"),
+		WithPluginPath(path),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ech, err := p.Start(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := p.Stop(context.Background()); err != nil {
+			t.Error(err)
+		}
+	}()
+	vec := &payload.Object_Vector{
+		Vector: []float32{1},
+	}
+
+	// the invalid module is rejected and the current module is kept.
+	writePluginFile(t, path, []byte("vald"))
+	select {
+	case err := <-ech:
+		if !errors.Is(err, errors.ErrFilterPluginLoadFailed("double", errors.New("invalid magic number"))) {
+			t.Errorf("got_error: \"%#v\"", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the reload error is not notified")
+	}
+	if _, err := p.FilterVector(ctx, vec); err != nil {
+		t.Errorf("got_error: \"%#v\"", err)
+	}
+
+	// the new module is loaded when the file is changed.
+	writePluginFile(t, path, wasmFilterModule(true, false))
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err := p.FilterVector(ctx, vec)
+		if isUnimplemented(err) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the plugin is not reloaded: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+type ingressClientMock struct {
+	ingress.Client
+}
+
+func (*ingressClientMock) Target(ctx context.Context, targets ...string) (ingressgrpc.FilterClient, error) {
+	return nil, errors.ErrTargetFilterNotFound(targets[0])
+}
+
+type egressClientMock struct {
+	egress.Client
+}
+
+func (*egressClientMock) Target(ctx context.Context, targets ...string) (egressgrpc.FilterClient, error) {
+	return nil, errors.ErrTargetFilterNotFound(targets[0])
+}
+
+func TestNewPluginClient(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugin.wasm")
+	writePluginFile(t, path, wasmFilterModule(false, false))
+	p, err := NewPlugin(
+		WithPluginName("double"),
+		WithPluginPath(path),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	ic := NewIngressPluginClient(new(ingressClientMock), p)
+	if got, err := ic.Target(ctx, "double"); err != nil || got != p {
+		t.Errorf("got: %#v, got_error: %v, want the plugin", got, err)
+	}
+	if _, err := ic.Target(ctx, "localhost"); !errors.Is(err, errors.ErrTargetFilterNotFound("localhost")) {
+		t.Errorf("got_error: \"%#v\", want the delegated error", err)
+	}
+
+	ec := NewEgressPluginClient(new(egressClientMock), p)
+	if got, err := ec.Target(ctx, "double"); err != nil || got != p {
+		t.Errorf("got: %#v, got_error: %v, want the plugin", got, err)
+	}
+	if _, err := ec.Target(ctx, "localhost"); !errors.Is(err, errors.ErrTargetFilterNotFound("localhost")) {
+		t.Errorf("got_error: \"%#v\", want the delegated error", err)
+	}
+
+	c := new(ingressClientMock)
+	if got := NewIngressPluginClient(c); got != c {
+		t.Errorf("got: %#v, want the client itself when there is no plugin", got)
+	}
+}
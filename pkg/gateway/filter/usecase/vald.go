@@ -23,11 +23,17 @@ import (
 	"github.com/vdaas/vald/internal/client/v1/client/filter/egress"
 	"github.com/vdaas/vald/internal/client/v1/client/filter/ingress"
 	client "github.com/vdaas/vald/internal/client/v1/client/vald"
+	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage"
+	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage/urlopener"
+	"github.com/vdaas/vald/internal/db/storage/blob/local"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3/session"
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/net/grpc"
 	"github.com/vdaas/vald/internal/net/grpc/metric"
 	"github.com/vdaas/vald/internal/observability"
+	metrics "github.com/vdaas/vald/internal/observability/metrics/gateway/filter"
 	"github.com/vdaas/vald/internal/runner"
 	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/internal/servers/server"
@@ -36,6 +42,7 @@ import (
 	handler "github.com/vdaas/vald/pkg/gateway/filter/handler/grpc"
 	"github.com/vdaas/vald/pkg/gateway/filter/handler/rest"
 	"github.com/vdaas/vald/pkg/gateway/filter/router"
+	"github.com/vdaas/vald/pkg/gateway/filter/service"
 )
 
 type run struct {
@@ -46,6 +53,8 @@ type run struct {
 	client        client.Client
 	ingress       ingress.Client
 	egress        egress.Client
+	objectStore   service.ObjectStore
+	plugins       []service.Plugin
 }
 
 func New(cfg *config.Data) (r runner.Runner, err error) {
@@ -66,8 +75,14 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 	if err != nil {
 		return nil, err
 	}
+	var hooks []service.Hook
 	if cfg.Observability.Enabled {
-		obs, err = observability.NewWithConfig(cfg.Observability)
+		metricsHook, err := metrics.New()
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, metricsHook)
+		obs, err = observability.NewWithConfig(cfg.Observability, metricsHook)
 		if err != nil {
 			return nil, err
 		}
@@ -91,6 +106,15 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		)
 	}
 
+	iplugins, err := newPlugins(eg, cfg.IngressFilters.Plugins)
+	if err != nil {
+		return nil, err
+	}
+	eplugins, err := newPlugins(eg, cfg.EgressFilters.Plugins)
+	if err != nil {
+		return nil, err
+	}
+
 	c, err := client.New(
 		client.WithAddrs(cfg.Client.Addrs...),
 		client.WithClient(grpc.New(copts...)),
@@ -99,42 +123,88 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		return nil, err
 	}
 	ic, err := ingress.New(
-		ingress.WithAddrs(append(append(append(append(append(
+		ingress.WithAddrs(excludePlugins(iplugins, append(append(append(append(append(append(append(append(append(
 			cfg.IngressFilters.Client.Addrs,
 			cfg.IngressFilters.Vectorizer),
 			cfg.IngressFilters.SearchFilters...),
 			cfg.IngressFilters.InsertFilters...),
 			cfg.IngressFilters.UpdateFilters...),
-			cfg.IngressFilters.UpsertFilters...)...),
+			cfg.IngressFilters.UpsertFilters...),
+			stageTargets(cfg.IngressFilters.SearchPipeline)...),
+			stageTargets(cfg.IngressFilters.InsertPipeline)...),
+			stageTargets(cfg.IngressFilters.UpdatePipeline)...),
+			stageTargets(cfg.IngressFilters.UpsertPipeline)...))...),
 		ingress.WithClient(grpc.New(icopts...)),
 	)
 	if err != nil {
 		return nil, err
 	}
 	ec, err := egress.New(
-		egress.WithAddrs(append(append(
+		egress.WithAddrs(excludePlugins(eplugins, append(append(append(append(append(
 			cfg.EgressFilters.Client.Addrs,
 			cfg.EgressFilters.DistanceFilters...),
-			cfg.EgressFilters.ObjectFilters...)...),
+			cfg.EgressFilters.ObjectFilters...),
+			cfg.EgressFilters.SearchResponseFilters...),
+			stageTargets(cfg.EgressFilters.DistancePipeline)...),
+			stageTargets(cfg.EgressFilters.ObjectPipeline)...))...),
 		egress.WithClient(grpc.New(ecopts...)),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// the plugin names are used as the filter targets which are called in-process.
+	ic = service.NewIngressPluginClient(ic, iplugins...)
+	ec = service.NewEgressPluginClient(ec, eplugins...)
+
+	pipelines := make(map[string]service.Pipeline, 6)
+	for name, pc := range map[string]struct {
+		stages  []*config.FilterStage
+		targets []string
+	}{
+		"search":   {cfg.IngressFilters.SearchPipeline, cfg.IngressFilters.SearchFilters},
+		"insert":   {cfg.IngressFilters.InsertPipeline, cfg.IngressFilters.InsertFilters},
+		"update":   {cfg.IngressFilters.UpdatePipeline, cfg.IngressFilters.UpdateFilters},
+		"upsert":   {cfg.IngressFilters.UpsertPipeline, cfg.IngressFilters.UpsertFilters},
+		"distance": {cfg.EgressFilters.DistancePipeline, cfg.EgressFilters.DistanceFilters},
+		"object":   {cfg.EgressFilters.ObjectPipeline, cfg.EgressFilters.ObjectFilters},
+	} {
+		// the configured stages run first, followed by the stages of the filter targets.
+		pipelines[name], err = service.NewPipeline(
+			service.WithPipelineName(name),
+			service.WithStages(pc.stages...),
+			service.WithTargets(pc.targets...),
+			service.WithHooks(hooks...),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var ostore service.ObjectStore
+	if cfg.ObjectStore.Enabled {
+		ostore, err = newObjectStore(eg, cfg.ObjectStore)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	v := handler.New(
 		handler.WithValdClient(c),
+		handler.WithObjectStore(ostore),
 		handler.WithEgressFilterClient(ec),
 		handler.WithIngressFilterClient(ic),
 		handler.WithErrGroup(eg),
 		handler.WithStreamConcurrency(cfg.Server.GetGRPCStreamConcurrency()),
 		handler.WithVectorizerTargets(cfg.IngressFilters.Vectorizer),
-		handler.WithSearchFilterTargets(cfg.IngressFilters.SearchFilters...),
-		handler.WithInsertFilterTargets(cfg.IngressFilters.InsertFilters...),
-		handler.WithUpdateFilterTargets(cfg.IngressFilters.UpdateFilters...),
-		handler.WithUpsertFilterTargets(cfg.IngressFilters.UpsertFilters...),
-		handler.WithDistanceFilterTargets(cfg.EgressFilters.DistanceFilters...),
-		handler.WithObjectFilterTargets(cfg.EgressFilters.ObjectFilters...),
+		handler.WithSearchFilterPipeline(pipelines["search"]),
+		handler.WithInsertFilterPipeline(pipelines["insert"]),
+		handler.WithUpdateFilterPipeline(pipelines["update"]),
+		handler.WithUpsertFilterPipeline(pipelines["upsert"]),
+		handler.WithDistanceFilterPipeline(pipelines["distance"]),
+		handler.WithObjectFilterPipeline(pipelines["object"]),
+		handler.WithSearchResponseFilterTargets(cfg.EgressFilters.SearchResponseFilters...),
+		handler.WithSearchOverFetchRatio(cfg.EgressFilters.OverFetchRatio),
 	)
 
 	grpcServerOptions := []server.Option{
@@ -187,9 +257,109 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		client:        c,
 		ingress:       ic,
 		egress:        ec,
+		objectStore:   ostore,
+		plugins:       append(iplugins, eplugins...),
 	}, nil
 }
 
+func newPlugins(eg errgroup.Group, cfgs []*config.FilterPlugin) ([]service.Plugin, error) {
+	plugins := make([]service.Plugin, 0, len(cfgs))
+	for _, pc := range cfgs {
+		if pc == nil {
+			continue
+		}
+		p, err := service.NewPlugin(
+			service.WithPluginErrGroup(eg),
+			service.WithPluginName(pc.Name),
+			service.WithPluginType(pc.Type),
+			service.WithPluginPath(pc.Path),
+			service.WithPluginTimeout(pc.Timeout),
+			service.WithPluginMemoryLimit(pc.MemoryLimit),
+		)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, p)
+	}
+	return plugins, nil
+}
+
+// excludePlugins removes the plugin names from the filter addresses, because the plugins are not dialed.
+func excludePlugins(plugins []service.Plugin, addrs []string) []string {
+	if len(plugins) == 0 {
+		return addrs
+	}
+	names := make(map[string]struct{}, len(plugins))
+	for _, p := range plugins {
+		names[p.Name()] = struct{}{}
+	}
+	res := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := names[addr]; !ok {
+			res = append(res, addr)
+		}
+	}
+	return res
+}
+
+func newObjectStore(eg errgroup.Group, cfg *config.ObjectStore) (service.ObjectStore, error) {
+	return service.NewObjectStore(
+		service.WithObjectStoreErrGroup(eg),
+		service.WithObjectStoreType(cfg.BlobStorage.StorageType),
+		service.WithObjectStoreBucketName(cfg.BlobStorage.Bucket),
+		service.WithObjectStorePrefix(cfg.Prefix),
+		service.WithObjectStoreS3SessionOpts(
+			session.WithEndpoint(cfg.BlobStorage.S3.Endpoint),
+			session.WithRegion(cfg.BlobStorage.S3.Region),
+			session.WithAccessKey(cfg.BlobStorage.S3.AccessKey),
+			session.WithSecretAccessKey(cfg.BlobStorage.S3.SecretAccessKey),
+			session.WithToken(cfg.BlobStorage.S3.Token),
+			session.WithMaxRetries(cfg.BlobStorage.S3.MaxRetries),
+			session.WithForcePathStyle(cfg.BlobStorage.S3.ForcePathStyle),
+			session.WithUseAccelerate(cfg.BlobStorage.S3.UseAccelerate),
+			session.WithUseARNRegion(cfg.BlobStorage.S3.UseARNRegion),
+			session.WithUseDualStack(cfg.BlobStorage.S3.UseDualStack),
+			session.WithEnableSSL(cfg.BlobStorage.S3.EnableSSL),
+			session.WithEnableParamValidation(cfg.BlobStorage.S3.EnableParamValidation),
+			session.WithEnable100Continue(cfg.BlobStorage.S3.Enable100Continue),
+			session.WithEnableContentMD5Validation(cfg.BlobStorage.S3.EnableContentMD5Validation),
+			session.WithEnableEndpointDiscovery(cfg.BlobStorage.S3.EnableEndpointDiscovery),
+			session.WithEnableEndpointHostPrefix(cfg.BlobStorage.S3.EnableEndpointHostPrefix),
+		),
+		service.WithObjectStoreS3Opts(
+			s3.WithMaxPartSize(cfg.BlobStorage.S3.MaxPartSize),
+			s3.WithMaxChunkSize(cfg.BlobStorage.S3.MaxChunkSize),
+			s3.WithDownloadConcurrency(cfg.BlobStorage.S3.DownloadConcurrency),
+		),
+		service.WithObjectStoreCloudStorageURLOpenerOpts(
+			urlopener.WithCredentialsFile(cfg.BlobStorage.CloudStorage.Client.CredentialsFilePath),
+			urlopener.WithCredentialsJSON(cfg.BlobStorage.CloudStorage.Client.CredentialsJSON),
+		),
+		service.WithObjectStoreCloudStorageOpts(
+			cloudstorage.WithURL(cfg.BlobStorage.CloudStorage.URL),
+			cloudstorage.WithWriteBufferSize(cfg.BlobStorage.CloudStorage.WriteBufferSize),
+			cloudstorage.WithWriteCacheControl(cfg.BlobStorage.CloudStorage.WriteCacheControl),
+			cloudstorage.WithWriteContentDisposition(cfg.BlobStorage.CloudStorage.WriteContentDisposition),
+			cloudstorage.WithWriteContentEncoding(cfg.BlobStorage.CloudStorage.WriteContentEncoding),
+			cloudstorage.WithWriteContentLanguage(cfg.BlobStorage.CloudStorage.WriteContentLanguage),
+			cloudstorage.WithWriteContentType(cfg.BlobStorage.CloudStorage.WriteContentType),
+		),
+		service.WithObjectStoreLocalOpts(
+			local.WithDir(cfg.BlobStorage.Local.Path),
+		),
+	)
+}
+
+func stageTargets(stages []*config.FilterStage) []string {
+	addrs := make([]string, 0, len(stages))
+	for _, stage := range stages {
+		if stage != nil && len(stage.Target) != 0 {
+			addrs = append(addrs, stage.Target)
+		}
+	}
+	return addrs
+}
+
 func (r *run) PreStart(ctx context.Context) error {
 	if r.observability != nil {
 		return r.observability.PreStart(ctx)
@@ -198,8 +368,8 @@ func (r *run) PreStart(ctx context.Context) error {
 }
 
 func (r *run) Start(ctx context.Context) (<-chan error, error) {
-	ech := make(chan error, 6)
-	var eech, iech, sech, oech, cech <-chan error
+	ech := make(chan error, 8)
+	var eech, iech, sech, oech, cech, osech, pech <-chan error
 	var err error
 	if r.observability != nil {
 		oech = r.observability.Start(ctx)
@@ -225,6 +395,20 @@ func (r *run) Start(ctx context.Context) (<-chan error, error) {
 			return nil, err
 		}
 	}
+	if r.objectStore != nil {
+		osech, err = r.objectStore.Start(ctx)
+		if err != nil {
+			close(ech)
+			return nil, err
+		}
+	}
+	if len(r.plugins) != 0 {
+		pech, err = r.startPlugins(ctx)
+		if err != nil {
+			close(ech)
+			return nil, err
+		}
+	}
 	sech = r.server.ListenAndServe(ctx)
 	r.eg.Go(safety.RecoverFunc(func() (err error) {
 		defer close(ech)
@@ -236,6 +420,8 @@ func (r *run) Start(ctx context.Context) (<-chan error, error) {
 			case err = <-iech:
 			case err = <-eech:
 			case err = <-cech:
+			case err = <-osech:
+			case err = <-pech:
 			case err = <-sech:
 			}
 			if err != nil {
@@ -250,6 +436,42 @@ func (r *run) Start(ctx context.Context) (<-chan error, error) {
 	return ech, nil
 }
 
+// startPlugins starts the plugins and merges their error channels.
+func (r *run) startPlugins(ctx context.Context) (<-chan error, error) {
+	ech := make(chan error, len(r.plugins))
+	echs := make([]<-chan error, 0, len(r.plugins))
+	for _, p := range r.plugins {
+		pech, err := p.Start(ctx)
+		if err != nil {
+			return nil, err
+		}
+		echs = append(echs, pech)
+	}
+	for _, pech := range echs {
+		pech := pech
+		r.eg.Go(safety.RecoverFunc(func() error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case err, ok := <-pech:
+					if !ok {
+						return nil
+					}
+					if err != nil {
+						select {
+						case <-ctx.Done():
+							return nil
+						case ech <- err:
+						}
+					}
+				}
+			}
+		}))
+	}
+	return ech, nil
+}
+
 func (r *run) PreStop(ctx context.Context) error {
 	return nil
 }
@@ -258,7 +480,26 @@ func (r *run) Stop(ctx context.Context) error {
 	if r.observability != nil {
 		r.observability.Stop(ctx)
 	}
-	return r.server.Shutdown(ctx)
+	err := r.server.Shutdown(ctx)
+	for _, p := range r.plugins {
+		if perr := p.Stop(ctx); perr != nil {
+			if err != nil {
+				err = errors.Wrap(err, perr.Error())
+			} else {
+				err = perr
+			}
+		}
+	}
+	if r.objectStore != nil {
+		serr := r.objectStore.Stop(ctx)
+		if serr != nil {
+			if err != nil {
+				return errors.Wrap(err, serr.Error())
+			}
+			return serr
+		}
+	}
+	return err
 }
 
 func (r *run) PostStop(ctx context.Context) (err error) {
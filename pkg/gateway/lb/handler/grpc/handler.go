@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -51,8 +52,13 @@ type server struct {
 	timeout           time.Duration
 	replica           int
 	streamConcurrency int
+	cache             service.SearchCache
 	name              string
 	ip                string
+
+	streamInsertBatchSize     int
+	streamInsertBatchDuration time.Duration
+
 	vald.UnimplementedValdServer
 }
 
@@ -79,8 +85,8 @@ func (s *server) Exists(ctx context.Context, meta *payload.Object_ID) (id *paylo
 	s.eg.Go(func() error {
 		defer close(ich)
 		defer close(ech)
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithCancel(ctx)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
 		var once sync.Once
 		ech <- s.gateway.BroadCast(ctx, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
 			sctx, sspan := trace.StartSpan(ctx, apiName+".Exists/"+target)
@@ -200,6 +206,19 @@ func (s *server) Search(ctx context.Context, req *payload.Search_Request) (res *
 		}
 		return nil, err
 	}
+	if s.cache != nil {
+		var ok bool
+		res, ok = s.cache.Get(req)
+		if ok {
+			return res, nil
+		}
+		gen := s.cache.Generation()
+		defer func() {
+			if err == nil {
+				s.cache.Set(req, res, gen)
+			}
+		}()
+	}
 	res, err = s.search(ctx, req.GetConfig(),
 		func(ctx context.Context, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
 			return vc.Search(ctx, req, copts...)
@@ -251,6 +270,8 @@ func (s *server) SearchByID(ctx context.Context, req *payload.Search_IDRequest)
 		}
 		return nil, err
 	}
+	// resolve the query vector once from the agents which own the ID,
+	// and then search it across all agents as a normal vector search.
 	oreq := &payload.Object_VectorRequest{
 		Id: &payload.Object_ID{
 			Id: req.GetId(),
@@ -259,7 +280,7 @@ func (s *server) SearchByID(ctx context.Context, req *payload.Search_IDRequest)
 	}
 	vec, err := s.GetObject(ctx, oreq)
 	if err != nil {
-		_, _, err := status.ParseError(err, codes.NotFound, fmt.Sprintf("SearchByID API failed to get uuid %s's object", req.GetId()),
+		st, msg, err := status.ParseError(err, codes.NotFound, fmt.Sprintf("SearchByID API failed to get uuid %s's object", req.GetId()),
 			&errdetails.RequestInfo{
 				RequestId:   req.GetConfig().GetRequestId(),
 				ServingData: errdetails.Serialize(oreq),
@@ -268,36 +289,17 @@ func (s *server) SearchByID(ctx context.Context, req *payload.Search_IDRequest)
 				ResourceType: errdetails.ValdGRPCResourceTypePrefix + "/vald.v1.GetObject",
 				ResourceName: fmt.Sprintf("%s: %s(%s) to %v", apiName, s.name, s.ip, s.gateway.Addrs(ctx)),
 			})
-		var serr error
-		res, serr = s.search(ctx, req.GetConfig(),
-			func(ctx context.Context, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
-				return vc.SearchByID(ctx, req, copts...)
-			})
-
-		if serr == nil {
-			return res, nil
-		}
-		err = errors.Wrap(err, serr.Error())
-		st, msg, serr := status.ParseError(err, codes.Internal, "SearchByID API failed to process search request",
-			&errdetails.RequestInfo{
-				RequestId: req.GetConfig().GetRequestId(),
-			},
-			&errdetails.ResourceInfo{
-				ResourceType: errdetails.ValdGRPCResourceTypePrefix + "/vald.v1.SearchByID",
-				ResourceName: fmt.Sprintf("%s: %s(%s) to %v", apiName, s.name, s.ip, s.gateway.Addrs(ctx)),
-			})
 		if span != nil {
 			span.SetStatus(trace.FromGRPCStatus(st.Code(), msg))
 		}
-		return nil, errors.Wrap(err, serr.Error())
+		return nil, err
 	}
-	sreq := &payload.Search_Request{
+	res, err = s.Search(ctx, &payload.Search_Request{
 		Vector: vec.GetVector(),
 		Config: req.GetConfig(),
-	}
-	res, err = s.Search(ctx, sreq)
+	})
 	if err != nil {
-		_, _, err := status.ParseError(err, codes.Internal, "SearchByID API failed to process search request",
+		st, msg, err := status.ParseError(err, codes.Internal, "SearchByID API failed to process search request",
 			&errdetails.RequestInfo{
 				RequestId: req.GetConfig().GetRequestId(),
 			},
@@ -305,23 +307,6 @@ func (s *server) SearchByID(ctx context.Context, req *payload.Search_IDRequest)
 				ResourceType: errdetails.ValdGRPCResourceTypePrefix + "/vald.v1.Search",
 				ResourceName: fmt.Sprintf("%s: %s(%s) to %v", apiName, s.name, s.ip, s.gateway.Addrs(ctx)),
 			}, info.Get())
-		var serr error
-		res, serr = s.search(ctx, req.GetConfig(),
-			func(ctx context.Context, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
-				return vc.SearchByID(ctx, req, copts...)
-			})
-		if serr == nil {
-			return res, nil
-		}
-		st, msg, serr := status.ParseError(serr, codes.Internal, "SearchByID API failed to process search request",
-			&errdetails.RequestInfo{
-				RequestId: req.GetConfig().GetRequestId(),
-			},
-			&errdetails.ResourceInfo{
-				ResourceType: errdetails.ValdGRPCResourceTypePrefix + "/vald.v1.SearchByID",
-				ResourceName: fmt.Sprintf("%s: %s(%s) to %v", apiName, s.name, s.ip, s.gateway.Addrs(ctx)),
-			})
-		err = errors.Wrap(err, serr.Error())
 		if span != nil {
 			span.SetStatus(trace.FromGRPCStatus(st.Code(), msg))
 		}
@@ -330,6 +315,13 @@ func (s *server) SearchByID(ctx context.Context, req *payload.Search_IDRequest)
 	return res, nil
 }
 
+// invalidateCache invalidates the cached search results, since the index may be changed by the write request.
+func (s *server) invalidateCache() {
+	if s.cache != nil {
+		s.cache.Invalidate()
+	}
+}
+
 type DistPayload struct {
 	raw      *payload.Object_Distance
 	distance *big.Float
@@ -798,6 +790,7 @@ func (s *server) Insert(ctx context.Context, req *payload.Insert_Request) (ce *p
 			span.End()
 		}
 	}()
+	defer s.invalidateCache()
 	vec := req.GetVector().GetVector()
 	uuid := req.GetVector().GetId()
 	vl := len(vec)
@@ -868,6 +861,8 @@ func (s *server) Insert(ctx context.Context, req *payload.Insert_Request) (ce *p
 	}
 	emu := new(sync.Mutex)
 	var errs error
+	acked := make(map[string]struct{}, s.replica)
+	failures := make(map[string]*payload.Object_Location_Failure)
 	err = s.gateway.DoMulti(ctx, s.replica, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
 		ctx, span := trace.StartSpan(ctx, apiName+".Insert/"+target)
 		defer func() {
@@ -886,6 +881,12 @@ func (s *server) Insert(ctx context.Context, req *payload.Insert_Request) (ce *p
 							"/vald.v1.Insert.DoMulti/" +
 							target + " canceled: " + err.Error()))
 				}
+				emu.Lock()
+				failures[target] = &payload.Object_Location_Failure{
+					Addr:   target,
+					Status: status.New(codes.Canceled, err.Error()).Proto(),
+				}
+				emu.Unlock()
 				return nil
 			case errors.Is(err, context.DeadlineExceeded),
 				errors.Is(err, errors.ErrRPCCallFailed(target, context.DeadlineExceeded)):
@@ -895,6 +896,12 @@ func (s *server) Insert(ctx context.Context, req *payload.Insert_Request) (ce *p
 							"/vald.v1.Insert.DoMulti/" +
 							target + " deadline_exceeded: " + err.Error()))
 				}
+				emu.Lock()
+				failures[target] = &payload.Object_Location_Failure{
+					Addr:   target,
+					Status: status.New(codes.DeadlineExceeded, err.Error()).Proto(),
+				}
+				emu.Unlock()
 				return nil
 			}
 			st, msg, err := status.ParseError(err, codes.Internal,
@@ -917,15 +924,27 @@ func (s *server) Insert(ctx context.Context, req *payload.Insert_Request) (ce *p
 				} else {
 					errs = errors.Wrap(errs, err.Error())
 				}
+				failures[target] = &payload.Object_Location_Failure{
+					Addr:   target,
+					Status: st.Proto(),
+				}
 				emu.Unlock()
 				return err
 			}
+			emu.Lock()
+			acked[target] = struct{}{}
+			delete(failures, target)
+			emu.Unlock()
 			return nil
 		}
 		mu.Lock()
 		ce.Ips = append(ce.GetIps(), loc.GetIps()...)
 		ce.Name = loc.GetName()
 		mu.Unlock()
+		emu.Lock()
+		acked[target] = struct{}{}
+		delete(failures, target)
+		emu.Unlock()
 		return nil
 	})
 	if err != nil {
@@ -935,6 +954,16 @@ func (s *server) Insert(ctx context.Context, req *payload.Insert_Request) (ce *p
 			errs = errors.Wrap(errs, err.Error())
 		}
 	}
+	ce.Failures = sortedFailures(failures)
+	required := requiredAcks(req.GetConfig().GetConsistency(), s.replicas(ctx))
+	switch {
+	case required > 0 && len(acked) >= required:
+		errs = nil
+	case required > 0 && errs != nil:
+		errs = errors.Wrap(errors.ErrInsufficientReplicaAcks(len(acked), required), errs.Error())
+	case required > 0:
+		errs = errors.ErrInsufficientReplicaAcks(len(acked), required)
+	}
 	if errs != nil {
 		st, msg, err := status.ParseError(errs, codes.Internal,
 			"failed to parse Insert gRPC error response",
@@ -962,33 +991,15 @@ func (s *server) StreamInsert(stream vald.Insert_StreamInsertServer) (err error)
 			span.End()
 		}
 	}()
-	err = grpc.BidirectionalStream(ctx, stream, s.streamConcurrency,
+	err = grpc.BidirectionalStreamBatch(ctx, stream, s.streamConcurrency,
+		s.streamInsertBatchSize, s.streamInsertBatchDuration,
 		func() interface{} { return new(payload.Insert_Request) },
-		func(ctx context.Context, data interface{}) (interface{}, error) {
-			req := data.(*payload.Insert_Request)
-			ctx, sspan := trace.StartSpan(ctx, apiName+".StreamInsert/id-"+req.GetVector().GetId())
-			defer func() {
-				if sspan != nil {
-					sspan.End()
-				}
-			}()
-			res, err := s.Insert(ctx, req)
-			if err != nil {
-				st, msg, err := status.ParseError(err, codes.Internal, "failed to parse Insert gRPC error response")
-				if sspan != nil {
-					sspan.SetStatus(trace.FromGRPCStatus(st.Code(), msg))
-				}
-				return &payload.Object_StreamLocation{
-					Payload: &payload.Object_StreamLocation_Status{
-						Status: st.Proto(),
-					},
-				}, err
+		func(ctx context.Context, data []interface{}) ([]interface{}, error) {
+			reqs := make([]*payload.Insert_Request, 0, len(data))
+			for _, d := range data {
+				reqs = append(reqs, d.(*payload.Insert_Request))
 			}
-			return &payload.Object_StreamLocation{
-				Payload: &payload.Object_StreamLocation_Location{
-					Location: res,
-				},
-			}, nil
+			return s.streamInsertBatch(ctx, reqs)
 		})
 
 	if err != nil {
@@ -1001,6 +1012,104 @@ func (s *server) StreamInsert(stream vald.Insert_StreamInsertServer) (err error)
 	return nil
 }
 
+// streamInsertBatch inserts the coalesced StreamInsert requests with a single MultiInsert fan-out.
+// When the batch can not be inserted at once, each request is inserted individually
+// so that every message receives its own location or status.
+// If the batch may have reached the agents, the individual inserts skip the strict exist check
+// and the agents which already stored the vector acknowledge it with AlreadyExists,
+// so that the partially written requests are not written twice.
+func (s *server) streamInsertBatch(ctx context.Context, reqs []*payload.Insert_Request) ([]interface{}, error) {
+	res := make([]interface{}, len(reqs))
+	if len(reqs) > 1 && !hasDuplicateID(reqs) {
+		skips := make([]bool, 0, len(reqs))
+		for _, req := range reqs {
+			skips = append(skips, req.GetConfig().GetSkipStrictExistCheck())
+		}
+		locs, err := s.MultiInsert(ctx, &payload.Insert_MultiRequest{
+			Requests: reqs,
+		})
+		if err == nil && len(locs.GetLocations()) >= len(reqs) {
+			for i, loc := range locs.GetLocations()[:len(reqs)] {
+				if len(loc.GetUuid()) == 0 {
+					loc.Uuid = reqs[i].GetVector().GetId()
+				}
+				res[i] = &payload.Object_StreamLocation{
+					Payload: &payload.Object_StreamLocation_Location{
+						Location: loc,
+					},
+				}
+			}
+			return res, nil
+		}
+		log.Debugf("StreamInsert API failed to insert %d requests at once, falling back to individual Insert: %v", len(reqs), err)
+		written := true
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument, codes.AlreadyExists:
+				// MultiInsert rejected the batch before sending it to the agents.
+				written = false
+			}
+		}
+		for i, req := range reqs {
+			if req.GetConfig() == nil {
+				req.Config = new(payload.Insert_Config)
+			}
+			req.GetConfig().SkipStrictExistCheck = written || skips[i]
+		}
+	}
+	var errs error
+	for i, req := range reqs {
+		loc, err := s.streamInsert(ctx, req)
+		res[i] = loc
+		if err != nil {
+			if errs == nil {
+				errs = err
+			} else {
+				errs = errors.Wrap(errs, err.Error())
+			}
+		}
+	}
+	return res, errs
+}
+
+func (s *server) streamInsert(ctx context.Context, req *payload.Insert_Request) (*payload.Object_StreamLocation, error) {
+	ctx, sspan := trace.StartSpan(ctx, apiName+".StreamInsert/id-"+req.GetVector().GetId())
+	defer func() {
+		if sspan != nil {
+			sspan.End()
+		}
+	}()
+	res, err := s.Insert(ctx, req)
+	if err != nil {
+		st, msg, err := status.ParseError(err, codes.Internal, "failed to parse Insert gRPC error response")
+		if sspan != nil {
+			sspan.SetStatus(trace.FromGRPCStatus(st.Code(), msg))
+		}
+		return &payload.Object_StreamLocation{
+			Payload: &payload.Object_StreamLocation_Status{
+				Status: st.Proto(),
+			},
+		}, err
+	}
+	return &payload.Object_StreamLocation{
+		Payload: &payload.Object_StreamLocation_Location{
+			Location: res,
+		},
+	}, nil
+}
+
+func hasDuplicateID(reqs []*payload.Insert_Request) bool {
+	ids := make(map[string]struct{}, len(reqs))
+	for _, req := range reqs {
+		id := req.GetVector().GetId()
+		if _, ok := ids[id]; ok {
+			return true
+		}
+		ids[id] = struct{}{}
+	}
+	return false
+}
+
 func (s *server) MultiInsert(ctx context.Context, reqs *payload.Insert_MultiRequest) (locs *payload.Object_Locations, err error) {
 	ctx, span := trace.StartSpan(ctx, apiName+".MultiInsert")
 	defer func() {
@@ -1008,7 +1117,31 @@ func (s *server) MultiInsert(ctx context.Context, reqs *payload.Insert_MultiRequ
 			span.End()
 		}
 	}()
+	defer s.invalidateCache()
 	vecs := reqs.GetRequests()
+	cs := make([]payload.Consistency, 0, len(vecs))
+	for _, req := range vecs {
+		cs = append(cs, req.GetConfig().GetConsistency())
+	}
+	consistency, err := multiConsistency(cs...)
+	if err != nil {
+		err = status.WrapWithInvalidArgument("MultiInsert API invalid consistency argument", err,
+			&errdetails.RequestInfo{
+				ServingData: errdetails.Serialize(reqs),
+			},
+			&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequestFieldViolation{
+					{
+						Field:       "consistency",
+						Description: err.Error(),
+					},
+				},
+			}, info.Get())
+		if span != nil {
+			span.SetStatus(trace.StatusCodeInvalidArgument(err.Error()))
+		}
+		return nil, err
+	}
 	ids := make([]string, 0, len(vecs))
 	now := time.Now().UnixNano()
 	for i, req := range vecs {
@@ -1083,6 +1216,8 @@ func (s *server) MultiInsert(ctx context.Context, reqs *payload.Insert_MultiRequ
 
 	emu := new(sync.Mutex)
 	var errs error
+	acked := make(map[string]struct{}, s.replica)
+	failures := make(map[string]*payload.Object_Location_Failure)
 	err = s.gateway.DoMulti(ctx, s.replica, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
 		ctx, span := trace.StartSpan(ctx, apiName+".MultiInsert/"+target)
 		defer func() {
@@ -1101,6 +1236,12 @@ func (s *server) MultiInsert(ctx context.Context, reqs *payload.Insert_MultiRequ
 							"/vald.v1.MultiInsert.DoMulti/" +
 							target + " canceled: " + err.Error()))
 				}
+				emu.Lock()
+				failures[target] = &payload.Object_Location_Failure{
+					Addr:   target,
+					Status: status.New(codes.Canceled, err.Error()).Proto(),
+				}
+				emu.Unlock()
 				return nil
 			case errors.Is(err, context.DeadlineExceeded),
 				errors.Is(err, errors.ErrRPCCallFailed(target, context.DeadlineExceeded)):
@@ -1110,6 +1251,12 @@ func (s *server) MultiInsert(ctx context.Context, reqs *payload.Insert_MultiRequ
 							"/vald.v1.MultiInsert.DoMulti/" +
 							target + " deadline_exceeded: " + err.Error()))
 				}
+				emu.Lock()
+				failures[target] = &payload.Object_Location_Failure{
+					Addr:   target,
+					Status: status.New(codes.DeadlineExceeded, err.Error()).Proto(),
+				}
+				emu.Unlock()
 				return nil
 			}
 			st, msg, err := status.ParseError(err, codes.Internal,
@@ -1133,6 +1280,10 @@ func (s *server) MultiInsert(ctx context.Context, reqs *payload.Insert_MultiRequ
 				} else {
 					errs = errors.Wrap(errs, err.Error())
 				}
+				failures[target] = &payload.Object_Location_Failure{
+					Addr:   target,
+					Status: st.Proto(),
+				}
 				emu.Unlock()
 			}
 			return err
@@ -1140,6 +1291,10 @@ func (s *server) MultiInsert(ctx context.Context, reqs *payload.Insert_MultiRequ
 		mu.Lock()
 		locs.Locations = append(locs.GetLocations(), loc.Locations...)
 		mu.Unlock()
+		emu.Lock()
+		acked[target] = struct{}{}
+		delete(failures, target)
+		emu.Unlock()
 		return nil
 	})
 	if err != nil {
@@ -1149,6 +1304,15 @@ func (s *server) MultiInsert(ctx context.Context, reqs *payload.Insert_MultiRequ
 			errs = errors.Wrap(errs, err.Error())
 		}
 	}
+	required := requiredAcks(consistency, s.replicas(ctx))
+	switch {
+	case required > 0 && len(acked) >= required:
+		errs = nil
+	case required > 0 && errs != nil:
+		errs = errors.Wrap(errors.ErrInsufficientReplicaAcks(len(acked), required), errs.Error())
+	case required > 0:
+		errs = errors.ErrInsufficientReplicaAcks(len(acked), required)
+	}
 
 	if errs != nil {
 		st, msg, err := status.ParseError(errs, codes.Internal,
@@ -1166,7 +1330,13 @@ func (s *server) MultiInsert(ctx context.Context, reqs *payload.Insert_MultiRequ
 		}
 		return nil, err
 	}
-	return location.ReStructure(ids, locs), nil
+	locs = location.ReStructure(ids, locs)
+	if fs := sortedFailures(failures); len(fs) != 0 {
+		for _, loc := range locs.GetLocations() {
+			loc.Failures = fs
+		}
+	}
+	return locs, nil
 }
 
 func (s *server) Update(ctx context.Context, req *payload.Update_Request) (res *payload.Object_Location, err error) {
@@ -1176,6 +1346,7 @@ func (s *server) Update(ctx context.Context, req *payload.Update_Request) (res *
 			span.End()
 		}
 	}()
+	defer s.invalidateCache()
 	vec := req.GetVector().GetVector()
 	uuid := req.GetVector().GetId()
 	vl := len(vec)
@@ -1264,6 +1435,7 @@ func (s *server) Update(ctx context.Context, req *payload.Update_Request) (res *
 		Config: &payload.Remove_Config{
 			SkipStrictExistCheck: true,
 			Timestamp:            now,
+			Consistency:          req.GetConfig().GetConsistency(),
 		},
 	}
 	res, err = s.Remove(ctx, rreq)
@@ -1290,6 +1462,7 @@ func (s *server) Update(ctx context.Context, req *payload.Update_Request) (res *
 			SkipStrictExistCheck: true,
 			Filters:              req.GetConfig().GetFilters(),
 			Timestamp:            now,
+			Consistency:          req.GetConfig().GetConsistency(),
 		},
 	}
 	res, err = s.Insert(ctx, ireq)
@@ -1366,6 +1539,7 @@ func (s *server) MultiUpdate(ctx context.Context, reqs *payload.Update_MultiRequ
 			span.End()
 		}
 	}()
+	defer s.invalidateCache()
 	vecs := reqs.GetRequests()
 	ids := make([]string, 0, len(vecs))
 	ireqs := make([]*payload.Insert_Request, 0, len(vecs))
@@ -1443,6 +1617,7 @@ func (s *server) MultiUpdate(ctx context.Context, reqs *payload.Update_MultiRequ
 			Config: &payload.Remove_Config{
 				SkipStrictExistCheck: true,
 				Timestamp:            n,
+				Consistency:          req.GetConfig().GetConsistency(),
 			},
 		})
 		n++
@@ -1452,6 +1627,7 @@ func (s *server) MultiUpdate(ctx context.Context, reqs *payload.Update_MultiRequ
 				SkipStrictExistCheck: true,
 				Filters:              req.GetConfig().GetFilters(),
 				Timestamp:            n,
+				Consistency:          req.GetConfig().GetConsistency(),
 			},
 		})
 	}
@@ -1504,6 +1680,7 @@ func (s *server) Upsert(ctx context.Context, req *payload.Upsert_Request) (loc *
 			span.End()
 		}
 	}()
+	defer s.invalidateCache()
 
 	vec := req.GetVector()
 	uuid := vec.GetId()
@@ -1572,6 +1749,7 @@ func (s *server) Upsert(ctx context.Context, req *payload.Upsert_Request) (loc *
 				SkipStrictExistCheck: true,
 				Filters:              req.GetConfig().GetFilters(),
 				Timestamp:            req.GetConfig().GetTimestamp(),
+				Consistency:          req.GetConfig().GetConsistency(),
 			},
 		})
 	} else {
@@ -1582,6 +1760,7 @@ func (s *server) Upsert(ctx context.Context, req *payload.Upsert_Request) (loc *
 				SkipStrictExistCheck: true,
 				Filters:              req.GetConfig().GetFilters(),
 				Timestamp:            req.GetConfig().GetTimestamp(),
+				Consistency:          req.GetConfig().GetConsistency(),
 			},
 		})
 	}
@@ -1658,6 +1837,7 @@ func (s *server) MultiUpsert(ctx context.Context, reqs *payload.Upsert_MultiRequ
 			span.End()
 		}
 	}()
+	defer s.invalidateCache()
 
 	insertReqs := make([]*payload.Insert_Request, 0, len(reqs.GetRequests()))
 	updateReqs := make([]*payload.Update_Request, 0, len(reqs.GetRequests()))
@@ -1713,6 +1893,7 @@ func (s *server) MultiUpsert(ctx context.Context, reqs *payload.Upsert_MultiRequ
 					SkipStrictExistCheck: true,
 					Filters:              req.GetConfig().GetFilters(),
 					Timestamp:            req.GetConfig().GetTimestamp(),
+					Consistency:          req.GetConfig().GetConsistency(),
 				},
 			})
 		} else {
@@ -1722,6 +1903,7 @@ func (s *server) MultiUpsert(ctx context.Context, reqs *payload.Upsert_MultiRequ
 					SkipStrictExistCheck: true,
 					Filters:              req.GetConfig().GetFilters(),
 					Timestamp:            req.GetConfig().GetTimestamp(),
+					Consistency:          req.GetConfig().GetConsistency(),
 				},
 			})
 		}
@@ -1825,6 +2007,7 @@ func (s *server) Remove(ctx context.Context, req *payload.Remove_Request) (locs
 			span.End()
 		}
 	}()
+	defer s.invalidateCache()
 
 	id := req.GetId()
 	if !req.GetConfig().GetSkipStrictExistCheck() {
@@ -1869,6 +2052,8 @@ func (s *server) Remove(ctx context.Context, req *payload.Remove_Request) (locs
 		Uuid: id.GetId(),
 		Ips:  make([]string, 0, s.replica),
 	}
+	var acks int
+	failures := make(map[string]*payload.Object_Location_Failure)
 	err = s.gateway.BroadCast(ctx, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
 		ctx, span := trace.StartSpan(ctx, apiName+".Remove/"+target)
 		defer func() {
@@ -1893,6 +2078,12 @@ func (s *server) Remove(ctx context.Context, req *payload.Remove_Request) (locs
 			}
 			if err != nil && st.Code() != codes.NotFound {
 				log.Error(err)
+				mu.Lock()
+				failures[target] = &payload.Object_Location_Failure{
+					Addr:   target,
+					Status: st.Proto(),
+				}
+				mu.Unlock()
 				return err
 			}
 			return nil
@@ -1900,6 +2091,7 @@ func (s *server) Remove(ctx context.Context, req *payload.Remove_Request) (locs
 		mu.Lock()
 		locs.Ips = append(locs.GetIps(), loc.GetIps()...)
 		locs.Name = loc.GetName()
+		acks++
 		mu.Unlock()
 		return nil
 	})
@@ -1919,6 +2111,26 @@ func (s *server) Remove(ctx context.Context, req *payload.Remove_Request) (locs
 		}
 		return nil, err
 	}
+	locs.Failures = sortedFailures(failures)
+	if len(failures) > 0 {
+		if required := requiredAcks(req.GetConfig().GetConsistency(), s.replicas(ctx)); acks < required {
+			err = errors.ErrInsufficientReplicaAcks(acks, required)
+			st, msg, err := status.ParseError(err, codes.Internal,
+				"failed to parse Remove gRPC error response",
+				&errdetails.RequestInfo{
+					RequestId:   id.GetId(),
+					ServingData: errdetails.Serialize(req),
+				},
+				&errdetails.ResourceInfo{
+					ResourceType: errdetails.ValdGRPCResourceTypePrefix + "/vald.v1.Remove",
+					ResourceName: fmt.Sprintf("%s: %s(%s) to %v", apiName, s.name, s.ip, s.gateway.Addrs(ctx)),
+				}, info.Get())
+			if span != nil {
+				span.SetStatus(trace.FromGRPCStatus(st.Code(), msg))
+			}
+			return nil, err
+		}
+	}
 	if len(locs.Ips) <= 0 {
 		err = errors.ErrIndexNotFound
 		err = status.WrapWithNotFound("Remove API remove target not found", err,
@@ -1991,7 +2203,31 @@ func (s *server) MultiRemove(ctx context.Context, reqs *payload.Remove_MultiRequ
 			span.End()
 		}
 	}()
+	defer s.invalidateCache()
 
+	cs := make([]payload.Consistency, 0, len(reqs.GetRequests()))
+	for _, req := range reqs.GetRequests() {
+		cs = append(cs, req.GetConfig().GetConsistency())
+	}
+	consistency, err := multiConsistency(cs...)
+	if err != nil {
+		err = status.WrapWithInvalidArgument("MultiRemove API invalid consistency argument", err,
+			&errdetails.RequestInfo{
+				ServingData: errdetails.Serialize(reqs),
+			},
+			&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequestFieldViolation{
+					{
+						Field:       "consistency",
+						Description: err.Error(),
+					},
+				},
+			}, info.Get())
+		if span != nil {
+			span.SetStatus(trace.StatusCodeInvalidArgument(err.Error()))
+		}
+		return nil, err
+	}
 	now := time.Now().UnixNano()
 	ids := make([]string, 0, len(reqs.GetRequests()))
 	for i, req := range reqs.GetRequests() {
@@ -2039,6 +2275,8 @@ func (s *server) MultiRemove(ctx context.Context, reqs *payload.Remove_MultiRequ
 	locs = &payload.Object_Locations{
 		Locations: make([]*payload.Object_Location, 0, len(reqs.GetRequests())),
 	}
+	var acks int
+	failures := make(map[string]*payload.Object_Location_Failure)
 	err = s.gateway.BroadCast(ctx, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
 		ctx, span := trace.StartSpan(ctx, apiName+".MultiRemove/"+target)
 		defer func() {
@@ -2057,6 +2295,12 @@ func (s *server) MultiRemove(ctx context.Context, reqs *payload.Remove_MultiRequ
 							"/vald.v1.MultiRemove.BroadCast/" +
 							target + " canceled: " + err.Error()))
 				}
+				mu.Lock()
+				failures[target] = &payload.Object_Location_Failure{
+					Addr:   target,
+					Status: status.New(codes.Canceled, err.Error()).Proto(),
+				}
+				mu.Unlock()
 				return nil
 			case errors.Is(err, context.DeadlineExceeded),
 				errors.Is(err, errors.ErrRPCCallFailed(target, context.DeadlineExceeded)):
@@ -2066,6 +2310,12 @@ func (s *server) MultiRemove(ctx context.Context, reqs *payload.Remove_MultiRequ
 							"/vald.v1.MultiRemove.BroadCast/" +
 							target + " deadline_exceeded: " + err.Error()))
 				}
+				mu.Lock()
+				failures[target] = &payload.Object_Location_Failure{
+					Addr:   target,
+					Status: status.New(codes.DeadlineExceeded, err.Error()).Proto(),
+				}
+				mu.Unlock()
 				return nil
 			}
 			st, msg, err := status.ParseError(err, codes.Internal,
@@ -2084,12 +2334,19 @@ func (s *server) MultiRemove(ctx context.Context, reqs *payload.Remove_MultiRequ
 
 			if err != nil && st.Code() != codes.NotFound {
 				log.Error(err)
+				mu.Lock()
+				failures[target] = &payload.Object_Location_Failure{
+					Addr:   target,
+					Status: st.Proto(),
+				}
+				mu.Unlock()
 				return err
 			}
 			return nil
 		}
 		mu.Lock()
 		locs.Locations = append(locs.GetLocations(), loc.GetLocations()...)
+		acks++
 		mu.Unlock()
 		return nil
 	})
@@ -2109,6 +2366,25 @@ func (s *server) MultiRemove(ctx context.Context, reqs *payload.Remove_MultiRequ
 		}
 		return nil, err
 	}
+	if len(failures) > 0 {
+		if required := requiredAcks(consistency, s.replicas(ctx)); acks < required {
+			err = errors.ErrInsufficientReplicaAcks(acks, required)
+			st, msg, err := status.ParseError(err, codes.Internal,
+				"failed to parse MultiRemove gRPC error response",
+				&errdetails.RequestInfo{
+					RequestId:   strings.Join(ids, ","),
+					ServingData: errdetails.Serialize(reqs),
+				},
+				&errdetails.ResourceInfo{
+					ResourceType: errdetails.ValdGRPCResourceTypePrefix + "/vald.v1.MultiRemove",
+					ResourceName: fmt.Sprintf("%s: %s(%s) to %v", apiName, s.name, s.ip, s.gateway.Addrs(ctx)),
+				}, info.Get())
+			if span != nil {
+				span.SetStatus(trace.FromGRPCStatus(st.Code(), msg))
+			}
+			return nil, err
+		}
+	}
 	if len(locs.Locations) <= 0 {
 		err = errors.ErrIndexNotFound
 		err = status.WrapWithNotFound("MultiRemove API remove target not found", err,
@@ -2125,7 +2401,13 @@ func (s *server) MultiRemove(ctx context.Context, reqs *payload.Remove_MultiRequ
 		}
 		return nil, err
 	}
-	return location.ReStructure(ids, locs), nil
+	locs = location.ReStructure(ids, locs)
+	if fs := sortedFailures(failures); len(fs) != 0 {
+		for _, loc := range locs.GetLocations() {
+			loc.Failures = fs
+		}
+	}
+	return locs, nil
 }
 
 func (s *server) GetObject(ctx context.Context, req *payload.Object_VectorRequest) (vec *payload.Object_Vector, err error) {
@@ -2140,8 +2422,8 @@ func (s *server) GetObject(ctx context.Context, req *payload.Object_VectorReques
 	s.eg.Go(func() error {
 		defer close(vch)
 		defer close(ech)
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithCancel(ctx)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
 		var once sync.Once
 		ech <- s.gateway.BroadCast(ctx, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
 			sctx, sspan := trace.StartSpan(ctx, apiName+".GetObject/"+target)
@@ -2273,6 +2555,63 @@ func (s *server) StreamGetObject(stream vald.Object_StreamGetObjectServer) (err
 	return nil
 }
 
+// replicas returns the number of the replicas of a vector, which is the index replica bounded by the number of the agents.
+// The failed agents are not counted, because the broadcast writes also fail on the agents which do not hold the vector.
+func (s *server) replicas(ctx context.Context) int {
+	if cnt := s.gateway.GetAgentCount(ctx); cnt < s.replica {
+		return cnt
+	}
+	return s.replica
+}
+
+// requiredAcks returns the number of replica acknowledgements needed to
+// satisfy the consistency level c when the write targets n replicas.
+// DEFAULT requires no acknowledgement count and keeps the legacy behavior,
+// where replica errors other than a timeout or a cancellation fail the request.
+func requiredAcks(c payload.Consistency, n int) int {
+	if n <= 0 {
+		return 0
+	}
+	switch c {
+	case payload.Consistency_ONE:
+		return 1
+	case payload.Consistency_QUORUM:
+		return n/2 + 1
+	case payload.Consistency_ALL:
+		return n
+	default:
+		return 0
+	}
+}
+
+// multiConsistency returns the consistency level shared by the requests of a
+// Multi* API, which sends every request to the same replicas at once.
+func multiConsistency(cs ...payload.Consistency) (payload.Consistency, error) {
+	if len(cs) == 0 {
+		return payload.Consistency_DEFAULT, nil
+	}
+	for _, c := range cs[1:] {
+		if c != cs[0] {
+			return payload.Consistency_DEFAULT, errors.ErrMixedConsistency(cs[0].String(), c.String())
+		}
+	}
+	return cs[0], nil
+}
+
+func sortedFailures(failures map[string]*payload.Object_Location_Failure) []*payload.Object_Location_Failure {
+	if len(failures) == 0 {
+		return nil
+	}
+	fs := make([]*payload.Object_Location_Failure, 0, len(failures))
+	for _, f := range failures {
+		fs = append(fs, f)
+	}
+	sort.Slice(fs, func(i, j int) bool {
+		return fs[i].GetAddr() < fs[j].GetAddr()
+	})
+	return fs
+}
+
 func f32stos(fs []float32) string {
 	lf := 4 * len(fs)
 	buf := (*(*[1]byte)(unsafe.Pointer(&(fs[0]))))[:]
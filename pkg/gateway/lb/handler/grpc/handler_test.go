@@ -19,7 +19,12 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -27,11 +32,229 @@ import (
 	"github.com/vdaas/vald/apis/grpc/v1/vald"
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
 	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
 	"github.com/vdaas/vald/internal/test/goleak"
 	"github.com/vdaas/vald/pkg/gateway/lb/service"
 )
 
+func TestMain(m *testing.M) {
+	info.Init("")
+	os.Exit(m.Run())
+}
+
+// gatewayMock is the gateway which calls the agent mocks in the order of the addresses.
+// Like the discoverer client, it does not return the errors of f to the caller.
+type gatewayMock struct {
+	service.Gateway
+	agents []*agentMock
+}
+
+func (g *gatewayMock) GetAgentCount(ctx context.Context) int {
+	return len(g.agents)
+}
+
+func (g *gatewayMock) Addrs(ctx context.Context) []string {
+	addrs := make([]string, 0, len(g.agents))
+	for _, a := range g.agents {
+		addrs = append(addrs, a.name)
+	}
+	return addrs
+}
+
+func (g *gatewayMock) DoMulti(ctx context.Context, num int,
+	f func(ctx context.Context, tgt string, ac vald.Client, copts ...grpc.CallOption) error) error {
+	var cnt int
+	for _, a := range g.agents {
+		if cnt >= num {
+			break
+		}
+		if f(ctx, a.name, a) == nil {
+			cnt++
+		}
+	}
+	return nil
+}
+
+func (g *gatewayMock) BroadCast(ctx context.Context,
+	f func(ctx context.Context, tgt string, ac vald.Client, copts ...grpc.CallOption) error) error {
+	var wg sync.WaitGroup
+	for _, a := range g.agents {
+		wg.Add(1)
+		go func(a *agentMock) {
+			defer wg.Done()
+			_ = f(ctx, a.name, a)
+		}(a)
+	}
+	wg.Wait()
+	return nil
+}
+
+// agentMock is the agent which stores the vectors in memory and records the requests.
+// The write RPCs fail with writeErr and MultiInsert fails with multiErr when they are set.
+type agentMock struct {
+	vald.Client
+	name string
+
+	mu       sync.Mutex
+	vecs     map[string][]float32
+	results  []*payload.Object_Distance
+	err      error
+	writeErr error
+	multiErr error
+	writes   int
+	searches []*payload.Search_Request
+	gets     []*payload.Object_VectorRequest
+}
+
+func (a *agentMock) GetObject(ctx context.Context, req *payload.Object_VectorRequest, _ ...grpc.CallOption) (*payload.Object_Vector, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.gets = append(a.gets, req)
+	vec, ok := a.vecs[req.GetId().GetId()]
+	if !ok {
+		return nil, status.WrapWithNotFound("not found", errors.ErrObjectIDNotFound(req.GetId().GetId()))
+	}
+	return &payload.Object_Vector{
+		Id:     req.GetId().GetId(),
+		Vector: vec,
+	}, nil
+}
+
+func (a *agentMock) Exists(ctx context.Context, req *payload.Object_ID, _ ...grpc.CallOption) (*payload.Object_ID, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.vecs[req.GetId()]; !ok {
+		return nil, status.WrapWithNotFound("not found", errors.ErrObjectIDNotFound(req.GetId()))
+	}
+	return req, nil
+}
+
+func (a *agentMock) Search(ctx context.Context, req *payload.Search_Request, _ ...grpc.CallOption) (*payload.Search_Response, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.searches = append(a.searches, req)
+	if a.err != nil {
+		return nil, a.err
+	}
+	return &payload.Search_Response{
+		Results: a.results,
+	}, nil
+}
+
+func (a *agentMock) Insert(ctx context.Context, req *payload.Insert_Request, _ ...grpc.CallOption) (*payload.Object_Location, error) {
+	locs, err := a.insert(req)
+	if err != nil {
+		return nil, err
+	}
+	return locs.GetLocations()[0], nil
+}
+
+func (a *agentMock) MultiInsert(ctx context.Context, reqs *payload.Insert_MultiRequest, _ ...grpc.CallOption) (*payload.Object_Locations, error) {
+	if a.multiErr != nil {
+		return nil, a.multiErr
+	}
+	return a.insert(reqs.GetRequests()...)
+}
+
+func (a *agentMock) insert(reqs ...*payload.Insert_Request) (*payload.Object_Locations, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.writeErr != nil {
+		return nil, a.writeErr
+	}
+	if a.vecs == nil {
+		a.vecs = make(map[string][]float32)
+	}
+	for _, req := range reqs {
+		id := req.GetVector().GetId()
+		if _, ok := a.vecs[id]; ok {
+			return nil, status.WrapWithAlreadyExists("already exists", errors.ErrUUIDAlreadyExists(id))
+		}
+	}
+	locs := new(payload.Object_Locations)
+	for _, req := range reqs {
+		a.vecs[req.GetVector().GetId()] = req.GetVector().GetVector()
+		a.writes++
+		locs.Locations = append(locs.GetLocations(), &payload.Object_Location{
+			Name: a.name,
+			Uuid: req.GetVector().GetId(),
+			Ips:  []string{a.name},
+		})
+	}
+	return locs, nil
+}
+
+func (a *agentMock) Remove(ctx context.Context, req *payload.Remove_Request, _ ...grpc.CallOption) (*payload.Object_Location, error) {
+	locs, err := a.MultiRemove(ctx, &payload.Remove_MultiRequest{
+		Requests: []*payload.Remove_Request{req},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return locs.GetLocations()[0], nil
+}
+
+func (a *agentMock) MultiRemove(ctx context.Context, reqs *payload.Remove_MultiRequest, _ ...grpc.CallOption) (*payload.Object_Locations, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.writeErr != nil {
+		return nil, a.writeErr
+	}
+	locs := new(payload.Object_Locations)
+	for _, req := range reqs.GetRequests() {
+		id := req.GetId().GetId()
+		if _, ok := a.vecs[id]; !ok {
+			return nil, status.WrapWithNotFound("not found", errors.ErrObjectIDNotFound(id))
+		}
+		delete(a.vecs, id)
+		locs.Locations = append(locs.GetLocations(), &payload.Object_Location{
+			Name: a.name,
+			Uuid: id,
+			Ips:  []string{a.name},
+		})
+	}
+	return locs, nil
+}
+
+// newAgentMocks returns n agent mocks which already store the vectors of ids.
+func newAgentMocks(n int, ids ...string) []*agentMock {
+	agents := make([]*agentMock, 0, n)
+	for i := 0; i < n; i++ {
+		vecs := make(map[string][]float32, len(ids))
+		for _, id := range ids {
+			vecs[id] = []float32{0.1, 0.2}
+		}
+		agents = append(agents, &agentMock{
+			name: fmt.Sprintf("agent-%d", i),
+			vecs: vecs,
+		})
+	}
+	return agents
+}
+
+// checkLocation returns an error when loc does not hold the ips and the failure codes per address.
+func checkLocation(loc *payload.Object_Location, ips []string, failures map[string]codes.Code) error {
+	got := append([]string(nil), loc.GetIps()...)
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, ips) {
+		return errors.Errorf("got ips: %v,\n\t\t\t\twant: %v", got, ips)
+	}
+	fs := make(map[string]codes.Code, len(loc.GetFailures()))
+	for _, f := range loc.GetFailures() {
+		fs[f.GetAddr()] = codes.Code(f.GetStatus().GetCode())
+	}
+	if len(fs) == 0 {
+		fs = nil
+	}
+	if !reflect.DeepEqual(fs, failures) {
+		return errors.Errorf("got failures: %v,\n\t\t\t\twant: %v", fs, failures)
+	}
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -359,47 +582,145 @@ func Test_server_SearchByID(t *testing.T) {
 		return nil
 	}
 	tests := []test{
-		// TODO test cases
-		/*
-		   {
-		       name: "test_case_1",
-		       args: args {
-		           ctx: nil,
-		           req: nil,
-		       },
-		       fields: fields {
-		           eg: nil,
-		           gateway: nil,
-		           timeout: nil,
-		           replica: 0,
-		           streamConcurrency: 0,
-		       },
-		       want: want{},
-		       checkFunc: defaultCheckFunc,
-		   },
-		*/
-
-		// TODO test cases
-		/*
-		   func() test {
-		       return test {
-		           name: "test_case_2",
-		           args: args {
-		           ctx: nil,
-		           req: nil,
-		           },
-		           fields: fields {
-		           eg: nil,
-		           gateway: nil,
-		           timeout: nil,
-		           replica: 0,
-		           streamConcurrency: 0,
-		           },
-		           want: want{},
-		           checkFunc: defaultCheckFunc,
-		       }
-		   }(),
-		*/
+		func() test {
+			filters := &payload.Filter_Config{
+				Targets: []*payload.Filter_Target{
+					{
+						Host: "egress-filter",
+						Port: 8081,
+					},
+				},
+			}
+			agents := []*agentMock{
+				{
+					name: "agent-0",
+					vecs: map[string][]float32{
+						"uuid": {0.1, 0.2},
+					},
+					results: []*payload.Object_Distance{
+						{Id: "uuid", Distance: 0},
+					},
+				},
+				{
+					name: "agent-1",
+					results: []*payload.Object_Distance{
+						{Id: "uuid-2", Distance: 2},
+					},
+				},
+				{
+					name: "agent-2",
+					results: []*payload.Object_Distance{
+						{Id: "uuid-1", Distance: 1},
+					},
+				},
+			}
+			return test{
+				name: "resolve the vector once with the egress filters and search it across all the agents",
+				args: args{
+					ctx: context.Background(),
+					req: &payload.Search_IDRequest{
+						Id: "uuid",
+						Config: &payload.Search_Config{
+							Num:           3,
+							EgressFilters: filters,
+						},
+					},
+				},
+				fields: fields{
+					eg: errgroup.Get(),
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					timeout: time.Second,
+				},
+				want: want{
+					wantRes: &payload.Search_Response{
+						Results: []*payload.Object_Distance{
+							{Id: "uuid", Distance: 0},
+							{Id: "uuid-1", Distance: 1},
+							{Id: "uuid-2", Distance: 2},
+						},
+					},
+				},
+				checkFunc: func(w want, gotRes *payload.Search_Response, err error) error {
+					if err := defaultCheckFunc(w, gotRes, err); err != nil {
+						return err
+					}
+					for _, a := range agents {
+						for _, get := range a.gets {
+							if !reflect.DeepEqual(get.GetFilters(), filters) {
+								return errors.Errorf("%s got filters: %#v,\n\t\t\t\twant: %#v", a.name, get.GetFilters(), filters)
+							}
+						}
+						if len(a.searches) != 1 {
+							return errors.Errorf("%s got searches: %d, want: 1", a.name, len(a.searches))
+						}
+						if got := a.searches[0].GetVector(); !reflect.DeepEqual(got, []float32{0.1, 0.2}) {
+							return errors.Errorf("%s got vector: %v, want: %v", a.name, got, []float32{0.1, 0.2})
+						}
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			agents := []*agentMock{
+				{
+					name: "agent-0",
+				},
+				{
+					name: "agent-1",
+				},
+			}
+			return test{
+				name: "return NotFound error without searching when the ID is not found",
+				args: args{
+					ctx: context.Background(),
+					req: &payload.Search_IDRequest{
+						Id: "uuid",
+						Config: &payload.Search_Config{
+							Num: 3,
+						},
+					},
+				},
+				fields: fields{
+					eg: errgroup.Get(),
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					timeout: time.Second,
+				},
+				checkFunc: func(w want, gotRes *payload.Search_Response, err error) error {
+					if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant code: %s", err, codes.NotFound)
+					}
+					for _, a := range agents {
+						if len(a.searches) != 0 {
+							return errors.Errorf("%s got searches: %d, want: 0", a.name, len(a.searches))
+						}
+					}
+					return nil
+				},
+			}
+		}(),
+		{
+			name: "return InvalidArgument error when the ID is empty",
+			args: args{
+				ctx: context.Background(),
+				req: new(payload.Search_IDRequest),
+			},
+			fields: fields{
+				eg:      errgroup.Get(),
+				gateway: new(gatewayMock),
+				timeout: time.Second,
+			},
+			checkFunc: func(w want, gotRes *payload.Search_Response, err error) error {
+				if st, ok := status.FromError(err); !ok || st.Code() != codes.InvalidArgument {
+					return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant code: %s", err, codes.InvalidArgument)
+				}
+				return nil
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1002,47 +1323,137 @@ func Test_server_Insert(t *testing.T) {
 		return nil
 	}
 	tests := []test{
-		// TODO test cases
-		/*
-		   {
-		       name: "test_case_1",
-		       args: args {
-		           ctx: nil,
-		           req: nil,
-		       },
-		       fields: fields {
-		           eg: nil,
-		           gateway: nil,
-		           timeout: nil,
-		           replica: 0,
-		           streamConcurrency: 0,
-		       },
-		       want: want{},
-		       checkFunc: defaultCheckFunc,
-		   },
-		*/
-
-		// TODO test cases
-		/*
-		   func() test {
-		       return test {
-		           name: "test_case_2",
-		           args: args {
-		           ctx: nil,
-		           req: nil,
-		           },
-		           fields: fields {
-		           eg: nil,
-		           gateway: nil,
-		           timeout: nil,
-		           replica: 0,
-		           streamConcurrency: 0,
-		           },
-		           want: want{},
-		           checkFunc: defaultCheckFunc,
-		       }
-		   }(),
-		*/
+		func() test {
+			agents := newAgentMocks(3)
+			agents[1].writeErr = context.DeadlineExceeded
+			return test{
+				name: "keep the legacy DEFAULT behavior and report the timed out replica as a failure",
+				args: args{
+					ctx: context.Background(),
+					req: &payload.Insert_Request{
+						Vector: &payload.Object_Vector{
+							Id:     "uuid",
+							Vector: []float32{0.1, 0.2},
+						},
+						Config: &payload.Insert_Config{
+							SkipStrictExistCheck: true,
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotCe *payload.Object_Location, err error) error {
+					if err != nil {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+					}
+					return checkLocation(gotCe, []string{"agent-0", "agent-2"}, map[string]codes.Code{
+						"agent-1": codes.DeadlineExceeded,
+					})
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3)
+			agents[1].writeErr = status.WrapWithInternal("write failed", errors.New("disk is full"))
+			return test{
+				name: "return error with DEFAULT when a replica fails",
+				args: args{
+					ctx: context.Background(),
+					req: &payload.Insert_Request{
+						Vector: &payload.Object_Vector{
+							Id:     "uuid",
+							Vector: []float32{0.1, 0.2},
+						},
+						Config: &payload.Insert_Config{
+							SkipStrictExistCheck: true,
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotCe *payload.Object_Location, err error) error {
+					if st, ok := status.FromError(err); !ok || st.Code() != codes.Internal {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant code: %s", err, codes.Internal)
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3)
+			agents[1].writeErr = context.DeadlineExceeded
+			return test{
+				name: "return error with ALL when a replica times out",
+				args: args{
+					ctx: context.Background(),
+					req: &payload.Insert_Request{
+						Vector: &payload.Object_Vector{
+							Id:     "uuid",
+							Vector: []float32{0.1, 0.2},
+						},
+						Config: &payload.Insert_Config{
+							SkipStrictExistCheck: true,
+							Consistency:          payload.Consistency_ALL,
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotCe *payload.Object_Location, err error) error {
+					if st, ok := status.FromError(err); !ok || st.Code() != codes.Internal ||
+						!strings.Contains(fmt.Sprint(st.Details()), errors.ErrInsufficientReplicaAcks(2, 3).Error()) {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: %v", err, errors.ErrInsufficientReplicaAcks(2, 3))
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3)
+			agents[1].writeErr = status.WrapWithInternal("write failed", errors.New("disk is full"))
+			return test{
+				name: "succeed with QUORUM when a replica fails and report it as a failure",
+				args: args{
+					ctx: context.Background(),
+					req: &payload.Insert_Request{
+						Vector: &payload.Object_Vector{
+							Id:     "uuid",
+							Vector: []float32{0.1, 0.2},
+						},
+						Config: &payload.Insert_Config{
+							SkipStrictExistCheck: true,
+							Consistency:          payload.Consistency_QUORUM,
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotCe *payload.Object_Location, err error) error {
+					if err != nil {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+					}
+					return checkLocation(gotCe, []string{"agent-0", "agent-2"}, map[string]codes.Code{
+						"agent-1": codes.Internal,
+					})
+				},
+			}
+		}(),
 	}
 
 	for _, tc := range tests {
@@ -1213,47 +1624,212 @@ func Test_server_MultiInsert(t *testing.T) {
 		return nil
 	}
 	tests := []test{
-		// TODO test cases
-		/*
-		   {
-		       name: "test_case_1",
-		       args: args {
-		           ctx: nil,
-		           reqs: nil,
-		       },
-		       fields: fields {
-		           eg: nil,
-		           gateway: nil,
-		           timeout: nil,
-		           replica: 0,
-		           streamConcurrency: 0,
-		       },
-		       want: want{},
-		       checkFunc: defaultCheckFunc,
-		   },
-		*/
-
-		// TODO test cases
-		/*
-		   func() test {
-		       return test {
-		           name: "test_case_2",
-		           args: args {
-		           ctx: nil,
-		           reqs: nil,
-		           },
-		           fields: fields {
-		           eg: nil,
-		           gateway: nil,
-		           timeout: nil,
-		           replica: 0,
-		           streamConcurrency: 0,
-		           },
-		           want: want{},
-		           checkFunc: defaultCheckFunc,
-		       }
-		   }(),
-		*/
+		func() test {
+			agents := newAgentMocks(3)
+			agents[1].writeErr = status.WrapWithInternal("write failed", errors.New("disk is full"))
+			return test{
+				name: "succeed with QUORUM when a replica fails and report it on every location",
+				args: args{
+					ctx: context.Background(),
+					reqs: &payload.Insert_MultiRequest{
+						Requests: []*payload.Insert_Request{
+							{
+								Vector: &payload.Object_Vector{
+									Id:     "uuid-1",
+									Vector: []float32{0.1, 0.2},
+								},
+								Config: &payload.Insert_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_QUORUM,
+								},
+							},
+							{
+								Vector: &payload.Object_Vector{
+									Id:     "uuid-2",
+									Vector: []float32{0.1, 0.2},
+								},
+								Config: &payload.Insert_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_QUORUM,
+								},
+							},
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Locations, err error) error {
+					if err != nil {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+					}
+					if len(gotLocs.GetLocations()) != 2 {
+						return errors.Errorf("got locations: %d, want: 2", len(gotLocs.GetLocations()))
+					}
+					for _, loc := range gotLocs.GetLocations() {
+						if err := checkLocation(loc, []string{"agent-0", "agent-2"}, map[string]codes.Code{
+							"agent-1": codes.Internal,
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3)
+			agents[1].writeErr = status.WrapWithInternal("write failed", errors.New("disk is full"))
+			return test{
+				name: "return error with ALL when a replica fails",
+				args: args{
+					ctx: context.Background(),
+					reqs: &payload.Insert_MultiRequest{
+						Requests: []*payload.Insert_Request{
+							{
+								Vector: &payload.Object_Vector{
+									Id:     "uuid-1",
+									Vector: []float32{0.1, 0.2},
+								},
+								Config: &payload.Insert_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_ALL,
+								},
+							},
+							{
+								Vector: &payload.Object_Vector{
+									Id:     "uuid-2",
+									Vector: []float32{0.1, 0.2},
+								},
+								Config: &payload.Insert_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_ALL,
+								},
+							},
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Locations, err error) error {
+					if st, ok := status.FromError(err); !ok || st.Code() != codes.Internal ||
+						!strings.Contains(fmt.Sprint(st.Details()), errors.ErrInsufficientReplicaAcks(2, 3).Error()) {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: %v", err, errors.ErrInsufficientReplicaAcks(2, 3))
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3)
+			agents[1].writeErr = context.Canceled
+			return test{
+				name: "keep the legacy DEFAULT behavior and report the canceled replica on every location",
+				args: args{
+					ctx: context.Background(),
+					reqs: &payload.Insert_MultiRequest{
+						Requests: []*payload.Insert_Request{
+							{
+								Vector: &payload.Object_Vector{
+									Id:     "uuid-1",
+									Vector: []float32{0.1, 0.2},
+								},
+								Config: &payload.Insert_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_DEFAULT,
+								},
+							},
+							{
+								Vector: &payload.Object_Vector{
+									Id:     "uuid-2",
+									Vector: []float32{0.1, 0.2},
+								},
+								Config: &payload.Insert_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_DEFAULT,
+								},
+							},
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Locations, err error) error {
+					if err != nil {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+					}
+					for _, loc := range gotLocs.GetLocations() {
+						if err := checkLocation(loc, []string{"agent-0", "agent-2"}, map[string]codes.Code{
+							"agent-1": codes.Canceled,
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3)
+			return test{
+				name: "return InvalidArgument error without writing when the requests use different consistency levels",
+				args: args{
+					ctx: context.Background(),
+					reqs: &payload.Insert_MultiRequest{
+						Requests: []*payload.Insert_Request{
+							{
+								Vector: &payload.Object_Vector{
+									Id:     "uuid-1",
+									Vector: []float32{0.1, 0.2},
+								},
+								Config: &payload.Insert_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_ONE,
+								},
+							},
+							{
+								Vector: &payload.Object_Vector{
+									Id:     "uuid-2",
+									Vector: []float32{0.1, 0.2},
+								},
+								Config: &payload.Insert_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_ALL,
+								},
+							},
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Locations, err error) error {
+					if st, ok := status.FromError(err); !ok || st.Code() != codes.InvalidArgument {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant code: %s", err, codes.InvalidArgument)
+					}
+					for _, a := range agents {
+						if len(a.vecs) != 0 {
+							return errors.Errorf("%s got vectors: %d, want: 0", a.name, len(a.vecs))
+						}
+					}
+					return nil
+				},
+			}
+		}(),
 	}
 
 	for _, tc := range tests {
@@ -1962,47 +2538,139 @@ func Test_server_Remove(t *testing.T) {
 		return nil
 	}
 	tests := []test{
-		// TODO test cases
-		/*
-		   {
-		       name: "test_case_1",
-		       args: args {
-		           ctx: nil,
-		           req: nil,
-		       },
-		       fields: fields {
-		           eg: nil,
-		           gateway: nil,
-		           timeout: nil,
-		           replica: 0,
-		           streamConcurrency: 0,
-		       },
-		       want: want{},
-		       checkFunc: defaultCheckFunc,
-		   },
-		*/
-
-		// TODO test cases
-		/*
-		   func() test {
-		       return test {
-		           name: "test_case_2",
-		           args: args {
-		           ctx: nil,
-		           req: nil,
-		           },
-		           fields: fields {
-		           eg: nil,
-		           gateway: nil,
-		           timeout: nil,
-		           replica: 0,
-		           streamConcurrency: 0,
-		           },
-		           want: want{},
-		           checkFunc: defaultCheckFunc,
-		       }
-		   }(),
-		*/
+		func() test {
+			agents := newAgentMocks(3, "uuid")
+			agents[1].writeErr = status.WrapWithInternal("write failed", errors.New("disk is full"))
+			return test{
+				name: "keep the legacy DEFAULT behavior when a replica fails and report it as a failure",
+				args: args{
+					ctx: context.Background(),
+					req: &payload.Remove_Request{
+						Id: &payload.Object_ID{
+							Id: "uuid",
+						},
+						Config: &payload.Remove_Config{
+							SkipStrictExistCheck: true,
+							Consistency:          payload.Consistency_DEFAULT,
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Location, err error) error {
+					if err != nil {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+					}
+					return checkLocation(gotLocs, []string{"agent-0", "agent-2"}, map[string]codes.Code{
+						"agent-1": codes.Internal,
+					})
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3, "uuid")
+			agents[1].writeErr = status.WrapWithInternal("write failed", errors.New("disk is full"))
+			return test{
+				name: "succeed with QUORUM when a replica fails and report it as a failure",
+				args: args{
+					ctx: context.Background(),
+					req: &payload.Remove_Request{
+						Id: &payload.Object_ID{
+							Id: "uuid",
+						},
+						Config: &payload.Remove_Config{
+							SkipStrictExistCheck: true,
+							Consistency:          payload.Consistency_QUORUM,
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Location, err error) error {
+					if err != nil {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+					}
+					return checkLocation(gotLocs, []string{"agent-0", "agent-2"}, map[string]codes.Code{
+						"agent-1": codes.Internal,
+					})
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3, "uuid")
+			agents[1].writeErr = status.WrapWithInternal("write failed", errors.New("disk is full"))
+			return test{
+				name: "return error with ALL when a replica fails",
+				args: args{
+					ctx: context.Background(),
+					req: &payload.Remove_Request{
+						Id: &payload.Object_ID{
+							Id: "uuid",
+						},
+						Config: &payload.Remove_Config{
+							SkipStrictExistCheck: true,
+							Consistency:          payload.Consistency_ALL,
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Location, err error) error {
+					if st, ok := status.FromError(err); !ok || st.Code() != codes.Internal ||
+						!strings.Contains(fmt.Sprint(st.Details()), errors.ErrInsufficientReplicaAcks(2, 3).Error()) {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: %v", err, errors.ErrInsufficientReplicaAcks(2, 3))
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3, "uuid")
+			// agent-2 does not hold the vector and is unavailable.
+			delete(agents[2].vecs, "uuid")
+			agents[2].writeErr = status.WrapWithUnavailable("unavailable", errors.New("connection refused"))
+			return test{
+				name: "succeed with ALL when an agent which does not hold the vector fails",
+				args: args{
+					ctx: context.Background(),
+					req: &payload.Remove_Request{
+						Id: &payload.Object_ID{
+							Id: "uuid",
+						},
+						Config: &payload.Remove_Config{
+							SkipStrictExistCheck: true,
+							Consistency:          payload.Consistency_ALL,
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 2,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Location, err error) error {
+					if err != nil {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+					}
+					return checkLocation(gotLocs, []string{"agent-0", "agent-1"}, map[string]codes.Code{
+						"agent-2": codes.Unavailable,
+					})
+				},
+			}
+		}(),
 	}
 
 	for _, tc := range tests {
@@ -2173,47 +2841,209 @@ func Test_server_MultiRemove(t *testing.T) {
 		return nil
 	}
 	tests := []test{
-		// TODO test cases
-		/*
-		   {
-		       name: "test_case_1",
-		       args: args {
-		           ctx: nil,
-		           reqs: nil,
-		       },
-		       fields: fields {
-		           eg: nil,
-		           gateway: nil,
-		           timeout: nil,
-		           replica: 0,
-		           streamConcurrency: 0,
-		       },
-		       want: want{},
-		       checkFunc: defaultCheckFunc,
-		   },
-		*/
-
-		// TODO test cases
-		/*
-		   func() test {
-		       return test {
-		           name: "test_case_2",
-		           args: args {
-		           ctx: nil,
-		           reqs: nil,
-		           },
-		           fields: fields {
-		           eg: nil,
-		           gateway: nil,
-		           timeout: nil,
-		           replica: 0,
-		           streamConcurrency: 0,
-		           },
-		           want: want{},
-		           checkFunc: defaultCheckFunc,
-		       }
-		   }(),
-		*/
+		func() test {
+			agents := newAgentMocks(3, "uuid-1", "uuid-2")
+			agents[1].writeErr = status.WrapWithInternal("write failed", errors.New("disk is full"))
+			return test{
+				name: "succeed with QUORUM when a replica fails and report it on every location",
+				args: args{
+					ctx: context.Background(),
+					reqs: &payload.Remove_MultiRequest{
+						Requests: []*payload.Remove_Request{
+							{
+								Id: &payload.Object_ID{
+									Id: "uuid-1",
+								},
+								Config: &payload.Remove_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_QUORUM,
+								},
+							},
+							{
+								Id: &payload.Object_ID{
+									Id: "uuid-2",
+								},
+								Config: &payload.Remove_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_QUORUM,
+								},
+							},
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Locations, err error) error {
+					if err != nil {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+					}
+					if len(gotLocs.GetLocations()) != 2 {
+						return errors.Errorf("got locations: %d, want: 2", len(gotLocs.GetLocations()))
+					}
+					for _, loc := range gotLocs.GetLocations() {
+						if err := checkLocation(loc, []string{"agent-0", "agent-2"}, map[string]codes.Code{
+							"agent-1": codes.Internal,
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3, "uuid-1", "uuid-2")
+			agents[1].writeErr = status.WrapWithInternal("write failed", errors.New("disk is full"))
+			return test{
+				name: "return error with ALL when a replica fails",
+				args: args{
+					ctx: context.Background(),
+					reqs: &payload.Remove_MultiRequest{
+						Requests: []*payload.Remove_Request{
+							{
+								Id: &payload.Object_ID{
+									Id: "uuid-1",
+								},
+								Config: &payload.Remove_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_ALL,
+								},
+							},
+							{
+								Id: &payload.Object_ID{
+									Id: "uuid-2",
+								},
+								Config: &payload.Remove_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_ALL,
+								},
+							},
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Locations, err error) error {
+					if st, ok := status.FromError(err); !ok || st.Code() != codes.Internal ||
+						!strings.Contains(fmt.Sprint(st.Details()), errors.ErrInsufficientReplicaAcks(2, 3).Error()) {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: %v", err, errors.ErrInsufficientReplicaAcks(2, 3))
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3, "uuid-1", "uuid-2")
+			// agent-2 does not hold the vectors and is unavailable.
+			agents[2].vecs = map[string][]float32{}
+			agents[2].writeErr = status.WrapWithUnavailable("unavailable", errors.New("connection refused"))
+			return test{
+				name: "succeed with ALL when an agent which does not hold the vectors fails",
+				args: args{
+					ctx: context.Background(),
+					reqs: &payload.Remove_MultiRequest{
+						Requests: []*payload.Remove_Request{
+							{
+								Id: &payload.Object_ID{
+									Id: "uuid-1",
+								},
+								Config: &payload.Remove_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_ALL,
+								},
+							},
+							{
+								Id: &payload.Object_ID{
+									Id: "uuid-2",
+								},
+								Config: &payload.Remove_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_ALL,
+								},
+							},
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 2,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Locations, err error) error {
+					if err != nil {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+					}
+					if len(gotLocs.GetLocations()) != 2 {
+						return errors.Errorf("got locations: %d, want: 2", len(gotLocs.GetLocations()))
+					}
+					for _, loc := range gotLocs.GetLocations() {
+						if err := checkLocation(loc, []string{"agent-0", "agent-1"}, map[string]codes.Code{
+							"agent-2": codes.Unavailable,
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3, "uuid-1", "uuid-2")
+			return test{
+				name: "return InvalidArgument error without removing when the requests use different consistency levels",
+				args: args{
+					ctx: context.Background(),
+					reqs: &payload.Remove_MultiRequest{
+						Requests: []*payload.Remove_Request{
+							{
+								Id: &payload.Object_ID{
+									Id: "uuid-1",
+								},
+								Config: &payload.Remove_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_QUORUM,
+								},
+							},
+							{
+								Id: &payload.Object_ID{
+									Id: "uuid-2",
+								},
+								Config: &payload.Remove_Config{
+									SkipStrictExistCheck: true,
+									Consistency:          payload.Consistency_ALL,
+								},
+							},
+						},
+					},
+				},
+				fields: fields{
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, gotLocs *payload.Object_Locations, err error) error {
+					if st, ok := status.FromError(err); !ok || st.Code() != codes.InvalidArgument {
+						return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant code: %s", err, codes.InvalidArgument)
+					}
+					for _, a := range agents {
+						if len(a.vecs) != 2 {
+							return errors.Errorf("%s got vectors: %d, want: 2", a.name, len(a.vecs))
+						}
+					}
+					return nil
+				},
+			}
+		}(),
 	}
 
 	for _, tc := range tests {
@@ -2457,6 +3287,519 @@ func Test_server_StreamGetObject(t *testing.T) {
 	}
 }
 
+func Test_server_streamInsertBatch(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx  context.Context
+		reqs []*payload.Insert_Request
+	}
+	type fields struct {
+		eg      errgroup.Group
+		gateway service.Gateway
+		replica int
+	}
+	type want struct {
+		want []interface{}
+		err  error
+	}
+	type test struct {
+		name       string
+		args       args
+		fields     fields
+		want       want
+		checkFunc  func(want, []interface{}, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, got []interface{}, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	newReqs := func(skip bool, cs ...payload.Consistency) []*payload.Insert_Request {
+		reqs := make([]*payload.Insert_Request, 0, len(cs))
+		for i, c := range cs {
+			reqs = append(reqs, &payload.Insert_Request{
+				Vector: &payload.Object_Vector{
+					Id:     fmt.Sprintf("uuid-%d", i),
+					Vector: []float32{0.1, 0.2},
+				},
+				Config: &payload.Insert_Config{
+					SkipStrictExistCheck: skip,
+					Consistency:          c,
+				},
+			})
+		}
+		return reqs
+	}
+	// checkResults returns an error when the results are not the locations of the requests
+	// or when an agent stored a vector more than once.
+	checkResults := func(got []interface{}, err error, ips []string, agents []*agentMock, writes int) error {
+		if err != nil {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: nil", err)
+		}
+		for i, res := range got {
+			loc := res.(*payload.Object_StreamLocation).GetLocation()
+			if id := fmt.Sprintf("uuid-%d", i); loc.GetUuid() != id {
+				return errors.Errorf("got uuid: %s, want: %s", loc.GetUuid(), id)
+			}
+			if err := checkLocation(loc, ips, nil); err != nil {
+				return err
+			}
+		}
+		for _, a := range agents {
+			if a.writes != writes {
+				return errors.Errorf("%s got writes: %d, want: %d", a.name, a.writes, writes)
+			}
+		}
+		return nil
+	}
+	tests := []test{
+		func() test {
+			agents := newAgentMocks(3)
+			return test{
+				name: "return the location of every request inserted by MultiInsert",
+				args: args{
+					ctx:  context.Background(),
+					reqs: newReqs(true, payload.Consistency_ALL, payload.Consistency_ALL),
+				},
+				fields: fields{
+					eg: errgroup.Get(),
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, got []interface{}, err error) error {
+					return checkResults(got, err, []string{"agent-0", "agent-1", "agent-2"}, agents, 2)
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3)
+			agents[2].multiErr = status.WrapWithInternal("write failed", errors.New("disk is full"))
+			return test{
+				name: "insert each request once when the batch was partially written",
+				args: args{
+					ctx:  context.Background(),
+					reqs: newReqs(false, payload.Consistency_ALL, payload.Consistency_ALL),
+				},
+				fields: fields{
+					eg: errgroup.Get(),
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, got []interface{}, err error) error {
+					return checkResults(got, err, []string{"agent-2"}, agents, 2)
+				},
+			}
+		}(),
+		func() test {
+			agents := newAgentMocks(3)
+			return test{
+				name: "insert each request individually when the batch is rejected before writing",
+				args: args{
+					ctx:  context.Background(),
+					reqs: newReqs(true, payload.Consistency_ONE, payload.Consistency_ALL),
+				},
+				fields: fields{
+					eg: errgroup.Get(),
+					gateway: &gatewayMock{
+						agents: agents,
+					},
+					replica: 3,
+				},
+				checkFunc: func(w want, got []interface{}, err error) error {
+					return checkResults(got, err, []string{"agent-0", "agent-1", "agent-2"}, agents, 2)
+				},
+			}
+		}(),
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			s := &server{
+				eg:      test.fields.eg,
+				gateway: test.fields.gateway,
+				replica: test.fields.replica,
+			}
+
+			got, err := s.streamInsertBatch(test.args.ctx, test.args.reqs)
+			if err := test.checkFunc(test.want, got, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_hasDuplicateID(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		reqs []*payload.Insert_Request
+	}
+	type want struct {
+		want bool
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, bool) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, got bool) error {
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	newReq := func(id string) *payload.Insert_Request {
+		return &payload.Insert_Request{
+			Vector: &payload.Object_Vector{
+				Id: id,
+			},
+		}
+	}
+	tests := []test{
+		{
+			name: "return false when all ids are unique",
+			args: args{
+				reqs: []*payload.Insert_Request{
+					newReq("a"), newReq("b"), newReq("c"),
+				},
+			},
+			want: want{
+				want: false,
+			},
+		},
+		{
+			name: "return true when the same id appears twice",
+			args: args{
+				reqs: []*payload.Insert_Request{
+					newReq("a"), newReq("b"), newReq("a"),
+				},
+			},
+			want: want{
+				want: true,
+			},
+		},
+		{
+			name: "return false when reqs is empty",
+			want: want{
+				want: false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := hasDuplicateID(test.args.reqs)
+			if err := test.checkFunc(test.want, got); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_requiredAcks(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		c payload.Consistency
+		n int
+	}
+	type want struct {
+		want int
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, int) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, got int) error {
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "return 0 to keep the legacy behavior when consistency is DEFAULT",
+			args: args{
+				c: payload.Consistency_DEFAULT,
+				n: 3,
+			},
+			want: want{
+				want: 0,
+			},
+		},
+		{
+			name: "return 1 when consistency is ONE",
+			args: args{
+				c: payload.Consistency_ONE,
+				n: 3,
+			},
+			want: want{
+				want: 1,
+			},
+		},
+		{
+			name: "return the majority when consistency is QUORUM and replicas are odd",
+			args: args{
+				c: payload.Consistency_QUORUM,
+				n: 3,
+			},
+			want: want{
+				want: 2,
+			},
+		},
+		{
+			name: "return the majority when consistency is QUORUM and replicas are even",
+			args: args{
+				c: payload.Consistency_QUORUM,
+				n: 4,
+			},
+			want: want{
+				want: 3,
+			},
+		},
+		{
+			name: "return all replicas when consistency is ALL",
+			args: args{
+				c: payload.Consistency_ALL,
+				n: 5,
+			},
+			want: want{
+				want: 5,
+			},
+		},
+		{
+			name: "return 0 when there is no replica",
+			args: args{
+				c: payload.Consistency_ONE,
+				n: 0,
+			},
+			want: want{
+				want: 0,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := requiredAcks(test.args.c, test.args.n)
+			if err := test.checkFunc(test.want, got); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_multiConsistency(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		cs []payload.Consistency
+	}
+	type want struct {
+		want payload.Consistency
+		err  error
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, payload.Consistency, error) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, got payload.Consistency, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "return DEFAULT when there is no request",
+			want: want{
+				want: payload.Consistency_DEFAULT,
+			},
+		},
+		{
+			name: "return the consistency level shared by the requests",
+			args: args{
+				cs: []payload.Consistency{
+					payload.Consistency_QUORUM,
+					payload.Consistency_QUORUM,
+				},
+			},
+			want: want{
+				want: payload.Consistency_QUORUM,
+			},
+		},
+		{
+			name: "return error when the requests use different consistency levels",
+			args: args{
+				cs: []payload.Consistency{
+					payload.Consistency_DEFAULT,
+					payload.Consistency_ONE,
+				},
+			},
+			want: want{
+				want: payload.Consistency_DEFAULT,
+				err:  errors.ErrMixedConsistency("DEFAULT", "ONE"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got, err := multiConsistency(test.args.cs...)
+			if err := test.checkFunc(test.want, got, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_sortedFailures(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		failures map[string]*payload.Object_Location_Failure
+	}
+	type want struct {
+		want []*payload.Object_Location_Failure
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, []*payload.Object_Location_Failure) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+	defaultCheckFunc := func(w want, got []*payload.Object_Location_Failure) error {
+		if !reflect.DeepEqual(got, w.want) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, w.want)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "return nil when failures is empty",
+			args: args{
+				failures: map[string]*payload.Object_Location_Failure{},
+			},
+			want: want{
+				want: nil,
+			},
+		},
+		func() test {
+			a := &payload.Object_Location_Failure{Addr: "10.0.0.1"}
+			b := &payload.Object_Location_Failure{Addr: "10.0.0.2"}
+			c := &payload.Object_Location_Failure{Addr: "10.0.0.3"}
+			return test{
+				name: "return failures sorted by address",
+				args: args{
+					failures: map[string]*payload.Object_Location_Failure{
+						c.GetAddr(): c,
+						a.GetAddr(): a,
+						b.GetAddr(): b,
+					},
+				},
+				want: want{
+					want: []*payload.Object_Location_Failure{a, b, c},
+				},
+			}
+		}(),
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+
+			got := sortedFailures(test.args.failures)
+			if err := test.checkFunc(test.want, got); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
 func Test_f32stos(t *testing.T) {
 	type args struct {
 		fs []float32
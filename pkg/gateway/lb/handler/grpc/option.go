@@ -36,6 +36,8 @@ var defaultOptions = []Option{
 	WithReplicationCount(3),
 	WithStreamConcurrency(runtime.GOMAXPROCS(-1) * 10),
 	WithTimeout("5s"),
+	WithStreamInsertBatchSize(100),
+	WithStreamInsertBatchDuration("10ms"),
 	WithName(func() string {
 		name, err := os.Hostname()
 		if err != nil {
@@ -72,6 +74,14 @@ func WithGateway(g service.Gateway) Option {
 	}
 }
 
+func WithSearchCache(c service.SearchCache) Option {
+	return func(s *server) {
+		if c != nil {
+			s.cache = c
+		}
+	}
+}
+
 func WithErrGroup(eg errgroup.Group) Option {
 	return func(s *server) {
 		if eg != nil {
@@ -105,3 +115,23 @@ func WithStreamConcurrency(c int) Option {
 		}
 	}
 }
+
+// WithStreamInsertBatchSize returns the option to set the maximum number of StreamInsert requests coalesced into a MultiInsert batch.
+func WithStreamInsertBatchSize(size int) Option {
+	return func(s *server) {
+		if size > 0 {
+			s.streamInsertBatchSize = size
+		}
+	}
+}
+
+// WithStreamInsertBatchDuration returns the option to set the maximum time to wait for a StreamInsert batch to fill up.
+func WithStreamInsertBatchDuration(dur string) Option {
+	return func(s *server) {
+		d, err := timeutil.Parse(dur)
+		if err != nil || d <= 0 {
+			d = time.Millisecond * 10
+		}
+		s.streamInsertBatchDuration = d
+	}
+}
@@ -18,9 +18,12 @@
 package grpc
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/test/goleak"
 	"github.com/vdaas/vald/pkg/gateway/lb/service"
 )
@@ -841,3 +844,151 @@ func TestWithName(t *testing.T) {
 		})
 	}
 }
+
+func TestWithStreamInsertBatchSize(t *testing.T) {
+	t.Parallel()
+	type T = server
+	type args struct {
+		size int
+	}
+	type want struct {
+		obj *T
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, *T) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+
+	defaultCheckFunc := func(w want, obj *T) error {
+		if !reflect.DeepEqual(obj, w.obj) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", obj, w.obj)
+		}
+		return nil
+	}
+
+	tests := []test{
+		{
+			name: "set success when size is 100",
+			args: args{
+				size: 100,
+			},
+			want: want{
+				obj: &T{
+					streamInsertBatchSize: 100,
+				},
+			},
+		},
+		{
+			name: "set nothing when size is 0",
+			args: args{
+				size: 0,
+			},
+			want: want{
+				obj: new(T),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			got := WithStreamInsertBatchSize(test.args.size)
+			obj := new(T)
+			got(obj)
+			if err := test.checkFunc(test.want, obj); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func TestWithStreamInsertBatchDuration(t *testing.T) {
+	t.Parallel()
+	type T = server
+	type args struct {
+		dur string
+	}
+	type want struct {
+		obj *T
+	}
+	type test struct {
+		name       string
+		args       args
+		want       want
+		checkFunc  func(want, *T) error
+		beforeFunc func(args)
+		afterFunc  func(args)
+	}
+
+	defaultCheckFunc := func(w want, obj *T) error {
+		if !reflect.DeepEqual(obj, w.obj) {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", obj, w.obj)
+		}
+		return nil
+	}
+
+	tests := []test{
+		{
+			name: "set success when dur is 50ms",
+			args: args{
+				dur: "50ms",
+			},
+			want: want{
+				obj: &T{
+					streamInsertBatchDuration: 50 * time.Millisecond,
+				},
+			},
+		},
+		{
+			name: "set default value when dur is invalid",
+			args: args{
+				dur: "invalid",
+			},
+			want: want{
+				obj: &T{
+					streamInsertBatchDuration: 10 * time.Millisecond,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if test.beforeFunc != nil {
+				test.beforeFunc(test.args)
+			}
+			if test.afterFunc != nil {
+				defer test.afterFunc(test.args)
+			}
+
+			if test.checkFunc == nil {
+				test.checkFunc = defaultCheckFunc
+			}
+			got := WithStreamInsertBatchDuration(test.args.dur)
+			obj := new(T)
+			got(obj)
+			if err := test.checkFunc(test.want, obj); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service represents gateway's service logic
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/cache"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/zeebo/xxh3"
+)
+
+// SearchCache represents the interface to cache the search results of the gateway.
+type SearchCache interface {
+	Start(ctx context.Context)
+	Get(req *payload.Search_Request) (*payload.Search_Response, bool)
+	Set(req *payload.Search_Request, res *payload.Search_Response, generation uint64)
+	Generation() uint64
+	Invalidate()
+	Hits() uint64
+	Misses() uint64
+	Len() int
+}
+
+type searchCache struct {
+	cache          cache.Cache
+	expireDur      string
+	expireCheckDur string
+	maxSize        int
+	generation     uint64
+	hits           uint64
+	misses         uint64
+
+	// keys holds the generation of each cached key to evict the stale generations when the cache is full.
+	keys sync.Map
+	// swept is the generation whose stale entries are already evicted.
+	swept uint64
+}
+
+type cachedResponse struct {
+	generation uint64
+	res        *payload.Search_Response
+}
+
+// NewSearchCache returns the SearchCache implementation if no error occurs.
+func NewSearchCache(opts ...CacheOption) (SearchCache, error) {
+	c := new(searchCache)
+	for _, opt := range append(defaultCacheOpts, opts...) {
+		if err := opt(c); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	var err error
+	c.cache, err = cache.New(
+		cache.WithExpireDuration(c.expireDur),
+		cache.WithExpireCheckDuration(c.expireCheckDur),
+		cache.WithExpiredHook(func(_ context.Context, key string) {
+			c.keys.Delete(key)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Start starts the expiration daemon of the cache.
+func (c *searchCache) Start(ctx context.Context) {
+	c.cache.Start(ctx)
+}
+
+// Get returns the cached search response of the request.
+// The cached response which is stored before the last invalidation is treated as a miss.
+func (c *searchCache) Get(req *payload.Search_Request) (*payload.Search_Response, bool) {
+	key := c.key(req)
+	v, ok := c.cache.Get(key)
+	if ok {
+		cr, ok := v.(*cachedResponse)
+		if ok && cr.generation == atomic.LoadUint64(&c.generation) {
+			atomic.AddUint64(&c.hits, 1)
+			return &payload.Search_Response{
+				RequestId: req.GetConfig().GetRequestId(),
+				Results:   cr.res.GetResults(),
+			}, true
+		}
+		c.delete(key)
+	}
+	atomic.AddUint64(&c.misses, 1)
+	return nil, false
+}
+
+// Set stores the search response of the request unless the cache is full.
+// The generation should be the one loaded before the search is started,
+// so that the response racing with an invalidation is never served.
+// The entries of the old generations are evicted when the cache is full, since they are never served.
+func (c *searchCache) Set(req *payload.Search_Request, res *payload.Search_Response, generation uint64) {
+	if res == nil || generation != atomic.LoadUint64(&c.generation) {
+		return
+	}
+	if c.maxSize > 0 && c.cache.Len() >= c.maxSize {
+		c.evict(generation)
+		if c.cache.Len() >= c.maxSize {
+			return
+		}
+	}
+	key := c.key(req)
+	c.cache.Set(key, &cachedResponse{
+		generation: generation,
+		res:        res,
+	})
+	c.keys.Store(key, generation)
+}
+
+// evict deletes the entries which are stored before the generation.
+// The entries are swept once for each generation, because the entries of the old generations are never stored after that.
+func (c *searchCache) evict(generation uint64) {
+	swept := atomic.LoadUint64(&c.swept)
+	if swept >= generation || !atomic.CompareAndSwapUint64(&c.swept, swept, generation) {
+		return
+	}
+	c.keys.Range(func(k, v interface{}) bool {
+		if gen, ok := v.(uint64); ok && gen < generation {
+			c.delete(k.(string))
+		}
+		return true
+	})
+}
+
+func (c *searchCache) delete(key string) {
+	c.cache.Delete(key)
+	c.keys.Delete(key)
+}
+
+// Generation returns the current generation of the cache which is incremented by each invalidation.
+func (c *searchCache) Generation() uint64 {
+	return atomic.LoadUint64(&c.generation)
+}
+
+// Invalidate invalidates all of the cached search responses.
+// It should be called when the index is changed by inserts or removes.
+func (c *searchCache) Invalidate() {
+	atomic.AddUint64(&c.generation, 1)
+}
+
+// Hits returns the number of the cache hits.
+func (c *searchCache) Hits() uint64 {
+	return atomic.LoadUint64(&c.hits)
+}
+
+// Misses returns the number of the cache misses.
+func (c *searchCache) Misses() uint64 {
+	return atomic.LoadUint64(&c.misses)
+}
+
+// Len returns the number of the cached search responses.
+func (c *searchCache) Len() int {
+	return c.cache.Len()
+}
+
+// key returns the hash of the query vector and the search config except for the request ID.
+func (c *searchCache) key(req *payload.Search_Request) string {
+	vec := req.GetVector()
+	cfg := req.GetConfig()
+	b, _ := (&payload.Search_Config{
+		Num:            cfg.GetNum(),
+		Radius:         cfg.GetRadius(),
+		Epsilon:        cfg.GetEpsilon(),
+		Timeout:        cfg.GetTimeout(),
+		IngressFilters: cfg.GetIngressFilters(),
+		EgressFilters:  cfg.GetEgressFilters(),
+	}).MarshalVT()
+	buf := make([]byte, len(vec)*4, len(vec)*4+len(b))
+	for i, f := range vec {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(f))
+	}
+	h := xxh3.Hash128(append(buf, b...))
+	return strconv.FormatUint(h.Hi, 16) + strconv.FormatUint(h.Lo, 16)
+}
@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service represents gateway's service logic
+package service
+
+import (
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+type CacheOption func(c *searchCache) error
+
+var defaultCacheOpts = []CacheOption{
+	WithCacheExpireDuration("1m"),
+	WithCacheExpireCheckDuration("10s"),
+	WithCacheMaxSize(10000),
+}
+
+func WithCacheExpireDuration(dur string) CacheOption {
+	return func(c *searchCache) error {
+		if dur == "" {
+			return nil
+		}
+		if _, err := timeutil.Parse(dur); err != nil {
+			return err
+		}
+		c.expireDur = dur
+		return nil
+	}
+}
+
+func WithCacheExpireCheckDuration(dur string) CacheOption {
+	return func(c *searchCache) error {
+		if dur == "" {
+			return nil
+		}
+		if _, err := timeutil.Parse(dur); err != nil {
+			return err
+		}
+		c.expireCheckDur = dur
+		return nil
+	}
+}
+
+func WithCacheMaxSize(size int) CacheOption {
+	return func(c *searchCache) error {
+		if size > 0 {
+			c.maxSize = size
+		}
+		return nil
+	}
+}
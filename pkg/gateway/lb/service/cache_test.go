@@ -0,0 +1,269 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service represents gateway's service logic
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+// Goroutine leak is detected by `fastime`, but it should be ignored in the test because it is an external package.
+var goleakIgnoreOptions = []goleak.Option{
+	goleak.IgnoreTopFunction("github.com/kpango/fastime.(*Fastime).StartTimerD.func1"),
+}
+
+func TestNewSearchCache(t *testing.T) {
+	type args struct {
+		opts []CacheOption
+	}
+	type want struct {
+		err error
+	}
+	type test struct {
+		name      string
+		args      args
+		want      want
+		checkFunc func(want, SearchCache, error) error
+	}
+	defaultCheckFunc := func(w want, got SearchCache, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if got == nil {
+			return errors.New("got: nil,\n\t\t\t\twant: non-nil SearchCache")
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "return SearchCache when the default options are used",
+		},
+		{
+			name: "return SearchCache when all options are set",
+			args: args{
+				opts: []CacheOption{
+					WithCacheExpireDuration("5m"),
+					WithCacheExpireCheckDuration("1m"),
+					WithCacheMaxSize(100),
+				},
+			},
+		},
+		{
+			name: "return error when the expire duration is invalid",
+			args: args{
+				opts: []CacheOption{
+					WithCacheExpireDuration("invalid"),
+				},
+			},
+			checkFunc: func(w want, got SearchCache, err error) error {
+				if err == nil {
+					return errors.New("got_error: nil,\n\t\t\t\twant: non-nil error")
+				}
+				if got != nil {
+					return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: nil", got)
+				}
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			checkFunc := test.checkFunc
+			if test.checkFunc == nil {
+				checkFunc = defaultCheckFunc
+			}
+
+			got, err := NewSearchCache(test.args.opts...)
+			if err := checkFunc(test.want, got, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_searchCache_GetAndSet(t *testing.T) {
+	newReq := func(rid string, num uint32, vec ...float32) *payload.Search_Request {
+		return &payload.Search_Request{
+			Vector: vec,
+			Config: &payload.Search_Config{
+				RequestId: rid,
+				Num:       num,
+			},
+		}
+	}
+	res := &payload.Search_Response{
+		RequestId: "req-1",
+		Results: []*payload.Object_Distance{
+			{
+				Id:       "uuid-1",
+				Distance: 0.1,
+			},
+		},
+	}
+	type want struct {
+		res    *payload.Search_Response
+		ok     bool
+		hits   uint64
+		misses uint64
+	}
+	type test struct {
+		name       string
+		opts       []CacheOption
+		req        *payload.Search_Request
+		beforeFunc func(*testing.T, SearchCache)
+		want       want
+	}
+	tests := []test{
+		{
+			name: "return false when the cache is empty",
+			req:  newReq("req-1", 10, 0.1, 0.2, 0.3),
+			want: want{
+				misses: 1,
+			},
+		},
+		{
+			name: "return the cached response with the request ID of the query",
+			req:  newReq("req-2", 10, 0.1, 0.2, 0.3),
+			beforeFunc: func(t *testing.T, c SearchCache) {
+				t.Helper()
+				c.Set(newReq("req-1", 10, 0.1, 0.2, 0.3), res, c.Generation())
+			},
+			want: want{
+				res: &payload.Search_Response{
+					RequestId: "req-2",
+					Results:   res.GetResults(),
+				},
+				ok:   true,
+				hits: 1,
+			},
+		},
+		{
+			name: "return false when the search config is different",
+			req:  newReq("req-2", 5, 0.1, 0.2, 0.3),
+			beforeFunc: func(t *testing.T, c SearchCache) {
+				t.Helper()
+				c.Set(newReq("req-1", 10, 0.1, 0.2, 0.3), res, c.Generation())
+			},
+			want: want{
+				misses: 1,
+			},
+		},
+		{
+			name: "return false when the cache is invalidated",
+			req:  newReq("req-2", 10, 0.1, 0.2, 0.3),
+			beforeFunc: func(t *testing.T, c SearchCache) {
+				t.Helper()
+				c.Set(newReq("req-1", 10, 0.1, 0.2, 0.3), res, c.Generation())
+				c.Invalidate()
+			},
+			want: want{
+				misses: 1,
+			},
+		},
+		{
+			name: "return false when the response is stored with the old generation",
+			req:  newReq("req-2", 10, 0.1, 0.2, 0.3),
+			beforeFunc: func(t *testing.T, c SearchCache) {
+				t.Helper()
+				gen := c.Generation()
+				c.Invalidate()
+				c.Set(newReq("req-1", 10, 0.1, 0.2, 0.3), res, gen)
+			},
+			want: want{
+				misses: 1,
+			},
+		},
+		{
+			name: "return false when the cache is full",
+			opts: []CacheOption{
+				WithCacheMaxSize(1),
+			},
+			req: newReq("req-2", 10, 0.1, 0.2, 0.3),
+			beforeFunc: func(t *testing.T, c SearchCache) {
+				t.Helper()
+				c.Set(newReq("req-0", 10, 0.4, 0.5, 0.6), res, c.Generation())
+				c.Set(newReq("req-1", 10, 0.1, 0.2, 0.3), res, c.Generation())
+			},
+			want: want{
+				misses: 1,
+			},
+		},
+		{
+			name: "return the cached response when the cache is full of the invalidated responses",
+			opts: []CacheOption{
+				WithCacheMaxSize(2),
+			},
+			req: newReq("req-2", 10, 0.1, 0.2, 0.3),
+			beforeFunc: func(t *testing.T, c SearchCache) {
+				t.Helper()
+				c.Set(newReq("req-0", 10, 0.4, 0.5, 0.6), res, c.Generation())
+				c.Set(newReq("req-0", 10, 0.7, 0.8, 0.9), res, c.Generation())
+				c.Invalidate()
+				c.Set(newReq("req-1", 10, 0.1, 0.2, 0.3), res, c.Generation())
+				if got := c.Len(); got != 1 {
+					t.Errorf("got_len: %d,\n\t\t\t\twant: 1", got)
+				}
+			},
+			want: want{
+				res: &payload.Search_Response{
+					RequestId: "req-2",
+					Results:   res.GetResults(),
+				},
+				ok:   true,
+				hits: 1,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleakIgnoreOptions...)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			c, err := NewSearchCache(test.opts...)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			c.Start(ctx)
+			if test.beforeFunc != nil {
+				test.beforeFunc(tt, c)
+			}
+
+			got, ok := c.Get(test.req)
+			if ok != test.want.ok {
+				tt.Errorf("got_ok: %v,\n\t\t\t\twant: %v", ok, test.want.ok)
+			}
+			if !reflect.DeepEqual(got, test.want.res) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.res)
+			}
+			if c.Hits() != test.want.hits || c.Misses() != test.want.misses {
+				tt.Errorf("got_hits: %d, got_misses: %d,\n\t\t\t\twant_hits: %d, want_misses: %d",
+					c.Hits(), c.Misses(), test.want.hits, test.want.misses)
+			}
+		})
+	}
+}
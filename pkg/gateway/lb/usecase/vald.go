@@ -26,6 +26,8 @@ import (
 	"github.com/vdaas/vald/internal/net/grpc"
 	"github.com/vdaas/vald/internal/net/grpc/metric"
 	"github.com/vdaas/vald/internal/observability"
+	"github.com/vdaas/vald/internal/observability/metrics"
+	lbmetrics "github.com/vdaas/vald/internal/observability/metrics/gateway/lb"
 	"github.com/vdaas/vald/internal/runner"
 	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/internal/servers/server"
@@ -43,12 +45,16 @@ type run struct {
 	server        starter.Server
 	observability observability.Observability
 	gateway       service.Gateway
+	cache         service.SearchCache
 }
 
 func New(cfg *config.Data) (r runner.Runner, err error) {
 	eg := errgroup.Get()
 
-	var gateway service.Gateway
+	var (
+		gateway service.Gateway
+		cache   service.SearchCache
+	)
 
 	cOpts, err := cfg.Gateway.Discoverer.Client.Opts()
 	if err != nil {
@@ -65,9 +71,24 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		acOpts,
 		grpc.WithErrGroup(eg))
 
+	if sc := cfg.Gateway.SearchCache; sc != nil && sc.Enabled {
+		cache, err = service.NewSearchCache(
+			service.WithCacheExpireDuration(sc.ExpireDuration),
+			service.WithCacheExpireCheckDuration(sc.ExpireCheckDuration),
+			service.WithCacheMaxSize(sc.MaxSize),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var obs observability.Observability
 	if cfg.Observability.Enabled {
-		obs, err = observability.NewWithConfig(cfg.Observability)
+		var ms []metrics.Metric
+		if cache != nil {
+			ms = append(ms, lbmetrics.New(cache))
+		}
+		obs, err = observability.NewWithConfig(cfg.Observability, ms...)
 		if err != nil {
 			return nil, err
 		}
@@ -107,12 +128,20 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		return nil, err
 	}
 
-	v := handler.New(
+	hopts := []handler.Option{
 		handler.WithGateway(gateway),
+		handler.WithSearchCache(cache),
 		handler.WithErrGroup(eg),
 		handler.WithReplicationCount(cfg.Gateway.IndexReplica),
 		handler.WithStreamConcurrency(cfg.Server.GetGRPCStreamConcurrency()),
-	)
+	}
+	if sb := cfg.Gateway.StreamInsertBatch; sb != nil {
+		hopts = append(hopts,
+			handler.WithStreamInsertBatchSize(sb.Size),
+			handler.WithStreamInsertBatchDuration(sb.Duration),
+		)
+	}
+	v := handler.New(hopts...)
 
 	grpcServerOptions := []server.Option{
 		server.WithGRPCRegistFunc(func(srv *grpc.Server) {
@@ -162,6 +191,7 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		server:        srv,
 		observability: obs,
 		gateway:       gateway,
+		cache:         cache,
 	}, nil
 }
 
@@ -179,6 +209,9 @@ func (r *run) Start(ctx context.Context) (<-chan error, error) {
 	if r.observability != nil {
 		oech = r.observability.Start(ctx)
 	}
+	if r.cache != nil {
+		r.cache.Start(ctx)
+	}
 	if r.gateway != nil {
 		gech, err = r.gateway.Start(ctx)
 		if err != nil {
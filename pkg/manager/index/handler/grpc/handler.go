@@ -27,7 +27,8 @@ import (
 )
 
 type server struct {
-	indexer service.Indexer
+	indexer    service.Indexer
+	rebalancer service.Rebalancer
 	index.UnimplementedIndexServer
 }
 
@@ -53,3 +54,16 @@ func (s *server) IndexInfo(ctx context.Context, _ *payload.Empty) (res *payload.
 		Indexing:    s.indexer.IsIndexing(),
 	}, nil
 }
+
+func (s *server) RebalanceStatus(ctx context.Context, _ *payload.Empty) (res *payload.Info_Rebalance, err error) {
+	_, span := trace.StartSpan(ctx, "vald/manager-index.RebalanceStatus")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	if s.rebalancer == nil {
+		return new(payload.Info_Rebalance), nil
+	}
+	return s.rebalancer.Status(), nil
+}
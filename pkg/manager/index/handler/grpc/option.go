@@ -32,3 +32,11 @@ func WithIndexer(i service.Indexer) Option {
 		}
 	}
 }
+
+func WithRebalancer(r service.Rebalancer) Option {
+	return func(s *server) {
+		if r != nil {
+			s.rebalancer = r
+		}
+	}
+}
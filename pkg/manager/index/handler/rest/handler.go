@@ -29,6 +29,7 @@ import (
 type Handler interface {
 	Index(w http.ResponseWriter, r *http.Request) (int, error)
 	IndexInfo(w http.ResponseWriter, r *http.Request) (int, error)
+	RebalanceStatus(w http.ResponseWriter, r *http.Request) (int, error)
 }
 
 type handler struct {
@@ -57,3 +58,10 @@ func (h *handler) IndexInfo(w http.ResponseWriter, r *http.Request) (code int, e
 		return h.indexer.IndexInfo(r.Context(), req)
 	})
 }
+
+func (h *handler) RebalanceStatus(w http.ResponseWriter, r *http.Request) (code int, err error) {
+	var req *payload.Empty
+	return json.Handler(w, r, &req, func() (interface{}, error) {
+		return h.indexer.RebalanceStatus(r.Context(), req)
+	})
+}
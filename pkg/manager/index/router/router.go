@@ -57,5 +57,13 @@ func New(opts ...Option) http.Handler {
 				"/index",
 				h.IndexInfo,
 			},
+			{
+				"RebalanceStatus",
+				[]string{
+					http.MethodGet,
+				},
+				"/rebalance/status",
+				h.RebalanceStatus,
+			},
 		}...))
 }
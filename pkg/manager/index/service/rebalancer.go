@@ -0,0 +1,548 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service
+package service
+
+import (
+	"context"
+	"io/fs"
+	"math"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	agent "github.com/vdaas/vald/apis/grpc/v1/agent/core"
+	dscv1 "github.com/vdaas/vald/apis/grpc/v1/discoverer"
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/client/v1/client/discoverer"
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+// Rebalancer represents the interface to move vectors from over-full agents to under-full agents.
+type Rebalancer interface {
+	Start(ctx context.Context) (<-chan error, error)
+	Status() *payload.Info_Rebalance
+}
+
+type rebalancer struct {
+	client         discoverer.Client
+	dscClient      grpc.Client
+	eg             errgroup.Group
+	agentName      string
+	agentNamespace string
+	agentPort      int
+	checkDuration  time.Duration
+	threshold      float64
+	rateLimit      int
+	maxMoves       uint32
+	checkpointPath string
+	running        atomic.Value // bool
+	mu             sync.RWMutex
+	status         *payload.Info_Rebalance
+	cursors        []string
+}
+
+// checkpoint represents the persisted status of the rebalance and the last listed UUID of each move to resume the moves.
+type checkpoint struct {
+	Status  *payload.Info_Rebalance `json:"status"`
+	Cursors []string                `json:"cursors"`
+}
+
+const (
+	// checkpointInterval is the number of moved vectors between each checkpoint.
+	checkpointInterval = 100
+	// uuidPageSize is the maximum number of the UUIDs listed from the source agent at once.
+	uuidPageSize = 1000
+)
+
+// NewRebalancer returns the Rebalancer implementation if no error occurs.
+func NewRebalancer(opts ...RebalancerOption) (Rebalancer, error) {
+	r := new(rebalancer)
+	for _, opt := range append(defaultRebalancerOptions, opts...) {
+		if err := opt(r); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	if r.client == nil || r.dscClient == nil {
+		return nil, errors.ErrInvalidConfig
+	}
+	r.running.Store(false)
+	r.status = new(payload.Info_Rebalance)
+	return r, nil
+}
+
+func (r *rebalancer) Start(ctx context.Context) (<-chan error, error) {
+	dech, err := r.dscClient.StartConnectionMonitor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resume, err := r.loadCheckpoint()
+	if err != nil {
+		log.Warnf("failed to load rebalance checkpoint from %s: %v", r.checkpointPath, err)
+		resume = false
+	}
+	ech := make(chan error, 100)
+	r.eg.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+		if resume {
+			log.Infof("resuming the interrupted rebalance from %s", r.checkpointPath)
+			err = r.rebalance(ctx)
+			if err != nil {
+				log.Error("an error occurred during resuming rebalance", err)
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+		ct := time.NewTicker(r.checkDuration)
+		defer ct.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+				if err != nil && err != context.Canceled {
+					return err
+				}
+				return nil
+			case err = <-dech:
+			case <-ct.C:
+				err = r.rebalance(ctx)
+				if err != nil {
+					log.Error("an error occurred during rebalance", err)
+				}
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+	}))
+	return ech, nil
+}
+
+// Status returns the progress of the current or the last rebalance.
+func (r *rebalancer) Status() *payload.Info_Rebalance {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	st := &payload.Info_Rebalance{
+		Running:    r.status.GetRunning(),
+		StartedAt:  r.status.GetStartedAt(),
+		FinishedAt: r.status.GetFinishedAt(),
+		Moves:      make([]*payload.Info_Rebalance_Move, 0, len(r.status.GetMoves())),
+	}
+	for _, mv := range r.status.GetMoves() {
+		st.Moves = append(st.Moves, &payload.Info_Rebalance_Move{
+			Source:  mv.GetSource(),
+			Target:  mv.GetTarget(),
+			Planned: mv.GetPlanned(),
+			Moved:   mv.GetMoved(),
+			Failed:  mv.GetFailed(),
+		})
+	}
+	return st
+}
+
+func (r *rebalancer) rebalance(ctx context.Context) (err error) {
+	ctx, span := trace.StartSpan(ctx, "vald/manager-index/service/Rebalancer.rebalance")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+
+	if r.running.Load().(bool) {
+		return nil
+	}
+	r.running.Store(true)
+	defer r.running.Store(false)
+
+	r.mu.Lock()
+	if !r.status.GetRunning() {
+		r.mu.Unlock()
+		moves, err := r.plan(ctx)
+		if err != nil {
+			return err
+		}
+		if len(moves) == 0 {
+			return nil
+		}
+		r.mu.Lock()
+		r.status = &payload.Info_Rebalance{
+			Running:   true,
+			Moves:     moves,
+			StartedAt: time.Now().UnixNano(),
+		}
+		r.cursors = nil
+	}
+	if len(r.cursors) != len(r.status.GetMoves()) {
+		r.cursors = make([]string, len(r.status.GetMoves()))
+	}
+	moves := r.status.GetMoves()
+	r.mu.Unlock()
+
+	err = r.storeCheckpoint()
+	if err != nil {
+		log.Warnf("failed to store rebalance checkpoint to %s: %v", r.checkpointPath, err)
+	}
+
+	var limiter *time.Ticker
+	if r.rateLimit > 0 {
+		limiter = time.NewTicker(time.Second / time.Duration(r.rateLimit))
+		defer limiter.Stop()
+	}
+	var errs error
+	for i, mv := range moves {
+		err = r.migrate(ctx, i, mv, limiter)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				// keep the status running to resume the moves on the next start.
+				return r.storeCheckpoint()
+			}
+			log.Warnf("failed to move vectors from %s to %s: %v", mv.GetSource(), mv.GetTarget(), err)
+			errs = errors.Wrap(errs, err.Error())
+		}
+	}
+
+	r.mu.Lock()
+	r.status.Running = false
+	r.status.FinishedAt = time.Now().UnixNano()
+	r.mu.Unlock()
+	err = r.storeCheckpoint()
+	if err != nil {
+		errs = errors.Wrap(errs, err.Error())
+	}
+	return errs
+}
+
+type agentMemory struct {
+	addr  string
+	ratio float64
+}
+
+// plan decides the moves from the memory usage of each agent pod reported by the discoverer.
+func (r *rebalancer) plan(ctx context.Context) (moves []*payload.Info_Rebalance_Move, err error) {
+	ctx, span := trace.StartSpan(ctx, "vald/manager-index/service/Rebalancer.plan")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+
+	res, err := r.dscClient.RoundRobin(ctx, func(ctx context.Context,
+		conn *grpc.ClientConn, copts ...grpc.CallOption) (interface{}, error) {
+		return dscv1.NewDiscovererClient(conn).Pods(ctx, &payload.Discoverer_Request{
+			Name:      r.agentName,
+			Namespace: r.agentNamespace,
+		}, copts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	pods, ok := res.(*payload.Info_Pods)
+	if !ok || len(pods.GetPods()) < 2 {
+		return nil, nil
+	}
+
+	connected := make(map[string]struct{}, len(pods.GetPods()))
+	for _, addr := range r.client.GetAddrs(ctx) {
+		connected[addr] = struct{}{}
+	}
+	mems := make([]agentMemory, 0, len(pods.GetPods()))
+	var sum float64
+	for _, pod := range pods.GetPods() {
+		addr := net.JoinHostPort(pod.GetIp(), uint16(r.agentPort))
+		if _, ok := connected[addr]; !ok {
+			continue
+		}
+		mem := pod.GetMemory()
+		ratio := mem.GetUsage()
+		if mem.GetLimit() > 0 {
+			ratio /= mem.GetLimit()
+		}
+		mems = append(mems, agentMemory{
+			addr:  addr,
+			ratio: ratio,
+		})
+		sum += ratio
+	}
+	if len(mems) < 2 {
+		return nil, nil
+	}
+	avg := sum / float64(len(mems))
+
+	var donors, receivers []agentMemory
+	var deficit float64
+	for _, m := range mems {
+		switch {
+		case m.ratio > avg+r.threshold:
+			donors = append(donors, m)
+		case m.ratio < avg-r.threshold:
+			receivers = append(receivers, m)
+			deficit += avg - m.ratio
+		}
+	}
+	if len(donors) == 0 || len(receivers) == 0 {
+		return nil, nil
+	}
+	sort.Slice(donors, func(i, j int) bool {
+		return donors[i].ratio > donors[j].ratio
+	})
+
+	var total uint32
+	for _, d := range donors {
+		var stored uint32
+		_, err = r.client.GetClient().Do(ctx, d.addr, func(ctx context.Context,
+			conn *grpc.ClientConn, copts ...grpc.CallOption) (interface{}, error) {
+			info, err := agent.NewAgentClient(conn).IndexInfo(ctx, new(payload.Empty), copts...)
+			if err != nil {
+				return nil, err
+			}
+			stored = info.GetStored()
+			return info, nil
+		})
+		if err != nil {
+			log.Warnf("an error occurred while calling IndexInfo of %s: %s", d.addr, err)
+			continue
+		}
+		excess := float64(stored) * (d.ratio - avg) / d.ratio
+		for _, rc := range receivers {
+			n := uint32(math.Floor(excess * (avg - rc.ratio) / deficit))
+			if r.maxMoves > 0 && total+n > r.maxMoves {
+				n = r.maxMoves - total
+			}
+			if n == 0 {
+				continue
+			}
+			moves = append(moves, &payload.Info_Rebalance_Move{
+				Source:  d.addr,
+				Target:  rc.addr,
+				Planned: n,
+			})
+			total += n
+		}
+	}
+	return moves, nil
+}
+
+// migrate moves the remaining vectors of the i-th move from the source agent to the target agent.
+// The UUIDs are listed in pages after the cursor of the move, so that the resumed move does not list the vectors which have been kept or failed again.
+func (r *rebalancer) migrate(ctx context.Context, i int, mv *payload.Info_Rebalance_Move, limiter *time.Ticker) (err error) {
+	ctx, span := trace.StartSpan(ctx, "vald/manager-index/service/Rebalancer.migrate")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+
+	var n int
+	for {
+		r.mu.RLock()
+		done := mv.GetMoved() + mv.GetFailed()
+		planned := mv.GetPlanned()
+		cursor := r.cursors[i]
+		r.mu.RUnlock()
+		if done >= planned {
+			return nil
+		}
+		limit := planned - done
+		if limit > uuidPageSize {
+			limit = uuidPageSize
+		}
+
+		var res *payload.Info_Index_UUIDs
+		_, err = r.client.GetClient().Do(ctx, mv.GetSource(), func(ctx context.Context,
+			conn *grpc.ClientConn, copts ...grpc.CallOption) (interface{}, error) {
+			res, err = agent.NewAgentClient(conn).IndexUUIDs(ctx, &payload.Info_Index_UUIDs_Request{
+				Limit:   limit,
+				Ordered: true,
+				Cursor:  cursor,
+			}, copts...)
+			return res, err
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, uuid := range res.GetUuids() {
+			if limiter != nil {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-limiter.C:
+				}
+			}
+			err = r.move(ctx, mv.GetSource(), mv.GetTarget(), uuid)
+			if err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+				return err
+			}
+			r.mu.Lock()
+			if err != nil {
+				log.Debugf("failed to move %s from %s to %s: %v", uuid, mv.GetSource(), mv.GetTarget(), err)
+				mv.Failed++
+			} else {
+				mv.Moved++
+			}
+			r.cursors[i] = uuid
+			r.mu.Unlock()
+			n++
+			if n%checkpointInterval == 0 {
+				if err := r.storeCheckpoint(); err != nil {
+					log.Warnf("failed to store rebalance checkpoint to %s: %v", r.checkpointPath, err)
+				}
+			}
+		}
+		// the source agent has no more vectors after the cursor.
+		if len(res.GetNextCursor()) == 0 {
+			return nil
+		}
+	}
+}
+
+// move copies the vector to the target agent and removes it from the source agent.
+// The source vector is kept when the target agent already stores it, because it may be another replica of the vector.
+func (r *rebalancer) move(ctx context.Context, src, dst, uuid string) (err error) {
+	var vec *payload.Object_Vector
+	_, err = r.client.GetClient().Do(ctx, src, func(ctx context.Context,
+		conn *grpc.ClientConn, copts ...grpc.CallOption) (interface{}, error) {
+		vec, err = vald.NewValdClient(conn).GetObject(ctx, &payload.Object_VectorRequest{
+			Id: &payload.Object_ID{
+				Id: uuid,
+			},
+		}, copts...)
+		return vec, err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = r.client.GetClient().Do(ctx, dst, func(ctx context.Context,
+		conn *grpc.ClientConn, copts ...grpc.CallOption) (interface{}, error) {
+		return vald.NewValdClient(conn).Insert(ctx, &payload.Insert_Request{
+			Vector: vec,
+			Config: &payload.Insert_Config{
+				SkipStrictExistCheck: true,
+			},
+		}, copts...)
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if ok && st != nil && st.Code() == codes.AlreadyExists {
+			return errors.ErrRebalanceTargetExists
+		}
+		return err
+	}
+	_, err = r.client.GetClient().Do(ctx, src, func(ctx context.Context,
+		conn *grpc.ClientConn, copts ...grpc.CallOption) (interface{}, error) {
+		return vald.NewValdClient(conn).Remove(ctx, &payload.Remove_Request{
+			Id: &payload.Object_ID{
+				Id: uuid,
+			},
+			Config: &payload.Remove_Config{
+				SkipStrictExistCheck: true,
+			},
+		}, copts...)
+	})
+	return err
+}
+
+// loadCheckpoint loads the persisted status and reports whether the rebalance should be resumed.
+func (r *rebalancer) loadCheckpoint() (resume bool, err error) {
+	if r.checkpointPath == "" {
+		return false, nil
+	}
+	exists, fi, err := file.ExistsWithDetail(r.checkpointPath)
+	if err != nil && !os.IsNotExist(err) {
+		return false, err
+	}
+	if !exists || fi == nil || fi.Size() == 0 {
+		return false, nil
+	}
+	f, err := file.Open(r.checkpointPath, os.O_RDONLY|os.O_SYNC, fs.ModePerm)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if f != nil {
+			derr := f.Close()
+			if derr != nil {
+				err = errors.Wrap(err, derr.Error())
+			}
+		}
+	}()
+	cp := new(checkpoint)
+	err = json.Decode(f, cp)
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	st := cp.Status
+	if st == nil {
+		st = new(payload.Info_Rebalance)
+	}
+	r.mu.Lock()
+	r.status = st
+	r.cursors = cp.Cursors
+	r.mu.Unlock()
+	return st.GetRunning(), nil
+}
+
+func (r *rebalancer) storeCheckpoint() (err error) {
+	if r.checkpointPath == "" {
+		return nil
+	}
+	f, err := file.Open(r.checkpointPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if f != nil {
+			derr := f.Close()
+			if derr != nil {
+				err = errors.Wrap(err, derr.Error())
+			}
+		}
+	}()
+	r.mu.RLock()
+	cursors := append([]string(nil), r.cursors...)
+	r.mu.RUnlock()
+	err = json.Encode(f, &checkpoint{
+		Status:  r.Status(),
+		Cursors: cursors,
+	})
+	if err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service
+package service
+
+import (
+	"github.com/vdaas/vald/internal/client/v1/client/discoverer"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+type RebalancerOption func(r *rebalancer) error
+
+var defaultRebalancerOptions = []RebalancerOption{
+	WithRebalancerErrGroup(errgroup.Get()),
+	WithRebalanceCheckDuration("10m"),
+	WithRebalanceThreshold(0.1),
+	WithRebalanceRateLimit(100),
+}
+
+func WithRebalancerDiscoverer(c discoverer.Client) RebalancerOption {
+	return func(r *rebalancer) error {
+		if c != nil {
+			r.client = c
+		}
+		return nil
+	}
+}
+
+func WithRebalancerDiscovererClient(c grpc.Client) RebalancerOption {
+	return func(r *rebalancer) error {
+		if c != nil {
+			r.dscClient = c
+		}
+		return nil
+	}
+}
+
+func WithRebalancerErrGroup(eg errgroup.Group) RebalancerOption {
+	return func(r *rebalancer) error {
+		if eg != nil {
+			r.eg = eg
+		}
+		return nil
+	}
+}
+
+func WithRebalancerAgentName(name string) RebalancerOption {
+	return func(r *rebalancer) error {
+		if name != "" {
+			r.agentName = name
+		}
+		return nil
+	}
+}
+
+func WithRebalancerAgentNamespace(ns string) RebalancerOption {
+	return func(r *rebalancer) error {
+		if ns != "" {
+			r.agentNamespace = ns
+		}
+		return nil
+	}
+}
+
+func WithRebalancerAgentPort(port int) RebalancerOption {
+	return func(r *rebalancer) error {
+		if port > 0 {
+			r.agentPort = port
+		}
+		return nil
+	}
+}
+
+func WithRebalanceCheckDuration(dur string) RebalancerOption {
+	return func(r *rebalancer) error {
+		if dur == "" {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return err
+		}
+		r.checkDuration = d
+		return nil
+	}
+}
+
+func WithRebalanceThreshold(th float64) RebalancerOption {
+	return func(r *rebalancer) error {
+		if th > 0 {
+			r.threshold = th
+		}
+		return nil
+	}
+}
+
+func WithRebalanceRateLimit(limit int) RebalancerOption {
+	return func(r *rebalancer) error {
+		if limit > 0 {
+			r.rateLimit = limit
+		}
+		return nil
+	}
+}
+
+func WithRebalanceMaxMoves(n uint32) RebalancerOption {
+	return func(r *rebalancer) error {
+		if n > 0 {
+			r.maxMoves = n
+		}
+		return nil
+	}
+}
+
+func WithRebalanceCheckpointPath(path string) RebalancerOption {
+	return func(r *rebalancer) error {
+		if path != "" {
+			r.checkpointPath = path
+		}
+		return nil
+	}
+}
@@ -0,0 +1,331 @@
+//
+// Copyright (C) 2019-2021 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package service
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestNewRebalancer(t *testing.T) {
+	type args struct {
+		opts []RebalancerOption
+	}
+	type want struct {
+		err error
+	}
+	type test struct {
+		name      string
+		args      args
+		want      want
+		checkFunc func(want, Rebalancer, error) error
+	}
+	defaultCheckFunc := func(w want, got Rebalancer, err error) error {
+		if !errors.Is(err, w.err) {
+			return errors.Errorf("got_error: \"%#v\",\n\t\t\t\twant: \"%#v\"", err, w.err)
+		}
+		if w.err != nil && got != nil {
+			return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: nil", got)
+		}
+		return nil
+	}
+	tests := []test{
+		{
+			name: "return invalid config error when the clients are not set",
+			want: want{
+				err: errors.ErrInvalidConfig,
+			},
+		},
+		{
+			name: "return error when the check duration is invalid",
+			args: args{
+				opts: []RebalancerOption{
+					WithRebalanceCheckDuration("invalid"),
+				},
+			},
+			checkFunc: func(w want, got Rebalancer, err error) error {
+				if err == nil {
+					return errors.New("got_error: nil,\n\t\t\t\twant: non-nil error")
+				}
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			checkFunc := test.checkFunc
+			if test.checkFunc == nil {
+				checkFunc = defaultCheckFunc
+			}
+
+			got, err := NewRebalancer(test.args.opts...)
+			if err := checkFunc(test.want, got, err); err != nil {
+				tt.Errorf("error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_rebalancer_Status(t *testing.T) {
+	type fields struct {
+		status *payload.Info_Rebalance
+	}
+	type want struct {
+		want *payload.Info_Rebalance
+	}
+	type test struct {
+		name   string
+		fields fields
+		want   want
+	}
+	tests := []test{
+		{
+			name: "return the copy of the current status",
+			fields: fields{
+				status: &payload.Info_Rebalance{
+					Running: true,
+					Moves: []*payload.Info_Rebalance_Move{
+						{
+							Source:  "10.0.0.1:8081",
+							Target:  "10.0.0.2:8081",
+							Planned: 10,
+							Moved:   5,
+							Failed:  1,
+						},
+					},
+					StartedAt: 100,
+				},
+			},
+			want: want{
+				want: &payload.Info_Rebalance{
+					Running: true,
+					Moves: []*payload.Info_Rebalance_Move{
+						{
+							Source:  "10.0.0.1:8081",
+							Target:  "10.0.0.2:8081",
+							Planned: 10,
+							Moved:   5,
+							Failed:  1,
+						},
+					},
+					StartedAt: 100,
+				},
+			},
+		},
+		{
+			name: "return the empty status when no rebalance has been run",
+			fields: fields{
+				status: new(payload.Info_Rebalance),
+			},
+			want: want{
+				want: &payload.Info_Rebalance{
+					Moves: []*payload.Info_Rebalance_Move{},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			r := &rebalancer{
+				status: test.fields.status,
+			}
+
+			got := r.Status()
+			if !reflect.DeepEqual(got, test.want.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.want)
+			}
+			if len(got.GetMoves()) != 0 && got.GetMoves()[0] == test.fields.status.GetMoves()[0] {
+				tt.Error("the moves of the status must be copied")
+			}
+		})
+	}
+}
+
+func Test_rebalancer_checkpoint(t *testing.T) {
+	type want struct {
+		resume  bool
+		status  *payload.Info_Rebalance
+		cursors []string
+	}
+	type test struct {
+		name    string
+		status  *payload.Info_Rebalance
+		cursors []string
+		want    want
+	}
+	tests := []test{
+		{
+			name: "resume the running rebalance from the checkpoint",
+			status: &payload.Info_Rebalance{
+				Running: true,
+				Moves: []*payload.Info_Rebalance_Move{
+					{
+						Source:  "10.0.0.1:8081",
+						Target:  "10.0.0.2:8081",
+						Planned: 100,
+						Moved:   42,
+					},
+					{
+						Source:  "10.0.0.1:8081",
+						Target:  "10.0.0.3:8081",
+						Planned: 50,
+					},
+				},
+				StartedAt: 100,
+			},
+			cursors: []string{"uuid-42", ""},
+			want: want{
+				resume: true,
+				status: &payload.Info_Rebalance{
+					Running: true,
+					Moves: []*payload.Info_Rebalance_Move{
+						{
+							Source:  "10.0.0.1:8081",
+							Target:  "10.0.0.2:8081",
+							Planned: 100,
+							Moved:   42,
+						},
+						{
+							Source:  "10.0.0.1:8081",
+							Target:  "10.0.0.3:8081",
+							Planned: 50,
+						},
+					},
+					StartedAt: 100,
+				},
+				cursors: []string{"uuid-42", ""},
+			},
+		},
+		{
+			name: "do not resume the finished rebalance",
+			status: &payload.Info_Rebalance{
+				StartedAt:  100,
+				FinishedAt: 200,
+			},
+			want: want{
+				resume: false,
+				status: &payload.Info_Rebalance{
+					Moves:      []*payload.Info_Rebalance_Move{},
+					StartedAt:  100,
+					FinishedAt: 200,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			path := filepath.Join(tt.TempDir(), "rebalance.json")
+			w := &rebalancer{
+				checkpointPath: path,
+				status:         test.status,
+				cursors:        test.cursors,
+			}
+			if err := w.storeCheckpoint(); err != nil {
+				tt.Fatalf("failed to store checkpoint: %v", err)
+			}
+
+			r := &rebalancer{
+				checkpointPath: path,
+				status:         new(payload.Info_Rebalance),
+			}
+			resume, err := r.loadCheckpoint()
+			if err != nil {
+				tt.Fatalf("failed to load checkpoint: %v", err)
+			}
+			if resume != test.want.resume {
+				tt.Errorf("got_resume: %v,\n\t\t\t\twant: %v", resume, test.want.resume)
+			}
+			if got := r.Status(); !reflect.DeepEqual(got, test.want.status) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.status)
+			}
+			if !reflect.DeepEqual(r.cursors, test.want.cursors) {
+				tt.Errorf("got_cursors: %v,\n\t\t\t\twant: %v", r.cursors, test.want.cursors)
+			}
+		})
+	}
+}
+
+func Test_rebalancer_loadCheckpoint(t *testing.T) {
+	type want struct {
+		resume bool
+		err    bool
+	}
+	type test struct {
+		name string
+		path func(dir string) string
+		want want
+	}
+	tests := []test{
+		{
+			name: "start a new rebalance when the checkpoint does not exist",
+			path: func(dir string) string {
+				return filepath.Join(dir, "rebalance.json")
+			},
+			want: want{},
+		},
+		{
+			name: "return error when the checkpoint cannot be inspected",
+			path: func(dir string) string {
+				// the parent of the checkpoint is a regular file.
+				parent := filepath.Join(dir, "file")
+				if err := os.WriteFile(parent, nil, 0o600); err != nil {
+					t.Fatal(err)
+				}
+				return filepath.Join(parent, "rebalance.json")
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			r := &rebalancer{
+				checkpointPath: test.path(tt.TempDir()),
+				status:         new(payload.Info_Rebalance),
+			}
+			resume, err := r.loadCheckpoint()
+			if (err != nil) != test.want.err {
+				tt.Errorf("got_error: %v,\n\t\t\t\twant error: %v", err, test.want.err)
+			}
+			if resume != test.want.resume {
+				tt.Errorf("got_resume: %v,\n\t\t\t\twant: %v", resume, test.want.resume)
+			}
+		})
+	}
+}
@@ -44,12 +44,16 @@ type run struct {
 	server        starter.Server
 	observability observability.Observability
 	indexer       service.Indexer
+	rebalancer    service.Rebalancer
 }
 
 func New(cfg *config.Data) (r runner.Runner, err error) {
 	eg := errgroup.Get()
 
-	var indexer service.Indexer
+	var (
+		indexer    service.Indexer
+		rebalancer service.Rebalancer
+	)
 
 	cOpts, err := cfg.Indexer.Discoverer.Client.Opts()
 	if err != nil {
@@ -123,6 +127,25 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		return nil, err
 	}
 
+	if rc := cfg.Indexer.Rebalancer; rc != nil && rc.Enabled {
+		rebalancer, err = service.NewRebalancer(
+			service.WithRebalancerErrGroup(eg),
+			service.WithRebalancerDiscoverer(client),
+			service.WithRebalancerDiscovererClient(grpc.New(dopts...)),
+			service.WithRebalancerAgentName(cfg.Indexer.AgentName),
+			service.WithRebalancerAgentNamespace(cfg.Indexer.AgentNamespace),
+			service.WithRebalancerAgentPort(cfg.Indexer.AgentPort),
+			service.WithRebalanceCheckDuration(rc.CheckDuration),
+			service.WithRebalanceThreshold(rc.Threshold),
+			service.WithRebalanceRateLimit(rc.RateLimit),
+			service.WithRebalanceMaxMoves(rc.MaxMoves),
+			service.WithRebalanceCheckpointPath(rc.CheckpointPath),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if cfg.Observability.Enabled {
 		obs, err = observability.NewWithConfig(
 			cfg.Observability,
@@ -133,7 +156,10 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		}
 	}
 
-	idx := handler.New(handler.WithIndexer(indexer))
+	idx := handler.New(
+		handler.WithIndexer(indexer),
+		handler.WithRebalancer(rebalancer),
+	)
 
 	grpcServerOptions := []server.Option{
 		server.WithGRPCRegistFunc(func(srv *grpc.Server) {
@@ -184,6 +210,7 @@ func New(cfg *config.Data) (r runner.Runner, err error) {
 		server:        srv,
 		observability: obs,
 		indexer:       indexer,
+		rebalancer:    rebalancer,
 	}, nil
 }
 
@@ -196,7 +223,7 @@ func (r *run) PreStart(ctx context.Context) error {
 
 func (r *run) Start(ctx context.Context) (<-chan error, error) {
 	ech := make(chan error, 5)
-	var iech, sech, oech <-chan error
+	var iech, rech, sech, oech <-chan error
 	var err error
 	if r.observability != nil {
 		oech = r.observability.Start(ctx)
@@ -208,6 +235,13 @@ func (r *run) Start(ctx context.Context) (<-chan error, error) {
 			return nil, err
 		}
 	}
+	if r.rebalancer != nil {
+		rech, err = r.rebalancer.Start(ctx)
+		if err != nil {
+			close(ech)
+			return nil, err
+		}
+	}
 	sech = r.server.ListenAndServe(ctx)
 	r.eg.Go(safety.RecoverFunc(func() (err error) {
 		defer close(ech)
@@ -217,6 +251,7 @@ func (r *run) Start(ctx context.Context) (<-chan error, error) {
 				return ctx.Err()
 			case err = <-oech:
 			case err = <-iech:
+			case err = <-rech:
 			case err = <-sech:
 			}
 			if err != nil {